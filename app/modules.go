@@ -177,7 +177,7 @@ func appModules(
 		interchainstaking.NewAppModule(appCodec, app.InterchainstakingKeeper),
 		interchainquery.NewAppModule(appCodec, app.InterchainQueryKeeper),
 		participationrewards.NewAppModule(appCodec, app.ParticipationRewardsKeeper),
-		airdrop.NewAppModule(appCodec, app.AirdropKeeper),
+		airdrop.NewAppModule(appCodec, app.AirdropKeeper, app.AccountKeeper, app.BankKeeper),
 		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper),
 		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		supply.NewAppModule(appCodec, app.SupplyKeeper),
@@ -217,7 +217,7 @@ func simulationModules(
 		interchainstaking.NewAppModule(appCodec, app.InterchainstakingKeeper),
 		interchainquery.NewAppModule(appCodec, app.InterchainQueryKeeper),
 		participationrewards.NewAppModule(appCodec, app.ParticipationRewardsKeeper),
-		airdrop.NewAppModule(appCodec, app.AirdropKeeper),
+		airdrop.NewAppModule(appCodec, app.AirdropKeeper, app.AccountKeeper, app.BankKeeper),
 		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper),
 		// supply.NewAppModule(appCodec, app.SupplyKeeper),
 		// wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
@@ -32,6 +32,7 @@ func Upgrades() []Upgrade {
 		{UpgradeName: V010406UpgradeName, CreateUpgradeHandler: V010406UpgradeHandler},
 		{UpgradeName: V010407UpgradeName, CreateUpgradeHandler: V010407UpgradeHandler},
 		{UpgradeName: V010600UpgradeName, CreateUpgradeHandler: V010600UpgradeHandler},
+		{UpgradeName: V010700UpgradeName, CreateUpgradeHandler: V010700UpgradeHandler},
 	}
 }
 
@@ -291,3 +292,17 @@ func V010600UpgradeHandler(
 		return mm.RunMigrations(ctx, configurator, fromVM)
 	}
 }
+
+// V010700UpgradeHandler sets the interchainstaking zone_history_retention_epochs param, added in v1.7.0, which
+// GetParams otherwise panics on.
+func V010700UpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	appKeepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		appKeepers.InterchainstakingKeeper.MigrateParams(ctx)
+
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
@@ -8,10 +8,12 @@ import (
 
 	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
@@ -376,3 +378,34 @@ func (s *AppTestSuite) TestV010600UpgradeHandler() {
 	s.Require().Equal(zone.ChainId, "cosmoshub-4")
 	s.Require().Equal(zone.ConnectionId, "connection-77001")
 }
+
+func (s *AppTestSuite) TestV010700UpgradeHandler() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	params := app.InterchainstakingKeeper.GetParams(ctx)
+	params.UnbondingEnabled = true
+	app.InterchainstakingKeeper.SetParams(ctx, params)
+
+	// params as set before v1.7.0, without zone_history_retention_epochs.
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), append([]byte(icstypes.ModuleName), '/'))
+	paramStore.Delete(icstypes.KeyZoneHistoryRetentionEpochs)
+	s.Require().Panics(func() { app.InterchainstakingKeeper.GetParams(ctx) })
+
+	handler := upgrades.V010700UpgradeHandler(app.mm, app.configurator, &app.AppKeepers)
+	_, err := handler(ctx, types.Plan{}, app.mm.GetVersionMap())
+	s.Require().NoError(err)
+
+	migrated := app.InterchainstakingKeeper.GetParams(ctx)
+	s.Require().Equal(icstypes.DefaultZoneHistoryRetentionEpochs, migrated.ZoneHistoryRetentionEpochs)
+	s.Require().True(migrated.UnbondingEnabled)
+	s.Require().Equal(params.DepositInterval, migrated.DepositInterval)
+	s.Require().True(params.CommissionRate.Equal(migrated.CommissionRate))
+
+	// a retention already set is kept.
+	migrated.ZoneHistoryRetentionEpochs = 30
+	app.InterchainstakingKeeper.SetParams(ctx, migrated)
+	_, err = handler(ctx, types.Plan{}, app.mm.GetVersionMap())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(30), app.InterchainstakingKeeper.GetParams(ctx).ZoneHistoryRetentionEpochs)
+}
@@ -37,6 +37,7 @@ func keysCmd() *cobra.Command {
 	cmd.AddCommand(keysShowCmd())
 	cmd.AddCommand(keysEnumerateCmd())
 	cmd.AddCommand(keysExportCmd())
+	cmd.AddCommand(keysPoolCmd())
 
 	return cmd
 }
@@ -0,0 +1,255 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	qstypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/lens/client"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/config"
+)
+
+const flagSpendLimit = "spend-limit"
+
+// keysPoolCmd represents the `keys pool` command
+func keysPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pool",
+		Aliases: []string{"p"},
+		Short:   "manage the pool of keys signing for a controller chain",
+		Long:    "keys in the pool sign submissions to the controller in rotation; the default chain is the controller unless --chain is passed",
+	}
+
+	cmd.AddCommand(keysPoolAddCmd())
+	cmd.AddCommand(keysPoolRemoveCmd())
+	cmd.AddCommand(keysPoolListCmd())
+	cmd.AddCommand(keysPoolGrantCmd())
+
+	return cmd
+}
+
+// keysPoolAddCmd represents the `keys pool add` command
+func keysPoolAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add [name...]",
+		Aliases: []string{"a"},
+		Short:   "adds keys to the signer pool, creating any not already in the keychain",
+		Args:    cobra.MinimumNArgs(1),
+		Example: strings.TrimSpace(fmt.Sprintf(`
+$ %s keys pool add relayer-0 relayer-1 relayer-2
+$ %s k p a relayer-3 --chain quicksilver`, appName, appName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl := cfg.GetDefaultClient()
+			controller, err := poolController(cl)
+			if err != nil {
+				return err
+			}
+			for _, keyName := range args {
+				if !cl.KeyExists(keyName) {
+					ko, err := cl.AddKey(keyName, 118)
+					if err != nil {
+						return err
+					}
+					out, err := json.Marshal(&ko)
+					if err != nil {
+						return err
+					}
+					fmt.Println(string(out))
+				}
+				if !inPool(controller, keyName) {
+					controller.Keys = append(controller.Keys, keyName)
+				}
+			}
+			return writeConfig()
+		},
+	}
+
+	return cmd
+}
+
+// keysPoolRemoveCmd represents the `keys pool remove` command
+func keysPoolRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove [name]",
+		Aliases: []string{"r"},
+		Short:   "removes a key from the signer pool, leaving it in the keychain",
+		Args:    cobra.ExactArgs(1),
+		Example: strings.TrimSpace(fmt.Sprintf(`
+$ %s keys pool remove relayer-2
+$ %s k p r relayer-2`, appName, appName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			controller, err := poolController(cfg.GetDefaultClient())
+			if err != nil {
+				return err
+			}
+			keyName := args[0]
+			if !inPool(controller, keyName) {
+				return fmt.Errorf("key %s is not in the signer pool of %s", keyName, controller.ChainID)
+			}
+			keys := []string{}
+			for _, key := range controller.Keys {
+				if key != keyName {
+					keys = append(keys, key)
+				}
+			}
+			controller.Keys = keys
+			return writeConfig()
+		},
+	}
+
+	return cmd
+}
+
+// keysPoolListCmd represents the `keys pool list` command
+func keysPoolListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l"},
+		Short:   "lists the keys signing for a controller chain, in rotation order",
+		Args:    cobra.NoArgs,
+		Example: strings.TrimSpace(fmt.Sprintf(`
+$ %s keys pool list
+$ %s k p l --chain quicksilver`, appName, appName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl := cfg.GetDefaultClient()
+			controller, err := poolController(cl)
+			if err != nil {
+				return err
+			}
+			for _, keyName := range controller.GetKeys() {
+				if keyName == "" {
+					keyName = cl.Config.Key
+				}
+				address, err := cl.ShowAddress(keyName)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("key(%s) -> %s\n", keyName, address)
+			}
+			if controller.FeeGranter != "" {
+				fmt.Printf("fees paid by %s\n", controller.FeeGranter)
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// keysPoolGrantCmd represents the `keys pool grant` command
+func keysPoolGrantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "grant [granter]",
+		Aliases: []string{"g"},
+		Short:   "grants each key in the signer pool an allowance to submit responses with fees paid by granter",
+		Long:    "granter must be a key in the keychain; it is recorded as the fee granter of the controller, so that the pool need not be funded",
+		Args:    cobra.ExactArgs(1),
+		Example: strings.TrimSpace(fmt.Sprintf(`
+$ %s keys pool grant treasury
+$ %s k p g treasury --spend-limit 100000000uqck`, appName, appName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl := cfg.GetDefaultClient()
+			controller, err := poolController(cl)
+			if err != nil {
+				return err
+			}
+			granterName := args[0]
+			if !cl.KeyExists(granterName) {
+				return errKeyDoesntExist(granterName)
+			}
+			granter, err := cl.ShowAddress(granterName)
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := cmd.Flags().GetString(flagSpendLimit)
+			if err != nil {
+				return err
+			}
+			basic := &feegrant.BasicAllowance{}
+			if spendLimit != "" {
+				if basic.SpendLimit, err = sdk.ParseCoinsNormalized(spendLimit); err != nil {
+					return err
+				}
+			}
+			// the allowance only pays for the msgs the relayer submits.
+			allowance, err := feegrant.NewAllowedMsgAllowance(basic, []string{sdk.MsgTypeURL(&qstypes.MsgSubmitQueryResponse{}), sdk.MsgTypeURL(&clienttypes.MsgUpdateClient{})})
+			if err != nil {
+				return err
+			}
+			anyAllowance, err := codectypes.NewAnyWithValue(allowance)
+			if err != nil {
+				return err
+			}
+
+			msgs := []sdk.Msg{}
+			for _, keyName := range controller.GetKeys() {
+				if keyName == "" {
+					keyName = cl.Config.Key
+				}
+				grantee, err := cl.ShowAddress(keyName)
+				if err != nil {
+					return err
+				}
+				msgs = append(msgs, &feegrant.MsgGrantAllowance{Granter: granter, Grantee: grantee, Allowance: anyAllowance})
+			}
+
+			cl.Config.Key = granterName
+			res, err := cl.SendMsgs(context.Background(), msgs, "")
+			if err != nil {
+				return err
+			}
+			if res.Code != 0 {
+				return fmt.Errorf("grant failed with code %d: %s", res.Code, res.RawLog)
+			}
+			fmt.Printf("granted %d keys allowances from %s in tx %s\n", len(msgs), granter, res.TxHash)
+
+			controller.FeeGranter = granterName
+			return writeConfig()
+		},
+	}
+	cmd.Flags().String(flagSpendLimit, "", "total fees each key may spend; unlimited if unset")
+
+	return cmd
+}
+
+// poolController returns the controller config of the default chain. Absent
+// explicit controllers, the implicit default controller is made explicit, so
+// that its pool is written to the config.
+func poolController(cl *client.ChainClient) (*config.ControllerConfig, error) {
+	if cl == nil {
+		return nil, fmt.Errorf("default chain (%s) configuration not found", cfg.DefaultChain)
+	}
+	if len(cfg.Controllers) == 0 {
+		cfg.Controllers = cfg.GetControllers()
+	}
+	controller := cfg.GetController(cl.Config.ChainID)
+	if controller == nil {
+		return nil, fmt.Errorf("chain %s is not a controller", cl.Config.ChainID)
+	}
+	return controller, nil
+}
+
+func inPool(controller *config.ControllerConfig, keyName string) bool {
+	for _, key := range controller.Keys {
+		if key == keyName {
+			return true
+		}
+	}
+	return false
+}
+
+// writeConfig writes the config, without the clients instantiated from it.
+func writeConfig() error {
+	out := *cfg
+	out.Cl = nil
+	return config.OverwriteConfig(&out)
+}
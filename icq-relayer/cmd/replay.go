@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/runner"
+	"github.com/spf13/cobra"
+)
+
+func replayCmd() *cobra.Command {
+	var (
+		controller string
+		queryId    string
+		height     int64
+	)
+	cmd := &cobra.Command{
+		Use:   "replay [query-file]",
+		Short: "re-process a query and print the proof, header and messages built for it",
+		Long: `Re-process a query recorded by run --record or run --dry-run, or the query
+with the given id on a controller, and print the proof, header and messages
+the relayer builds in response, without submitting them.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: strings.TrimSpace(fmt.Sprintf(`
+$ %s replay dry-run/queries/query-quicksilver-2-<id>-100.json
+$ %s replay --controller quicksilver-2 --query-id <id> --height 100`, appName, appName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := runner.Query{SourceChainId: controller, QueryId: queryId}
+			switch {
+			case len(args) == 1:
+				var err error
+				if query, err = runner.LoadQuery(args[0]); err != nil {
+					return err
+				}
+			case controller == "" || queryId == "":
+				return fmt.Errorf("either a query file, or --controller and --query-id, must be given")
+			}
+			if height != 0 {
+				query.Height = height
+			}
+			return runner.Replay(cfg, homePath, query, cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().StringVar(&controller, "controller", "", "chain id of the controller of the query")
+	cmd.Flags().StringVar(&queryId, "query-id", "", "id of the query on the controller")
+	cmd.Flags().Int64Var(&height, "height", 0, "height at which to query the host chain (defaults to the recorded or latest height)")
+	return cmd
+}
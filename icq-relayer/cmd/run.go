@@ -19,6 +19,9 @@ Cobra is a CLI library for Go that empowers applications.
 This application is a tool to generate the needed files
 to quickly create a Cobra application.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		if runner.DryRunDir != "" && runner.RecordDir == "" {
+			runner.RecordDir = runner.DryRunDir
+		}
 		err := runner.Run(cfg, cmd.Flag("home").Value.String())
 		if err != nil {
 			return
@@ -28,6 +31,9 @@ to quickly create a Cobra application.`,
 
 func init() {
 	rootCmd.AddCommand(runCmd)
+	runCmd.Flags().StringVar(&runner.DryRunDir, "dry-run", "", "write txs to this directory rather than broadcasting them")
+	runCmd.Flags().BoolVar(&runner.DryRunSign, "sign", false, "sign the txs written on a dry run")
+	runCmd.Flags().StringVar(&runner.RecordDir, "record", "", "record each handled query to this directory, for replay (defaults to the dry run directory)")
 	// Here you will define your flags and configuration settings.
 
 	// Cobra supports Persistent Flags which will work for this command
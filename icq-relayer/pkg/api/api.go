@@ -0,0 +1,273 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrUnknownChain is returned by State when an admin action names a chain
+// that is not configured.
+var ErrUnknownChain = errors.New("unknown chain")
+
+// ChainStatus describes the freshness of a chain client.
+type ChainStatus struct {
+	ChainID    string    `json:"chain_id"`
+	Controller bool      `json:"controller"`
+	Paused     bool      `json:"paused"`
+	Height     int64     `json:"height"`
+	BlockTime  time.Time `json:"block_time"`
+	CheckedAt  time.Time `json:"checked_at"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// PendingQuery is an outstanding query, as last polled from a controller.
+type PendingQuery struct {
+	Controller   string `json:"controller"`
+	ChainID      string `json:"chain_id"`
+	QueryID      string `json:"query_id"`
+	Type         string `json:"type"`
+	CallbackID   string `json:"callback_id"`
+	LastEmission string `json:"last_emission"`
+}
+
+// QueuedMsg is a message awaiting submission to a controller.
+type QueuedMsg struct {
+	ChainID string `json:"chain_id"`
+	Seq     uint64 `json:"seq"`
+	Type    string `json:"type"`
+	QueryID string `json:"query_id,omitempty"`
+}
+
+// DeadLetter is a message that failed to submit to a controller.
+type DeadLetter struct {
+	QueuedMsg
+	Reason string    `json:"reason"`
+	Time   time.Time `json:"time"`
+}
+
+// TxSearch is the progress of a tx search, such as for the deposits to an
+// address, made by a controller against a host chain.
+type TxSearch struct {
+	Controller string `json:"controller"`
+	ChainID    string `json:"chain_id"`
+	// Query is the conjunction of the events searched for.
+	Query string `json:"query"`
+	// Height is that up to which matching txs have been relayed.
+	Height   int64     `json:"height"`
+	Backfill *Backfill `json:"backfill,omitempty"`
+}
+
+// Backfill is a height range, inclusive, of a tx search to be searched again.
+type Backfill struct {
+	FromHeight int64 `json:"from_height"`
+	ToHeight   int64 `json:"to_height"`
+}
+
+// BackfillRequest requests that a range of a tx search be searched again.
+type BackfillRequest struct {
+	Controller string `json:"controller"`
+	ChainID    string `json:"chain_id"`
+	Query      string `json:"query"`
+	Backfill
+}
+
+// State is the view of the relayer served by the API.
+type State interface {
+	Chains() []ChainStatus
+	PendingQueries() []PendingQuery
+	SendQueue() ([]QueuedMsg, error)
+	DeadLetters() ([]DeadLetter, error)
+	SetPaused(chainID string, paused bool) error
+	Reprocess(queryID string) error
+	TxSearches() ([]TxSearch, error)
+	Backfill(req BackfillRequest) error
+}
+
+// Server serves metrics, health checks, relayer state and, if enabled, admin
+// actions over HTTP.
+type Server struct {
+	state        State
+	maxStaleness time.Duration
+	mux          *http.ServeMux
+
+	// Now returns the current time; overridden in tests.
+	Now func() time.Time
+}
+
+func New(state State, metrics http.Handler, maxStaleness time.Duration, enableAdmin bool) *Server {
+	s := &Server{state: state, maxStaleness: maxStaleness, mux: http.NewServeMux(), Now: time.Now}
+
+	s.mux.Handle("/metrics", metrics)
+	s.mux.HandleFunc("/healthz", s.get(s.healthz))
+	s.mux.HandleFunc("/readyz", s.get(s.readyz))
+	s.mux.HandleFunc("/chains", s.get(s.chains))
+	s.mux.HandleFunc("/queries", s.get(s.queries))
+	s.mux.HandleFunc("/send_queue", s.get(s.sendQueue))
+	s.mux.HandleFunc("/dead_letters", s.get(s.deadLetters))
+	s.mux.HandleFunc("/tx_searches", s.get(s.txSearches))
+	if enableAdmin {
+		s.mux.HandleFunc("/admin/chains/", s.post(s.adminChain))
+		s.mux.HandleFunc("/admin/queries/", s.post(s.adminQuery))
+		s.mux.HandleFunc("/admin/tx_searches/backfill", s.post(s.adminBackfill))
+	}
+
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Ready returns true if every chain that is not paused has been checked
+// without error and its latest block is no older than the staleness limit.
+func (s *Server) Ready(chains []ChainStatus) bool {
+	for _, chain := range chains {
+		if chain.Paused {
+			continue
+		}
+		if chain.Error != "" || chain.CheckedAt.IsZero() || s.Now().Sub(chain.BlockTime) > s.maxStaleness {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) healthz(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (s *Server) readyz(w http.ResponseWriter, _ *http.Request) {
+	chains := s.state.Chains()
+	ready := s.Ready(chains)
+	status := http.StatusOK
+	if !ready {
+		status = http.StatusServiceUnavailable
+	}
+	writeJSON(w, status, map[string]interface{}{"ready": ready, "chains": chains})
+}
+
+func (s *Server) chains(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.state.Chains())
+}
+
+func (s *Server) queries(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.state.PendingQueries())
+}
+
+func (s *Server) sendQueue(w http.ResponseWriter, _ *http.Request) {
+	msgs, err := s.state.SendQueue()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, msgs)
+}
+
+func (s *Server) deadLetters(w http.ResponseWriter, _ *http.Request) {
+	letters, err := s.state.DeadLetters()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, letters)
+}
+
+func (s *Server) txSearches(w http.ResponseWriter, _ *http.Request) {
+	searches, err := s.state.TxSearches()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, searches)
+}
+
+// adminChain serves /admin/chains/{chain_id}/{pause,resume}.
+func (s *Server) adminChain(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/admin/chains/"), "/")
+	if len(parts) != 2 || parts[0] == "" || (parts[1] != "pause" && parts[1] != "resume") {
+		http.NotFound(w, r)
+		return
+	}
+	paused := parts[1] == "pause"
+	if err := s.state.SetPaused(parts[0], paused); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, ErrUnknownChain) {
+			status = http.StatusNotFound
+		}
+		writeError(w, status, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"chain_id": parts[0], "paused": paused})
+}
+
+// adminQuery serves /admin/queries/{query_id}/reprocess.
+func (s *Server) adminQuery(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/admin/queries/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] != "reprocess" {
+		http.NotFound(w, r)
+		return
+	}
+	if err := s.state.Reprocess(parts[0]); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"query_id": parts[0], "status": "reprocessing"})
+}
+
+// adminBackfill serves /admin/tx_searches/backfill, taking a BackfillRequest.
+func (s *Server) adminBackfill(w http.ResponseWriter, r *http.Request) {
+	req := BackfillRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if req.Controller == "" || req.ChainID == "" || req.Query == "" {
+		writeError(w, http.StatusBadRequest, errors.New("controller, chain_id and query are required"))
+		return
+	}
+	if req.FromHeight < 1 || req.ToHeight < req.FromHeight {
+		writeError(w, http.StatusBadRequest, errors.New("from_height must be positive and no greater than to_height"))
+		return
+	}
+	if err := s.state.Backfill(req); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, ErrUnknownChain) {
+			status = http.StatusNotFound
+		}
+		writeError(w, status, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, req)
+}
+
+func (s *Server) get(handler http.HandlerFunc) http.HandlerFunc {
+	return method(http.MethodGet, handler)
+}
+
+func (s *Server) post(handler http.HandlerFunc) http.HandlerFunc {
+	return method(http.MethodPost, handler)
+}
+
+func method(m string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != m {
+			w.Header().Set("Allow", m)
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+		handler(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
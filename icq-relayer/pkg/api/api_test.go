@@ -0,0 +1,180 @@
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/api"
+)
+
+var now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type fakeState struct {
+	chains      []api.ChainStatus
+	reprocessed []string
+	backfills   []api.BackfillRequest
+}
+
+func (f *fakeState) Chains() []api.ChainStatus { return f.chains }
+
+func (f *fakeState) PendingQueries() []api.PendingQuery {
+	return []api.PendingQuery{{Controller: "quicksilver-1", ChainID: "cosmoshub-4", QueryID: "abc", Type: "store/bank/key", CallbackID: "allbalances", LastEmission: "10"}}
+}
+
+func (f *fakeState) SendQueue() ([]api.QueuedMsg, error) {
+	return []api.QueuedMsg{{ChainID: "quicksilver-1", Seq: 1, Type: "/quicksilver.interchainquery.v1.MsgSubmitQueryResponse", QueryID: "abc"}}, nil
+}
+
+func (f *fakeState) DeadLetters() ([]api.DeadLetter, error) {
+	return nil, fmt.Errorf("store closed")
+}
+
+func (f *fakeState) SetPaused(chainID string, paused bool) error {
+	for i := range f.chains {
+		if f.chains[i].ChainID == chainID {
+			f.chains[i].Paused = paused
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", api.ErrUnknownChain, chainID)
+}
+
+func (f *fakeState) Reprocess(queryID string) error {
+	f.reprocessed = append(f.reprocessed, queryID)
+	return nil
+}
+
+func (f *fakeState) TxSearches() ([]api.TxSearch, error) {
+	return []api.TxSearch{{Controller: "quicksilver-1", ChainID: "cosmoshub-4", Query: "transfer.recipient='cosmos1deposit'", Height: 100}}, nil
+}
+
+func (f *fakeState) Backfill(req api.BackfillRequest) error {
+	if req.Controller != "quicksilver-1" {
+		return fmt.Errorf("%w: %s", api.ErrUnknownChain, req.Controller)
+	}
+	f.backfills = append(f.backfills, req)
+	return nil
+}
+
+func newServer(state *fakeState, enableAdmin bool) *api.Server {
+	server := api.New(state, http.NotFoundHandler(), time.Minute, enableAdmin)
+	server.Now = func() time.Time { return now }
+	return server
+}
+
+func do(server http.Handler, method, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+	return rec
+}
+
+func doJSON(server http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+	return rec
+}
+
+func TestHealthAndReadiness(t *testing.T) {
+	state := &fakeState{chains: []api.ChainStatus{
+		{ChainID: "quicksilver-1", Controller: true, Height: 100, BlockTime: now.Add(-5 * time.Second), CheckedAt: now},
+		{ChainID: "cosmoshub-4", Height: 200, BlockTime: now.Add(-5 * time.Minute), CheckedAt: now},
+	}}
+	server := newServer(state, false)
+
+	require.Equal(t, http.StatusOK, do(server, http.MethodGet, "/healthz").Code)
+
+	// cosmoshub-4 is stale.
+	rec := do(server, http.MethodGet, "/readyz")
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	var body struct {
+		Ready  bool              `json:"ready"`
+		Chains []api.ChainStatus `json:"chains"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.False(t, body.Ready)
+	require.Len(t, body.Chains, 2)
+
+	// paused chains do not count against readiness.
+	state.chains[1].Paused = true
+	require.Equal(t, http.StatusOK, do(server, http.MethodGet, "/readyz").Code)
+
+	// an unchecked or erroring chain is not ready.
+	state.chains[1] = api.ChainStatus{ChainID: "cosmoshub-4"}
+	require.Equal(t, http.StatusServiceUnavailable, do(server, http.MethodGet, "/readyz").Code)
+	state.chains[1] = api.ChainStatus{ChainID: "cosmoshub-4", BlockTime: now, CheckedAt: now, Error: "connection refused"}
+	require.Equal(t, http.StatusServiceUnavailable, do(server, http.MethodGet, "/readyz").Code)
+}
+
+func TestStateViews(t *testing.T) {
+	server := newServer(&fakeState{}, false)
+
+	rec := do(server, http.MethodGet, "/queries")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var queries []api.PendingQuery
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &queries))
+	require.Equal(t, "abc", queries[0].QueryID)
+
+	rec = do(server, http.MethodGet, "/send_queue")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var msgs []api.QueuedMsg
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &msgs))
+	require.Equal(t, uint64(1), msgs[0].Seq)
+
+	rec = do(server, http.MethodGet, "/dead_letters")
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.Contains(t, rec.Body.String(), "store closed")
+
+	rec = do(server, http.MethodGet, "/tx_searches")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var searches []api.TxSearch
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &searches))
+	require.Equal(t, int64(100), searches[0].Height)
+
+	require.Equal(t, http.StatusMethodNotAllowed, do(server, http.MethodPost, "/queries").Code)
+}
+
+func TestAdmin(t *testing.T) {
+	state := &fakeState{chains: []api.ChainStatus{{ChainID: "cosmoshub-4"}}}
+
+	// admin actions are not served unless enabled.
+	require.Equal(t, http.StatusNotFound, do(newServer(state, false), http.MethodPost, "/admin/chains/cosmoshub-4/pause").Code)
+	require.False(t, state.chains[0].Paused)
+
+	server := newServer(state, true)
+	require.Equal(t, http.StatusMethodNotAllowed, do(server, http.MethodGet, "/admin/chains/cosmoshub-4/pause").Code)
+
+	require.Equal(t, http.StatusOK, do(server, http.MethodPost, "/admin/chains/cosmoshub-4/pause").Code)
+	require.True(t, state.chains[0].Paused)
+	require.Equal(t, http.StatusOK, do(server, http.MethodPost, "/admin/chains/cosmoshub-4/resume").Code)
+	require.False(t, state.chains[0].Paused)
+
+	require.Equal(t, http.StatusNotFound, do(server, http.MethodPost, "/admin/chains/unknown-1/pause").Code)
+	require.Equal(t, http.StatusNotFound, do(server, http.MethodPost, "/admin/chains/cosmoshub-4/halt").Code)
+
+	require.Equal(t, http.StatusOK, do(server, http.MethodPost, "/admin/queries/abc/reprocess").Code)
+	require.Equal(t, []string{"abc"}, state.reprocessed)
+	require.Equal(t, http.StatusNotFound, do(server, http.MethodPost, "/admin/queries/abc").Code)
+}
+
+func TestAdminBackfill(t *testing.T) {
+	state := &fakeState{}
+	server := newServer(state, true)
+	const path = "/admin/tx_searches/backfill"
+
+	rec := doJSON(server, http.MethodPost, path, `{"controller":"quicksilver-1","chain_id":"cosmoshub-4","query":"transfer.recipient='cosmos1deposit'","from_height":10,"to_height":20}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, []api.BackfillRequest{{Controller: "quicksilver-1", ChainID: "cosmoshub-4", Query: "transfer.recipient='cosmos1deposit'", Backfill: api.Backfill{FromHeight: 10, ToHeight: 20}}}, state.backfills)
+
+	require.Equal(t, http.StatusBadRequest, doJSON(server, http.MethodPost, path, `{"controller":"quicksilver-1","chain_id":"cosmoshub-4","query":"q","from_height":20,"to_height":10}`).Code)
+	require.Equal(t, http.StatusBadRequest, doJSON(server, http.MethodPost, path, `{"chain_id":"cosmoshub-4","from_height":1,"to_height":10}`).Code)
+	require.Equal(t, http.StatusBadRequest, doJSON(server, http.MethodPost, path, `not json`).Code)
+	require.Equal(t, http.StatusNotFound, doJSON(server, http.MethodPost, path, `{"controller":"unknown-1","chain_id":"cosmoshub-4","query":"q","from_height":1,"to_height":10}`).Code)
+	require.Len(t, state.backfills, 1)
+}
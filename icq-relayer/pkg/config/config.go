@@ -5,7 +5,9 @@ import (
 	"log"
 	"os"
 	"path"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/viper"
 	"github.com/strangelove-ventures/lens/client"
 	"gopkg.in/yaml.v2"
@@ -62,10 +64,318 @@ type Config struct {
 	DefaultChain   string                               `yaml:"default_chain" json:"default_chain"`
 	AllowedQueries []string                             `yaml:"allowed_queries" json:"allowed_queries"`
 	SkipEpoch      bool                                 `yaml:"skip_epoch" json:"skip_epoch"`
+	Controllers    []*ControllerConfig                  `yaml:"controllers,omitempty" json:"controllers,omitempty"`
+	Scheduler      *SchedulerConfig                     `yaml:"scheduler,omitempty" json:"scheduler,omitempty"`
+	Server         *ServerConfig                        `yaml:"server,omitempty" json:"server,omitempty"`
+	Gas            *GasConfig                           `yaml:"gas,omitempty" json:"gas,omitempty"`
+	TxSearch       *TxSearchConfig                      `yaml:"tx_search,omitempty" json:"tx_search,omitempty"`
+	Cache          *CacheConfig                         `yaml:"cache,omitempty" json:"cache,omitempty"`
 	Chains         map[string]*client.ChainClientConfig `yaml:"chains" json:"chains"`
 	Cl             map[string]*client.ChainClient       `yaml:",omitempty" json:",omitempty"`
 }
 
+// ControllerConfig represents a chain running the interchainquery module, whose
+// query requests the relayer serves and to which it submits responses.
+// Responses are signed by the keys of the pool in Keys, in rotation, or by Key
+// alone absent a pool. If FeeGranter is set, it is the key or address paying
+// the fees of every key under fee allowances. Payers whose balance falls below
+// MinBalance, a coin such as 1000000uqck, are flagged in metrics.
+type ControllerConfig struct {
+	ChainID        string   `yaml:"chain_id" json:"chain_id"`
+	Key            string   `yaml:"key,omitempty" json:"key,omitempty"`
+	Keys           []string `yaml:"keys,omitempty" json:"keys,omitempty"`
+	FeeGranter     string   `yaml:"fee_granter,omitempty" json:"fee_granter,omitempty"`
+	MinBalance     string   `yaml:"min_balance,omitempty" json:"min_balance,omitempty"`
+	AllowedQueries []string `yaml:"allowed_queries" json:"allowed_queries"`
+}
+
+// GetKeys returns the names of the keys signing for the controller: its pool,
+// or else its key, where an empty name is the key of the chain config.
+func (c *ControllerConfig) GetKeys() []string {
+	if len(c.Keys) > 0 {
+		return c.Keys
+	}
+	return []string{c.Key}
+}
+
+// Validate ensures the controller config is well formed.
+func (c *ControllerConfig) Validate() error {
+	seen := make(map[string]bool)
+	for _, key := range c.Keys {
+		if key == "" {
+			return fmt.Errorf("controller chain (%s) has an unnamed pool key", c.ChainID)
+		}
+		if seen[key] {
+			return fmt.Errorf("controller chain (%s) has key %s in its pool more than once", c.ChainID, key)
+		}
+		seen[key] = true
+	}
+	if c.MinBalance != "" {
+		if _, err := sdk.ParseCoinNormalized(c.MinBalance); err != nil {
+			return fmt.Errorf("invalid min_balance for controller chain (%s): %w", c.ChainID, err)
+		}
+	}
+	return nil
+}
+
+// Allows returns true if the controller permits queries of the given type. An
+// empty allow list permits all queries.
+func (c *ControllerConfig) Allows(queryType string) bool {
+	if len(c.AllowedQueries) == 0 {
+		return true
+	}
+	for _, allowed := range c.AllowedQueries {
+		if queryType == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// GetControllers returns the configured controller chains. Absent explicit
+// controllers, the default chain is the sole controller.
+func (c *Config) GetControllers() []*ControllerConfig {
+	if len(c.Controllers) > 0 {
+		return c.Controllers
+	}
+	return []*ControllerConfig{{ChainID: c.DefaultChain, AllowedQueries: c.AllowedQueries}}
+}
+
+// GetController returns the controller config for the given chain, or nil if
+// the chain is not a controller.
+func (c *Config) GetController(chainID string) *ControllerConfig {
+	for _, controller := range c.GetControllers() {
+		if controller.ChainID == chainID {
+			return controller
+		}
+	}
+	return nil
+}
+
+// ServerConfig controls the HTTP server exposing metrics, health checks and
+// the admin API. Chains whose latest block is older than MaxStaleness are
+// reported as not ready. Admin actions are only served if EnableAdmin is set.
+type ServerConfig struct {
+	ListenAddr   string `yaml:"listen_addr" json:"listen_addr"`
+	MaxStaleness string `yaml:"max_staleness" json:"max_staleness"`
+	EnableAdmin  bool   `yaml:"enable_admin" json:"enable_admin"`
+}
+
+// GetServer returns the server config, with unset fields defaulted.
+func (c *Config) GetServer() *ServerConfig {
+	server := ServerConfig{}
+	if c.Server != nil {
+		server = *c.Server
+	}
+	if server.ListenAddr == "" {
+		server.ListenAddr = ":2112"
+	}
+	if server.MaxStaleness == "" {
+		server.MaxStaleness = "60s"
+	}
+	return &server
+}
+
+// GasConfig controls how response batches are sized and priced. Batches are
+// simulated, and split if simulation fails or they exceed MaxGas or
+// MaxTxBytes. Fees are escalated by FeeEscalation, up to MaxFeeMultiplier,
+// on mempool rejection. A zero GasAdjustment uses that of the chain config,
+// and zero MaxGas or MaxTxBytes are unlimited.
+type GasConfig struct {
+	GasAdjustment    float64 `yaml:"gas_adjustment" json:"gas_adjustment"`
+	MaxGas           uint64  `yaml:"max_gas" json:"max_gas"`
+	MaxTxBytes       int     `yaml:"max_tx_bytes" json:"max_tx_bytes"`
+	MaxTxMsgs        int     `yaml:"max_tx_msgs" json:"max_tx_msgs"`
+	FeeEscalation    float64 `yaml:"fee_escalation" json:"fee_escalation"`
+	MaxFeeMultiplier float64 `yaml:"max_fee_multiplier" json:"max_fee_multiplier"`
+	MaxAttempts      int     `yaml:"max_attempts" json:"max_attempts"`
+}
+
+// GetGas returns the gas config, with unset fields defaulted.
+func (c *Config) GetGas() *GasConfig {
+	gas := GasConfig{}
+	if c.Gas != nil {
+		gas = *c.Gas
+	}
+	if gas.MaxTxBytes == 0 {
+		gas.MaxTxBytes = 1000000
+	}
+	if gas.MaxTxMsgs == 0 {
+		gas.MaxTxMsgs = 12
+	}
+	if gas.FeeEscalation == 0 {
+		gas.FeeEscalation = 1.5
+	}
+	if gas.MaxFeeMultiplier == 0 {
+		gas.MaxFeeMultiplier = 4
+	}
+	if gas.MaxAttempts == 0 {
+		gas.MaxAttempts = 3
+	}
+	return &gas
+}
+
+// Validate ensures the gas config is well formed.
+func (g *GasConfig) Validate() error {
+	if g.GasAdjustment < 0 || g.MaxTxBytes < 0 || g.MaxTxMsgs < 0 || g.MaxAttempts < 0 {
+		return fmt.Errorf("gas limits must not be negative")
+	}
+	if g.FeeEscalation < 1 || g.MaxFeeMultiplier < 1 {
+		return fmt.Errorf("fee_escalation and max_fee_multiplier must be at least 1")
+	}
+	return nil
+}
+
+// TxSearchConfig controls how tx searches, such as for deposits, are paged
+// through. Each response carries the txs after the height last relayed, in
+// ascending order, fetched PageLimit at a time; once MaxTxs are collected,
+// only the rest of the last height is added, and the remainder is relayed in
+// response to the next query.
+type TxSearchConfig struct {
+	PageLimit uint64 `yaml:"page_limit" json:"page_limit"`
+	MaxTxs    int    `yaml:"max_txs" json:"max_txs"`
+}
+
+// GetTxSearch returns the tx search config, with unset fields defaulted.
+func (c *Config) GetTxSearch() *TxSearchConfig {
+	search := TxSearchConfig{}
+	if c.TxSearch != nil {
+		search = *c.TxSearch
+	}
+	if search.PageLimit == 0 {
+		search.PageLimit = 100
+	}
+	if search.MaxTxs == 0 {
+		search.MaxTxs = 500
+	}
+	return &search
+}
+
+// Validate ensures the tx search config is well formed.
+func (t *TxSearchConfig) Validate() error {
+	if t.MaxTxs < 0 {
+		return fmt.Errorf("tx_search max_txs must not be negative")
+	}
+	return nil
+}
+
+// CacheConfig controls the caching of data fetched from host chains for client
+// updates. Up to MaxLightBlocks light blocks per chain, with their validator
+// sets, are persisted with the relayer state, so that they are not refetched
+// after a restart; zero is unlimited. Headers built to update a client are
+// reused for HeaderReuse by queries answered at the same height.
+type CacheConfig struct {
+	MaxLightBlocks int    `yaml:"max_light_blocks" json:"max_light_blocks"`
+	HeaderReuse    string `yaml:"header_reuse" json:"header_reuse"`
+}
+
+// GetCache returns the cache config, with unset fields defaulted.
+func (c *Config) GetCache() *CacheConfig {
+	cache := CacheConfig{}
+	if c.Cache != nil {
+		cache = *c.Cache
+	}
+	if cache.MaxLightBlocks == 0 {
+		cache.MaxLightBlocks = 1000
+	}
+	if cache.HeaderReuse == "" {
+		cache.HeaderReuse = "10s"
+	}
+	return &cache
+}
+
+// Validate ensures the cache config is well formed.
+func (c *CacheConfig) Validate() error {
+	if c.MaxLightBlocks < 0 {
+		return fmt.Errorf("cache max_light_blocks must not be negative")
+	}
+	if _, err := time.ParseDuration(c.HeaderReuse); err != nil {
+		return fmt.Errorf("invalid cache header_reuse: %w", err)
+	}
+	return nil
+}
+
+// SchedulerConfig controls how outstanding queries are ordered and throttled.
+// Zero concurrency and rate limits are unlimited.
+type SchedulerConfig struct {
+	HistoricInterval   string         `yaml:"historic_interval" json:"historic_interval"`
+	MaxHistoricQueries int            `yaml:"max_historic_queries" json:"max_historic_queries"`
+	HistoricPageLimit  uint64         `yaml:"historic_page_limit" json:"historic_page_limit"`
+	Priorities         []PriorityRule `yaml:"priorities" json:"priorities"`
+	ChainConcurrency   int            `yaml:"chain_concurrency" json:"chain_concurrency"`
+	TypeConcurrency    map[string]int `yaml:"type_concurrency,omitempty" json:"type_concurrency,omitempty"`
+	RateLimit          float64        `yaml:"rate_limit" json:"rate_limit"`
+	RateBurst          int            `yaml:"rate_burst" json:"rate_burst"`
+}
+
+// PriorityRule assigns a priority to queries matching the given type and/or
+// callback id; an empty field matches any value. Higher priorities are
+// handled first.
+type PriorityRule struct {
+	QueryType  string `yaml:"query_type,omitempty" json:"query_type,omitempty"`
+	CallbackID string `yaml:"callback_id,omitempty" json:"callback_id,omitempty"`
+	Priority   int    `yaml:"priority" json:"priority"`
+}
+
+// Matches returns true if the rule applies to the given query type and
+// callback id.
+func (r PriorityRule) Matches(queryType, callbackID string) bool {
+	return (r.QueryType == "" || r.QueryType == queryType) && (r.CallbackID == "" || r.CallbackID == callbackID)
+}
+
+// DefaultSchedulerConfig returns the scheduler config used when none is
+// given: deposit queries first, with no concurrency or rate limits.
+func DefaultSchedulerConfig() *SchedulerConfig {
+	return &SchedulerConfig{
+		HistoricInterval:   "15s",
+		MaxHistoricQueries: 12,
+		HistoricPageLimit:  500,
+		Priorities: []PriorityRule{
+			{CallbackID: "allbalances", Priority: 1},
+			{CallbackID: "depositinterval", Priority: 1},
+			{CallbackID: "deposittx", Priority: 1},
+		},
+	}
+}
+
+// GetScheduler returns the scheduler config, with unset fields defaulted.
+func (c *Config) GetScheduler() *SchedulerConfig {
+	defaults := DefaultSchedulerConfig()
+	if c.Scheduler == nil {
+		return defaults
+	}
+	scheduler := *c.Scheduler
+	if scheduler.HistoricInterval == "" {
+		scheduler.HistoricInterval = defaults.HistoricInterval
+	}
+	if scheduler.MaxHistoricQueries == 0 {
+		scheduler.MaxHistoricQueries = defaults.MaxHistoricQueries
+	}
+	if scheduler.HistoricPageLimit == 0 {
+		scheduler.HistoricPageLimit = defaults.HistoricPageLimit
+	}
+	if scheduler.Priorities == nil {
+		scheduler.Priorities = defaults.Priorities
+	}
+	return &scheduler
+}
+
+// Validate ensures the scheduler config is well formed.
+func (s *SchedulerConfig) Validate() error {
+	if _, err := time.ParseDuration(s.HistoricInterval); err != nil {
+		return fmt.Errorf("invalid historic_interval: %w", err)
+	}
+	if s.MaxHistoricQueries < 0 || s.ChainConcurrency < 0 || s.RateLimit < 0 || s.RateBurst < 0 {
+		return fmt.Errorf("scheduler limits must not be negative")
+	}
+	for queryType, limit := range s.TypeConcurrency {
+		if limit < 0 {
+			return fmt.Errorf("type_concurrency for %s must not be negative", queryType)
+		}
+	}
+	return nil
+}
+
 func (c *Config) GetDefaultClient() *client.ChainClient {
 	return c.GetClient(c.DefaultChain)
 }
@@ -84,9 +394,52 @@ func ValidateConfig(c *Config) error {
 			return err
 		}
 	}
-	if c.GetDefaultClient() == nil {
+	if len(c.Controllers) == 0 && c.GetDefaultClient() == nil {
 		return fmt.Errorf("default chain (%s) configuration not found", c.DefaultChain)
 	}
+	if err := c.GetScheduler().Validate(); err != nil {
+		return err
+	}
+	if err := c.GetGas().Validate(); err != nil {
+		return err
+	}
+	if err := c.GetTxSearch().Validate(); err != nil {
+		return err
+	}
+	if err := c.GetCache().Validate(); err != nil {
+		return err
+	}
+	if _, err := time.ParseDuration(c.GetServer().MaxStaleness); err != nil {
+		return fmt.Errorf("invalid max_staleness: %w", err)
+	}
+	seen := make(map[string]bool)
+	for _, controller := range c.Controllers {
+		if seen[controller.ChainID] {
+			return fmt.Errorf("controller chain (%s) configured more than once", controller.ChainID)
+		}
+		seen[controller.ChainID] = true
+		if !c.hasChain(controller.ChainID) {
+			return fmt.Errorf("controller chain (%s) configuration not found", controller.ChainID)
+		}
+		if err := controller.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Config) hasChain(chainID string) bool {
+	return c.GetChainConfig(chainID) != nil
+}
+
+// GetChainConfig returns the config of the chain with the given chain id, or
+// nil if the chain is not configured.
+func (c *Config) GetChainConfig(chainID string) *client.ChainClientConfig {
+	for _, chain := range c.Chains {
+		if chain.ChainID == chainID {
+			return chain
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,94 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/strangelove-ventures/lens/client"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/config"
+)
+
+func newConfig() *config.Config {
+	testnet := config.GetQuicksilverConfig("keys", false)
+	testnet.ChainID = "rhye-2"
+	return &config.Config{
+		DefaultChain:   "quicksilver-1",
+		AllowedQueries: []string{"store/bank/key"},
+		Chains: map[string]*client.ChainClientConfig{
+			"quicksilver-1": config.GetQuicksilverConfig("keys", false),
+			"osmosis-1":     config.GetOsmosisConfig("keys", false),
+			"rhye-2":        testnet,
+		},
+		Cl: map[string]*client.ChainClient{"quicksilver-1": {}},
+	}
+}
+
+func TestGetControllersDefault(t *testing.T) {
+	cfg := newConfig()
+
+	controllers := cfg.GetControllers()
+	require.Len(t, controllers, 1)
+	require.Equal(t, "quicksilver-1", controllers[0].ChainID)
+	require.True(t, controllers[0].Allows("store/bank/key"))
+	require.False(t, controllers[0].Allows("cosmos.staking.v1beta1.Query/Validators"))
+	require.Nil(t, cfg.GetController("osmosis-1"))
+}
+
+func TestGetControllersExplicit(t *testing.T) {
+	cfg := newConfig()
+	cfg.Controllers = []*config.ControllerConfig{
+		{ChainID: "quicksilver-1", Key: "mainnet"},
+		{ChainID: "rhye-2", AllowedQueries: []string{"store/bank/key"}},
+	}
+
+	require.Len(t, cfg.GetControllers(), 2)
+	require.True(t, cfg.GetController("quicksilver-1").Allows("cosmos.staking.v1beta1.Query/Validators"))
+	require.False(t, cfg.GetController("rhye-2").Allows("cosmos.staking.v1beta1.Query/Validators"))
+	require.NoError(t, config.ValidateConfig(cfg))
+
+	cfg.Controllers = append(cfg.Controllers, &config.ControllerConfig{ChainID: "unknown-1"})
+	require.ErrorContains(t, config.ValidateConfig(cfg), "controller chain (unknown-1) configuration not found")
+
+	cfg.Controllers = []*config.ControllerConfig{{ChainID: "rhye-2"}, {ChainID: "rhye-2"}}
+	require.ErrorContains(t, config.ValidateConfig(cfg), "configured more than once")
+}
+
+func TestGetScheduler(t *testing.T) {
+	cfg := newConfig()
+	require.Equal(t, config.DefaultSchedulerConfig(), cfg.GetScheduler())
+
+	cfg.Scheduler = &config.SchedulerConfig{ChainConcurrency: 4, Priorities: []config.PriorityRule{{QueryType: "tendermint.Tx", Priority: 1}}}
+	scheduler := cfg.GetScheduler()
+	require.Equal(t, 4, scheduler.ChainConcurrency)
+	require.Equal(t, 12, scheduler.MaxHistoricQueries)
+	require.Equal(t, uint64(500), scheduler.HistoricPageLimit)
+	require.Len(t, scheduler.Priorities, 1)
+	require.NoError(t, config.ValidateConfig(cfg))
+
+	cfg.Scheduler.HistoricInterval = "soon"
+	require.ErrorContains(t, config.ValidateConfig(cfg), "invalid historic_interval")
+
+	cfg.Scheduler.HistoricInterval = ""
+	cfg.Scheduler.TypeConcurrency = map[string]int{"tendermint.Tx": -1}
+	require.ErrorContains(t, config.ValidateConfig(cfg), "must not be negative")
+}
+
+func TestControllerKeyPool(t *testing.T) {
+	cfg := newConfig()
+	cfg.Controllers = []*config.ControllerConfig{{ChainID: "quicksilver-1", Key: "mainnet"}}
+	require.Equal(t, []string{"mainnet"}, cfg.GetController("quicksilver-1").GetKeys())
+
+	cfg.Controllers[0].Keys = []string{"relayer-0", "relayer-1"}
+	cfg.Controllers[0].FeeGranter = "mainnet"
+	cfg.Controllers[0].MinBalance = "1000000uqck"
+	require.Equal(t, []string{"relayer-0", "relayer-1"}, cfg.GetController("quicksilver-1").GetKeys())
+	require.NoError(t, config.ValidateConfig(cfg))
+
+	cfg.Controllers[0].Keys = []string{"relayer-0", "relayer-0"}
+	require.ErrorContains(t, config.ValidateConfig(cfg), "more than once")
+
+	cfg.Controllers[0].Keys = nil
+	cfg.Controllers[0].MinBalance = "plenty"
+	require.ErrorContains(t, config.ValidateConfig(cfg), "invalid min_balance")
+}
@@ -0,0 +1,353 @@
+package mockchain
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v5/modules/core/23-commitment/types"
+	tmclient "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
+	qstypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// GasPerMsg is the gas simulated for each msg broadcast to a controller.
+const GasPerMsg = 50000
+
+// lightClient is a controller's view of a host chain, updated by the headers
+// relayed to it.
+type lightClient struct {
+	chainID string
+	latest  uint64
+	// appHashes are the app hashes of verified headers, by height.
+	appHashes map[uint64][]byte
+}
+
+func (l *lightClient) clone() *lightClient {
+	out := &lightClient{chainID: l.chainID, latest: l.latest, appHashes: make(map[uint64][]byte, len(l.appHashes))}
+	for height, hash := range l.appHashes {
+		out.appHashes[height] = hash
+	}
+	return out
+}
+
+// AddConnection opens connectionID to host over a client trusting the latest
+// header of host.
+func (c *Chain) AddConnection(connectionID, clientID string, host *Chain) {
+	header, err := host.LightBlock(context.Background(), host.Height())
+	if err != nil {
+		panic(err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connections[connectionID] = clientID
+	c.clients[clientID] = &lightClient{
+		chainID:   host.ChainID(),
+		latest:    uint64(header.Height),
+		appHashes: map[uint64][]byte{uint64(header.Height): header.AppHash},
+	}
+}
+
+// AddQuery registers q as outstanding, to be found by polling the controller.
+func (c *Chain) AddQuery(q qstypes.Query) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.queries = append(c.queries, q)
+}
+
+// EmitQuery registers q as outstanding and emits it to subscribers, as when a
+// query is first made.
+func (c *Chain) EmitQuery(q qstypes.Query) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.queries = append(c.queries, q)
+
+	event := coretypes.ResultEvent{
+		Query: "message.module='interchainquery'",
+		Data:  tmtypes.EventDataTx{TxResult: abcitypes.TxResult{Height: c.height()}},
+		Events: map[string][]string{
+			"message.module":        {"interchainquery"},
+			"message.connection_id": {q.ConnectionId},
+			"message.chain_id":      {q.ChainId},
+			"message.query_id":      {q.Id},
+			"message.type":          {q.QueryType},
+			"message.request":       {hex.EncodeToString(q.Request)},
+			"message.height":        {"0"},
+		},
+	}
+	for _, ch := range c.subscribers {
+		ch <- event
+	}
+}
+
+// Queries returns the queries not yet answered.
+func (c *Chain) Queries() []qstypes.Query {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]qstypes.Query{}, c.queries...)
+}
+
+// Submitted returns the msgs of every tx successfully broadcast.
+func (c *Chain) Submitted() []sdk.Msg {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]sdk.Msg{}, c.submitted...)
+}
+
+func (c *Chain) queryRequests(data []byte) ([]byte, error) {
+	req := qstypes.QueryRequestsRequest{}
+	if err := c.cdc.Unmarshal(data, &req); err != nil {
+		return nil, err
+	}
+	res := qstypes.QueryRequestsResponse{}
+	for _, q := range c.queries {
+		if q.ChainId == req.ChainId {
+			res.Queries = append(res.Queries, q)
+		}
+	}
+	return c.cdc.Marshal(&res)
+}
+
+func (c *Chain) ClientID(_ context.Context, connectionID string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clientID, ok := c.connections[connectionID]
+	if !ok {
+		return "", fmt.Errorf("connection %s not found", connectionID)
+	}
+	return clientID, nil
+}
+
+func (c *Chain) ClientHeight(_ context.Context, clientID string) (clienttypes.Height, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	client, ok := c.clients[clientID]
+	if !ok {
+		return clienttypes.Height{}, fmt.Errorf("client %s not found", clientID)
+	}
+	return clienttypes.NewHeight(clienttypes.ParseChainID(client.chainID), client.latest), nil
+}
+
+func (c *Chain) Start() error {
+	return nil
+}
+
+func (c *Chain) Subscribe(_ context.Context, subscriber, _ string) (<-chan coretypes.ResultEvent, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.subscribers[subscriber]; ok {
+		return nil, fmt.Errorf("%s is already subscribed", subscriber)
+	}
+	ch := make(chan coretypes.ResultEvent, 16)
+	c.subscribers[subscriber] = ch
+	return ch, nil
+}
+
+func (c *Chain) Unsubscribe(_ context.Context, subscriber, _ string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch, ok := c.subscribers[subscriber]
+	if !ok {
+		return fmt.Errorf("%s is not subscribed", subscriber)
+	}
+	close(ch)
+	delete(c.subscribers, subscriber)
+	return nil
+}
+
+// Simulate checks msgs as Broadcast would, without applying them.
+func (c *Chain) Simulate(_ context.Context, msgs []sdk.Msg) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, _, err := c.check(msgs); err != nil {
+		return 0, err
+	}
+	return uint64(len(msgs)) * GasPerMsg, nil
+}
+
+// Broadcast applies msgs atomically, failing the tx if any msg is invalid.
+func (c *Chain) Broadcast(_ context.Context, msgs []sdk.Msg, gas uint64, _ sdk.Coins) (*sdk.TxResponse, error) {
+	return c.broadcast(msgs, gas, c.signer)
+}
+
+func (c *Chain) broadcast(msgs []sdk.Msg, gas uint64, signer string) (*sdk.TxResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clients, answered, err := c.check(msgs)
+	if err != nil {
+		return &sdk.TxResponse{Height: c.height(), Code: 1, Codespace: "mockchain", RawLog: err.Error()}, err
+	}
+
+	c.clients = clients
+	remaining := c.queries[:0]
+	for _, q := range c.queries {
+		if !answered[q.Id] {
+			remaining = append(remaining, q)
+		}
+	}
+	c.queries = remaining
+	c.submitted = append(c.submitted, msgs...)
+	c.signers = append(c.signers, signer)
+	return &sdk.TxResponse{Height: c.height(), GasWanted: int64(gas), GasUsed: int64(uint64(len(msgs)) * GasPerMsg)}, nil
+}
+
+// check validates msgs in order against a copy of the light clients,
+// returning the updated clients and the ids of the queries answered.
+func (c *Chain) check(msgs []sdk.Msg) (map[string]*lightClient, map[string]bool, error) {
+	clients := make(map[string]*lightClient, len(c.clients))
+	for id, client := range c.clients {
+		clients[id] = client.clone()
+	}
+	answered := map[string]bool{}
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, nil, err
+		}
+		switch msg := msg.(type) {
+		case *clienttypes.MsgUpdateClient:
+			if err := updateClient(clients, msg); err != nil {
+				return nil, nil, err
+			}
+		case *qstypes.MsgSubmitQueryResponse:
+			q, found := c.query(msg.QueryId)
+			if !found || answered[msg.QueryId] {
+				// as on chain, responses to settled queries are ignored.
+				continue
+			}
+			if err := c.verifyResponse(clients, q, msg); err != nil {
+				return nil, nil, fmt.Errorf("query %s: %w", msg.QueryId, err)
+			}
+			answered[msg.QueryId] = true
+		default:
+			return nil, nil, fmt.Errorf("unexpected msg %s", sdk.MsgTypeURL(msg))
+		}
+	}
+	return clients, answered, nil
+}
+
+func (c *Chain) query(id string) (qstypes.Query, bool) {
+	for _, q := range c.queries {
+		if q.Id == id {
+			return q, true
+		}
+	}
+	return qstypes.Query{}, false
+}
+
+func updateClient(clients map[string]*lightClient, msg *clienttypes.MsgUpdateClient) error {
+	client, ok := clients[msg.ClientId]
+	if !ok {
+		return fmt.Errorf("client %s not found", msg.ClientId)
+	}
+	exported, err := clienttypes.UnpackHeader(msg.Header)
+	if err != nil {
+		return err
+	}
+	header, ok := exported.(*tmclient.Header)
+	if !ok {
+		return fmt.Errorf("unexpected header %T", exported)
+	}
+	if err := header.ValidateBasic(); err != nil {
+		return err
+	}
+	if header.TrustedHeight.RevisionHeight > client.latest {
+		return fmt.Errorf("trusted height %d is beyond latest height %d", header.TrustedHeight.RevisionHeight, client.latest)
+	}
+	signed, err := verifyHeader(client.chainID, header)
+	if err != nil {
+		return err
+	}
+
+	height := uint64(signed.Height)
+	client.appHashes[height] = signed.AppHash
+	if height > client.latest {
+		client.latest = height
+	}
+	return nil
+}
+
+// verifyHeader checks that header is a header of chainID, committed to by its
+// validator set.
+func verifyHeader(chainID string, header *tmclient.Header) (*tmtypes.SignedHeader, error) {
+	if header == nil || header.SignedHeader == nil || header.ValidatorSet == nil {
+		return nil, fmt.Errorf("header is incomplete")
+	}
+	signed, err := tmtypes.SignedHeaderFromProto(header.SignedHeader)
+	if err != nil {
+		return nil, err
+	}
+	if err := signed.ValidateBasic(chainID); err != nil {
+		return nil, err
+	}
+	vals, err := tmtypes.ValidatorSetFromProto(header.ValidatorSet)
+	if err != nil {
+		return nil, err
+	}
+	if err := vals.VerifyCommitLight(chainID, signed.Commit.BlockID, signed.Height, signed.Commit); err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+// verifyResponse verifies the proof of a response, as the interchainquery
+// module does: key queries against the app hash of the header following the
+// queried height, and txs against the data hash of the relayed header.
+func (c *Chain) verifyResponse(clients map[string]*lightClient, q qstypes.Query, msg *qstypes.MsgSubmitQueryResponse) error {
+	clientID, ok := c.connections[q.ConnectionId]
+	if !ok {
+		return fmt.Errorf("connection %s not found", q.ConnectionId)
+	}
+	client := clients[clientID]
+	if client.chainID != msg.ChainId {
+		return fmt.Errorf("response for %s does not match client of %s", msg.ChainId, client.chainID)
+	}
+
+	pathParts := strings.Split(q.QueryType, "/")
+	switch {
+	case pathParts[len(pathParts)-1] == "key":
+		if msg.ProofOps == nil {
+			return fmt.Errorf("unable to validate proof; no proof submitted")
+		}
+		appHash, ok := client.appHashes[uint64(msg.Height)+1]
+		if !ok {
+			return fmt.Errorf("unable to fetch consensus state for height %d", msg.Height+1)
+		}
+		proof, err := commitmenttypes.ConvertProofs(msg.ProofOps)
+		if err != nil {
+			return err
+		}
+		root := commitmenttypes.NewMerkleRoot(appHash)
+		path := commitmenttypes.NewMerklePath(pathParts[1], url.PathEscape(string(q.Request)))
+		if len(msg.Result) != 0 {
+			return proof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, path, msg.Result)
+		}
+		return proof.VerifyNonMembership(commitmenttypes.GetSDKSpecs(), root, path)
+
+	case q.QueryType == "tendermint.Tx":
+		res := qstypes.GetTxWithProofResponse{}
+		if err := c.cdc.Unmarshal(msg.Result, &res); err != nil {
+			return err
+		}
+		signed, err := verifyHeader(client.chainID, res.Header)
+		if err != nil {
+			return err
+		}
+		if res.Proof == nil {
+			return fmt.Errorf("no tx proof submitted")
+		}
+		proof, err := tmtypes.TxProofFromProto(*res.Proof)
+		if err != nil {
+			return err
+		}
+		return proof.Validate(signed.DataHash)
+	}
+	return nil
+}
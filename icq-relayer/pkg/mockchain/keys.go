@@ -0,0 +1,90 @@
+package mockchain
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/submitter"
+)
+
+// KeyAddress returns the address of the named key; an empty name is the key
+// that signs by default.
+func (c *Chain) KeyAddress(name string) string {
+	if name == "" {
+		return c.signer
+	}
+	return sdk.AccAddress(tmhash.SumTruncated([]byte(c.chainID + "/" + name))).String()
+}
+
+// Key returns the named key, with a client broadcasting txs signed by it,
+// which are rejected unless their msgs are all to be signed by the key. A fee
+// granter may be a key or an address.
+func (c *Chain) Key(name, feeGranter string) (submitter.Key, error) {
+	key := submitter.Key{Name: name, Address: c.KeyAddress(name)}
+	if feeGranter != "" {
+		key.FeeGranter = feeGranter
+		if _, err := sdk.AccAddressFromBech32(feeGranter); err != nil {
+			key.FeeGranter = c.KeyAddress(feeGranter)
+		}
+	}
+	key.Client = keyClient{chain: c, address: key.Address}
+	return key, nil
+}
+
+// Signers returns the signer of every tx successfully broadcast.
+func (c *Chain) Signers() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string{}, c.signers...)
+}
+
+// SetBalance sets the balance of address, as served by bank balance queries.
+func (c *Chain) SetBalance(address string, coins ...sdk.Coin) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.balances[address] = sdk.NewCoins(coins...)
+}
+
+func (c *Chain) balance(data []byte) ([]byte, error) {
+	req := banktypes.QueryBalanceRequest{}
+	if err := c.cdc.Unmarshal(data, &req); err != nil {
+		return nil, err
+	}
+	balance := sdk.NewCoin(req.Denom, c.balances[req.Address].AmountOf(req.Denom))
+	return c.cdc.Marshal(&banktypes.QueryBalanceResponse{Balance: &balance})
+}
+
+// keyClient broadcasts to a chain as one of its keys.
+type keyClient struct {
+	chain   *Chain
+	address string
+}
+
+func (k keyClient) Simulate(ctx context.Context, msgs []sdk.Msg) (uint64, error) {
+	if err := k.checkSigners(msgs); err != nil {
+		return 0, err
+	}
+	return k.chain.Simulate(ctx, msgs)
+}
+
+func (k keyClient) Broadcast(_ context.Context, msgs []sdk.Msg, gas uint64, _ sdk.Coins) (*sdk.TxResponse, error) {
+	if err := k.checkSigners(msgs); err != nil {
+		return nil, err
+	}
+	return k.chain.broadcast(msgs, gas, k.address)
+}
+
+func (k keyClient) checkSigners(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if signer.String() != k.address {
+				return fmt.Errorf("%s must be signed by %s, not %s", sdk.MsgTypeURL(msg), signer, k.address)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,406 @@
+// Package mockchain provides in-process chains for offline relayer tests.
+//
+// A Chain serves ABCI store queries with real ICS-23 proofs from an in-memory
+// IAVL multistore, signed light blocks, tx inclusion proofs and tx search. It
+// can also act as a controller: emitting interchain query events, and
+// verifying the client updates and query responses broadcast to it as the
+// interchainquery module would.
+package mockchain
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	tmclient "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
+	qstypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Handler serves an ABCI query path not otherwise served by a Chain.
+type Handler func(req abcitypes.RequestQuery) ([]byte, error)
+
+// Chain is an in-process chain with a single validator, producing a block
+// each time Commit is called.
+type Chain struct {
+	mu      sync.Mutex
+	chainID string
+	cdc     codec.Codec
+	signer  string
+
+	ms   *rootmulti.Store
+	keys map[string]*storetypes.KVStoreKey
+
+	pv      tmtypes.PrivValidator
+	valSet  *tmtypes.ValidatorSet
+	genesis time.Time
+	blocks  []*tmtypes.Block
+	commits []*tmtypes.Commit
+
+	pendingTxs tmtypes.Txs
+	pendingEvs [][]string
+	txs        map[string]indexedTx
+	txOrder    []string
+	handlers   map[string]Handler
+
+	queries     []qstypes.Query
+	subscribers map[string]chan coretypes.ResultEvent
+	connections map[string]string
+	clients     map[string]*lightClient
+	submitted   []sdk.Msg
+	signers     []string
+	balances    map[string]sdk.Coins
+}
+
+type indexedTx struct {
+	height int64
+	index  int
+	tx     tmtypes.Tx
+	events []string
+}
+
+// New returns a chain with the given IAVL stores mounted, at height 1.
+func New(chainID string, stores ...string) *Chain {
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	keys := make(map[string]*storetypes.KVStoreKey, len(stores))
+	for _, name := range stores {
+		keys[name] = storetypes.NewKVStoreKey(name)
+		ms.MountStoreWithDB(keys[name], storetypes.StoreTypeIAVL, nil)
+	}
+	if err := ms.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	pv := tmtypes.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	if err != nil {
+		panic(err)
+	}
+
+	c := &Chain{
+		chainID:     chainID,
+		cdc:         Codec(),
+		signer:      sdk.AccAddress(tmhash.SumTruncated([]byte(chainID))).String(),
+		ms:          ms,
+		keys:        keys,
+		pv:          pv,
+		valSet:      tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 10)}),
+		genesis:     time.Now().UTC().Truncate(time.Second),
+		txs:         map[string]indexedTx{},
+		handlers:    map[string]Handler{},
+		subscribers: map[string]chan coretypes.ResultEvent{},
+		connections: map[string]string{},
+		clients:     map[string]*lightClient{},
+		balances:    map[string]sdk.Coins{},
+	}
+	c.commit()
+	return c
+}
+
+// Codec returns a codec with the interfaces of the msgs relayed registered.
+func Codec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	qstypes.RegisterInterfaces(registry)
+	clienttypes.RegisterInterfaces(registry)
+	tmclient.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+// Set writes value to key in the named store, to be committed by the next
+// block.
+func (c *Chain) Set(store string, key, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ms.GetCommitKVStore(c.keys[store]).Set(key, value)
+}
+
+// AddTx includes tx in the next block, indexed for tx search under the given
+// events, each of the form "type.attribute='value'". It returns the tx hash.
+func (c *Chain) AddTx(tx []byte, events ...string) []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pendingTxs = append(c.pendingTxs, tx)
+	c.pendingEvs = append(c.pendingEvs, events)
+	return tmtypes.Tx(tx).Hash()
+}
+
+// Handle serves path with handler.
+func (c *Chain) Handle(path string, handler Handler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.handlers[path] = handler
+}
+
+// Commit produces a block containing any added txs, commits the state
+// written since the last block, and returns the new height.
+func (c *Chain) Commit() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.commit()
+}
+
+// Height returns the latest block height.
+func (c *Chain) Height() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.height()
+}
+
+func (c *Chain) height() int64 {
+	return int64(len(c.blocks))
+}
+
+// commit produces the next block. As in tendermint, the app hash in the
+// header of block h commits to the state after block h-1; the state after
+// block h, as queried at height h, is thus proven by the header of block h+1.
+func (c *Chain) commit() int64 {
+	height := c.height() + 1
+	lastCommit := &tmtypes.Commit{}
+	if height > 1 {
+		lastCommit = c.commits[height-2]
+	}
+
+	block := tmtypes.MakeBlock(height, c.pendingTxs, lastCommit, nil)
+	block.Header.Populate(
+		tmversion.Consensus{Block: version.BlockProtocol},
+		c.chainID,
+		c.genesis.Add(time.Duration(height)*time.Second),
+		lastCommit.BlockID,
+		c.valSet.Hash(),
+		c.valSet.Hash(),
+		nil,
+		c.ms.LastCommitID().Hash,
+		nil,
+		c.valSet.Proposer.Address,
+	)
+	blockID := tmtypes.BlockID{Hash: block.Hash(), PartSetHeader: block.MakePartSet(tmtypes.BlockPartSizeBytes).Header()}
+	voteSet := tmtypes.NewVoteSet(c.chainID, height, 0, tmproto.PrecommitType, c.valSet)
+	commit, err := tmtypes.MakeCommit(blockID, height, 0, voteSet, []tmtypes.PrivValidator{c.pv}, block.Time)
+	if err != nil {
+		panic(err)
+	}
+
+	for i, tx := range c.pendingTxs {
+		hash := hex.EncodeToString(tx.Hash())
+		c.txs[hash] = indexedTx{height: height, index: i, tx: tx, events: c.pendingEvs[i]}
+		c.txOrder = append(c.txOrder, hash)
+	}
+	c.pendingTxs, c.pendingEvs = nil, nil
+
+	c.blocks = append(c.blocks, block)
+	c.commits = append(c.commits, commit)
+	c.ms.Commit()
+	return height
+}
+
+func (c *Chain) ChainID() string {
+	return c.chainID
+}
+
+func (c *Chain) Codec() codec.Codec {
+	return c.cdc
+}
+
+func (c *Chain) Signer() (string, error) {
+	return c.signer, nil
+}
+
+// QueryABCI serves store queries under /store/, tx search, balances, the
+// outstanding queries of a controller and any handled paths.
+func (c *Chain) QueryABCI(_ context.Context, req abcitypes.RequestQuery) (abcitypes.ResponseQuery, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if req.Height == 0 {
+		req.Height = c.height()
+	}
+	if req.Height > c.height() {
+		return abcitypes.ResponseQuery{}, fmt.Errorf("height %d is not available; latest height is %d", req.Height, c.height())
+	}
+
+	if strings.HasPrefix(req.Path, "/store/") {
+		req.Path = strings.TrimPrefix(req.Path, "/store")
+		res := c.ms.Query(req)
+		if !res.IsOK() {
+			return res, fmt.Errorf("query failed with code %d: %s", res.Code, res.Log)
+		}
+		return res, nil
+	}
+
+	var value []byte
+	var err error
+	switch req.Path {
+	case "/cosmos.tx.v1beta1.Service/GetTxsEvent":
+		value, err = c.searchTxs(req.Data)
+	case "/quicksilver.interchainquery.v1.QuerySrvr/Queries":
+		value, err = c.queryRequests(req.Data)
+	case "/cosmos.bank.v1beta1.Query/Balance":
+		value, err = c.balance(req.Data)
+	default:
+		handler, ok := c.handlers[req.Path]
+		if !ok {
+			return abcitypes.ResponseQuery{}, fmt.Errorf("unknown query path %s", req.Path)
+		}
+		value, err = handler(req)
+	}
+	if err != nil {
+		return abcitypes.ResponseQuery{}, err
+	}
+	return abcitypes.ResponseQuery{Value: value, Height: req.Height}, nil
+}
+
+// searchTxs returns the txs indexed under all of the requested events, oldest
+// first unless otherwise ordered, a page at a time. Events may also bound the
+// height, as "tx.height>=n" or "tx.height<=n".
+func (c *Chain) searchTxs(data []byte) ([]byte, error) {
+	req := txtypes.GetTxsEventRequest{}
+	if err := c.cdc.Unmarshal(data, &req); err != nil {
+		return nil, err
+	}
+
+	matched := []indexedTx{}
+	for _, hash := range c.txOrder {
+		tx := c.txs[hash]
+		ok, err := matches(tx, req.Events)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, tx)
+		}
+	}
+	if req.OrderBy == txtypes.OrderBy_ORDER_BY_DESC {
+		sort.SliceStable(matched, func(i, j int) bool { return matched[i].height > matched[j].height })
+	}
+	total := uint64(len(matched))
+	if limit := req.Limit; limit > 0 {
+		page := req.Page
+		if page == 0 {
+			page = 1
+		}
+		start := (page - 1) * limit
+		if start > total {
+			start = total
+		}
+		end := start + limit
+		if end > total {
+			end = total
+		}
+		matched = matched[start:end]
+	}
+
+	res := txtypes.GetTxsEventResponse{Total: total}
+	for _, tx := range matched {
+		decoded := &txtypes.Tx{}
+		if err := c.cdc.Unmarshal(tx.tx, decoded); err != nil {
+			return nil, err
+		}
+		res.Txs = append(res.Txs, decoded)
+		res.TxResponses = append(res.TxResponses, &sdk.TxResponse{Height: tx.height, TxHash: strings.ToUpper(hex.EncodeToString(tx.tx.Hash()))})
+	}
+	return c.cdc.Marshal(&res)
+}
+
+// matches returns true if tx is indexed under, or within the height bounds
+// of, every wanted event.
+func matches(tx indexedTx, wanted []string) (bool, error) {
+	for _, want := range wanted {
+		if bound, ok := strings.CutPrefix(want, "tx.height"); ok {
+			if len(bound) < 3 {
+				return false, fmt.Errorf("invalid height bound %s", want)
+			}
+			op := bound[:2]
+			height, err := strconv.ParseInt(bound[2:], 10, 64)
+			if err != nil || (op != ">=" && op != "<=") {
+				return false, fmt.Errorf("invalid height bound %s", want)
+			}
+			if (op == ">=" && tx.height < height) || (op == "<=" && tx.height > height) {
+				return false, nil
+			}
+			continue
+		}
+		found := false
+		for _, event := range tx.events {
+			if event == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Block returns the block at the given height, or the latest if nil.
+func (c *Chain) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	h := c.height()
+	if height != nil {
+		h = *height
+	}
+	if h < 1 || h > c.height() {
+		return nil, fmt.Errorf("block %d is not available; latest height is %d", h, c.height())
+	}
+	return &coretypes.ResultBlock{BlockID: c.commits[h-1].BlockID, Block: c.blocks[h-1]}, nil
+}
+
+func (c *Chain) Status(_ context.Context) (*coretypes.ResultStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	latest := c.blocks[len(c.blocks)-1]
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{
+		LatestBlockHash:   latest.Hash(),
+		LatestAppHash:     latest.AppHash,
+		LatestBlockHeight: latest.Height,
+		LatestBlockTime:   latest.Time,
+	}}, nil
+}
+
+// LightBlock returns the signed header and validator set at height.
+func (c *Chain) LightBlock(_ context.Context, height int64) (*tmtypes.LightBlock, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if height < 1 || height > c.height() {
+		return nil, fmt.Errorf("light block %d is not available; latest height is %d", height, c.height())
+	}
+	return &tmtypes.LightBlock{
+		SignedHeader: &tmtypes.SignedHeader{Header: &c.blocks[height-1].Header, Commit: c.commits[height-1]},
+		ValidatorSet: c.valSet.Copy(),
+	}, nil
+}
+
+// Tx returns the inclusion proof and height of a committed tx.
+func (c *Chain) Tx(_ context.Context, hash []byte) (tmtypes.TxProof, int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tx, ok := c.txs[hex.EncodeToString(hash)]
+	if !ok {
+		return tmtypes.TxProof{}, 0, fmt.Errorf("tx %X not found", hash)
+	}
+	return c.blocks[tx.height-1].Data.Txs.Proof(tx.index), tx.height, nil
+}
@@ -0,0 +1,97 @@
+package mockchain_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	tmclient "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
+	qstypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/mockchain"
+)
+
+var ctx = context.Background()
+
+func updateClient(t *testing.T, host, controller *mockchain.Chain, height int64) sdk.Msg {
+	t.Helper()
+	block, err := host.LightBlock(ctx, height)
+	require.NoError(t, err)
+	vals, err := block.ValidatorSet.ToProto()
+	require.NoError(t, err)
+	trusted, err := controller.ClientHeight(ctx, "07-tendermint-0")
+	require.NoError(t, err)
+
+	header, err := clienttypes.PackHeader(&tmclient.Header{SignedHeader: block.SignedHeader.ToProto(), ValidatorSet: vals, TrustedHeight: trusted, TrustedValidators: vals})
+	require.NoError(t, err)
+	signer, _ := controller.Signer()
+	return &clienttypes.MsgUpdateClient{ClientId: "07-tendermint-0", Header: header, Signer: signer}
+}
+
+func TestControllerVerifiesKeyProofs(t *testing.T) {
+	host := mockchain.New("mockhost-1", "bank")
+	controller := mockchain.New("quicksilver-1")
+	controller.AddConnection("connection-0", "07-tendermint-0", host)
+
+	host.Set("bank", []byte("balance/alice"), []byte("100uatom"))
+	height := host.Commit()
+	host.Commit()
+
+	res, err := host.QueryABCI(ctx, abcitypes.RequestQuery{Path: "/store/bank/key", Data: []byte("balance/alice"), Height: height, Prove: true})
+	require.NoError(t, err)
+	require.Equal(t, []byte("100uatom"), res.Value)
+	require.Equal(t, height, res.Height)
+
+	id := sha256.Sum256([]byte("alice"))
+	q := qstypes.Query{Id: hex.EncodeToString(id[:]), ConnectionId: "connection-0", ChainId: "mockhost-1", QueryType: "store/bank/key", Request: []byte("balance/alice")}
+	controller.AddQuery(q)
+	signer, _ := controller.Signer()
+	response := func(result []byte) sdk.Msg {
+		return &qstypes.MsgSubmitQueryResponse{ChainId: "mockhost-1", QueryId: q.Id, Result: result, Height: res.Height, ProofOps: res.ProofOps, FromAddress: signer}
+	}
+
+	// the proof is against the app hash of the next header, which the client
+	// has not yet been updated with.
+	_, err = controller.Broadcast(ctx, []sdk.Msg{response(res.Value)}, 0, nil)
+	require.ErrorContains(t, err, "unable to fetch consensus state")
+
+	update := updateClient(t, host, controller, height+1)
+	_, err = controller.Broadcast(ctx, []sdk.Msg{update, response([]byte("999uatom"))}, 0, nil)
+	require.Error(t, err)
+	// failed txs are not applied.
+	clientHeight, err := controller.ClientHeight(ctx, "07-tendermint-0")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), clientHeight.RevisionHeight)
+	require.Len(t, controller.Queries(), 1)
+
+	_, err = controller.Simulate(ctx, []sdk.Msg{update, response(res.Value)})
+	require.NoError(t, err)
+	_, err = controller.Broadcast(ctx, []sdk.Msg{update, response(res.Value)}, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, controller.Queries())
+	require.Len(t, controller.Submitted(), 2)
+}
+
+func TestTxProofs(t *testing.T) {
+	host := mockchain.New("mockhost-1")
+	hash := host.AddTx([]byte("tx"))
+	height := host.Commit()
+
+	proof, txHeight, err := host.Tx(ctx, hash)
+	require.NoError(t, err)
+	require.Equal(t, height, txHeight)
+
+	block, err := host.LightBlock(ctx, txHeight)
+	require.NoError(t, err)
+	require.NoError(t, proof.Validate(block.DataHash))
+	require.NoError(t, block.ValidateBasic("mockhost-1"))
+
+	_, _, err = host.Tx(ctx, []byte("unknown"))
+	require.Error(t, err)
+}
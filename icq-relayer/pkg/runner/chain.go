@@ -0,0 +1,183 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	lensclient "github.com/strangelove-ventures/lens/client"
+	lensquery "github.com/strangelove-ventures/lens/client/query"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/submitter"
+)
+
+// Chain is everything the runner needs of a chain, whether it is queried as a
+// host or relayed to as a controller. Production chains are backed by lens
+// clients; tests substitute in-process mock chains.
+type Chain interface {
+	submitter.Client
+
+	ChainID() string
+	Codec() codec.Codec
+	// Signer returns the address that signs txs broadcast to the chain.
+	Signer() (string, error)
+	// Key returns the named key, with a client signing txs as it, whose fees
+	// are paid by feeGranter, a key or address, if set. An empty name is the
+	// key that signs by default.
+	Key(name, feeGranter string) (submitter.Key, error)
+
+	QueryABCI(ctx context.Context, req abcitypes.RequestQuery) (abcitypes.ResponseQuery, error)
+	// Block returns the block at the given height, or the latest if nil.
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+	LightBlock(ctx context.Context, height int64) (*tmtypes.LightBlock, error)
+	// Tx returns the inclusion proof and height of the tx with the given hash.
+	Tx(ctx context.Context, hash []byte) (tmtypes.TxProof, int64, error)
+
+	// ClientID returns the IBC client underlying the given connection.
+	ClientID(ctx context.Context, connectionID string) (string, error)
+	// ClientHeight returns the latest height of the given IBC client.
+	ClientHeight(ctx context.Context, clientID string) (clienttypes.Height, error)
+
+	Start() error
+	Subscribe(ctx context.Context, subscriber, query string) (<-chan coretypes.ResultEvent, error)
+	Unsubscribe(ctx context.Context, subscriber, query string) error
+}
+
+// LensChain adapts a lens chain client to Chain.
+type LensChain struct {
+	client *lensclient.ChainClient
+	submitter.LensClient
+}
+
+var _ Chain = (*LensChain)(nil)
+
+func NewLensChain(client *lensclient.ChainClient) *LensChain {
+	return &LensChain{client: client, LensClient: submitter.LensClient{ChainClient: client, Memo: VERSION}}
+}
+
+func (c *LensChain) ChainID() string {
+	return c.client.Config.ChainID
+}
+
+func (c *LensChain) Codec() codec.Codec {
+	return c.client.Codec.Marshaler
+}
+
+func (c *LensChain) Signer() (string, error) {
+	from, err := c.client.GetKeyAddress()
+	if err != nil {
+		return "", err
+	}
+	return c.client.EncodeBech32AccAddr(from)
+}
+
+func (c *LensChain) Key(name, feeGranter string) (submitter.Key, error) {
+	if name == "" {
+		name = c.client.Config.Key
+	}
+	if !c.client.KeyExists(name) {
+		return submitter.Key{}, fmt.Errorf("no key %s for chain %s", name, c.ChainID())
+	}
+	address, err := c.address(name)
+	if err != nil {
+		return submitter.Key{}, err
+	}
+	client := submitter.LensClient{ChainClient: c.client, Memo: VERSION, Key: name, Sequence: &submitter.Sequence{}}
+	key := submitter.Key{Name: name}
+	if key.Address, err = c.client.EncodeBech32AccAddr(address); err != nil {
+		return submitter.Key{}, err
+	}
+	if feeGranter != "" {
+		if client.FeeGranter, err = c.address(feeGranter); err != nil {
+			return submitter.Key{}, err
+		}
+		if key.FeeGranter, err = c.client.EncodeBech32AccAddr(client.FeeGranter); err != nil {
+			return submitter.Key{}, err
+		}
+	}
+	key.Client = client
+	return key, nil
+}
+
+// address returns the address of the given key, or the given address itself.
+func (c *LensChain) address(keyOrAddress string) (sdk.AccAddress, error) {
+	if !c.client.KeyExists(keyOrAddress) {
+		return c.client.DecodeBech32AccAddr(keyOrAddress)
+	}
+	key, err := c.client.Keybase.Key(keyOrAddress)
+	if err != nil {
+		return nil, err
+	}
+	return key.GetAddress()
+}
+
+func (c *LensChain) QueryABCI(ctx context.Context, req abcitypes.RequestQuery) (abcitypes.ResponseQuery, error) {
+	return c.client.QueryABCI(ctx, req)
+}
+
+func (c *LensChain) Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	return c.client.RPCClient.Block(ctx, height)
+}
+
+func (c *LensChain) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	return c.client.RPCClient.Status(ctx)
+}
+
+func (c *LensChain) LightBlock(ctx context.Context, height int64) (*tmtypes.LightBlock, error) {
+	return c.client.LightProvider.LightBlock(ctx, height)
+}
+
+func (c *LensChain) Tx(ctx context.Context, hash []byte) (tmtypes.TxProof, int64, error) {
+	return Tx(ctx, c.client, hash)
+}
+
+func (c *LensChain) ClientID(_ context.Context, connectionID string) (string, error) {
+	querier := lensquery.Query{Client: c.client, Options: lensquery.DefaultOptions()}
+	connection, err := querier.Ibc_Connection(connectionID)
+	if err != nil {
+		return "", err
+	}
+	return connection.Connection.ClientId, nil
+}
+
+func (c *LensChain) ClientHeight(_ context.Context, clientID string) (clienttypes.Height, error) {
+	querier := lensquery.Query{Client: c.client, Options: lensquery.DefaultOptions()}
+	state, err := querier.Ibc_ClientState(clientID)
+	if err != nil {
+		return clienttypes.Height{}, err
+	}
+	clientState, err := clienttypes.UnpackClientState(state.ClientState)
+	if err != nil {
+		return clienttypes.Height{}, err
+	}
+	height, ok := clientState.GetLatestHeight().(clienttypes.Height)
+	if !ok {
+		return clienttypes.Height{}, fmt.Errorf("could not coerce height of client %s", clientID)
+	}
+	return height, nil
+}
+
+func (c *LensChain) Start() error {
+	return c.client.RPCClient.Start()
+}
+
+func (c *LensChain) Subscribe(ctx context.Context, subscriber, query string) (<-chan coretypes.ResultEvent, error) {
+	return c.client.RPCClient.Subscribe(ctx, subscriber, query)
+}
+
+func (c *LensChain) Unsubscribe(ctx context.Context, subscriber, query string) error {
+	return c.client.RPCClient.Unsubscribe(ctx, subscriber, query)
+}
+
+// signer returns the signer of chain, for use in msgs that are validated on
+// submission; an unknown signer is left empty.
+func signer(chain Chain) string {
+	from, _ := chain.Signer()
+	return from
+}
@@ -0,0 +1,123 @@
+package runner
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+
+	tmclient "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/prommetrics"
+)
+
+var (
+	// MaxLightBlocks is the number of light blocks persisted per host chain;
+	// zero is unlimited.
+	MaxLightBlocks = 1000
+	// HeaderReuseWindow is how long a header built to update a client is
+	// reused by other queries answered at the same height, such as those of
+	// the same event or page of historic queries.
+	HeaderReuseWindow = time.Second * 10
+
+	headersMu sync.Mutex
+	// headers holds the headers being built or recently built, keyed by
+	// headerKey.
+	headers = map[string]*headerCall{}
+)
+
+type headerCall struct {
+	done   chan struct{}
+	header *tmclient.Header
+	err    error
+	// built is when the header was built; it is set once done is closed.
+	built time.Time
+}
+
+// headerKey identifies the header updating the given client on the submit
+// chain to the given height of the host chain.
+func headerKey(chainId, submitChainId, clientId string, requestHeight int64, historicOk bool) string {
+	return fmt.Sprintf("%s/%s/%s/%d/%t", chainId, submitChainId, clientId, requestHeight, historicOk)
+}
+
+// sharedHeader returns the header with the given key, calling build for it
+// unless it is already being built, or was built within HeaderReuseWindow.
+// Failed builds are not reused.
+func sharedHeader(key, chainId string, build func() (*tmclient.Header, error), metrics prommetrics.Metrics) (*tmclient.Header, error) {
+	headersMu.Lock()
+	for k, call := range headers {
+		select {
+		case <-call.done:
+			if time.Since(call.built) > HeaderReuseWindow {
+				delete(headers, k)
+			}
+		default:
+		}
+	}
+	if call, ok := headers[key]; ok {
+		headersMu.Unlock()
+		<-call.done
+		if call.err == nil {
+			metrics.HeaderReuse.WithLabelValues("header_reuse", chainId).Inc()
+		}
+		return call.header, call.err
+	}
+	call := &headerCall{done: make(chan struct{})}
+	headers[key] = call
+	headersMu.Unlock()
+
+	call.header, call.err = build()
+	call.built = time.Now()
+	close(call.done)
+	if call.err != nil {
+		headersMu.Lock()
+		delete(headers, key)
+		headersMu.Unlock()
+	}
+	return call.header, call.err
+}
+
+// resetHeaders forgets every header built, such as on restart.
+func resetHeaders() {
+	headersMu.Lock()
+	defer headersMu.Unlock()
+	headers = map[string]*headerCall{}
+}
+
+// storedLightBlock returns the light block of the given chain at the given
+// height from the relayer store, or nil if it is not stored.
+func storedLightBlock(chainId string, height int64, logger log.Logger) *tmtypes.LightBlock {
+	if db == nil {
+		return nil
+	}
+	stored, found, err := db.GetLightBlock(chainId, height)
+	if err != nil {
+		_ = logger.Log("msg", "Error: Unable to read stored lightblock", "chain", chainId, "height", height, "err", err)
+		return nil
+	}
+	if !found {
+		return nil
+	}
+	lightBlock, err := tmtypes.LightBlockFromProto(stored)
+	if err != nil {
+		_ = logger.Log("msg", "Error: Invalid stored lightblock", "chain", chainId, "height", height, "err", err)
+		return nil
+	}
+	return lightBlock
+}
+
+// storeLightBlock persists a light block fetched from the given chain.
+func storeLightBlock(chainId string, lightBlock *tmtypes.LightBlock, logger log.Logger) {
+	if db == nil {
+		return
+	}
+	stored, err := lightBlock.ToProto()
+	if err == nil {
+		err = db.SetLightBlock(chainId, stored, MaxLightBlocks)
+	}
+	if err != nil {
+		_ = logger.Log("msg", "Error: Unable to store lightblock", "chain", chainId, "height", lightBlock.Height, "err", err)
+	}
+}
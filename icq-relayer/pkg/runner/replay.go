@@ -0,0 +1,211 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	querytypes "github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	qstypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/config"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/prommetrics"
+)
+
+var (
+	// DryRunDir, when set, is where txs are written instead of being
+	// broadcast.
+	DryRunDir string
+	// DryRunSign signs the txs written on a dry run.
+	DryRunSign bool
+	// RecordDir, when set, is where each handled query is recorded, for
+	// replay.
+	RecordDir string
+)
+
+// ReplayResult is what the relayer fetched and built in response to a query.
+type ReplayResult struct {
+	Query Query `json:"query"`
+	// Proof is the proof ops of a key query, or the tx proof of a tx query.
+	Proof json.RawMessage `json:"proof,omitempty"`
+	// Header is the header the proof is verified against.
+	Header json.RawMessage   `json:"header,omitempty"`
+	Msgs   []json.RawMessage `json:"msgs"`
+}
+
+// recordQuery writes query to dir, named by its controller, id and height.
+func recordQuery(dir string, query Query) error {
+	dir = path.Join(dir, "queries")
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(query, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path.Join(dir, fmt.Sprintf("query-%s-%s-%d.json", query.SourceChainId, query.QueryId, query.Height)), bz, 0o600)
+}
+
+// LoadQuery reads a query recorded by the relayer.
+func LoadQuery(file string) (Query, error) {
+	query := Query{}
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return query, err
+	}
+	if err := json.Unmarshal(bz, &query); err != nil {
+		return query, fmt.Errorf("invalid query record %s: %w", file, err)
+	}
+	return query, nil
+}
+
+// Replay fetches and builds the response to query, as the relayer would, and
+// writes it to out without submitting it. A query without a type is looked up
+// on its controller by id; one without a height is replayed at the latest
+// height of its host chain.
+func Replay(cfg *config.Config, home string, query Query, out io.Writer) error {
+	logger := log.NewLogfmtLogger(log.NewSyncWriter(os.Stderr))
+	logger = log.With(logger, "ts", log.DefaultTimestampUTC, "worker", "replay")
+
+	if cfg.GetController(query.SourceChainId) == nil {
+		return fmt.Errorf("%s is not a configured controller", query.SourceChainId)
+	}
+	clients, err := newClients(cfg, home, logger)
+	if err != nil {
+		return err
+	}
+	return replay(clients, query, out, logger, *prommetrics.NewMetrics(prometheus.NewRegistry()))
+}
+
+func replay(clients map[string]Chain, query Query, out io.Writer, logger log.Logger, metrics prommetrics.Metrics) error {
+	chains = clients
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	newCache()
+	resetHeaders()
+
+	var err error
+	if query.Type == "" {
+		if query, err = findQuery(query); err != nil {
+			return err
+		}
+	}
+	client, ok := chains[query.ChainId]
+	if !ok {
+		return fmt.Errorf("no client for chain %s", query.ChainId)
+	}
+	if query.Height == 0 {
+		block, err := client.Block(ctx, nil)
+		if err != nil {
+			return err
+		}
+		query.Height = block.Block.LastCommit.Height - 1
+	}
+
+	msgs, err := buildMsgs(query, logger, metrics)
+	if err != nil {
+		return err
+	}
+
+	result, err := replayResult(client, query, msgs)
+	if err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(out, string(bz))
+	return err
+}
+
+// findQuery looks up the query with the id of the given query on its
+// controller, amongst those against each host chain.
+func findQuery(query Query) (Query, error) {
+	controller, ok := chains[query.SourceChainId]
+	if !ok {
+		return query, fmt.Errorf("no client for controller %s", query.SourceChainId)
+	}
+	for chainId := range chains {
+		if chainId == query.SourceChainId {
+			continue
+		}
+		var key []byte
+		for {
+			req := &qstypes.QueryRequestsRequest{Pagination: &querytypes.PageRequest{Key: key, Limit: HistoricPageLimit}, ChainId: chainId}
+			res, err := controller.QueryABCI(ctx, abcitypes.RequestQuery{Path: "/quicksilver.interchainquery.v1.QuerySrvr/Queries", Data: controller.Codec().MustMarshal(req)})
+			if err != nil {
+				return query, err
+			}
+			out := &qstypes.QueryRequestsResponse{}
+			if err := controller.Codec().Unmarshal(res.Value, out); err != nil {
+				return query, err
+			}
+			for _, q := range out.Queries {
+				if q.Id == query.QueryId {
+					query.ChainId = q.ChainId
+					query.ConnectionId = q.ConnectionId
+					query.Type = q.QueryType
+					query.Request = q.Request
+					return query, nil
+				}
+			}
+			if out.Pagination == nil || len(out.Pagination.NextKey) == 0 {
+				break
+			}
+			key = out.Pagination.NextKey
+		}
+	}
+	return query, fmt.Errorf("query %s not found on %s", query.QueryId, query.SourceChainId)
+}
+
+// replayResult extracts the proof and header from msgs built for query.
+func replayResult(client Chain, query Query, msgs []sdk.Msg) (ReplayResult, error) {
+	cdc := client.Codec()
+	result := ReplayResult{Query: query}
+	for _, msg := range msgs {
+		bz, err := cdc.MarshalInterfaceJSON(msg)
+		if err != nil {
+			return result, err
+		}
+		result.Msgs = append(result.Msgs, bz)
+
+		switch msg := msg.(type) {
+		case *clienttypes.MsgUpdateClient:
+			header, err := clienttypes.UnpackHeader(msg.Header)
+			if err != nil {
+				return result, err
+			}
+			if result.Header, err = cdc.MarshalJSON(header); err != nil {
+				return result, err
+			}
+		case *qstypes.MsgSubmitQueryResponse:
+			if query.Type == "tendermint.Tx" {
+				res := qstypes.GetTxWithProofResponse{}
+				if err := cdc.Unmarshal(msg.Result, &res); err != nil {
+					return result, err
+				}
+				if result.Proof, err = cdc.MarshalJSON(res.Proof); err != nil {
+					return result, err
+				}
+				if result.Header, err = cdc.MarshalJSON(res.Header); err != nil {
+					return result, err
+				}
+			} else if msg.ProofOps != nil && len(msg.ProofOps.Ops) > 0 {
+				if result.Proof, err = cdc.MarshalJSON(msg.ProofOps); err != nil {
+					return result, err
+				}
+			}
+		}
+	}
+	return result, nil
+}
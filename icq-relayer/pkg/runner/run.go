@@ -8,11 +8,9 @@ import (
 	"fmt"
 	"io"
 	stdlog "log"
-	"math"
-	"math/rand"
 	"net/http"
 	"os"
-	"sort"
+	"path"
 	"strconv"
 	"strings"
 	"sync"
@@ -20,7 +18,11 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/api"
 	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/config"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/scheduler"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/store"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/submitter"
 	"github.com/quicksilver-zone/quicksilver/icq-relayer/prommetrics"
 
 	"github.com/go-kit/log"
@@ -30,7 +32,6 @@ import (
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	qstypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
 	lensclient "github.com/strangelove-ventures/lens/client"
-	lensquery "github.com/strangelove-ventures/lens/client/query"
 	abcitypes "github.com/tendermint/tendermint/abci/types"
 	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 	"github.com/tendermint/tendermint/proto/tendermint/crypto"
@@ -53,12 +54,19 @@ const VERSION = "icq/v0.10.0"
 var (
 	WaitInterval          = time.Second * 6
 	HistoricQueryInterval = time.Second * 15
-	MaxHistoricQueries    = 12
+	HistoricPageLimit     = uint64(500)
 	MaxTxMsgs             = 12
-	ctx                   = context.Background()
-	sendQueue             = map[string]chan sdk.Msg{}
+	BroadcastTimeout      = time.Second * 15
+	QueryDedupeWindow     = time.Second * 10
+	QueryRetention        = time.Hour
+	ctx, cancel           = context.WithCancel(context.Background())
+	sendQueue             = map[string]chan []store.Entry{}
 	cache                 *ristretto.Cache
+	db                    *store.Store
+	sched                 *scheduler.Scheduler
+	submitters            = map[string]*submitter.Pool{}
 	globalCfg             *config.Config
+	chains                = map[string]Chain{}
 )
 
 func (clients Clients) GetForChainId(chainId string) *lensclient.ChainClient {
@@ -71,31 +79,19 @@ func (clients Clients) GetForChainId(chainId string) *lensclient.ChainClient {
 }
 
 func Run(cfg *config.Config, home string) error {
-	globalCfg = cfg
 	logger := log.NewLogfmtLogger(log.NewSyncWriter(os.Stderr))
 	logger = log.With(logger, "ts", log.DefaultTimestampUTC, "caller", log.DefaultCaller)
 
 	_ = logger.Log("worker", "init", "msg", "starting icq relayer", "version", VERSION)
-	_ = logger.Log("worker", "init", "msg", "permitted queries", "queries", strings.Join(globalCfg.AllowedQueries, ","))
+	controllers := cfg.GetControllers()
+	for _, controller := range controllers {
+		_ = logger.Log("worker", "init", "msg", "permitted queries", "controller", controller.ChainID, "queries", strings.Join(controller.AllowedQueries, ","))
+	}
 
 	reg := prometheus.NewRegistry()
 	metrics := *prommetrics.NewMetrics(reg)
 
 	promHandler := promhttp.HandlerFor(reg, promhttp.HandlerOpts{})
-	var err error
-	cache, err = ristretto.NewCache(&ristretto.Config{
-		NumCounters: 1e7,     // Num keys to track frequency of (10M).
-		MaxCost:     1 << 30, // Maximum cost of cache (1GB).
-		BufferItems: 64,      // Number of keys per Get buffer.
-	})
-	if err != nil {
-		panic("unable to start ristretto cache")
-	}
-
-	http.Handle("/metrics", promHandler)
-	go func() {
-		stdlog.Fatal(http.ListenAndServe(":2112", nil))
-	}()
 
 	defer func() {
 		err := Close()
@@ -103,37 +99,192 @@ func Run(cfg *config.Config, home string) error {
 			stdlog.Fatal("Error in Closing the routine")
 		}
 	}()
+	clients, err := newClients(cfg, home, logger)
+	if err != nil {
+		return err
+	}
+
+	for _, controller := range controllers {
+		if _, ok := clients[controller.ChainID]; !ok {
+			panic(fmt.Sprintf("unable to create controller chainClient for %s; Client is nil", controller.ChainID))
+		}
+	}
+
+	// dry runs queue work apart, so that nothing they queue is later broadcast.
+	queuePath := path.Join(home, "data", "queue")
+	if DryRunDir != "" {
+		queuePath = path.Join(DryRunDir, "queue")
+		_ = logger.Log("worker", "init", "msg", "dry run; writing txs rather than broadcasting", "dir", DryRunDir, "sign", DryRunSign)
+	}
+	queue, err := store.Open(queuePath, clients[controllers[0].ChainID].Codec())
+	if err != nil {
+		return err
+	}
+
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+
+	if err := start(cfg, clients, queue, wg, logger, metrics); err != nil {
+		return err
+	}
+
+	serverCfg := cfg.GetServer()
+	maxStaleness, err := time.ParseDuration(serverCfg.MaxStaleness)
+	if err != nil {
+		return err
+	}
+	server := api.New(relayerState{}, promHandler, maxStaleness, serverCfg.EnableAdmin)
+	_ = logger.Log("worker", "init", "msg", "serving http api", "addr", serverCfg.ListenAddr, "admin", serverCfg.EnableAdmin)
+	go func() {
+		stdlog.Fatal(http.ListenAndServe(serverCfg.ListenAddr, server))
+	}()
+
+	return nil
+}
+
+// newClients returns lens clients for each configured chain, keyed by chain
+// id; controllers sign by default with their key, or the first of their pool.
+func newClients(cfg *config.Config, home string, logger log.Logger) (map[string]Chain, error) {
+	var err error
+	clients := map[string]Chain{}
 	for _, c := range cfg.Chains {
+		controller := cfg.GetController(c.ChainID)
+		if controller != nil && controller.GetKeys()[0] != "" {
+			c.Key = controller.GetKeys()[0]
+		}
 		cfg.Cl[c.ChainID], err = lensclient.NewChainClient(nil, c, home, os.Stdin, os.Stdout)
+		if err != nil {
+			return nil, err
+		}
+		clients[c.ChainID] = NewLensChain(cfg.Cl[c.ChainID])
+
+		err = logger.Log("worker", "init", "msg", "configured chain", "chain", c.ChainID, "controller", controller != nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return clients, nil
+}
+
+// start relays between the given chains, keyed by chain id, persisting work in
+// queue, until Close is called. Long-running workers are tracked by wg.
+func start(cfg *config.Config, clients map[string]Chain, queue *store.Store, wg *sync.WaitGroup, logger log.Logger, metrics prommetrics.Metrics) error {
+	var err error
+	globalCfg = cfg
+	chains = clients
+	db = queue
+	ctx, cancel = context.WithCancel(context.Background())
+	resetState()
+
+	MaxTxMsgs = cfg.GetGas().MaxTxMsgs
+	TxSearchPageLimit, TxSearchMaxTxs = cfg.GetTxSearch().PageLimit, cfg.GetTxSearch().MaxTxs
+	MaxLightBlocks = cfg.GetCache().MaxLightBlocks
+	if HeaderReuseWindow, err = time.ParseDuration(cfg.GetCache().HeaderReuse); err != nil {
+		return err
+	}
+	resetHeaders()
+	schedulerCfg := cfg.GetScheduler()
+	sched = scheduler.New(*schedulerCfg)
+	HistoricPageLimit = schedulerCfg.HistoricPageLimit
+	if HistoricQueryInterval, err = time.ParseDuration(schedulerCfg.HistoricInterval); err != nil {
+		return err
+	}
+
+	newCache()
+
+	controllers := cfg.GetControllers()
+	sendQueue = map[string]chan []store.Entry{}
+	submitters = map[string]*submitter.Pool{}
+	for _, controller := range controllers {
+		chain, ok := chains[controller.ChainID]
+		if !ok {
+			return fmt.Errorf("no client for controller chain %s", controller.ChainID)
+		}
+		submitterCfg, err := newSubmitterConfig(controller.ChainID, cfg.GetChainConfig(controller.ChainID), cfg.GetGas())
 		if err != nil {
 			return err
 		}
+		if submitters[controller.ChainID], err = newPool(chain, controller, submitterCfg); err != nil {
+			return err
+		}
+		go monitorSigners(ctx, chain, controller, submitters[controller.ChainID], balanceDenoms(controller, submitterCfg), log.With(logger, "worker", "signers", "chain", controller.ChainID), metrics)
+		sendQueue[controller.ChainID] = make(chan []store.Entry)
+		metrics.SendQueue.WithLabelValues("send-queue", controller.ChainID).Set(float64(len(sendQueue[controller.ChainID])))
+	}
 
-		err = logger.Log("worker", "init", "msg", "configured chain", "chain", c.ChainID)
+	// resume any work queued before the last shutdown.
+	for chainId := range chains {
+		if height, found := db.GetHeight(chainId); found {
+			_ = logger.Log("worker", "init", "msg", "resuming from last seen height", "chain", chainId, "height", height)
+		}
+	}
+	for chainId := range sendQueue {
+		pending, err := db.Pending(chainId)
 		if err != nil {
 			return err
 		}
-		sendQueue[c.ChainID] = make(chan sdk.Msg)
-		metrics.SendQueue.WithLabelValues("send-queue").Set(float64(len(sendQueue[c.ChainID])))
+		if len(pending) == 0 {
+			continue
+		}
+		_ = logger.Log("worker", "init", "msg", "replaying queued messages", "chain", chainId, "count", len(pending))
+		// replayed together, so that responses follow their client updates.
+		go func(ch chan []store.Entry, pending []store.Entry) {
+			ch <- pending
+		}(sendQueue[chainId], pending)
 	}
 
-	query := tmquery.MustParse(fmt.Sprintf("message.module='%s'", "interchainquery"))
+	for _, chain := range chains {
+		go monitorChain(chain, log.With(logger, "worker", "monitor"), metrics)
+	}
 
-	wg := &sync.WaitGroup{}
-	defer wg.Wait()
+	for _, controller := range controllers {
+		if err := runController(controller, wg, logger, metrics); err != nil {
+			return err
+		}
+	}
 
-	defaultClient, ok := cfg.Cl[cfg.DefaultChain]
-	if !ok {
-		panic("unable to create default chainClient; Client is nil")
+	return nil
+}
+
+func newCache() {
+	var err error
+	cache, err = ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1e7,     // Num keys to track frequency of (10M).
+		MaxCost:     1 << 30, // Maximum cost of cache (1GB).
+		BufferItems: 64,      // Number of keys per Get buffer.
+	})
+	if err != nil {
+		panic("unable to start ristretto cache")
 	}
-	err = defaultClient.RPCClient.Start()
+}
+
+// sleep pauses for d, returning false if the relayer is closed meanwhile.
+func sleep(d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+// runController subscribes to query requests emitted by the given controller
+// chain, polls it for outstanding queries against each host chain, and
+// flushes responses back to it.
+func runController(controller *config.ControllerConfig, wg *sync.WaitGroup, logger log.Logger, metrics prommetrics.Metrics) error {
+	// requests dispatched by this run are dropped once it is closed.
+	runCtx := ctx
+	query := tmquery.MustParse(fmt.Sprintf("message.module='%s'", "interchainquery"))
+	controllerClient := chains[controller.ChainID]
+
+	err := controllerClient.Start()
 	if err != nil {
 		_ = logger.Log("error", err.Error())
 	}
 
-	_ = logger.Log("worker", "init", "msg", "configuring subscription on default chainClient", "chain", defaultClient.Config.ChainID)
+	_ = logger.Log("worker", "init", "msg", "configuring subscription on controller chainClient", "chain", controller.ChainID)
 
-	ch, err := defaultClient.RPCClient.Subscribe(ctx, defaultClient.Config.ChainID+"-icq", query.String())
+	ch, err := controllerClient.Subscribe(ctx, controller.ChainID+"-icq", query.String())
 	if err != nil {
 		_ = logger.Log("error", err.Error())
 		return err
@@ -141,41 +292,56 @@ func Run(cfg *config.Config, home string) error {
 	wg.Add(1)
 	go func(chainId string, ch <-chan coretypes.ResultEvent) {
 		defer wg.Done()
-		for v := range ch {
+		for {
+			var v coretypes.ResultEvent
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-ch:
+				if !ok {
+					return
+				}
+				v = event
+			}
 			v.Events["source"] = []string{chainId}
 			// why does this always trigger twice? messages are deduped later, but this causes 2x queries to trigger.
 			time.Sleep(75 * time.Millisecond) // try to avoid thundering herd.
-			go handleEvent(v, log.With(logger, "worker", "chainClient", "chain", defaultClient.Config.ChainID), metrics)
+			go handleEvent(runCtx, v, controller, log.With(logger, "worker", "chainClient", "chain", chainId), metrics)
 		}
-	}(defaultClient.Config.ChainID, ch)
+	}(controller.ChainID, ch)
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		err := FlushSendQueue(defaultClient.Config.ChainID, log.With(logger, "worker", "flusher", "chain", defaultClient.Config.ChainID), metrics)
+		err := FlushSendQueue(controller.ChainID, log.With(logger, "worker", "flusher", "chain", controller.ChainID), metrics)
 		if err != nil {
 			_ = logger.Log("Flush Go-routine Bailing")
 			panic(err)
 		}
 	}()
 
-	for _, chainClient := range globalCfg.Cl {
-		if chainClient.Config.ChainID != cfg.DefaultChain {
+	for chainId := range chains {
+		if globalCfg.GetController(chainId) == nil {
 			wg.Add(1)
-			go func(defaultClient *lensclient.ChainClient, srcClient *lensclient.ChainClient, logger log.Logger) {
+			go func(controllerClient Chain, srcChainId string, logger log.Logger) {
 				defer wg.Done()
 			CNT:
 				for {
-					time.Sleep(HistoricQueryInterval)
+					if !sleep(HistoricQueryInterval) {
+						return
+					}
 					req := &qstypes.QueryRequestsRequest{
-						Pagination: &querytypes.PageRequest{Limit: 500},
-						ChainId:    srcClient.Config.ChainID,
+						Pagination: &querytypes.PageRequest{Limit: HistoricPageLimit},
+						ChainId:    srcChainId,
 					}
 
-					bz := defaultClient.Codec.Marshaler.MustMarshal(req)
-					metrics.HistoricQueryRequests.WithLabelValues("historic_requests").Inc()
-					res, err := defaultClient.RPCClient.ABCIQuery(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/Queries", bz)
+					bz := controllerClient.Codec().MustMarshal(req)
+					metrics.HistoricQueryRequests.WithLabelValues("historic_requests", controller.ChainID).Inc()
+					res, err := controllerClient.QueryABCI(ctx, abcitypes.RequestQuery{Path: "/quicksilver.interchainquery.v1.QuerySrvr/Queries", Data: bz})
 					if err != nil {
+						if ctx.Err() != nil {
+							return
+						}
 						if strings.Contains(err.Error(), "Client.Timeout") {
 							err := logger.Log("error", fmt.Sprintf("timeout: %s", err.Error()))
 							if err != nil {
@@ -186,7 +352,7 @@ func Run(cfg *config.Config, home string) error {
 						panic(fmt.Sprintf("panic(3): %v", err))
 					}
 					out := &qstypes.QueryRequestsResponse{}
-					err = defaultClient.Codec.Marshaler.Unmarshal(res.Response.Value, out)
+					err = controllerClient.Codec().Unmarshal(res.Value, out)
 					if err != nil {
 						err := logger.Log("msg", "Error: Unable to unmarshal: ", "error", err)
 						if err != nil {
@@ -195,12 +361,13 @@ func Run(cfg *config.Config, home string) error {
 						continue CNT
 					}
 					_ = logger.Log("worker", "chainClient", "msg", "fetched historic queries for chain", "count", len(out.Queries))
+					setPendingQueries(controller.ChainID, srcChainId, out.Queries)
 
 					if len(out.Queries) > 0 {
-						go handleHistoricRequests(out.Queries, defaultClient.Config.ChainID, log.With(logger, "worker", "historic"), metrics)
+						go handleHistoricRequests(runCtx, out.Queries, controller, log.With(logger, "worker", "historic"), metrics)
 					}
 				}
-			}(defaultClient, chainClient, log.With(logger, "chain", defaultClient.Config.ChainID, "src_chain", chainClient.Config.ChainID))
+			}(controllerClient, chainId, log.With(logger, "chain", controller.ChainID, "src_chain", chainId))
 		}
 	}
 
@@ -208,91 +375,78 @@ func Run(cfg *config.Config, home string) error {
 }
 
 type Query struct {
-	SourceChainId string
-	ConnectionId  string
-	ChainId       string
-	QueryId       string
-	Type          string
-	Height        int64
-	Request       []byte
+	SourceChainId string `json:"source_chain_id"`
+	ConnectionId  string `json:"connection_id"`
+	ChainId       string `json:"chain_id"`
+	QueryId       string `json:"query_id"`
+	Type          string `json:"type"`
+	Height        int64  `json:"height"`
+	Request       []byte `json:"request"`
 }
 
-func handleHistoricRequests(queries []qstypes.Query, sourceChainId string, logger log.Logger, metrics prommetrics.Metrics) {
-	metrics.HistoricQueries.WithLabelValues("historic-queries").Set(float64(len(queries)))
+func handleHistoricRequests(runCtx context.Context, queries []qstypes.Query, controller *config.ControllerConfig, logger log.Logger, metrics prommetrics.Metrics) {
+	metrics.HistoricQueries.WithLabelValues("historic-queries", controller.ChainID).Set(float64(len(queries)))
 
 	if len(queries) == 0 {
 		return
 	}
 
-	rand.Seed(time.Now().UnixNano())
-	rand.Shuffle(len(queries), func(i, j int) { queries[i], queries[j] = queries[j], queries[i] })
-
-	sort.SliceStable(queries, func(i, j int) bool {
-		return queries[i].CallbackId == "allbalances" || queries[i].CallbackId == "depositinterval" || queries[i].CallbackId == "deposittx" || queries[i].LastEmission.GT(queries[j].LastEmission)
-	})
-
-	for _, query := range queries[0:int(math.Min(float64(len(queries)), float64(MaxHistoricQueries)))] {
-		_, ok := globalCfg.Cl[query.ChainId]
+	for _, query := range sched.Next(queries) {
+		_, ok := chains[query.ChainId]
 		if !ok {
 			continue
 		}
 
 		q := Query{}
-		q.SourceChainId = sourceChainId
+		q.SourceChainId = controller.ChainID
 		q.ChainId = query.ChainId
 		q.ConnectionId = query.ConnectionId
 		q.QueryId = query.Id
 		q.Request = query.Request
 		q.Type = query.QueryType
 
-		if _, found := cache.Get("query/" + q.QueryId); found {
-			// break if this is in the cache
+		if db.RecentQuery(queryRecordKey(q.SourceChainId, q.QueryId), QueryDedupeWindow, time.Now()) {
+			// skip if this was handled recently
 			continue
 		}
 
 		currentheight, found := cache.Get("currentblock/" + q.ChainId)
 		if !found {
-			block, err := globalCfg.Cl[q.ChainId].RPCClient.Block(ctx, nil)
+			block, err := chains[q.ChainId].Block(ctx, nil)
 			if err != nil {
 				panic(fmt.Sprintf("panic(6): %v", err))
 			}
 			currentheight = block.Block.LastCommit.Height - 1
 			cache.SetWithTTL("currentblock/"+q.ChainId, currentheight, 1, 6*time.Second)
+			setHeight(q.ChainId, block.Block.Height, logger)
 			logger.Log("msg", "caching currentblock", "height", currentheight)
 		} else {
 			logger.Log("msg", "using cached currentblock", "height", currentheight)
 		}
 		q.Height = currentheight.(int64)
 
-		handle := false
-		if len(globalCfg.AllowedQueries) == 0 {
-			handle = true
-		} else {
-			for _, msgType := range globalCfg.AllowedQueries {
-				if q.Type == msgType {
-					handle = true
-					break
-				}
-			}
-		}
-
-		if !handle {
+		if !controller.Allows(q.Type) {
 			_ = logger.Log("msg", "Ignoring existing query; not a permitted type", "id", query.Id, "type", q.Type)
 			continue
 		}
 		_ = logger.Log("msg", "Handling existing query", "id", query.Id)
 
-		time.Sleep(75 * time.Millisecond) // try to avoid thundering herd.
+		// try to avoid thundering herd.
+		select {
+		case <-runCtx.Done():
+			return
+		case <-time.After(75 * time.Millisecond):
+		}
 
-		go doRequestWithMetrics(q, logger, metrics)
+		go doRequestWithMetrics(runCtx, q, logger, metrics)
 	}
 }
 
-func handleEvent(event coretypes.ResultEvent, logger log.Logger, metrics prommetrics.Metrics) {
+func handleEvent(runCtx context.Context, event coretypes.ResultEvent, controller *config.ControllerConfig, logger log.Logger, metrics prommetrics.Metrics) {
 	queries := []Query{}
 	source := event.Events["source"]
 	connections := event.Events["message.connection_id"]
-	chains := event.Events["message.chain_id"]
+	chainIds := event.Events["message.chain_id"]
 	queryIds := event.Events["message.query_id"]
 	types := event.Events["message.type"]
 	request := event.Events["message.request"]
@@ -300,8 +454,12 @@ func handleEvent(event coretypes.ResultEvent, logger log.Logger, metrics prommet
 
 	items := len(queryIds)
 
+	if data, ok := event.Data.(tmtypes.EventDataTx); ok {
+		setHeight(source[0], data.Height, logger)
+	}
+
 	for i := 0; i < items; i++ {
-		_, ok := globalCfg.Cl[chains[i]]
+		_, ok := chains[chainIds[i]]
 		if !ok {
 			continue
 		}
@@ -315,38 +473,27 @@ func handleEvent(event coretypes.ResultEvent, logger log.Logger, metrics prommet
 			panic(fmt.Sprintf("panic(5): %v", err))
 		}
 
-		handle := false
-		if len(globalCfg.AllowedQueries) == 0 {
-			handle = true
-		} else {
-			for _, msgType := range globalCfg.AllowedQueries {
-				if types[i] == msgType {
-					handle = true
-					break
-				}
-			}
-		}
-
-		if !handle {
+		if !controller.Allows(types[i]) {
 			_ = logger.Log("msg", "Ignoring current query; not a permitted type", "id", queryIds[i], "type", types[i])
 			continue
 		}
 
-		if _, found := cache.Get("query/" + queryIds[i]); found {
-			// break if this is in the cache
+		if db.RecentQuery(queryRecordKey(source[0], queryIds[i]), QueryDedupeWindow, time.Now()) {
+			// skip if this was handled recently
 			fmt.Println("avoiding duplicate")
 			continue
 		}
 
 		if h == 0 {
-			currentheight, found := cache.Get("currentblock/" + chains[i])
+			currentheight, found := cache.Get("currentblock/" + chainIds[i])
 			if !found {
-				block, err := globalCfg.Cl[chains[i]].RPCClient.Block(ctx, nil)
+				block, err := chains[chainIds[i]].Block(ctx, nil)
 				if err != nil {
 					panic(fmt.Sprintf("panic(6): %v", err))
 				}
 				currentheight = block.Block.LastCommit.Height - 1
-				cache.SetWithTTL("currentblock/"+chains[i], currentheight, 1, 6*time.Second)
+				cache.SetWithTTL("currentblock/"+chainIds[i], currentheight, 1, 6*time.Second)
+				setHeight(chainIds[i], block.Block.Height, logger)
 				logger.Log("msg", "caching currentblock", "height", currentheight)
 			} else {
 				logger.Log("msg", "using cached currentblock", "height", currentheight)
@@ -354,16 +501,18 @@ func handleEvent(event coretypes.ResultEvent, logger log.Logger, metrics prommet
 			h = currentheight.(int64)
 		}
 
-		cache.SetWithTTL("query/"+queryIds[i], true, 0, 10*time.Second) // just long enough to not duplicate.
-		queries = append(queries, Query{source[0], connections[i], chains[i], queryIds[i], types[i], h, req})
+		if err := db.SetQuery(queryRecordKey(source[0], queryIds[i]), h, time.Now()); err != nil {
+			_ = logger.Log("msg", "Error: Unable to record query", "id", queryIds[i], "err", err)
+		}
+		queries = append(queries, Query{source[0], connections[i], chainIds[i], queryIds[i], types[i], h, req})
 	}
 
 	for _, q := range queries {
-		go doRequestWithMetrics(q, log.With(logger, "src_chain", q.ChainId), metrics)
+		go doRequestWithMetrics(runCtx, q, log.With(logger, "src_chain", q.ChainId), metrics)
 	}
 }
 
-func RunGRPCQuery(ctx context.Context, client *lensclient.ChainClient, method string, reqBz []byte, md metadata.MD, metrics prommetrics.Metrics) (abcitypes.ResponseQuery, metadata.MD, error) {
+func RunGRPCQuery(ctx context.Context, client Chain, method string, reqBz []byte, md metadata.MD, metrics prommetrics.Metrics) (abcitypes.ResponseQuery, metadata.MD, error) {
 	// parse height header
 	height, err := lensclient.GetHeightFromMetadata(md)
 	if err != nil {
@@ -391,50 +540,103 @@ func RunGRPCQuery(ctx context.Context, client *lensclient.ChainClient, method st
 	return abciRes, md, nil
 }
 
-func retryLightblock(ctx context.Context, client *lensclient.ChainClient, height int64, maxTime int, logger log.Logger, metrics prommetrics.Metrics) (*tmtypes.LightBlock, error) {
-	lightBlock, found := cache.Get("lightblock/" + client.Config.ChainID + "/" + fmt.Sprintf("%d", height))
-	var err error
-	if !found {
-		interval := 1
-		_ = logger.Log("msg", "Querying lightblock", "attempt", interval)
-		lightBlock, err = client.LightProvider.LightBlock(ctx, height)
-		metrics.LightBlockRequests.WithLabelValues("lightblock_requests").Inc()
+// retryLightblock returns the light block of the given chain at the given
+// height from the memory cache, the relayer store, or else the chain itself,
+// retrying for up to maxTime attempts.
+func retryLightblock(ctx context.Context, client Chain, height int64, maxTime int, logger log.Logger, metrics prommetrics.Metrics) (*tmtypes.LightBlock, error) {
+	key := "lightblock/" + client.ChainID() + "/" + fmt.Sprintf("%d", height)
+	if lightBlock, found := cache.Get(key); found {
+		_ = logger.Log("msg", "got lightblock from cache")
+		metrics.LightBlockCacheHits.WithLabelValues("lightblock_cache_hits", client.ChainID(), "memory").Inc()
+		return lightBlock.(*tmtypes.LightBlock), nil
+	}
+	if lightBlock := storedLightBlock(client.ChainID(), height, logger); lightBlock != nil {
+		_ = logger.Log("msg", "got lightblock from store")
+		metrics.LightBlockCacheHits.WithLabelValues("lightblock_cache_hits", client.ChainID(), "disk").Inc()
+		cache.Set(key, lightBlock, 5)
+		return lightBlock, nil
+	}
+	metrics.LightBlockCacheMisses.WithLabelValues("lightblock_cache_misses", client.ChainID()).Inc()
 
-		if err != nil {
-			for {
-				time.Sleep(time.Duration(interval) * time.Second)
-				_ = logger.Log("msg", "Requerying lightblock", "attempt", interval)
-				lightBlock, err = client.LightProvider.LightBlock(ctx, height)
-				metrics.LightBlockRequests.WithLabelValues("lightblock_requests").Inc()
-				interval = interval + 1
-				if err == nil {
-					break
-				} else if interval > maxTime {
-					return nil, fmt.Errorf("unable to query light block, max interval exceeded")
-				}
+	interval := 1
+	_ = logger.Log("msg", "Querying lightblock", "attempt", interval)
+	lightBlock, err := client.LightBlock(ctx, height)
+	metrics.LightBlockRequests.WithLabelValues("lightblock_requests").Inc()
+
+	if err != nil {
+		for {
+			time.Sleep(time.Duration(interval) * time.Second)
+			_ = logger.Log("msg", "Requerying lightblock", "attempt", interval)
+			lightBlock, err = client.LightBlock(ctx, height)
+			metrics.LightBlockRequests.WithLabelValues("lightblock_requests").Inc()
+			interval = interval + 1
+			if err == nil {
+				break
+			} else if interval > maxTime {
+				return nil, fmt.Errorf("unable to query light block, max interval exceeded")
 			}
 		}
-		cache.Set("lightblock/"+client.Config.ChainID+"/"+fmt.Sprintf("%d", height), lightBlock, 5)
-	} else {
-		_ = logger.Log("msg", "got lightblock from cache")
 	}
-	return lightBlock.(*tmtypes.LightBlock), err
+	cache.Set(key, lightBlock, 5)
+	storeLightBlock(client.ChainID(), lightBlock, logger)
+	return lightBlock, nil
 }
 
-func doRequestWithMetrics(query Query, logger log.Logger, metrics prommetrics.Metrics) {
+func doRequestWithMetrics(runCtx context.Context, query Query, logger log.Logger, metrics prommetrics.Metrics) {
+	if isPaused(query.ChainId) || isPaused(query.SourceChainId) {
+		_ = logger.Log("msg", "Skipping request; chain paused", "id", query.QueryId)
+		return
+	}
+
+	release, err := sched.Acquire(runCtx, query.ChainId, query.Type)
+	if err != nil {
+		_ = logger.Log("msg", "Error: Unable to schedule request", "id", query.QueryId, "err", err)
+		return
+	}
+	defer release()
+	if runCtx.Err() != nil {
+		return
+	}
+
 	startTime := time.Now()
-	metrics.Requests.WithLabelValues("requests", query.Type).Inc()
+	metrics.Requests.WithLabelValues("requests", query.Type, query.SourceChainId).Inc()
 	doRequest(query, logger, metrics)
 	endTime := time.Now()
-	metrics.RequestsLatency.WithLabelValues("request-latency", query.Type).Observe(endTime.Sub(startTime).Seconds())
+	metrics.RequestsLatency.WithLabelValues("request-latency", query.Type, query.SourceChainId).Observe(endTime.Sub(startTime).Seconds())
 }
 
 func doRequest(query Query, logger log.Logger, metrics prommetrics.Metrics) {
-	var err error
-	client := globalCfg.Cl[query.ChainId]
-	if client == nil {
+	if _, ok := chains[query.ChainId]; !ok {
+		return
+	}
+	if RecordDir != "" {
+		if err := recordQuery(RecordDir, query); err != nil {
+			_ = logger.Log("msg", "Error: Unable to record query", "id", query.QueryId, "err", err)
+		}
+	}
+
+	msgs, err := buildMsgs(query, logger, metrics)
+	if err != nil {
+		_ = logger.Log("msg", fmt.Sprintf("Error: %s", err), "id", query.QueryId)
 		return
 	}
+	enqueue(query.SourceChainId, msgs, logger)
+	metrics.SendQueue.WithLabelValues("send-queue", query.SourceChainId).Set(float64(len(sendQueue[query.SourceChainId])))
+}
+
+// buildMsgs fetches the response to query from its host chain, returning the
+// msgs to submit to its controller: the response, preceded by any client
+// update it is proven against.
+func buildMsgs(query Query, logger log.Logger, metrics prommetrics.Metrics) ([]sdk.Msg, error) {
+	var err error
+	client, ok := chains[query.ChainId]
+	if !ok {
+		return nil, fmt.Errorf("no client for chain %s", query.ChainId)
+	}
+	submitClient, ok := chains[query.SourceChainId]
+	if !ok {
+		return nil, fmt.Errorf("no client for controller %s", query.SourceChainId)
+	}
 
 	_ = logger.Log("msg", "Handling request", "type", query.Type, "id", query.QueryId, "height", query.Height)
 
@@ -449,78 +651,46 @@ func doRequest(query Query, logger log.Logger, metrics prommetrics.Metrics) {
 	}
 
 	var res abcitypes.ResponseQuery
-	submitClient := globalCfg.Cl[query.SourceChainId]
 
 	switch query.Type {
-	// until we fix ordering and pagination in the binary, we can override the query here.
 	case "cosmos.tx.v1beta1.Service/GetTxsEvent":
-		request := txtypes.GetTxsEventRequest{}
-		err = client.Codec.Marshaler.Unmarshal(query.Request, &request)
+		res, err = searchTxs(client, query, inMd, logger, metrics)
 		if err != nil {
-			_ = logger.Log("msg", "Error: Failed in Unmarshalling Request", "type", query.Type, "id", query.QueryId, "height", query.Height)
-			panic(fmt.Sprintf("panic(7a): %v", err))
-		}
-		request.OrderBy = txtypes.OrderBy_ORDER_BY_DESC
-		request.Limit = 200
-		request.Pagination.Limit = 200
-
-		query.Request, err = client.Codec.Marshaler.Marshal(&request)
-		if err != nil {
-			_ = logger.Log("msg", "Error: Failed in Marshalling Request", "type", query.Type, "id", query.QueryId, "height", query.Height)
-			panic(fmt.Sprintf("panic(7b): %v", err))
-		}
-
-		_ = logger.Log("msg", "Handling GetTxsEvents", "id", query.QueryId, "height", query.Height)
-		res, _, err = RunGRPCQuery(ctx, client, "/"+query.Type, query.Request, inMd, metrics)
-		if err != nil {
-			_ = logger.Log("msg", "Error: Failed in RunGRPCQuery", "type", query.Type, "id", query.QueryId, "height", query.Height)
-			panic(fmt.Sprintf("panic(7c): %v", err))
+			return nil, err
 		}
 
 	case "tendermint.Tx":
 		req := txtypes.GetTxRequest{}
-		client.Codec.Marshaler.MustUnmarshal(query.Request, &req)
+		client.Codec().MustUnmarshal(query.Request, &req)
 		hashBytes, err := hex.DecodeString(req.GetHash())
 		if err != nil {
-			_ = logger.Log("msg", fmt.Sprintf("Error: Could not get decode hash %s", err))
-			return
+			return nil, fmt.Errorf("could not get decode hash %w", err)
 		}
-		txRes, height, err := Tx(client, hashBytes)
+		txRes, height, err := client.Tx(ctx, hashBytes)
 		if err != nil {
-			_ = logger.Log("msg", fmt.Sprintf("Error: Could not fetch proof %s", err))
-			return
+			return nil, fmt.Errorf("could not fetch proof %w", err)
 		}
 
 		protoProof := txRes.ToProto()
 
-		submitQuerier := lensquery.Query{Client: submitClient, Options: lensquery.DefaultOptions()}
-		clientId, found := cache.Get("clientId/" + query.ConnectionId)
-		if !found {
-			connection, err := submitQuerier.Ibc_Connection(query.ConnectionId)
-			if err != nil {
-				_ = logger.Log("msg", fmt.Sprintf("Error: Could not get connection from chain %s", err))
-				return
-			}
-			clientId = connection.Connection.ClientId
-			cache.Set("clientId/"+query.ConnectionId, clientId, 1)
+		clientId, err := clientIdForConnection(submitClient, query.ConnectionId)
+		if err != nil {
+			return nil, fmt.Errorf("could not get connection from chain %w", err)
 		}
 
-		header, err := getHeader(ctx, client, submitClient, clientId.(string), height-1, logger, true, metrics)
+		header, err := getHeader(ctx, client, submitClient, clientId, height-1, logger, true, metrics)
 		if err != nil {
-			_ = logger.Log("msg", fmt.Sprintf("Error: Could not get header %s", err))
-			return
+			return nil, fmt.Errorf("could not get header %w", err)
 		}
 
 		resp := qstypes.GetTxWithProofResponse{Proof: &protoProof, Header: header}
-		res.Value = client.Codec.Marshaler.MustMarshal(&resp)
+		res.Value = client.Codec().MustMarshal(&resp)
 
 	case "ibc.ClientUpdate":
-		submitClientUpdate(client, submitClient, query, int64(sdk.BigEndianToUint64(query.Request)), logger, metrics)
+		msgs := clientUpdate(client, submitClient, query, int64(sdk.BigEndianToUint64(query.Request)), logger, metrics)
 		// return a dummy message to settle the query.
-		from, _ := submitClient.GetKeyAddress()
-		msg := &qstypes.MsgSubmitQueryResponse{ChainId: query.ChainId, QueryId: query.QueryId, Result: []byte{}, Height: int64(sdk.BigEndianToUint64(query.Request)), ProofOps: &crypto.ProofOps{}, FromAddress: submitClient.MustEncodeAccAddr(from)}
-		sendQueue[query.SourceChainId] <- msg
-		return
+		msg := &qstypes.MsgSubmitQueryResponse{ChainId: query.ChainId, QueryId: query.QueryId, Result: []byte{}, Height: int64(sdk.BigEndianToUint64(query.Request)), ProofOps: &crypto.ProofOps{}, FromAddress: signer(submitClient)}
+		return append(msgs, msg), nil
 	default:
 		res, _, err = RunGRPCQuery(ctx, client, "/"+query.Type, query.Request, inMd, metrics)
 		if err != nil {
@@ -529,14 +699,68 @@ func doRequest(query Query, logger log.Logger, metrics prommetrics.Metrics) {
 		}
 	}
 
-	// submit tx to queue
-	from, _ := submitClient.GetKeyAddress()
+	msgs := []sdk.Msg{}
 	if pathParts[len(pathParts)-1] == "key" {
-		submitClientUpdate(client, submitClient, query, res.Height, logger, metrics)
+		msgs = clientUpdate(client, submitClient, query, res.Height, logger, metrics)
+	}
+
+	msg := &qstypes.MsgSubmitQueryResponse{ChainId: query.ChainId, QueryId: query.QueryId, Result: res.Value, Height: res.Height, ProofOps: res.ProofOps, FromAddress: signer(submitClient)}
+	return append(msgs, msg), nil
+}
+
+// newSubmitterConfig returns the submitter config for the given controller,
+// pricing txs at the gas prices of its chain config.
+func newSubmitterConfig(chainId string, chainCfg *lensclient.ChainClientConfig, gas *config.GasConfig) (submitter.Config, error) {
+	if chainCfg == nil {
+		return submitter.Config{}, fmt.Errorf("no config for chain %s", chainId)
+	}
+	gasPrices, err := sdk.ParseDecCoins(chainCfg.GasPrices)
+	if err != nil {
+		return submitter.Config{}, fmt.Errorf("invalid gas prices for %s: %w", chainCfg.ChainID, err)
 	}
+	gasAdjustment := gas.GasAdjustment
+	if gasAdjustment == 0 {
+		gasAdjustment = chainCfg.GasAdjustment
+	}
+	return submitter.Config{
+		GasAdjustment:    gasAdjustment,
+		GasPrices:        gasPrices,
+		MaxGas:           gas.MaxGas,
+		MaxTxBytes:       gas.MaxTxBytes,
+		FeeEscalation:    gas.FeeEscalation,
+		MaxFeeMultiplier: gas.MaxFeeMultiplier,
+		MaxAttempts:      gas.MaxAttempts,
+		Timeout:          BroadcastTimeout,
+	}, nil
+}
+
+// enqueue persists msgs before handing them to the flusher for chainId, so
+// that they survive a restart until they have been submitted. The msgs are
+// flushed together, so that a response is never submitted apart from the
+// client update it is proven against.
+func enqueue(chainId string, msgs []sdk.Msg, logger log.Logger) {
+	entries := make([]store.Entry, 0, len(msgs))
+	for _, msg := range msgs {
+		entry, err := db.Enqueue(chainId, msg)
+		if err != nil {
+			_ = logger.Log("msg", "Error: Unable to persist queued message", "err", err)
+			entry = store.Entry{Msg: msg}
+		}
+		entries = append(entries, entry)
+	}
+	sendQueue[chainId] <- entries
+}
 
-	msg := &qstypes.MsgSubmitQueryResponse{ChainId: query.ChainId, QueryId: query.QueryId, Result: res.Value, Height: res.Height, ProofOps: res.ProofOps, FromAddress: submitClient.MustEncodeAccAddr(from)}
-	sendQueue[query.SourceChainId] <- msg
+// queryRecordKey scopes query records by controller, as each controller
+// allocates query ids independently.
+func queryRecordKey(controllerId, queryId string) string {
+	return controllerId + "/" + queryId
+}
+
+func setHeight(chainId string, height int64, logger log.Logger) {
+	if err := db.SetHeight(chainId, height); err != nil {
+		_ = logger.Log("msg", "Error: Unable to record height", "chain", chainId, "err", err)
+	}
 }
 
 // tm0.37 has a breaking change whereby tx events are no longer base64 encoded, so are represented as string and not bytes.
@@ -544,7 +768,7 @@ func doRequest(query Query, logger log.Logger, metrics prommetrics.Metrics) {
 // As such, we want to query the result directly, and unmarshal the json ourselves, to a representation of the result that conveniently
 // does not contain the Tx object (that we don't use, because the TxProof already contains a byte representation of tx anyway!)
 // Note: this function is compatible with 0.34 and 0.37 representations of transactions.
-func Tx(client *lensclient.ChainClient, hash []byte) (tmtypes.TxProof, int64, error) {
+func Tx(ctx context.Context, client *lensclient.ChainClient, hash []byte) (tmtypes.TxProof, int64, error) {
 	params := map[string]interface{}{
 		"hash":  hash,
 		"prove": true,
@@ -617,56 +841,61 @@ type TxResultMinimal struct {
 	Proof  tmtypes.TxProof `json:"proof"`
 }
 
-func submitClientUpdate(client, submitClient *lensclient.ChainClient, query Query, height int64, logger log.Logger, metrics prommetrics.Metrics) {
-	from, _ := submitClient.GetKeyAddress()
-	submitQuerier := lensquery.Query{Client: submitClient, Options: lensquery.DefaultOptions()}
-	clientId, found := cache.Get("clientId/" + query.ConnectionId)
-	if !found {
-		connection, err := submitQuerier.Ibc_Connection(query.ConnectionId)
-		if err != nil {
-			_ = logger.Log("msg", fmt.Sprintf("Error: Could not get connection from chain %s", err))
-			return
-		}
-		clientId = connection.Connection.ClientId
-		cache.Set("clientId/"+query.ConnectionId, clientId, 1)
+// clientUpdate returns a msg updating the controller's client of the host to
+// the header following height, if the client is not already beyond it.
+func clientUpdate(client, submitClient Chain, query Query, height int64, logger log.Logger, metrics prommetrics.Metrics) []sdk.Msg {
+	clientId, err := clientIdForConnection(submitClient, query.ConnectionId)
+	if err != nil {
+		_ = logger.Log("msg", fmt.Sprintf("Error: Could not get connection from chain %s", err))
+		return nil
 	}
 
-	header, err := getHeader(ctx, client, submitClient, clientId.(string), height, logger, false, metrics)
+	header, err := getHeader(ctx, client, submitClient, clientId, height, logger, false, metrics)
 	if err != nil {
 		_ = logger.Log("msg", fmt.Sprintf("Error: Could not get header %s", err))
-		return
+		return nil
 	}
 	anyHeader, err := clienttypes.PackHeader(header)
 	if err != nil {
 		_ = logger.Log("msg", fmt.Sprintf("Error: Could not pack header %s", err))
-		return
+		return nil
 	}
 
-	msg := &clienttypes.MsgUpdateClient{
-		ClientId: clientId.(string), // needs to be passed in as part of request.
+	return []sdk.Msg{&clienttypes.MsgUpdateClient{
+		ClientId: clientId, // needs to be passed in as part of request.
 		Header:   anyHeader,
-		Signer:   submitClient.MustEncodeAccAddr(from),
-	}
-
-	sendQueue[query.SourceChainId] <- msg
-	metrics.SendQueue.WithLabelValues("send-queue").Set(float64(len(sendQueue)))
+		Signer:   signer(submitClient),
+	}}
 }
 
-func getHeader(ctx context.Context, client, submitClient *lensclient.ChainClient, clientId string, requestHeight int64, logger log.Logger, historicOk bool, metrics prommetrics.Metrics) (*tmclient.Header, error) {
-	submitQuerier := lensquery.Query{Client: submitClient, Options: lensquery.DefaultOptions()}
-	state, err := submitQuerier.Ibc_ClientState(clientId) // pass in from request
-	if err != nil {
-		return nil, fmt.Errorf("error: Could not get state from chain: %q ", err.Error())
+// clientIdForConnection returns the client underlying the given connection on
+// the controller; connection ids are only unique per controller.
+func clientIdForConnection(submitClient Chain, connectionId string) (string, error) {
+	key := "clientId/" + submitClient.ChainID() + "/" + connectionId
+	if clientId, found := cache.Get(key); found {
+		return clientId.(string), nil
 	}
-	unpackedState, err := clienttypes.UnpackClientState(state.ClientState)
+	clientId, err := submitClient.ClientID(ctx, connectionId)
 	if err != nil {
-		return nil, fmt.Errorf("error: Could not unpack state from chain: %q ", err.Error())
+		return "", err
 	}
+	cache.Set(key, clientId, 1)
+	return clientId, nil
+}
 
-	trustedHeight := unpackedState.GetLatestHeight()
-	clientHeight, ok := trustedHeight.(clienttypes.Height)
-	if !ok {
-		return nil, fmt.Errorf("error: Could coerce trusted height")
+// getHeader returns a header updating the given client on submitClient to
+// requestHeight+1 of client; queries at the same height share one header.
+func getHeader(ctx context.Context, client, submitClient Chain, clientId string, requestHeight int64, logger log.Logger, historicOk bool, metrics prommetrics.Metrics) (*tmclient.Header, error) {
+	key := headerKey(client.ChainID(), submitClient.ChainID(), clientId, requestHeight, historicOk)
+	return sharedHeader(key, client.ChainID(), func() (*tmclient.Header, error) {
+		return buildHeader(ctx, client, submitClient, clientId, requestHeight, logger, historicOk, metrics)
+	}, metrics)
+}
+
+func buildHeader(ctx context.Context, client, submitClient Chain, clientId string, requestHeight int64, logger log.Logger, historicOk bool, metrics prommetrics.Metrics) (*tmclient.Header, error) {
+	clientHeight, err := submitClient.ClientHeight(ctx, clientId) // pass in from request
+	if err != nil {
+		return nil, fmt.Errorf("error: Could not get state from chain: %q ", err.Error())
 	}
 
 	if !historicOk && clientHeight.RevisionHeight >= uint64(requestHeight+1) {
@@ -705,81 +934,144 @@ func getHeader(ctx context.Context, client, submitClient *lensclient.ChainClient
 	return header, nil
 }
 
+// FlushSendQueue batches the msgs queued for chainId into flushes, submitting
+// as many flushes at once as the chain has signer keys.
 func FlushSendQueue(chainId string, logger log.Logger, metrics prommetrics.Metrics) error {
-	time.Sleep(WaitInterval)
-	toSend := []sdk.Msg{}
+	if !sleep(WaitInterval) {
+		return nil
+	}
+	toSend := []store.Entry{}
 	ch := sendQueue[chainId]
 
-	for {
-		if len(toSend) > MaxTxMsgs {
+	inFlight := make(chan struct{}, 1)
+	if pool := submitters[chainId]; pool != nil && pool.Size() > 1 {
+		inFlight = make(chan struct{}, pool.Size())
+	}
+	flushes := &sync.WaitGroup{}
+	defer flushes.Wait()
+	dispatch := func(toSend []store.Entry) {
+		if len(toSend) == 0 {
+			return
+		}
+		inFlight <- struct{}{}
+		flushes.Add(1)
+		go func() {
+			defer flushes.Done()
+			defer func() { <-inFlight }()
 			flush(chainId, toSend, logger, metrics)
-			toSend = []sdk.Msg{}
+		}()
+	}
+
+	for {
+		// while paused, messages are held until the chain is resumed.
+		if len(toSend) > MaxTxMsgs && !isPaused(chainId) {
+			dispatch(toSend)
+			toSend = []store.Entry{}
 		}
 		select {
-		case msg := <-ch:
-			toSend = append(toSend, msg)
-			metrics.SendQueue.WithLabelValues("send-queue").Set(float64(len(sendQueue[chainId])))
+		case <-ctx.Done():
+			// anything unsent remains queued in the store for the next run.
+			return nil
+		case entries := <-ch:
+			toSend = append(toSend, entries...)
+			metrics.SendQueue.WithLabelValues("send-queue", chainId).Set(float64(len(sendQueue[chainId])))
 		case <-time.After(WaitInterval):
-			flush(chainId, toSend, logger, metrics)
-			metrics.SendQueue.WithLabelValues("send-queue").Set(float64(len(sendQueue[chainId])))
-			toSend = []sdk.Msg{}
+			if !isPaused(chainId) {
+				dispatch(toSend)
+				toSend = []store.Entry{}
+			}
+			metrics.SendQueue.WithLabelValues("send-queue", chainId).Set(float64(len(sendQueue[chainId])))
+			if _, err := db.PruneQueries(time.Now().Add(-QueryRetention)); err != nil {
+				_ = logger.Log("msg", "Error: Unable to prune query records", "err", err)
+			}
+			if _, err := db.DeleteDeadLetters(func(letter store.DeadLetter) bool { return time.Since(letter.Time) > QueryRetention }); err != nil {
+				_ = logger.Log("msg", "Error: Unable to prune dead letters", "err", err)
+			}
 		}
 	}
 }
 
-// TODO: refactor me!
-func flush(chainId string, toSend []sdk.Msg, logger log.Logger, metrics prommetrics.Metrics) {
-	if len(toSend) > 0 {
-		_ = logger.Log("msg", fmt.Sprintf("Sending batch of %d messages", len(toSend)))
-		chainClient := globalCfg.Cl[chainId]
-		if chainClient == nil {
-			return
+func flush(chainId string, toSend []store.Entry, logger log.Logger, metrics prommetrics.Metrics) {
+	if len(toSend) == 0 {
+		return
+	}
+	_ = logger.Log("msg", fmt.Sprintf("Sending batch of %d messages", len(toSend)))
+	pool := submitters[chainId]
+	if pool == nil {
+		return
+	}
+	// the batch is settled once submission has been attempted; queries whose
+	// responses fail to land remain open on chain and are re-requested.
+	defer func() {
+		if err := db.Ack(chainId, toSend...); err != nil {
+			_ = logger.Log("msg", "Error: Unable to remove sent messages from queue", "err", err)
 		}
-		// dedupe on queryId
-		msgs := unique(toSend, logger)
-		if len(msgs) > 0 {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
-			defer cancel()
-			resp, err := chainClient.SendMsgs(ctx, msgs, VERSION)
-			if err != nil {
-				switch {
-				case resp != nil && resp.Code == 19 && resp.Codespace == "sdk":
-					_ = logger.Log("msg", "Tx already in mempool")
-				case resp != nil && resp.Code == 12 && resp.Codespace == "sdk":
-					_ = logger.Log("msg", "Not enough gas")
-				case err.Error() == "context deadline exceeded":
-					_ = logger.Log("msg", "Failed to submit in time, retrying")
-					resp, err := chainClient.SendMsgs(ctx, msgs, VERSION)
-					if err != nil {
-						switch {
-						case resp != nil && resp.Code == 19 && resp.Codespace == "sdk":
-							_ = logger.Log("msg", "Tx already in mempool")
-						case resp != nil && resp.Code == 12 && resp.Codespace == "sdk":
-							_ = logger.Log("msg", "Not enough gas")
-						case err.Error() == "context deadline exceeded":
-							_ = logger.Log("msg", "Failed to submit in time, bailing")
-						default:
-							_ = logger.Log("msg", "Failed to submit after retry; nevermind, we'll try again!", "err", err)
-							metrics.FailedTxs.WithLabelValues("failed_txs").Inc()
-						}
-					}
-				default:
-					_ = logger.Log("msg", "Failed to submit; nevermind, we'll try again!", "err", err)
-					metrics.FailedTxs.WithLabelValues("failed_txs").Inc()
+	}()
+
+	// dedupe on queryId
+	entries := unique(toSend, logger)
+	if len(entries) == 0 {
+		return
+	}
+	msgs := make([]sdk.Msg, len(entries))
+	byMsg := make(map[sdk.Msg]store.Entry, len(entries))
+	for i, entry := range entries {
+		msgs[i] = entry.Msg
+		byMsg[entry.Msg] = entry
+	}
+
+	// batches are split in order, so client updates still precede the
+	// responses proven against them.
+	results := pool.Submit(context.Background(), msgs)
+	metrics.TxBatches.WithLabelValues("tx_batches", chainId).Add(float64(len(results)))
+
+	sent := 0
+	for _, result := range results {
+		if result.Err != nil {
+			_ = logger.Log("msg", "Failed to submit; nevermind, we'll try again!", "msgs", len(result.Msgs), "key", result.Key, "gas", result.Gas, "fees", result.Fees.String(), "err", result.Err)
+			metrics.FailedTxs.WithLabelValues("failed_txs", chainId).Inc()
+			failed := make([]store.Entry, 0, len(result.Msgs))
+			for _, msg := range result.Msgs {
+				failed = append(failed, byMsg[msg])
+				if res, ok := msg.(*qstypes.MsgSubmitQueryResponse); ok {
+					commitTxSearch(chainId, res.QueryId, false, logger)
 				}
 			}
-			_ = logger.Log("msg", fmt.Sprintf("Sent batch of %d (deduplicated) messages", len(msgs)))
+			if err := db.AddDeadLetters(chainId, result.Err.Error(), time.Now(), failed...); err != nil {
+				_ = logger.Log("msg", "Error: Unable to record dead letters", "err", err)
+			}
+			continue
+		}
+
+		metrics.SignerTxs.WithLabelValues("signer_txs", chainId, result.Key).Inc()
+		if res := result.Response; res != nil && res.Code == 0 {
+			metrics.GasWanted.WithLabelValues("gas_wanted", chainId).Add(float64(res.GasWanted))
+			metrics.GasUsed.WithLabelValues("gas_used", chainId).Add(float64(res.GasUsed))
+			for _, fee := range result.Fees {
+				amount, _ := sdk.NewDecFromInt(fee.Amount).Float64()
+				metrics.FeesPaid.WithLabelValues("fees_paid", chainId, fee.Denom).Add(amount)
+			}
 		}
+		for _, msg := range result.Msgs {
+			if res, ok := msg.(*qstypes.MsgSubmitQueryResponse); ok {
+				if err := db.SetQuery(queryRecordKey(chainId, res.QueryId), res.Height, time.Now()); err != nil {
+					_ = logger.Log("msg", "Error: Unable to record query", "id", res.QueryId, "err", err)
+				}
+				commitTxSearch(chainId, res.QueryId, true, logger)
+			}
+		}
+		sent += len(result.Msgs)
 	}
+	_ = logger.Log("msg", fmt.Sprintf("Sent %d of %d (deduplicated) messages in %d txs", sent, len(msgs), len(results)))
 }
 
-func unique(msgSlice []sdk.Msg, logger log.Logger) []sdk.Msg {
+func unique(entries []store.Entry, logger log.Logger) []store.Entry {
 	keys := make(map[string]bool)
 	clientUpdateHeights := make(map[string]bool)
 
-	list := []sdk.Msg{}
-	for _, entry := range msgSlice {
-		msg, ok := entry.(*clienttypes.MsgUpdateClient)
+	list := []store.Entry{}
+	for _, entry := range entries {
+		msg, ok := entry.Msg.(*clienttypes.MsgUpdateClient)
 		if ok {
 			header, _ := clienttypes.UnpackHeader(msg.Header)
 			key := header.GetHeight().String()
@@ -790,7 +1082,7 @@ func unique(msgSlice []sdk.Msg, logger log.Logger) []sdk.Msg {
 			}
 			continue
 		}
-		msg2, ok2 := entry.(*qstypes.MsgSubmitQueryResponse)
+		msg2, ok2 := entry.Msg.(*qstypes.MsgSubmitQueryResponse)
 		if ok2 {
 			if _, value := keys[msg2.QueryId]; !value {
 				keys[msg2.QueryId] = true
@@ -806,11 +1098,22 @@ func unique(msgSlice []sdk.Msg, logger log.Logger) []sdk.Msg {
 func Close() error {
 	query := tmquery.MustParse(fmt.Sprintf("message.module='%s'", "interchainquery"))
 
-	for _, chainClient := range globalCfg.Cl {
-		err := chainClient.RPCClient.Unsubscribe(ctx, chainClient.Config.ChainID+"-icq", query.String())
+	if globalCfg == nil {
+		return nil
+	}
+	for _, controller := range globalCfg.GetControllers() {
+		chainClient, ok := chains[controller.ChainID]
+		if !ok {
+			continue
+		}
+		err := chainClient.Unsubscribe(ctx, controller.ChainID+"-icq", query.String())
 		if err != nil {
 			return err
 		}
 	}
+	cancel()
+	if db != nil {
+		return db.Close()
+	}
 	return nil
 }
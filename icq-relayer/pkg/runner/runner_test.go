@@ -0,0 +1,480 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	querytypes "github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	qstypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	lensclient "github.com/strangelove-ventures/lens/client"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/api"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/config"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/mockchain"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/store"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/submitter"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/prommetrics"
+)
+
+const (
+	hostChainID       = "mockhost-1"
+	controllerChainID = "quicksilver-1"
+	connectionID      = "connection-0"
+)
+
+// relay runs the relayer offline between a mock host and controller, with the
+// host's light client on the controller trusting the host's genesis block.
+// Options amend the config before the relayer is started.
+func relay(t *testing.T, opts ...func(*config.Config)) (*mockchain.Chain, *mockchain.Chain) {
+	t.Helper()
+	host := mockchain.New(hostChainID, "bank")
+	controller := mockchain.New(controllerChainID)
+	controller.AddConnection(connectionID, "07-tendermint-0", host)
+
+	cfg := &config.Config{
+		Chains: map[string]*lensclient.ChainClientConfig{
+			"host":       {ChainID: hostChainID},
+			"controller": {ChainID: controllerChainID, GasPrices: "0.01uqck", GasAdjustment: 1.2},
+		},
+		Controllers: []*config.ControllerConfig{{ChainID: controllerChainID}},
+		Scheduler:   &config.SchedulerConfig{HistoricInterval: "200ms"},
+		TxSearch:    &config.TxSearchConfig{PageLimit: 2, MaxTxs: 3},
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	queue, err := store.Open(t.TempDir(), controller.Codec())
+	require.NoError(t, err)
+
+	interval := WaitInterval
+	WaitInterval = 100 * time.Millisecond
+	wg := &sync.WaitGroup{}
+	chains := map[string]Chain{hostChainID: host, controllerChainID: controller}
+	require.NoError(t, start(cfg, chains, queue, wg, log.NewNopLogger(), *prommetrics.NewMetrics(prometheus.NewRegistry())))
+	t.Cleanup(func() {
+		require.NoError(t, Close())
+		wg.Wait()
+		WaitInterval = interval
+	})
+	return host, controller
+}
+
+// queryId derives a query id of the form allocated by the controller.
+func queryId(name string) string {
+	hash := sha256.Sum256([]byte(name))
+	return hex.EncodeToString(hash[:])
+}
+
+func answered(controller *mockchain.Chain, id string) func() bool {
+	return func() bool {
+		for _, q := range controller.Queries() {
+			if q.Id == id {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func response(t *testing.T, controller *mockchain.Chain, id string) *qstypes.MsgSubmitQueryResponse {
+	t.Helper()
+	for _, msg := range controller.Submitted() {
+		if res, ok := msg.(*qstypes.MsgSubmitQueryResponse); ok && res.QueryId == id {
+			return res
+		}
+	}
+	require.FailNow(t, "no response submitted", id)
+	return nil
+}
+
+func TestRelayKeyQueries(t *testing.T) {
+	host, controller := relay(t)
+	host.Set("bank", []byte("balance/alice"), []byte("100uatom"))
+	for i := 0; i < 3; i++ {
+		host.Commit()
+	}
+
+	// the controller only accepts responses proven against headers it has
+	// been updated with.
+	controller.EmitQuery(qstypes.Query{Id: queryId("present"), ConnectionId: connectionID, ChainId: hostChainID, QueryType: "store/bank/key", Request: []byte("balance/alice")})
+	controller.EmitQuery(qstypes.Query{Id: queryId("absent"), ConnectionId: connectionID, ChainId: hostChainID, QueryType: "store/bank/key", Request: []byte("balance/bob")})
+	require.Eventually(t, answered(controller, queryId("present")), 10*time.Second, 50*time.Millisecond)
+	require.Eventually(t, answered(controller, queryId("absent")), 10*time.Second, 50*time.Millisecond)
+
+	require.Equal(t, []byte("100uatom"), response(t, controller, queryId("present")).Result)
+	require.Empty(t, response(t, controller, queryId("absent")).Result)
+
+	// client updates precede the responses proven against them.
+	_, ok := controller.Submitted()[0].(*clienttypes.MsgUpdateClient)
+	require.True(t, ok)
+}
+
+func TestRelayHistoricTxQuery(t *testing.T) {
+	host, controller := relay(t)
+	tx, err := controller.Codec().Marshal(&txtypes.Tx{Body: &txtypes.TxBody{Memo: "deposit"}, AuthInfo: &txtypes.AuthInfo{}})
+	require.NoError(t, err)
+	hash := host.AddTx(tx, "transfer.recipient='quick1deposit'")
+	host.Commit()
+	host.Commit()
+
+	// added without an event, so only found by polling.
+	request, err := controller.Codec().Marshal(&txtypes.GetTxRequest{Hash: hex.EncodeToString(hash)})
+	require.NoError(t, err)
+	controller.AddQuery(qstypes.Query{Id: queryId("deposit"), ConnectionId: connectionID, ChainId: hostChainID, QueryType: "tendermint.Tx", Request: request})
+	require.Eventually(t, answered(controller, queryId("deposit")), 10*time.Second, 50*time.Millisecond)
+
+	res := qstypes.GetTxWithProofResponse{}
+	require.NoError(t, controller.Codec().Unmarshal(response(t, controller, queryId("deposit")).Result, &res))
+	require.Equal(t, []byte(tx), res.Proof.Data)
+}
+
+func TestRelayTxSearch(t *testing.T) {
+	host, controller := relay(t)
+	for _, memo := range []string{"first", "second"} {
+		tx, err := controller.Codec().Marshal(&txtypes.Tx{Body: &txtypes.TxBody{Memo: memo}, AuthInfo: &txtypes.AuthInfo{}})
+		require.NoError(t, err)
+		host.AddTx(tx, "transfer.recipient='quick1deposit'")
+		host.Commit()
+	}
+	host.Commit()
+	host.Commit()
+
+	request, err := controller.Codec().Marshal(&txtypes.GetTxsEventRequest{Events: []string{"transfer.recipient='quick1deposit'"}, Pagination: &querytypes.PageRequest{}})
+	require.NoError(t, err)
+	controller.EmitQuery(qstypes.Query{Id: queryId("search"), ConnectionId: connectionID, ChainId: hostChainID, QueryType: "cosmos.tx.v1beta1.Service/GetTxsEvent", Request: request})
+	require.Eventually(t, answered(controller, queryId("search")), 10*time.Second, 50*time.Millisecond)
+
+	res := txtypes.GetTxsEventResponse{}
+	require.NoError(t, controller.Codec().Unmarshal(response(t, controller, queryId("search")).Result, &res))
+	require.Len(t, res.Txs, 2)
+	// the relayer relays the oldest txs first.
+	require.Equal(t, "first", res.Txs[0].Body.Memo)
+}
+
+// searchDeposits answers a deposit search under a fresh query id, returning
+// the hashes of the txs relayed.
+func searchDeposits(t *testing.T, host, controller *mockchain.Chain, id string) []string {
+	t.Helper()
+	// the current height of the host is cached; the search runs up to it.
+	cache.Del("currentblock/" + hostChainID)
+	request, err := controller.Codec().Marshal(&txtypes.GetTxsEventRequest{Events: []string{"transfer.recipient='quick1deposit'"}, OrderBy: txtypes.OrderBy_ORDER_BY_DESC, Pagination: &querytypes.PageRequest{Limit: 200}})
+	require.NoError(t, err)
+	controller.EmitQuery(qstypes.Query{Id: queryId(id), ConnectionId: connectionID, ChainId: hostChainID, QueryType: "cosmos.tx.v1beta1.Service/GetTxsEvent", Request: request})
+	require.Eventually(t, answered(controller, queryId(id)), 10*time.Second, 50*time.Millisecond)
+	// progress is recorded once the response lands.
+	require.Eventually(t, func() bool {
+		searchMu.Lock()
+		defer searchMu.Unlock()
+		return len(searchProgress) == 0
+	}, 10*time.Second, 50*time.Millisecond)
+
+	res := txtypes.GetTxsEventResponse{}
+	require.NoError(t, controller.Codec().Unmarshal(response(t, controller, queryId(id)).Result, &res))
+	hashes := []string{}
+	for _, txRes := range res.TxResponses {
+		hashes = append(hashes, txRes.TxHash)
+	}
+	return hashes
+}
+
+func TestRelayTxSearchPagesWithoutSkipping(t *testing.T) {
+	host, controller := relay(t)
+	// blocks of 2, 3, 1 and 1 deposits, amongst other txs.
+	deposits := []string{}
+	for i, count := range []int{2, 3, 1, 1} {
+		for j := 0; j < count; j++ {
+			tx, err := controller.Codec().Marshal(&txtypes.Tx{Body: &txtypes.TxBody{Memo: fmt.Sprintf("deposit-%d-%d", i, j)}, AuthInfo: &txtypes.AuthInfo{}})
+			require.NoError(t, err)
+			deposits = append(deposits, strings.ToUpper(hex.EncodeToString(host.AddTx(tx, "transfer.recipient='quick1deposit'"))))
+			other, err := controller.Codec().Marshal(&txtypes.Tx{Body: &txtypes.TxBody{Memo: fmt.Sprintf("other-%d-%d", i, j)}, AuthInfo: &txtypes.AuthInfo{}})
+			require.NoError(t, err)
+			host.AddTx(other, "transfer.recipient='quick1other'")
+		}
+		host.Commit()
+	}
+	// the relayer queries the host two blocks behind its latest.
+	host.Commit()
+	host.Commit()
+
+	// at most 3 txs are relayed per response, fetched 2 per page, save that a
+	// block is never split across responses.
+	first := searchDeposits(t, host, controller, "first")
+	require.Equal(t, deposits[:5], first)
+	second := searchDeposits(t, host, controller, "second")
+	require.Equal(t, deposits[5:], second)
+	require.Empty(t, searchDeposits(t, host, controller, "third"))
+
+	tx, err := controller.Codec().Marshal(&txtypes.Tx{Body: &txtypes.TxBody{Memo: "late"}, AuthInfo: &txtypes.AuthInfo{}})
+	require.NoError(t, err)
+	late := strings.ToUpper(hex.EncodeToString(host.AddTx(tx, "transfer.recipient='quick1deposit'")))
+	host.Commit()
+	host.Commit()
+	host.Commit()
+	require.Equal(t, []string{late}, searchDeposits(t, host, controller, "fourth"))
+
+	searches, err := relayerState{}.TxSearches()
+	require.NoError(t, err)
+	require.Len(t, searches, 1)
+	require.Equal(t, "transfer.recipient='quick1deposit'", searches[0].Query)
+	require.Equal(t, host.Height()-2, searches[0].Height)
+}
+
+func TestRelayTxSearchBackfill(t *testing.T) {
+	host, controller := relay(t)
+	deposits := []string{}
+	for i := 0; i < 3; i++ {
+		tx, err := controller.Codec().Marshal(&txtypes.Tx{Body: &txtypes.TxBody{Memo: fmt.Sprintf("deposit-%d", i)}, AuthInfo: &txtypes.AuthInfo{}})
+		require.NoError(t, err)
+		deposits = append(deposits, strings.ToUpper(hex.EncodeToString(host.AddTx(tx, "transfer.recipient='quick1deposit'"))))
+		host.Commit()
+	}
+	host.Commit()
+	host.Commit()
+	require.Equal(t, deposits, searchDeposits(t, host, controller, "initial"))
+	require.Empty(t, searchDeposits(t, host, controller, "caught-up"))
+
+	// the second deposit landed at height 3.
+	require.Error(t, relayerState{}.Backfill(api.BackfillRequest{Controller: hostChainID, ChainID: hostChainID, Query: "transfer.recipient='quick1deposit'", Backfill: api.Backfill{FromHeight: 3, ToHeight: 3}}))
+	require.NoError(t, relayerState{}.Backfill(api.BackfillRequest{Controller: controllerChainID, ChainID: hostChainID, Query: "transfer.recipient='quick1deposit'", Backfill: api.Backfill{FromHeight: 3, ToHeight: 3}}))
+	searches, err := relayerState{}.TxSearches()
+	require.NoError(t, err)
+	require.Equal(t, &api.Backfill{FromHeight: 3, ToHeight: 3}, searches[0].Backfill)
+
+	require.Equal(t, deposits[1:2], searchDeposits(t, host, controller, "backfill"))
+	searches, err = relayerState{}.TxSearches()
+	require.NoError(t, err)
+	require.Nil(t, searches[0].Backfill)
+	require.Empty(t, searchDeposits(t, host, controller, "after-backfill"))
+}
+
+func TestRelayDeadLettersRejectedResponses(t *testing.T) {
+	host, controller := relay(t)
+	host.Handle("/cosmos.bank.v1beta1.Query/AllBalances", func(abcitypes.RequestQuery) ([]byte, error) { return []byte{}, nil })
+	host.Commit()
+
+	// the controller knows of no connection-1, so rejects the response, which
+	// is recorded for inspection rather than retried.
+	controller.EmitQuery(qstypes.Query{Id: queryId("orphan"), ConnectionId: "connection-1", ChainId: hostChainID, QueryType: "cosmos.bank.v1beta1.Query/AllBalances"})
+	require.Eventually(t, func() bool {
+		letters, err := db.DeadLetters()
+		return err == nil && len(letters) == 1
+	}, 10*time.Second, 50*time.Millisecond)
+
+	letters, err := relayerState{}.DeadLetters()
+	require.NoError(t, err)
+	require.Equal(t, queryId("orphan"), letters[0].QueryID)
+	require.Contains(t, letters[0].Reason, "connection connection-1 not found")
+	require.Empty(t, controller.Submitted())
+}
+
+func TestRelayRotatesSignerKeys(t *testing.T) {
+	host, controller := relay(t, func(cfg *config.Config) {
+		cfg.Controllers[0].Keys = []string{"relayer-0", "relayer-1"}
+	})
+	host.Set("bank", []byte("balance/alice"), []byte("100uatom"))
+	for i := 0; i < 3; i++ {
+		host.Commit()
+	}
+
+	// answered one at a time, so in successive flushes.
+	for _, name := range []string{"first", "second"} {
+		controller.EmitQuery(qstypes.Query{Id: queryId(name), ConnectionId: connectionID, ChainId: hostChainID, QueryType: "store/bank/key", Request: []byte("balance/alice")})
+		require.Eventually(t, answered(controller, queryId(name)), 10*time.Second, 50*time.Millisecond)
+		host.Commit()
+	}
+
+	// the mock controller rejects msgs not signed by the key of their tx.
+	keys := map[string]bool{controller.KeyAddress("relayer-0"): true, controller.KeyAddress("relayer-1"): true}
+	signers := map[string]bool{}
+	for _, signer := range controller.Signers() {
+		signers[signer] = true
+	}
+	require.Equal(t, keys, signers)
+	require.True(t, keys[response(t, controller, queryId("first")).FromAddress])
+}
+
+func TestMonitorSignersFlagsLowBalance(t *testing.T) {
+	controller := mockchain.New(controllerChainID)
+	controllerCfg := &config.ControllerConfig{ChainID: controllerChainID, Keys: []string{"relayer-0", "relayer-1"}, FeeGranter: "treasury", MinBalance: "1000000uqck"}
+	pool, err := newPool(controller, controllerCfg, submitter.Config{})
+	require.NoError(t, err)
+	treasury := controller.KeyAddress("treasury")
+	controller.SetBalance(treasury, sdk.NewInt64Coin("uqck", 500000))
+
+	// checked once, as the relayer is closed.
+	closed, cancel := context.WithCancel(context.Background())
+	cancel()
+	metrics := *prommetrics.NewMetrics(prometheus.NewRegistry())
+	monitorSigners(closed, controller, controllerCfg, pool, balanceDenoms(controllerCfg, submitter.Config{}), log.NewNopLogger(), metrics)
+
+	require.Equal(t, 500000.0, testutil.ToFloat64(metrics.SignerBalance.WithLabelValues("signer_balance", controllerChainID, treasury, "uqck")))
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.LowBalance.WithLabelValues("low_balance", controllerChainID, treasury)))
+	// the keys are paid for by the granter, so go unmonitored.
+	require.Equal(t, 1, testutil.CollectAndCount(&metrics.SignerBalance))
+
+	controller.SetBalance(treasury, sdk.NewInt64Coin("uqck", 2000000))
+	monitorSigners(closed, controller, controllerCfg, pool, balanceDenoms(controllerCfg, submitter.Config{}), log.NewNopLogger(), metrics)
+	require.Equal(t, 0.0, testutil.ToFloat64(metrics.LowBalance.WithLabelValues("low_balance", controllerChainID, treasury)))
+}
+
+// countingChain counts the light blocks fetched from a mock chain.
+type countingChain struct {
+	*mockchain.Chain
+	mu          sync.Mutex
+	lightBlocks int
+}
+
+func (c *countingChain) LightBlock(ctx context.Context, height int64) (*tmtypes.LightBlock, error) {
+	c.mu.Lock()
+	c.lightBlocks++
+	c.mu.Unlock()
+	return c.Chain.LightBlock(ctx, height)
+}
+
+func (c *countingChain) fetched() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lightBlocks
+}
+
+func TestHeadersReuseCachedLightBlocks(t *testing.T) {
+	host := &countingChain{Chain: mockchain.New(hostChainID, "bank")}
+	controller := mockchain.New(controllerChainID)
+	controller.AddConnection(connectionID, "07-tendermint-0", host.Chain)
+	for i := 0; i < 3; i++ {
+		host.Commit()
+	}
+
+	dir := t.TempDir()
+	queue, err := store.Open(dir, controller.Codec())
+	require.NoError(t, err)
+	db = queue
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+		db = nil
+	})
+	newCache()
+	resetHeaders()
+	metrics := *prommetrics.NewMetrics(prometheus.NewRegistry())
+
+	// the new and trusted light blocks are fetched once...
+	header, err := getHeader(context.Background(), host, controller, "07-tendermint-0", 2, log.NewNopLogger(), false, metrics)
+	require.NoError(t, err)
+	require.Equal(t, 2, host.fetched())
+	require.Equal(t, 2.0, testutil.ToFloat64(metrics.LightBlockCacheMisses.WithLabelValues("lightblock_cache_misses", hostChainID)))
+
+	// ...and the header reused by queries at the same height.
+	reused, err := getHeader(context.Background(), host, controller, "07-tendermint-0", 2, log.NewNopLogger(), false, metrics)
+	require.NoError(t, err)
+	require.Same(t, header, reused)
+	require.Equal(t, 2, host.fetched())
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.HeaderReuse.WithLabelValues("header_reuse", hostChainID)))
+
+	// light blocks survive a restart.
+	require.NoError(t, db.Close())
+	db, err = store.Open(dir, controller.Codec())
+	require.NoError(t, err)
+	newCache()
+	resetHeaders()
+	rebuilt, err := getHeader(context.Background(), host, controller, "07-tendermint-0", 2, log.NewNopLogger(), false, metrics)
+	require.NoError(t, err)
+	require.Equal(t, header, rebuilt)
+	require.Equal(t, 2, host.fetched())
+	require.Equal(t, 2.0, testutil.ToFloat64(metrics.LightBlockCacheHits.WithLabelValues("lightblock_cache_hits", hostChainID, "disk")))
+
+	// and are then served from memory.
+	cache.Wait()
+	_, err = retryLightblock(context.Background(), host, 3, 5, log.NewNopLogger(), metrics)
+	require.NoError(t, err)
+	require.Equal(t, 2, host.fetched())
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.LightBlockCacheHits.WithLabelValues("lightblock_cache_hits", hostChainID, "memory")))
+}
+
+func TestDryRunWritesTxsAndRecordsQueries(t *testing.T) {
+	dir := t.TempDir()
+	DryRunDir, RecordDir = dir, dir
+	t.Cleanup(func() { DryRunDir, RecordDir = "", "" })
+
+	host, controller := relay(t)
+	host.Set("bank", []byte("balance/alice"), []byte("100uatom"))
+	host.Commit()
+	host.Commit()
+
+	controller.EmitQuery(qstypes.Query{Id: queryId("dry"), ConnectionId: connectionID, ChainId: hostChainID, QueryType: "store/bank/key", Request: []byte("balance/alice")})
+	// the client update and response may be written in separate txs.
+	written := func() []json.RawMessage {
+		txs, err := filepath.Glob(filepath.Join(dir, "txs", "*.json"))
+		require.NoError(t, err)
+		msgs := []json.RawMessage{}
+		for _, file := range txs {
+			bz, err := os.ReadFile(file)
+			require.NoError(t, err)
+			tx := submitter.DryRunTx{}
+			require.NoError(t, json.Unmarshal(bz, &tx))
+			require.Equal(t, controllerChainID, tx.ChainID)
+			msgs = append(msgs, tx.Msgs...)
+		}
+		return msgs
+	}
+	require.Eventually(t, func() bool { return len(written()) == 2 }, 10*time.Second, 50*time.Millisecond)
+	require.Contains(t, string(written()[0]), "MsgUpdateClient")
+	require.Contains(t, string(written()[1]), queryId("dry"))
+
+	// nothing reaches the controller, which still awaits a response.
+	require.Empty(t, controller.Submitted())
+	require.False(t, answered(controller, queryId("dry"))())
+
+	records, err := filepath.Glob(filepath.Join(dir, "queries", "query-"+controllerChainID+"-"+queryId("dry")+"-*.json"))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	query, err := LoadQuery(records[0])
+	require.NoError(t, err)
+	require.Equal(t, "store/bank/key", query.Type)
+	require.Equal(t, []byte("balance/alice"), query.Request)
+}
+
+func TestReplayQuery(t *testing.T) {
+	host := mockchain.New(hostChainID, "bank")
+	controller := mockchain.New(controllerChainID)
+	controller.AddConnection(connectionID, "07-tendermint-0", host)
+	host.Set("bank", []byte("balance/alice"), []byte("100uatom"))
+	host.Commit()
+	height := host.Commit()
+	host.Commit()
+	controller.AddQuery(qstypes.Query{Id: queryId("replay"), ConnectionId: connectionID, ChainId: hostChainID, QueryType: "store/bank/key", Request: []byte("balance/alice")})
+
+	// the query is looked up on the controller by id, and nothing submitted.
+	out := &bytes.Buffer{}
+	chains := map[string]Chain{hostChainID: host, controllerChainID: controller}
+	query := Query{SourceChainId: controllerChainID, QueryId: queryId("replay"), Height: height}
+	require.NoError(t, replay(chains, query, out, log.NewNopLogger(), *prommetrics.NewMetrics(prometheus.NewRegistry())))
+	require.Empty(t, controller.Submitted())
+
+	result := ReplayResult{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &result))
+	require.Equal(t, hostChainID, result.Query.ChainId)
+	require.Equal(t, height, result.Query.Height)
+	require.NotEmpty(t, result.Proof)
+	require.NotEmpty(t, result.Header)
+	require.Len(t, result.Msgs, 2)
+}
@@ -0,0 +1,142 @@
+package runner
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/go-kit/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	qstypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/config"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/submitter"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/prommetrics"
+)
+
+// BalanceInterval is how often the balances of the accounts paying for
+// submitted txs are checked.
+var BalanceInterval = time.Minute
+
+// newPool returns a pool of the signer keys of the given controller; on a dry
+// run, each key writes its txs rather than broadcasting them.
+func newPool(chain Chain, controller *config.ControllerConfig, cfg submitter.Config) (*submitter.Pool, error) {
+	keys := make([]submitter.Key, 0, len(controller.GetKeys()))
+	for _, name := range controller.GetKeys() {
+		key, err := chain.Key(name, controller.FeeGranter)
+		if err != nil {
+			return nil, err
+		}
+		if DryRunDir != "" {
+			key.Client = &submitter.DryRun{Client: key.Client, ChainID: controller.ChainID, Codec: chain.Codec(), Dir: path.Join(DryRunDir, "txs"), Sign: DryRunSign}
+		}
+		keys = append(keys, key)
+	}
+	return submitter.NewPool(keys, cfg, withSigner), nil
+}
+
+// withSigner returns a copy of msg to be signed by address. Msgs are built
+// before the key submitting them is chosen, so carry the default signer.
+func withSigner(msg sdk.Msg, address string) sdk.Msg {
+	switch msg := msg.(type) {
+	case *qstypes.MsgSubmitQueryResponse:
+		signed := *msg
+		signed.FromAddress = address
+		return &signed
+	case *clienttypes.MsgUpdateClient:
+		signed := *msg
+		signed.Signer = address
+		return &signed
+	}
+	return msg
+}
+
+// balanceDenoms returns the denoms whose balances are monitored for the given
+// controller: that of its min balance, or else those it pays fees in.
+func balanceDenoms(controller *config.ControllerConfig, cfg submitter.Config) []string {
+	if threshold := minBalance(controller); threshold != nil {
+		return []string{threshold.Denom}
+	}
+	denoms := make([]string, 0, len(cfg.GasPrices))
+	for _, price := range cfg.GasPrices {
+		denoms = append(denoms, price.Denom)
+	}
+	return denoms
+}
+
+// monitorSigners periodically records the balances of the accounts paying for
+// the txs of each key of pool: the fee granter, if any, or else the keys
+// themselves. Payers below the controller's min balance are flagged. It
+// returns once runCtx is done.
+func monitorSigners(runCtx context.Context, chain Chain, controller *config.ControllerConfig, pool *submitter.Pool, denoms []string, logger log.Logger, metrics prommetrics.Metrics) {
+	threshold := minBalance(controller)
+
+	for {
+		checked := map[string]bool{}
+		for _, key := range pool.Keys() {
+			payer := key.Payer()
+			if checked[payer] {
+				continue
+			}
+			checked[payer] = true
+
+			for _, denom := range denoms {
+				balance, err := queryBalance(runCtx, chain, payer, denom)
+				if err != nil {
+					_ = logger.Log("msg", "Error: Unable to fetch signer balance", "address", payer, "denom", denom, "err", err)
+					continue
+				}
+				amount, _ := sdk.NewDecFromInt(balance.Amount).Float64()
+				metrics.SignerBalance.WithLabelValues("signer_balance", controller.ChainID, payer, denom).Set(amount)
+				if threshold == nil || threshold.Denom != denom {
+					continue
+				}
+				low := 0.0
+				if balance.IsLT(*threshold) {
+					low = 1
+					_ = logger.Log("msg", "Warning: Signer balance is low", "address", payer, "balance", balance.String(), "min", threshold.String())
+				}
+				metrics.LowBalance.WithLabelValues("low_balance", controller.ChainID, payer).Set(low)
+			}
+		}
+
+		select {
+		case <-runCtx.Done():
+			return
+		case <-time.After(BalanceInterval):
+		}
+	}
+}
+
+// minBalance returns the min balance of the given controller, or nil if unset;
+// it is validated with the config.
+func minBalance(controller *config.ControllerConfig) *sdk.Coin {
+	if controller.MinBalance == "" {
+		return nil
+	}
+	threshold, err := sdk.ParseCoinNormalized(controller.MinBalance)
+	if err != nil {
+		return nil
+	}
+	return &threshold
+}
+
+func queryBalance(ctx context.Context, chain Chain, address, denom string) (sdk.Coin, error) {
+	req := banktypes.QueryBalanceRequest{Address: address, Denom: denom}
+	res, err := chain.QueryABCI(ctx, abcitypes.RequestQuery{Path: "/cosmos.bank.v1beta1.Query/Balance", Data: chain.Codec().MustMarshal(&req)})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	out := banktypes.QueryBalanceResponse{}
+	if err := chain.Codec().Unmarshal(res.Value, &out); err != nil {
+		return sdk.Coin{}, err
+	}
+	if out.Balance == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+	return *out.Balance, nil
+}
@@ -0,0 +1,204 @@
+package runner
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	qstypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/api"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/store"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/prommetrics"
+)
+
+var (
+	stateMu        sync.RWMutex
+	chainStatus    = map[string]api.ChainStatus{}
+	paused         = map[string]bool{}
+	pendingQueries = map[string][]api.PendingQuery{}
+)
+
+// relayerState exposes the runner state to the api.
+type relayerState struct{}
+
+var _ api.State = relayerState{}
+
+// resetState forgets the state of any previous run.
+func resetState() {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	chainStatus = map[string]api.ChainStatus{}
+	paused = map[string]bool{}
+	pendingQueries = map[string][]api.PendingQuery{}
+}
+
+func isPaused(chainId string) bool {
+	stateMu.RLock()
+	defer stateMu.RUnlock()
+	return paused[chainId]
+}
+
+// monitorChain periodically records the latest block of the given chain, so
+// that the api can report on its freshness.
+func monitorChain(client Chain, logger log.Logger, metrics prommetrics.Metrics) {
+	chainId := client.ChainID()
+	for {
+		status := api.ChainStatus{ChainID: chainId, CheckedAt: time.Now()}
+		res, err := client.Status(ctx)
+		if err != nil {
+			_ = logger.Log("msg", "Error: Unable to fetch chain status", "chain", chainId, "err", err)
+			status.Error = err.Error()
+		} else {
+			status.Height = res.SyncInfo.LatestBlockHeight
+			status.BlockTime = res.SyncInfo.LatestBlockTime
+			metrics.RemoteBlockHeight.WithLabelValues("remote_height", chainId).Set(float64(status.Height))
+			setHeight(chainId, status.Height, logger)
+		}
+
+		stateMu.Lock()
+		chainStatus[chainId] = status
+		stateMu.Unlock()
+
+		if !sleep(WaitInterval) {
+			return
+		}
+	}
+}
+
+func setPendingQueries(controllerId, chainId string, queries []qstypes.Query) {
+	pending := make([]api.PendingQuery, 0, len(queries))
+	for _, q := range queries {
+		pending = append(pending, api.PendingQuery{
+			Controller:   controllerId,
+			ChainID:      q.ChainId,
+			QueryID:      q.Id,
+			Type:         q.QueryType,
+			CallbackID:   q.CallbackId,
+			LastEmission: q.LastEmission.String(),
+		})
+	}
+
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	pendingQueries[controllerId+"/"+chainId] = pending
+}
+
+func (relayerState) Chains() []api.ChainStatus {
+	stateMu.RLock()
+	defer stateMu.RUnlock()
+	statuses := []api.ChainStatus{}
+	for chainId := range chains {
+		status, ok := chainStatus[chainId]
+		if !ok {
+			status = api.ChainStatus{ChainID: chainId}
+		}
+		status.Controller = globalCfg.GetController(chainId) != nil
+		status.Paused = paused[chainId]
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+func (relayerState) PendingQueries() []api.PendingQuery {
+	stateMu.RLock()
+	defer stateMu.RUnlock()
+	queries := []api.PendingQuery{}
+	for _, pending := range pendingQueries {
+		queries = append(queries, pending...)
+	}
+	return queries
+}
+
+func (relayerState) SendQueue() ([]api.QueuedMsg, error) {
+	msgs := []api.QueuedMsg{}
+	for _, controller := range globalCfg.GetControllers() {
+		pending, err := db.Pending(controller.ChainID)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range pending {
+			msgs = append(msgs, queuedMsg(controller.ChainID, entry.Seq, entry.Msg))
+		}
+	}
+	return msgs, nil
+}
+
+func (relayerState) DeadLetters() ([]api.DeadLetter, error) {
+	letters, err := db.DeadLetters()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]api.DeadLetter, 0, len(letters))
+	for _, letter := range letters {
+		out = append(out, api.DeadLetter{QueuedMsg: queuedMsg(letter.ChainID, letter.Seq, letter.Msg), Reason: letter.Reason, Time: letter.Time})
+	}
+	return out, nil
+}
+
+func (relayerState) SetPaused(chainId string, pause bool) error {
+	if _, ok := chains[chainId]; !ok {
+		return fmt.Errorf("%w: %s", api.ErrUnknownChain, chainId)
+	}
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	paused[chainId] = pause
+	return nil
+}
+
+// Reprocess forgets that the given query was handled, and drops it from the
+// dead-letter list, such that it is handled afresh on the next poll of its
+// controller.
+func (relayerState) Reprocess(queryId string) error {
+	for _, controller := range globalCfg.GetControllers() {
+		if err := db.DeleteQuery(queryRecordKey(controller.ChainID, queryId)); err != nil {
+			return err
+		}
+	}
+	_, err := db.DeleteDeadLetters(func(letter store.DeadLetter) bool {
+		res, ok := letter.Msg.(*qstypes.MsgSubmitQueryResponse)
+		return ok && res.QueryId == queryId
+	})
+	return err
+}
+
+func queuedMsg(chainId string, seq uint64, msg sdk.Msg) api.QueuedMsg {
+	out := api.QueuedMsg{ChainID: chainId, Seq: seq, Type: sdk.MsgTypeURL(msg)}
+	if res, ok := msg.(*qstypes.MsgSubmitQueryResponse); ok {
+		out.QueryID = res.QueryId
+	}
+	return out
+}
+
+func (relayerState) TxSearches() ([]api.TxSearch, error) {
+	searches, err := db.TxSearches()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]api.TxSearch, 0, len(searches))
+	for _, search := range searches {
+		height, _ := db.GetTxSearchHeight(search)
+		status := api.TxSearch{Controller: search.ControllerID, ChainID: search.ChainID, Query: search.Query, Height: height}
+		if fill, found := db.GetBackfill(search); found {
+			status.Backfill = &api.Backfill{FromHeight: fill.From, ToHeight: fill.To}
+		}
+		out = append(out, status)
+	}
+	return out, nil
+}
+
+// Backfill requests that the given range of a tx search be searched again,
+// ahead of its progress, in response to the next queries for it.
+func (relayerState) Backfill(req api.BackfillRequest) error {
+	if globalCfg.GetController(req.Controller) == nil {
+		return fmt.Errorf("%w: %s", api.ErrUnknownChain, req.Controller)
+	}
+	if _, ok := chains[req.ChainID]; !ok {
+		return fmt.Errorf("%w: %s", api.ErrUnknownChain, req.ChainID)
+	}
+	search := store.TxSearch{ControllerID: req.Controller, ChainID: req.ChainID, Query: req.Query}
+	return db.SetBackfill(search, store.Backfill{From: req.FromHeight, To: req.ToHeight})
+}
@@ -0,0 +1,165 @@
+package runner
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-kit/log"
+	"google.golang.org/grpc/metadata"
+
+	querytypes "github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/store"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/prommetrics"
+)
+
+var (
+	TxSearchPageLimit = uint64(100)
+	TxSearchMaxTxs    = 500
+
+	searchMu sync.Mutex
+	// searchProgress holds the progress made by each tx search response
+	// awaiting submission, keyed by query record key; it is committed once the
+	// response lands.
+	searchProgress = map[string]txSearchProgress{}
+)
+
+type txSearchProgress struct {
+	search store.TxSearch
+	// height is that through which the response searched.
+	height int64
+	// backfill is the backfill the response served, if any.
+	backfill *store.Backfill
+}
+
+// txSearch identifies the search made by a GetTxsEvent request; events are
+// sorted, so that the same search is identified regardless of their order.
+func txSearch(query Query, request txtypes.GetTxsEventRequest) store.TxSearch {
+	events := append([]string{}, request.Events...)
+	sort.Strings(events)
+	return store.TxSearch{ControllerID: query.SourceChainId, ChainID: query.ChainId, Query: strings.Join(events, " AND ")}
+}
+
+// searchTxs pages through the txs matching a GetTxsEvent query, oldest first,
+// from the height after that last relayed for it up to the query height, or
+// through any backfill requested for it. Once TxSearchMaxTxs are collected,
+// only the remaining txs at the height of the last are added, so that the
+// search resumes from a complete height in response to the next query.
+func searchTxs(client Chain, query Query, md metadata.MD, logger log.Logger, metrics prommetrics.Metrics) (abcitypes.ResponseQuery, error) {
+	request := txtypes.GetTxsEventRequest{}
+	if err := client.Codec().Unmarshal(query.Request, &request); err != nil {
+		return abcitypes.ResponseQuery{}, fmt.Errorf("could not unmarshal tx search %w", err)
+	}
+	search := txSearch(query, request)
+
+	// replays have no store, so search from genesis.
+	from, to := int64(1), query.Height
+	progress := txSearchProgress{search: search}
+	if db != nil {
+		if height, found := db.GetTxSearchHeight(search); found {
+			from = height + 1
+		}
+		if fill, found := db.GetBackfill(search); found {
+			from = fill.From
+			if fill.To < to {
+				to = fill.To
+			}
+			progress.backfill = &fill
+		}
+	}
+
+	out := txtypes.GetTxsEventResponse{}
+	res := abcitypes.ResponseQuery{Height: query.Height}
+	through, boundary := to, int64(0)
+	if from > to {
+		through = from - 1
+	}
+PAGES:
+	for page := uint64(1); from <= to; page++ {
+		req := txtypes.GetTxsEventRequest{
+			Events:  append(append([]string{}, request.Events...), fmt.Sprintf("tx.height>=%d", from), fmt.Sprintf("tx.height<=%d", to)),
+			OrderBy: txtypes.OrderBy_ORDER_BY_ASC,
+			Page:    page,
+			Limit:   TxSearchPageLimit,
+			// hosts predating page and limit paginate by offset.
+			Pagination: &querytypes.PageRequest{Offset: (page - 1) * TxSearchPageLimit, Limit: TxSearchPageLimit},
+		}
+		bz, err := client.Codec().Marshal(&req)
+		if err != nil {
+			return res, err
+		}
+		pageRes, _, err := RunGRPCQuery(ctx, client, "/"+query.Type, bz, md, metrics)
+		if err != nil {
+			return res, fmt.Errorf("could not search txs %w", err)
+		}
+		res.Height = pageRes.Height
+		txs := txtypes.GetTxsEventResponse{}
+		if err := client.Codec().Unmarshal(pageRes.Value, &txs); err != nil {
+			return res, fmt.Errorf("could not unmarshal txs %w", err)
+		}
+		metrics.TxSearchPages.WithLabelValues("tx_search_pages", query.ChainId).Inc()
+
+		for i, txRes := range txs.TxResponses {
+			if boundary > 0 && txRes.Height > boundary {
+				through = boundary
+				break PAGES
+			}
+			out.TxResponses = append(out.TxResponses, txRes)
+			if i < len(txs.Txs) {
+				out.Txs = append(out.Txs, txs.Txs[i])
+			}
+			if boundary == 0 && len(out.TxResponses) >= TxSearchMaxTxs {
+				boundary = txRes.Height
+			}
+		}
+		if uint64(len(txs.TxResponses)) < TxSearchPageLimit {
+			break
+		}
+	}
+	out.Total = uint64(len(out.TxResponses))
+	_ = logger.Log("msg", "Searched txs", "id", query.QueryId, "from", from, "to", to, "through", through, "txs", out.Total, "backfill", progress.backfill != nil)
+
+	progress.height = through
+	searchMu.Lock()
+	searchProgress[queryRecordKey(query.SourceChainId, query.QueryId)] = progress
+	searchMu.Unlock()
+
+	var err error
+	res.Value, err = client.Codec().Marshal(&out)
+	return res, err
+}
+
+// commitTxSearch records the progress of the tx search answered by the given
+// response, once it has landed, or forgets it otherwise; a search that fails
+// to land is repeated in response to the next query.
+func commitTxSearch(controllerId, queryId string, landed bool, logger log.Logger) {
+	key := queryRecordKey(controllerId, queryId)
+	searchMu.Lock()
+	defer searchMu.Unlock()
+	progress, ok := searchProgress[key]
+	delete(searchProgress, key)
+	if !ok || !landed {
+		return
+	}
+
+	var err error
+	fill := progress.backfill
+	switch {
+	case fill == nil:
+		// responses to successive queries may land out of order.
+		if height, found := db.GetTxSearchHeight(progress.search); !found || progress.height > height {
+			err = db.SetTxSearchHeight(progress.search, progress.height)
+		}
+	case progress.height >= fill.To:
+		err = db.DeleteBackfill(progress.search)
+	case progress.height >= fill.From:
+		err = db.SetBackfill(progress.search, store.Backfill{From: progress.height + 1, To: fill.To})
+	}
+	if err != nil {
+		_ = logger.Log("msg", "Error: Unable to record tx search progress", "id", queryId, "err", err)
+	}
+}
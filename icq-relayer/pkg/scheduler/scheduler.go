@@ -0,0 +1,160 @@
+package scheduler
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+
+	qstypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	"golang.org/x/time/rate"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/config"
+)
+
+// Scheduler orders outstanding queries by priority, and throttles the requests
+// made to each host chain by concurrency and rate.
+type Scheduler struct {
+	cfg config.SchedulerConfig
+
+	mu       sync.Mutex
+	chainSem map[string]chan struct{}
+	typeSem  map[string]chan struct{}
+	limiters map[string]*rate.Limiter
+}
+
+func New(cfg config.SchedulerConfig) *Scheduler {
+	return &Scheduler{
+		cfg:      cfg,
+		chainSem: make(map[string]chan struct{}),
+		typeSem:  make(map[string]chan struct{}),
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// Priority returns the highest priority of the rules matching the given query
+// type and callback id, or zero if none match.
+func (s *Scheduler) Priority(queryType, callbackID string) int {
+	priority := 0
+	matched := false
+	for _, rule := range s.cfg.Priorities {
+		if rule.Matches(queryType, callbackID) && (!matched || rule.Priority > priority) {
+			priority = rule.Priority
+			matched = true
+		}
+	}
+	return priority
+}
+
+// Sort orders queries by descending priority, then by most recent emission.
+// Queries that are otherwise equal are shuffled, such that a query that
+// repeatedly fails does not starve its peers.
+func (s *Scheduler) Sort(queries []qstypes.Query) {
+	rand.Shuffle(len(queries), func(i, j int) { queries[i], queries[j] = queries[j], queries[i] })
+
+	sort.SliceStable(queries, func(i, j int) bool {
+		pi := s.Priority(queries[i].QueryType, queries[i].CallbackId)
+		pj := s.Priority(queries[j].QueryType, queries[j].CallbackId)
+		if pi != pj {
+			return pi > pj
+		}
+		if queries[i].LastEmission.IsNil() || queries[j].LastEmission.IsNil() {
+			return false
+		}
+		return queries[i].LastEmission.GT(queries[j].LastEmission)
+	})
+}
+
+// Next returns up to MaxHistoricQueries of the given queries, in priority
+// order.
+func (s *Scheduler) Next(queries []qstypes.Query) []qstypes.Query {
+	s.Sort(queries)
+	if s.cfg.MaxHistoricQueries > 0 && len(queries) > s.cfg.MaxHistoricQueries {
+		return queries[:s.cfg.MaxHistoricQueries]
+	}
+	return queries
+}
+
+// Acquire blocks until a request of the given type may be made against the
+// given host chain, or the context is done. The returned function must be
+// called once the request completes.
+func (s *Scheduler) Acquire(ctx context.Context, chainID, queryType string) (func(), error) {
+	var held []chan struct{}
+	release := func() {
+		for _, sem := range held {
+			<-sem
+		}
+	}
+
+	// take the narrower type slot first, so that a request waiting on a busy
+	// type does not hold a chain slot.
+	for _, sem := range []chan struct{}{s.typeSemaphore(chainID, queryType), s.chainSemaphore(chainID)} {
+		if sem == nil {
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+			held = append(held, sem)
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+
+	if limiter := s.limiter(chainID); limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
+
+func (s *Scheduler) chainSemaphore(chainID string) chan struct{} {
+	if s.cfg.ChainConcurrency == 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sem, ok := s.chainSem[chainID]
+	if !ok {
+		sem = make(chan struct{}, s.cfg.ChainConcurrency)
+		s.chainSem[chainID] = sem
+	}
+	return sem
+}
+
+func (s *Scheduler) typeSemaphore(chainID, queryType string) chan struct{} {
+	limit := s.cfg.TypeConcurrency[queryType]
+	if limit == 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := chainID + "/" + queryType
+	sem, ok := s.typeSem[key]
+	if !ok {
+		sem = make(chan struct{}, limit)
+		s.typeSem[key] = sem
+	}
+	return sem
+}
+
+func (s *Scheduler) limiter(chainID string) *rate.Limiter {
+	if s.cfg.RateLimit == 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	limiter, ok := s.limiters[chainID]
+	if !ok {
+		burst := s.cfg.RateBurst
+		if burst == 0 {
+			burst = 1
+		}
+		limiter = rate.NewLimiter(rate.Limit(s.cfg.RateLimit), burst)
+		s.limiters[chainID] = limiter
+	}
+	return limiter
+}
@@ -0,0 +1,137 @@
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	qstypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/config"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/scheduler"
+)
+
+func query(id, queryType, callbackID string, lastEmission int64) qstypes.Query {
+	return qstypes.Query{Id: id, QueryType: queryType, CallbackId: callbackID, LastEmission: sdk.NewInt(lastEmission)}
+}
+
+func ids(queries []qstypes.Query) []string {
+	out := make([]string, len(queries))
+	for i, q := range queries {
+		out[i] = q.Id
+	}
+	return out
+}
+
+func TestPriority(t *testing.T) {
+	s := scheduler.New(config.SchedulerConfig{Priorities: []config.PriorityRule{
+		{QueryType: "cosmos.staking.v1beta1.Query/Validators", Priority: 1},
+		{CallbackID: "deposittx", Priority: 5},
+		{QueryType: "tendermint.Tx", CallbackID: "deposittx", Priority: 10},
+		{CallbackID: "rewards", Priority: -1},
+	}})
+
+	require.Equal(t, 10, s.Priority("tendermint.Tx", "deposittx"))
+	require.Equal(t, 5, s.Priority("store/bank/key", "deposittx"))
+	require.Equal(t, 1, s.Priority("cosmos.staking.v1beta1.Query/Validators", ""))
+	require.Equal(t, -1, s.Priority("store/bank/key", "rewards"))
+	require.Equal(t, 0, s.Priority("store/bank/key", "allbalances"))
+}
+
+func TestNextOrdersByPriorityThenEmission(t *testing.T) {
+	cfg := config.DefaultSchedulerConfig()
+	cfg.MaxHistoricQueries = 4
+	cfg.Priorities = append(cfg.Priorities, config.PriorityRule{QueryType: "cosmos.staking.v1beta1.Query/Validators", Priority: 2})
+	s := scheduler.New(*cfg)
+
+	for i := 0; i < 20; i++ {
+		queries := []qstypes.Query{
+			query("old", "store/bank/key", "", 10),
+			query("new", "store/bank/key", "", 30),
+			query("deposit", "tendermint.Tx", "deposittx", 5),
+			query("valset", "cosmos.staking.v1beta1.Query/Validators", "valset", 1),
+			query("newest", "store/bank/key", "", 40),
+			query("interval", "cosmos.tx.v1beta1.Service/GetTxsEvent", "depositinterval", 2),
+		}
+
+		next := s.Next(queries)
+		require.Len(t, next, 4)
+		require.Equal(t, "valset", next[0].Id)
+		// equal priority; most recently emitted first.
+		require.Equal(t, []string{"deposit", "interval"}, ids(next[1:3]))
+		require.Equal(t, "newest", next[3].Id)
+	}
+}
+
+func TestAcquireChainConcurrency(t *testing.T) {
+	s := scheduler.New(config.SchedulerConfig{ChainConcurrency: 2})
+
+	release1, err := s.Acquire(context.Background(), "cosmoshub-4", "store/bank/key")
+	require.NoError(t, err)
+	_, err = s.Acquire(context.Background(), "cosmoshub-4", "tendermint.Tx")
+	require.NoError(t, err)
+
+	// the chain is saturated, regardless of query type.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = s.Acquire(ctx, "cosmoshub-4", "store/staking/key")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// other chains are unaffected.
+	_, err = s.Acquire(context.Background(), "osmosis-1", "store/bank/key")
+	require.NoError(t, err)
+
+	release1()
+	_, err = s.Acquire(context.Background(), "cosmoshub-4", "store/staking/key")
+	require.NoError(t, err)
+}
+
+func TestAcquireTypeConcurrency(t *testing.T) {
+	s := scheduler.New(config.SchedulerConfig{ChainConcurrency: 2, TypeConcurrency: map[string]int{"tendermint.Tx": 1}})
+
+	release, err := s.Acquire(context.Background(), "cosmoshub-4", "tendermint.Tx")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = s.Acquire(ctx, "cosmoshub-4", "tendermint.Tx")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the blocked request did not hold on to a chain slot.
+	_, err = s.Acquire(context.Background(), "cosmoshub-4", "store/bank/key")
+	require.NoError(t, err)
+
+	// the type limit applies per chain.
+	_, err = s.Acquire(context.Background(), "osmosis-1", "tendermint.Tx")
+	require.NoError(t, err)
+
+	release()
+	_, err = s.Acquire(context.Background(), "cosmoshub-4", "tendermint.Tx")
+	require.NoError(t, err)
+}
+
+func TestAcquireRateLimit(t *testing.T) {
+	s := scheduler.New(config.SchedulerConfig{RateLimit: 20, RateBurst: 2})
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		release, err := s.Acquire(context.Background(), "cosmoshub-4", "store/bank/key")
+		require.NoError(t, err)
+		release()
+	}
+	// a burst of two, then two more at 50ms intervals.
+	require.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	// a request that cannot be admitted before its deadline fails immediately.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	_, err := s.Acquire(ctx, "cosmoshub-4", "store/bank/key")
+	require.Error(t, err)
+
+	// other chains have their own budget.
+	_, err = s.Acquire(context.Background(), "osmosis-1", "store/bank/key")
+	require.NoError(t, err)
+}
@@ -0,0 +1,491 @@
+package store
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// Store persists the relayer work queue, so that a restarted relayer resumes
+// exactly where it stopped. It holds:
+//   - messages queued for submission, per destination chain, in order;
+//   - recently handled query ids, with the height they were handled at;
+//   - the last block height seen on each chain;
+//   - messages that failed to submit, as a dead-letter list;
+//   - the height up to which each tx search has been relayed, and any height
+//     ranges requested to be searched again;
+//   - the most recent light blocks, with their validator sets, fetched from
+//     each chain, so that client updates do not refetch them after a restart.
+type Store struct {
+	db  *leveldb.DB
+	cdc codec.Codec
+
+	mu  sync.Mutex
+	seq uint64
+	// lightBlocks counts the light blocks stored per chain, once counted.
+	lightBlocks map[string]int
+}
+
+var (
+	prefixQueue  = []byte{0x01}
+	prefixQuery  = []byte{0x02}
+	prefixHeight = []byte{0x03}
+	prefixDead   = []byte{0x04}
+	prefixSearch = []byte{0x05}
+	prefixFill   = []byte{0x06}
+	prefixLight  = []byte{0x07}
+)
+
+// Entry is a message in the send queue, identified by its sequence number.
+type Entry struct {
+	Seq uint64
+	Msg sdk.Msg
+}
+
+// DeadLetter is a message that failed to submit to the given chain.
+type DeadLetter struct {
+	ChainID string
+	Seq     uint64
+	Msg     sdk.Msg
+	Reason  string
+	Time    time.Time
+}
+
+// QueryRecord records when, and at what height, a query was last handled.
+type QueryRecord struct {
+	Height int64
+	Time   time.Time
+}
+
+// TxSearch identifies a tx search made by a controller against a host chain,
+// such as for the deposits to an address.
+type TxSearch struct {
+	ControllerID string
+	ChainID      string
+	// Query is the conjunction of the events searched for.
+	Query string
+}
+
+// Backfill is a height range, inclusive, of a tx search to be searched again.
+type Backfill struct {
+	From int64
+	To   int64
+}
+
+// Open opens (creating if necessary) the store at the given directory. The
+// codec must be able to unpack every message type that will be enqueued.
+func Open(dir string, cdc codec.Codec) (*Store, error) {
+	db, err := leveldb.OpenFile(dir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open store at %s: %w", dir, err)
+	}
+
+	s := &Store{db: db, cdc: cdc, lightBlocks: map[string]int{}}
+
+	// resume the sequence from the last queued or dead-lettered message of any
+	// chain, as dead letters keep the sequence they were queued with.
+	for _, prefix := range [][]byte{prefixQueue, prefixDead} {
+		iter := db.NewIterator(util.BytesPrefix(prefix), nil)
+		for iter.Next() {
+			key := iter.Key()
+			if seq := binary.BigEndian.Uint64(key[len(key)-8:]); seq > s.seq {
+				s.seq = seq
+			}
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// Close flushes and closes the underlying database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Enqueue durably appends a message to the send queue of the given chain.
+func (s *Store) Enqueue(chainID string, msg sdk.Msg) (Entry, error) {
+	bz, err := s.cdc.MarshalInterface(msg)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seq++
+	if err := s.db.Put(queueKey(chainID, s.seq), bz, &opt.WriteOptions{Sync: true}); err != nil {
+		s.seq--
+		return Entry{}, err
+	}
+	return Entry{Seq: s.seq, Msg: msg}, nil
+}
+
+// Ack removes submitted messages from the send queue of the given chain.
+func (s *Store) Ack(chainID string, entries ...Entry) error {
+	batch := new(leveldb.Batch)
+	for _, entry := range entries {
+		batch.Delete(queueKey(chainID, entry.Seq))
+	}
+	return s.db.Write(batch, &opt.WriteOptions{Sync: true})
+}
+
+// Pending returns the messages queued for the given chain, oldest first.
+func (s *Store) Pending(chainID string) ([]Entry, error) {
+	entries := []Entry{}
+	prefix := queuePrefix(chainID)
+	iter := s.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+	for iter.Next() {
+		var msg sdk.Msg
+		if err := s.cdc.UnmarshalInterface(iter.Value(), &msg); err != nil {
+			return nil, err
+		}
+		entries = append(entries, Entry{Seq: binary.BigEndian.Uint64(iter.Key()[len(prefix):]), Msg: msg})
+	}
+	return entries, iter.Error()
+}
+
+// SetQuery records that the given query was handled at the given height.
+func (s *Store) SetQuery(queryID string, height int64, at time.Time) error {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	binary.BigEndian.PutUint64(bz[8:], uint64(at.UnixNano()))
+	return s.db.Put(append(prefixQuery, []byte(queryID)...), bz, nil)
+}
+
+// GetQuery returns the record of the given query, if one exists.
+func (s *Store) GetQuery(queryID string) (QueryRecord, bool) {
+	bz, err := s.db.Get(append(prefixQuery, []byte(queryID)...), nil)
+	if err != nil || len(bz) != 16 {
+		return QueryRecord{}, false
+	}
+	return QueryRecord{
+		Height: int64(binary.BigEndian.Uint64(bz)),
+		Time:   time.Unix(0, int64(binary.BigEndian.Uint64(bz[8:]))),
+	}, true
+}
+
+// DeleteQuery removes the record of the given query.
+func (s *Store) DeleteQuery(queryID string) error {
+	return s.db.Delete(append(prefixQuery, []byte(queryID)...), nil)
+}
+
+// RecentQuery returns true if the given query was handled within the window
+// ending at the given time.
+func (s *Store) RecentQuery(queryID string, window time.Duration, now time.Time) bool {
+	record, found := s.GetQuery(queryID)
+	return found && now.Sub(record.Time) < window
+}
+
+// PruneQueries removes query records older than the given time, returning the
+// number removed.
+func (s *Store) PruneQueries(before time.Time) (int, error) {
+	batch := new(leveldb.Batch)
+	iter := s.db.NewIterator(util.BytesPrefix(prefixQuery), nil)
+	defer iter.Release()
+	for iter.Next() {
+		if len(iter.Value()) == 16 && int64(binary.BigEndian.Uint64(iter.Value()[8:])) >= before.UnixNano() {
+			continue
+		}
+		batch.Delete(append([]byte{}, iter.Key()...))
+	}
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+	return batch.Len(), s.db.Write(batch, nil)
+}
+
+// SetHeight records the last block height seen on the given chain. Heights
+// never move backwards.
+func (s *Store) SetHeight(chainID string, height int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if current, found := s.GetHeight(chainID); found && current >= height {
+		return nil
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return s.db.Put(append(prefixHeight, []byte(chainID)...), bz, nil)
+}
+
+// GetHeight returns the last block height seen on the given chain.
+func (s *Store) GetHeight(chainID string) (int64, bool) {
+	bz, err := s.db.Get(append(prefixHeight, []byte(chainID)...), nil)
+	if err != nil || len(bz) != 8 {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// SetTxSearchHeight records that every tx matching the given search up to
+// and including height has been relayed.
+func (s *Store) SetTxSearchHeight(search TxSearch, height int64) error {
+	return s.db.Put(searchKey(prefixSearch, search), binary.BigEndian.AppendUint64(nil, uint64(height)), nil)
+}
+
+// GetTxSearchHeight returns the height up to which the given search has been
+// relayed.
+func (s *Store) GetTxSearchHeight(search TxSearch) (int64, bool) {
+	bz, err := s.db.Get(searchKey(prefixSearch, search), nil)
+	if err != nil || len(bz) != 8 {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// TxSearches returns every tx search with a recorded height or backfill.
+func (s *Store) TxSearches() ([]TxSearch, error) {
+	seen := map[TxSearch]bool{}
+	searches := []TxSearch{}
+	for _, prefix := range [][]byte{prefixSearch, prefixFill} {
+		iter := s.db.NewIterator(util.BytesPrefix(prefix), nil)
+		for iter.Next() {
+			search, err := decodeSearchKey(iter.Key()[len(prefix):])
+			if err != nil {
+				iter.Release()
+				return nil, err
+			}
+			if !seen[search] {
+				seen[search] = true
+				searches = append(searches, search)
+			}
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return nil, err
+		}
+	}
+	return searches, nil
+}
+
+// SetBackfill requests that the given range of a search be searched again,
+// replacing any range already requested.
+func (s *Store) SetBackfill(search TxSearch, fill Backfill) error {
+	bz := binary.BigEndian.AppendUint64(nil, uint64(fill.From))
+	return s.db.Put(searchKey(prefixFill, search), binary.BigEndian.AppendUint64(bz, uint64(fill.To)), nil)
+}
+
+// GetBackfill returns the range of the given search to be searched again.
+func (s *Store) GetBackfill(search TxSearch) (Backfill, bool) {
+	bz, err := s.db.Get(searchKey(prefixFill, search), nil)
+	if err != nil || len(bz) != 16 {
+		return Backfill{}, false
+	}
+	return Backfill{From: int64(binary.BigEndian.Uint64(bz)), To: int64(binary.BigEndian.Uint64(bz[8:]))}, true
+}
+
+// DeleteBackfill removes the range of the given search to be searched again.
+func (s *Store) DeleteBackfill(search TxSearch) error {
+	return s.db.Delete(searchKey(prefixFill, search), nil)
+}
+
+// SetLightBlock records the light block of the given chain at its height. At
+// most limit light blocks are kept per chain, evicting the lowest heights; zero
+// is unlimited.
+func (s *Store) SetLightBlock(chainID string, block *tmproto.LightBlock, limit int) error {
+	if block.SignedHeader == nil || block.SignedHeader.Header == nil {
+		return fmt.Errorf("light block of %s has no header", chainID)
+	}
+	bz, err := block.Marshal()
+	if err != nil {
+		return err
+	}
+	key := lightBlockKey(chainID, block.SignedHeader.Header.Height)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count, err := s.countLightBlocks(chainID)
+	if err != nil {
+		return err
+	}
+	if found, err := s.db.Has(key, nil); err != nil {
+		return err
+	} else if !found {
+		count++
+	}
+
+	batch := new(leveldb.Batch)
+	batch.Put(key, bz)
+	if limit > 0 && count > limit {
+		iter := s.db.NewIterator(util.BytesPrefix(chainPrefix(prefixLight, chainID)), nil)
+		for evict := count - limit; evict > 0 && iter.Next(); evict-- {
+			batch.Delete(append([]byte{}, iter.Key()...))
+			count--
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return err
+		}
+	}
+	if err := s.db.Write(batch, nil); err != nil {
+		return err
+	}
+	s.lightBlocks[chainID] = count
+	return nil
+}
+
+// GetLightBlock returns the light block of the given chain at the given
+// height, if one is stored.
+func (s *Store) GetLightBlock(chainID string, height int64) (*tmproto.LightBlock, bool, error) {
+	bz, err := s.db.Get(lightBlockKey(chainID, height), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	block := &tmproto.LightBlock{}
+	if err := block.Unmarshal(bz); err != nil {
+		return nil, false, err
+	}
+	return block, true, nil
+}
+
+// countLightBlocks returns the number of light blocks stored for the given
+// chain, counting them on first use; s.mu must be held.
+func (s *Store) countLightBlocks(chainID string) (int, error) {
+	if count, found := s.lightBlocks[chainID]; found {
+		return count, nil
+	}
+	count := 0
+	iter := s.db.NewIterator(util.BytesPrefix(chainPrefix(prefixLight, chainID)), nil)
+	defer iter.Release()
+	for iter.Next() {
+		count++
+	}
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+	s.lightBlocks[chainID] = count
+	return count, nil
+}
+
+// AddDeadLetters records messages that failed to submit to the given chain.
+func (s *Store) AddDeadLetters(chainID, reason string, at time.Time, entries ...Entry) error {
+	if len(reason) > math.MaxUint16 {
+		reason = reason[:math.MaxUint16]
+	}
+	batch := new(leveldb.Batch)
+	for _, entry := range entries {
+		bz, err := s.cdc.MarshalInterface(entry.Msg)
+		if err != nil {
+			return err
+		}
+		value := binary.BigEndian.AppendUint64(nil, uint64(at.UnixNano()))
+		value = binary.BigEndian.AppendUint16(value, uint16(len(reason)))
+		value = append(value, []byte(reason)...)
+		batch.Put(binary.BigEndian.AppendUint64(chainPrefix(prefixDead, chainID), entry.Seq), append(value, bz...))
+	}
+	return s.db.Write(batch, nil)
+}
+
+// DeadLetters returns the dead-letter list of every chain.
+func (s *Store) DeadLetters() ([]DeadLetter, error) {
+	letters := []DeadLetter{}
+	iter := s.db.NewIterator(util.BytesPrefix(prefixDead), nil)
+	defer iter.Release()
+	for iter.Next() {
+		letter, err := s.decodeDeadLetter(iter.Key(), iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		letters = append(letters, letter)
+	}
+	return letters, iter.Error()
+}
+
+// DeleteDeadLetters removes the dead letters matching the given predicate,
+// returning the number removed.
+func (s *Store) DeleteDeadLetters(match func(DeadLetter) bool) (int, error) {
+	batch := new(leveldb.Batch)
+	iter := s.db.NewIterator(util.BytesPrefix(prefixDead), nil)
+	defer iter.Release()
+	for iter.Next() {
+		letter, err := s.decodeDeadLetter(iter.Key(), iter.Value())
+		if err != nil {
+			return 0, err
+		}
+		if match(letter) {
+			batch.Delete(append([]byte{}, iter.Key()...))
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+	return batch.Len(), s.db.Write(batch, nil)
+}
+
+func (s *Store) decodeDeadLetter(key, value []byte) (DeadLetter, error) {
+	chainID := string(key[2 : 2+int(key[1])])
+	if len(value) < 10 || len(value) < 10+int(binary.BigEndian.Uint16(value[8:])) {
+		return DeadLetter{}, fmt.Errorf("malformed dead letter for %s", chainID)
+	}
+	reasonEnd := 10 + int(binary.BigEndian.Uint16(value[8:]))
+	var msg sdk.Msg
+	if err := s.cdc.UnmarshalInterface(value[reasonEnd:], &msg); err != nil {
+		return DeadLetter{}, err
+	}
+	return DeadLetter{
+		ChainID: chainID,
+		Seq:     binary.BigEndian.Uint64(key[len(key)-8:]),
+		Msg:     msg,
+		Reason:  string(value[10:reasonEnd]),
+		Time:    time.Unix(0, int64(binary.BigEndian.Uint64(value))),
+	}, nil
+}
+
+// chainPrefix is prefix | len(chainID) | chainID, so that no chain id is a
+// prefix of another.
+func chainPrefix(prefix []byte, chainID string) []byte {
+	out := append([]byte{}, prefix...)
+	out = append(out, byte(len(chainID)))
+	return append(out, []byte(chainID)...)
+}
+
+// searchKey is prefix | len(controller) | controller | len(chain) | chain |
+// query.
+func searchKey(prefix []byte, search TxSearch) []byte {
+	key := chainPrefix(prefix, search.ControllerID)
+	key = append(key, byte(len(search.ChainID)))
+	key = append(key, []byte(search.ChainID)...)
+	return append(key, []byte(search.Query)...)
+}
+
+func decodeSearchKey(key []byte) (TxSearch, error) {
+	search := TxSearch{}
+	for _, field := range []*string{&search.ControllerID, &search.ChainID} {
+		if len(key) < 1 || len(key) < 1+int(key[0]) {
+			return search, fmt.Errorf("malformed tx search key")
+		}
+		*field = string(key[1 : 1+int(key[0])])
+		key = key[1+int(key[0]):]
+	}
+	search.Query = string(key)
+	return search, nil
+}
+
+func lightBlockKey(chainID string, height int64) []byte {
+	return binary.BigEndian.AppendUint64(chainPrefix(prefixLight, chainID), uint64(height))
+}
+
+func queuePrefix(chainID string) []byte {
+	return chainPrefix(prefixQueue, chainID)
+}
+
+func queueKey(chainID string, seq uint64) []byte {
+	return binary.BigEndian.AppendUint64(queuePrefix(chainID), seq)
+}
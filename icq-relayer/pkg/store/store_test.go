@@ -0,0 +1,245 @@
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	qstypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/store"
+)
+
+func newCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	qstypes.RegisterInterfaces(registry)
+	clienttypes.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+func response(queryID string, height int64) sdk.Msg {
+	return &qstypes.MsgSubmitQueryResponse{ChainId: "cosmoshub-4", QueryId: queryID, Result: []byte{0x01}, Height: height, FromAddress: "quick1relayer"}
+}
+
+func TestQueueSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	cdc := newCodec()
+
+	s, err := store.Open(dir, cdc)
+	require.NoError(t, err)
+
+	first, err := s.Enqueue("quicksilver-1", response("a", 10))
+	require.NoError(t, err)
+	second, err := s.Enqueue("quicksilver-1", response("b", 11))
+	require.NoError(t, err)
+	_, err = s.Enqueue("quicksilver-1", response("c", 12))
+	require.NoError(t, err)
+	_, err = s.Enqueue("quicksilver-10", response("d", 13))
+	require.NoError(t, err)
+
+	// only the first two are submitted before the relayer stops.
+	require.NoError(t, s.Ack("quicksilver-1", first, second))
+	require.NoError(t, s.Close())
+
+	s, err = store.Open(dir, cdc)
+	require.NoError(t, err)
+	defer s.Close()
+
+	pending, err := s.Pending("quicksilver-1")
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, response("c", 12), pending[0].Msg)
+
+	// chain ids sharing a prefix do not share a queue.
+	pending, err = s.Pending("quicksilver-10")
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, response("d", 13), pending[0].Msg)
+
+	// new messages are queued after those replayed.
+	next, err := s.Enqueue("quicksilver-1", response("e", 14))
+	require.NoError(t, err)
+	require.Greater(t, next.Seq, pending[0].Seq)
+
+	pending, err = s.Pending("quicksilver-1")
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, response("e", 14), pending[1].Msg)
+}
+
+func TestQueryRecordsSurviveRestart(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	s, err := store.Open(dir, newCodec())
+	require.NoError(t, err)
+
+	require.NoError(t, s.SetQuery("recent", 100, now.Add(-time.Second)))
+	require.NoError(t, s.SetQuery("stale", 90, now.Add(-2*time.Hour)))
+	require.NoError(t, s.SetHeight("cosmoshub-4", 100))
+	require.NoError(t, s.SetHeight("cosmoshub-4", 99))
+	require.NoError(t, s.Close())
+
+	s, err = store.Open(dir, newCodec())
+	require.NoError(t, err)
+	defer s.Close()
+
+	record, found := s.GetQuery("recent")
+	require.True(t, found)
+	require.Equal(t, int64(100), record.Height)
+	require.True(t, s.RecentQuery("recent", 10*time.Second, now))
+	require.False(t, s.RecentQuery("stale", 10*time.Second, now))
+	require.False(t, s.RecentQuery("unknown", 10*time.Second, now))
+
+	// heights never move backwards.
+	height, found := s.GetHeight("cosmoshub-4")
+	require.True(t, found)
+	require.Equal(t, int64(100), height)
+
+	pruned, err := s.PruneQueries(now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, pruned)
+	_, found = s.GetQuery("stale")
+	require.False(t, found)
+	_, found = s.GetQuery("recent")
+	require.True(t, found)
+}
+
+func TestDeadLettersSurviveRestart(t *testing.T) {
+	dir := t.TempDir()
+	cdc := newCodec()
+	now := time.Now()
+
+	s, err := store.Open(dir, cdc)
+	require.NoError(t, err)
+
+	a, err := s.Enqueue("quicksilver-1", response("a", 10))
+	require.NoError(t, err)
+	b, err := s.Enqueue("quicksilver-1", response("b", 11))
+	require.NoError(t, err)
+	require.NoError(t, s.AddDeadLetters("quicksilver-1", "out of gas", now, a, b))
+	require.NoError(t, s.Ack("quicksilver-1", a, b))
+	require.NoError(t, s.Close())
+
+	s, err = store.Open(dir, cdc)
+	require.NoError(t, err)
+	defer s.Close()
+
+	letters, err := s.DeadLetters()
+	require.NoError(t, err)
+	require.Len(t, letters, 2)
+	require.Equal(t, "quicksilver-1", letters[0].ChainID)
+	require.Equal(t, "out of gas", letters[0].Reason)
+	require.Equal(t, response("a", 10), letters[0].Msg)
+	require.Equal(t, now.UnixNano(), letters[0].Time.UnixNano())
+
+	// with the queue empty, the sequence resumes past the dead letters, so that
+	// new dead letters do not overwrite them.
+	c, err := s.Enqueue("quicksilver-1", response("c", 12))
+	require.NoError(t, err)
+	require.Greater(t, c.Seq, b.Seq)
+	require.NoError(t, s.AddDeadLetters("quicksilver-1", "out of gas", now, c))
+	require.NoError(t, s.Ack("quicksilver-1", c))
+
+	removed, err := s.DeleteDeadLetters(func(letter store.DeadLetter) bool {
+		return letter.Msg.(*qstypes.MsgSubmitQueryResponse).QueryId == "a"
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+
+	letters, err = s.DeadLetters()
+	require.NoError(t, err)
+	require.Len(t, letters, 2)
+	require.Equal(t, b.Seq, letters[0].Seq)
+	require.Equal(t, c.Seq, letters[1].Seq)
+}
+
+func TestTxSearchesSurviveRestart(t *testing.T) {
+	dir := t.TempDir()
+	cdc := newCodec()
+	deposits := store.TxSearch{ControllerID: "quicksilver-1", ChainID: "cosmoshub-4", Query: "transfer.recipient='cosmos1deposit'"}
+	other := store.TxSearch{ControllerID: "quicksilver-2", ChainID: "cosmoshub-4", Query: "transfer.recipient='cosmos1deposit'"}
+
+	s, err := store.Open(dir, cdc)
+	require.NoError(t, err)
+	require.NoError(t, s.SetTxSearchHeight(deposits, 100))
+	require.NoError(t, s.SetBackfill(other, store.Backfill{From: 10, To: 20}))
+	require.NoError(t, s.Close())
+
+	s, err = store.Open(dir, cdc)
+	require.NoError(t, err)
+	defer s.Close()
+
+	// searches are scoped by controller.
+	height, found := s.GetTxSearchHeight(deposits)
+	require.True(t, found)
+	require.Equal(t, int64(100), height)
+	_, found = s.GetTxSearchHeight(other)
+	require.False(t, found)
+	_, found = s.GetBackfill(deposits)
+	require.False(t, found)
+	fill, found := s.GetBackfill(other)
+	require.True(t, found)
+	require.Equal(t, store.Backfill{From: 10, To: 20}, fill)
+
+	searches, err := s.TxSearches()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []store.TxSearch{deposits, other}, searches)
+
+	require.NoError(t, s.DeleteBackfill(other))
+	_, found = s.GetBackfill(other)
+	require.False(t, found)
+}
+
+func lightBlock(height int64) *tmproto.LightBlock {
+	return &tmproto.LightBlock{
+		SignedHeader: &tmproto.SignedHeader{Header: &tmproto.Header{ChainID: "cosmoshub-4", Height: height}, Commit: &tmproto.Commit{Height: height}},
+		ValidatorSet: &tmproto.ValidatorSet{},
+	}
+}
+
+func TestLightBlocksSurviveRestart(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := store.Open(dir, newCodec())
+	require.NoError(t, err)
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, s.SetLightBlock("cosmoshub-4", lightBlock(height), 3))
+	}
+	// rewriting a height does not count against the limit.
+	require.NoError(t, s.SetLightBlock("cosmoshub-4", lightBlock(3), 3))
+	require.NoError(t, s.Close())
+
+	s, err = store.Open(dir, newCodec())
+	require.NoError(t, err)
+	defer s.Close()
+
+	block, found, err := s.GetLightBlock("cosmoshub-4", 1)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(1), block.SignedHeader.Header.Height)
+
+	// the lowest heights are evicted beyond the limit.
+	require.NoError(t, s.SetLightBlock("cosmoshub-4", lightBlock(4), 3))
+	require.NoError(t, s.SetLightBlock("cosmoshub-4", lightBlock(5), 3))
+	for height, stored := range map[int64]bool{1: false, 2: false, 3: true, 4: true, 5: true} {
+		_, found, err := s.GetLightBlock("cosmoshub-4", height)
+		require.NoError(t, err)
+		require.Equal(t, stored, found, "height %d", height)
+	}
+
+	// limits are per chain.
+	require.NoError(t, s.SetLightBlock("osmosis-1", lightBlock(1), 3))
+	_, found, err = s.GetLightBlock("osmosis-1", 1)
+	require.NoError(t, err)
+	require.True(t, found)
+	_, found, err = s.GetLightBlock("cosmoshub-4", 3)
+	require.NoError(t, err)
+	require.True(t, found)
+}
@@ -0,0 +1,138 @@
+package submitter
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Signer is implemented by clients that can sign txs without broadcasting
+// them.
+type Signer interface {
+	// Sign returns the JSON encoding of a signed tx containing msgs.
+	Sign(ctx context.Context, msgs []sdk.Msg, gas uint64, fees sdk.Coins) ([]byte, error)
+}
+
+// DryRun is a Client that simulates txs against the wrapped client but,
+// rather than broadcasting them, writes them to Dir.
+type DryRun struct {
+	Client
+	ChainID string
+	Codec   codec.JSONCodec
+	Dir     string
+	// Sign writes txs signed by the wrapped client, which must be a Signer;
+	// otherwise only their msgs are written.
+	Sign bool
+	// FallbackGas is the gas of txs that fail simulation; as nothing is
+	// broadcast, msgs that depend on earlier txs, such as responses proven
+	// against a client update, cannot be simulated.
+	FallbackGas uint64
+
+	// Now returns the current time; overridden in tests.
+	Now func() time.Time
+	seq uint64
+
+	mu      sync.Mutex
+	simErrs map[sdk.Msg]error
+}
+
+// DryRunTx is a tx written by DryRun.
+type DryRunTx struct {
+	ChainID         string            `json:"chain_id"`
+	Gas             uint64            `json:"gas"`
+	Fees            string            `json:"fees"`
+	SimulationError string            `json:"simulation_error,omitempty"`
+	Msgs            []json.RawMessage `json:"msgs"`
+	SignedTx        json.RawMessage   `json:"signed_tx,omitempty"`
+}
+
+// DefaultFallbackGas is the FallbackGas of a DryRun that sets none.
+const DefaultFallbackGas = 1_000_000
+
+var _ Client = (*DryRun)(nil)
+
+// Simulate simulates msgs against the wrapped client. Should simulation fail,
+// FallbackGas is returned and the error recorded in the tx written.
+func (d *DryRun) Simulate(ctx context.Context, msgs []sdk.Msg) (uint64, error) {
+	gas, err := d.Client.Simulate(ctx, msgs)
+	if err == nil || len(msgs) == 0 {
+		return gas, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.simErrs == nil {
+		d.simErrs = map[sdk.Msg]error{}
+	}
+	d.simErrs[msgs[0]] = err
+	if d.FallbackGas == 0 {
+		return DefaultFallbackGas, nil
+	}
+	return d.FallbackGas, nil
+}
+
+// Broadcast writes the tx to a file in Dir, returning a successful response
+// whose hash is that of the file and whose log names it.
+func (d *DryRun) Broadcast(ctx context.Context, msgs []sdk.Msg, gas uint64, fees sdk.Coins) (*sdk.TxResponse, error) {
+	tx := DryRunTx{ChainID: d.ChainID, Gas: gas, Fees: fees.String()}
+	if len(msgs) > 0 {
+		d.mu.Lock()
+		if err, ok := d.simErrs[msgs[0]]; ok {
+			tx.SimulationError = err.Error()
+			delete(d.simErrs, msgs[0])
+		}
+		d.mu.Unlock()
+	}
+	for _, msg := range msgs {
+		bz, err := d.Codec.MarshalInterfaceJSON(msg)
+		if err != nil {
+			return nil, err
+		}
+		tx.Msgs = append(tx.Msgs, bz)
+	}
+	if d.Sign {
+		signer, ok := d.Client.(Signer)
+		if !ok {
+			return nil, fmt.Errorf("client for %s cannot sign txs", d.ChainID)
+		}
+		signed, err := signer.Sign(ctx, msgs, gas, fees)
+		if err != nil {
+			return nil, err
+		}
+		tx.SignedTx = signed
+	}
+
+	bz, err := json.MarshalIndent(tx, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(d.Dir, 0o750); err != nil {
+		return nil, err
+	}
+	now := time.Now
+	if d.Now != nil {
+		now = d.Now
+	}
+	name := filepath.Join(d.Dir, fmt.Sprintf("tx-%s-%d-%04d.json", d.ChainID, now().UnixNano(), atomic.AddUint64(&d.seq, 1)))
+	if err := os.WriteFile(name, bz, 0o600); err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(bz)
+	return &sdk.TxResponse{
+		TxHash:    strings.ToUpper(hex.EncodeToString(hash[:])),
+		GasWanted: int64(gas),
+		GasUsed:   int64(gas),
+		RawLog:    "dry run: written to " + name,
+	}, nil
+}
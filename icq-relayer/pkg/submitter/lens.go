@@ -0,0 +1,169 @@
+package submitter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	lensclient "github.com/strangelove-ventures/lens/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// LensClient adapts a lens chain client to Client, overriding the static gas
+// and fee settings of its config.
+type LensClient struct {
+	*lensclient.ChainClient
+	Memo string
+	// Key is the name of the key txs are signed with; empty is the key of the
+	// client config.
+	Key string
+	// FeeGranter, if set, pays the fees of txs under a fee allowance granted
+	// to Key.
+	FeeGranter sdk.AccAddress
+	// Sequence, if set, tracks the sequence of Key across txs, rather than
+	// fetching it for each.
+	Sequence *Sequence
+}
+
+var (
+	_ Client = LensClient{}
+	_ Signer = LensClient{}
+)
+
+func (c LensClient) Simulate(ctx context.Context, msgs []sdk.Msg) (uint64, error) {
+	txf, err := c.factory()
+	if err != nil {
+		return 0, err
+	}
+	key, err := c.Keybase.Key(c.key())
+	if err != nil {
+		return 0, err
+	}
+	txBytes, err := lensclient.BuildSimTx(key, txf, msgs...)
+	if err != nil {
+		return 0, err
+	}
+	res, err := c.QueryABCI(ctx, abci.RequestQuery{Path: "/cosmos.tx.v1beta1.Service/Simulate", Data: txBytes})
+	if err != nil {
+		return 0, err
+	}
+	simRes := txtypes.SimulateResponse{}
+	if err := simRes.Unmarshal(res.Value); err != nil {
+		return 0, err
+	}
+	return simRes.GasInfo.GasUsed, nil
+}
+
+func (c LensClient) Broadcast(ctx context.Context, msgs []sdk.Msg, gas uint64, fees sdk.Coins) (res *sdk.TxResponse, err error) {
+	txf, err := c.factory()
+	if err != nil {
+		return nil, err
+	}
+	if c.Sequence != nil {
+		defer func() { c.Sequence.Done(txf.Sequence(), res, err) }()
+	}
+
+	txb, err := c.sign(txf, msgs, gas, fees)
+	if err != nil {
+		return nil, err
+	}
+
+	txBytes, err := c.Codec.TxConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = c.BroadcastTx(ctx, txBytes)
+	if err != nil {
+		return res, err
+	}
+	if res.Code != 0 {
+		return res, fmt.Errorf("transaction failed with code: %d", res.Code)
+	}
+	return res, nil
+}
+
+// Sign returns the JSON encoding of the signed tx that Broadcast would
+// broadcast.
+func (c LensClient) Sign(_ context.Context, msgs []sdk.Msg, gas uint64, fees sdk.Coins) ([]byte, error) {
+	txf, err := c.factory()
+	if err != nil {
+		return nil, err
+	}
+	txb, err := c.sign(txf, msgs, gas, fees)
+	if err != nil {
+		return nil, err
+	}
+	return c.Codec.TxConfig.TxJSONEncoder()(txb.GetTx())
+}
+
+func (c LensClient) key() string {
+	if c.Key != "" {
+		return c.Key
+	}
+	return c.Config.Key
+}
+
+// factory returns a tx factory for signing as the client's key, at its
+// tracked sequence if any.
+func (c LensClient) factory() (tx.Factory, error) {
+	txf := c.TxFactory().WithFeeGranter(c.FeeGranter)
+	if c.Sequence == nil && c.Key == "" {
+		return c.PrepareFactory(txf)
+	}
+
+	fetch := c.fetchAccount
+	if c.Sequence != nil {
+		fetch = func() (uint64, uint64, error) { return c.Sequence.Get(c.fetchAccount) }
+	}
+	account, sequence, err := fetch()
+	if err != nil {
+		return txf, err
+	}
+	return txf.WithAccountNumber(account).WithSequence(sequence), nil
+}
+
+// fetchAccount returns the account number and sequence of the client's key.
+func (c LensClient) fetchAccount() (uint64, uint64, error) {
+	key, err := c.Keybase.Key(c.key())
+	if err != nil {
+		return 0, 0, err
+	}
+	address, err := key.GetAddress()
+	if err != nil {
+		return 0, 0, err
+	}
+	cliCtx := client.Context{}.WithClient(c.RPCClient).
+		WithInterfaceRegistry(c.Codec.InterfaceRegistry).
+		WithChainID(c.Config.ChainID).
+		WithCodec(c.Codec.Marshaler)
+	return c.GetAccountNumberSequence(cliCtx, address)
+}
+
+func (c LensClient) sign(txf tx.Factory, msgs []sdk.Msg, gas uint64, fees sdk.Coins) (client.TxBuilder, error) {
+	// fees and gas prices are mutually exclusive.
+	txf = txf.WithGasPrices("").WithFees(fees.String()).WithGas(gas).WithMemo(c.Memo)
+
+	txb, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	// force encoding in the chain specific address
+	for _, msg := range msgs {
+		c.Codec.Marshaler.MustMarshalJSON(msg)
+	}
+
+	err = func() error {
+		done := c.SetSDKContext()
+		defer done()
+		return tx.Sign(txf, c.key(), txb, false)
+	}()
+	if err != nil {
+		return nil, err
+	}
+	return txb, nil
+}
@@ -0,0 +1,140 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Key is a key of a Pool, with the client that signs txs as it.
+type Key struct {
+	Name    string
+	Address string
+	// FeeGranter is the address of the account granting the key fee
+	// allowances, if any; it pays for the txs the key signs.
+	FeeGranter string
+	Client     Client
+}
+
+// Payer returns the address of the account paying for txs signed by the key.
+func (k Key) Payer() string {
+	if k.FeeGranter != "" {
+		return k.FeeGranter
+	}
+	return k.Address
+}
+
+// SignerSetter returns a copy of msg to be signed by the given address.
+type SignerSetter func(msg sdk.Msg, address string) sdk.Msg
+
+// Pool submits msgs through a set of keys, rotating between them round-robin.
+// Each key submits one batch at a time, so that its sequence is not raced,
+// while the pool as a whole has as many batches in flight as it has keys.
+type Pool struct {
+	keys       []Key
+	submitters []*Submitter
+	setSigner  SignerSetter
+	// free holds the indices of idle keys, in the order they became idle.
+	free chan int
+}
+
+func NewPool(keys []Key, cfg Config, setSigner SignerSetter) *Pool {
+	p := &Pool{keys: keys, setSigner: setSigner, free: make(chan int, len(keys))}
+	for i, key := range keys {
+		p.submitters = append(p.submitters, New(key.Client, cfg))
+		p.free <- i
+	}
+	return p
+}
+
+// Keys returns the keys of the pool.
+func (p *Pool) Keys() []Key {
+	return p.keys
+}
+
+// Size returns the number of batches the pool submits concurrently.
+func (p *Pool) Size() int {
+	return len(p.keys)
+}
+
+// Submit submits msgs as the next idle key, waiting for one to become idle,
+// returning a result per tx. Results carry the msgs given, rather than the
+// copies signed by the key.
+func (p *Pool) Submit(ctx context.Context, msgs []sdk.Msg) []Result {
+	var i int
+	select {
+	case <-ctx.Done():
+		return []Result{{Msgs: msgs, Err: ctx.Err()}}
+	case i = <-p.free:
+	}
+	defer func() { p.free <- i }()
+
+	key := p.keys[i]
+	signed := make([]sdk.Msg, len(msgs))
+	original := make(map[sdk.Msg]sdk.Msg, len(msgs))
+	for j, msg := range msgs {
+		signed[j] = msg
+		if p.setSigner != nil {
+			signed[j] = p.setSigner(msg, key.Address)
+		}
+		original[signed[j]] = msg
+	}
+
+	results := p.submitters[i].Submit(ctx, signed)
+	for j := range results {
+		results[j].Key = key.Name
+		unsigned := make([]sdk.Msg, len(results[j].Msgs))
+		for k, msg := range results[j].Msgs {
+			unsigned[k] = original[msg]
+		}
+		results[j].Msgs = unsigned
+	}
+	return results
+}
+
+// Sequence tracks the account number and next sequence of a signer key, so
+// that successive txs are signed without refetching the account, which lags
+// behind txs still awaiting inclusion.
+type Sequence struct {
+	mu      sync.Mutex
+	known   bool
+	account uint64
+	next    uint64
+}
+
+// Get returns the account number and sequence with which to sign the next tx,
+// calling fetch for them if they are not known.
+func (s *Sequence) Get(fetch func() (uint64, uint64, error)) (uint64, uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.known {
+		account, next, err := fetch()
+		if err != nil {
+			return 0, 0, err
+		}
+		s.account, s.next, s.known = account, next, true
+	}
+	return s.account, s.next, nil
+}
+
+// Done records the outcome of broadcasting a tx signed with sequence. The
+// sequence is consumed by a tx that is included, even if it fails, and kept
+// by one rejected from the mempool; it is refetched if the tx was signed with
+// the wrong sequence, or if the outcome is unknown.
+func (s *Sequence) Done(sequence uint64, res *sdk.TxResponse, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.known || s.next != sequence {
+		return
+	}
+	var sdkErr *sdkerrors.Error
+	switch {
+	case err == nil, res != nil && res.Height > 0, errors.Is(err, sdkerrors.ErrTxInMempoolCache):
+		s.next = sequence + 1
+	case errors.Is(err, sdkerrors.ErrWrongSequence), !errors.As(err, &sdkErr):
+		s.known = false
+	}
+}
@@ -0,0 +1,209 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Client simulates and broadcasts transactions against a single chain.
+type Client interface {
+	// Simulate returns the gas used by a transaction containing msgs.
+	Simulate(ctx context.Context, msgs []sdk.Msg) (uint64, error)
+	// Broadcast signs and broadcasts a transaction containing msgs with the
+	// given gas limit and fees.
+	Broadcast(ctx context.Context, msgs []sdk.Msg, gas uint64, fees sdk.Coins) (*sdk.TxResponse, error)
+}
+
+// Config controls how batches are sized and priced.
+type Config struct {
+	// GasAdjustment is applied to simulated gas to derive the gas limit.
+	GasAdjustment float64
+	// GasPrices are multiplied by the gas limit to derive the fee.
+	GasPrices sdk.DecCoins
+	// MaxGas is the largest gas limit of a single tx; zero is unlimited.
+	MaxGas uint64
+	// MaxTxBytes is the largest encoded size of the msgs of a single tx; zero
+	// is unlimited.
+	MaxTxBytes int
+	// FeeEscalation multiplies the fee on mempool rejection, and the gas limit
+	// on running out of gas.
+	FeeEscalation float64
+	// MaxFeeMultiplier caps the escalated fee, relative to the base fee.
+	MaxFeeMultiplier float64
+	// MaxAttempts is the number of broadcasts attempted per batch.
+	MaxAttempts int
+	// Timeout bounds each simulation and broadcast.
+	Timeout time.Duration
+}
+
+// Result is the outcome of submitting a batch of msgs in a single tx.
+type Result struct {
+	Msgs     []sdk.Msg
+	Response *sdk.TxResponse
+	Gas      uint64
+	Fees     sdk.Coins
+	// Key is the name of the pool key that signed the tx, if submitted
+	// through a Pool.
+	Key string
+	Err error
+}
+
+// Submitter submits msgs, splitting batches that cannot be simulated or that
+// exceed the configured size limits, and escalating fees when rejected by the
+// mempool.
+type Submitter struct {
+	client Client
+	cfg    Config
+}
+
+func New(client Client, cfg Config) *Submitter {
+	if cfg.GasAdjustment <= 0 {
+		cfg.GasAdjustment = 1
+	}
+	if cfg.FeeEscalation < 1 {
+		cfg.FeeEscalation = 1
+	}
+	if cfg.MaxFeeMultiplier < 1 {
+		cfg.MaxFeeMultiplier = 1
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 1
+	}
+	return &Submitter{client: client, cfg: cfg}
+}
+
+// Submit submits msgs in as few txs as possible, returning a result per tx.
+func (s *Submitter) Submit(ctx context.Context, msgs []sdk.Msg) []Result {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if s.cfg.MaxTxBytes > 0 && len(msgs) > 1 && Size(msgs) > s.cfg.MaxTxBytes {
+		return s.split(ctx, msgs)
+	}
+
+	gasUsed, err := s.simulate(ctx, msgs)
+	if err != nil {
+		if len(msgs) > 1 {
+			return s.split(ctx, msgs)
+		}
+		return []Result{{Msgs: msgs, Err: fmt.Errorf("simulation failed: %w", err)}}
+	}
+
+	gas := scale(gasUsed, s.cfg.GasAdjustment)
+	if s.cfg.MaxGas > 0 && gas > s.cfg.MaxGas {
+		if len(msgs) > 1 {
+			return s.split(ctx, msgs)
+		}
+		return []Result{{Msgs: msgs, Gas: gas, Err: fmt.Errorf("gas %d exceeds limit %d", gas, s.cfg.MaxGas)}}
+	}
+
+	multiplier := 1.0
+	result := Result{Msgs: msgs}
+	for attempt := 0; attempt < s.cfg.MaxAttempts; attempt++ {
+		result.Gas = gas
+		result.Fees = Fees(s.cfg.GasPrices, gas, multiplier)
+		result.Response, result.Err = s.broadcast(ctx, msgs, result.Gas, result.Fees)
+		if result.Err == nil {
+			return []Result{result}
+		}
+
+		switch {
+		case matches(result, sdkerrors.ErrTxInMempoolCache):
+			// already submitted; nothing more to do.
+			result.Err = nil
+			return []Result{result}
+		case matches(result, sdkerrors.ErrInsufficientFee), matches(result, sdkerrors.ErrMempoolIsFull):
+			if multiplier >= s.cfg.MaxFeeMultiplier {
+				return []Result{result}
+			}
+			multiplier = math.Min(multiplier*s.cfg.FeeEscalation, s.cfg.MaxFeeMultiplier)
+		case matches(result, sdkerrors.ErrOutOfGas):
+			gas = scale(gas, s.cfg.FeeEscalation)
+			if s.cfg.MaxGas > 0 && gas > s.cfg.MaxGas {
+				if len(msgs) > 1 {
+					return s.split(ctx, msgs)
+				}
+				return []Result{result}
+			}
+		case matches(result, sdkerrors.ErrTxTooLarge):
+			if len(msgs) > 1 {
+				return s.split(ctx, msgs)
+			}
+			return []Result{result}
+		case matches(result, sdkerrors.ErrWrongSequence), errors.Is(result.Err, context.DeadlineExceeded):
+			// retry as is; the client refetches its sequence on mismatch.
+		default:
+			return []Result{result}
+		}
+	}
+
+	return []Result{result}
+}
+
+func (s *Submitter) split(ctx context.Context, msgs []sdk.Msg) []Result {
+	mid := len(msgs) / 2
+	return append(s.Submit(ctx, msgs[:mid]), s.Submit(ctx, msgs[mid:])...)
+}
+
+func (s *Submitter) simulate(ctx context.Context, msgs []sdk.Msg) (uint64, error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+	return s.client.Simulate(ctx, msgs)
+}
+
+func (s *Submitter) broadcast(ctx context.Context, msgs []sdk.Msg, gas uint64, fees sdk.Coins) (*sdk.TxResponse, error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+	return s.client.Broadcast(ctx, msgs, gas, fees)
+}
+
+func (s *Submitter) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.cfg.Timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, s.cfg.Timeout)
+}
+
+// Fees returns the fee for the given gas limit at the given gas prices, scaled
+// by multiplier and rounded up.
+func Fees(gasPrices sdk.DecCoins, gas uint64, multiplier float64) sdk.Coins {
+	m := sdk.MustNewDecFromStr(strconv.FormatFloat(multiplier, 'f', 6, 64))
+	fees := sdk.NewCoins()
+	for _, price := range gasPrices {
+		amount := price.Amount.MulInt64(int64(gas)).Mul(m).Ceil().TruncateInt()
+		fees = fees.Add(sdk.NewCoin(price.Denom, amount))
+	}
+	return fees
+}
+
+// Size returns the total encoded size of msgs.
+func Size(msgs []sdk.Msg) int {
+	size := 0
+	for _, msg := range msgs {
+		if sizer, ok := msg.(interface{ Size() int }); ok {
+			size += sizer.Size()
+		}
+	}
+	return size
+}
+
+func scale(gas uint64, factor float64) uint64 {
+	return uint64(math.Ceil(float64(gas) * factor))
+}
+
+// matches returns true if the tx failed with the given error, whether in
+// CheckTx, where only the error is returned, or in DeliverTx.
+func matches(result Result, target *sdkerrors.Error) bool {
+	if res := result.Response; res != nil && res.Codespace == target.Codespace() && res.Code == target.ABCICode() {
+		return true
+	}
+	return errors.Is(result.Err, target)
+}
@@ -0,0 +1,367 @@
+package submitter_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	qstypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/mockchain"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/submitter"
+)
+
+// fakeClient charges gasPerByte for each byte of result, and fails
+// simulation of any batch containing a msg in failSim.
+type fakeClient struct {
+	gasPerByte uint64
+	failSim    map[string]bool
+	// broadcast returns the outcome of each successive broadcast; once
+	// exhausted, broadcasts succeed.
+	broadcast []func(gas uint64, fees sdk.Coins) (*sdk.TxResponse, error)
+
+	broadcasts []broadcast
+}
+
+type broadcast struct {
+	ids  []string
+	gas  uint64
+	fees sdk.Coins
+	from string
+}
+
+func ids(msgs []sdk.Msg) []string {
+	out := make([]string, len(msgs))
+	for i, msg := range msgs {
+		out[i] = msg.(*qstypes.MsgSubmitQueryResponse).QueryId
+	}
+	return out
+}
+
+func (f *fakeClient) Simulate(_ context.Context, msgs []sdk.Msg) (uint64, error) {
+	gas := uint64(0)
+	for _, msg := range msgs {
+		res := msg.(*qstypes.MsgSubmitQueryResponse)
+		if f.failSim[res.QueryId] {
+			return 0, fmt.Errorf("simulation of %s failed", res.QueryId)
+		}
+		gas += uint64(len(res.Result)) * f.gasPerByte
+	}
+	return gas, nil
+}
+
+func (f *fakeClient) Broadcast(_ context.Context, msgs []sdk.Msg, gas uint64, fees sdk.Coins) (*sdk.TxResponse, error) {
+	f.broadcasts = append(f.broadcasts, broadcast{ids(msgs), gas, fees, msgs[0].(*qstypes.MsgSubmitQueryResponse).FromAddress})
+	if len(f.broadcast) > 0 {
+		next := f.broadcast[0]
+		f.broadcast = f.broadcast[1:]
+		return next(gas, fees)
+	}
+	return &sdk.TxResponse{GasWanted: int64(gas), GasUsed: int64(gas)}, nil
+}
+
+func msgs(sizes ...int) []sdk.Msg {
+	out := make([]sdk.Msg, len(sizes))
+	for i, size := range sizes {
+		out[i] = &qstypes.MsgSubmitQueryResponse{ChainId: "cosmoshub-4", QueryId: fmt.Sprintf("q%d", i), Result: make([]byte, size), FromAddress: "quick1relayer"}
+	}
+	return out
+}
+
+func config() submitter.Config {
+	return submitter.Config{
+		GasAdjustment:    1.5,
+		GasPrices:        sdk.NewDecCoins(sdk.NewDecCoinFromDec("uqck", sdk.NewDecWithPrec(1, 2))),
+		FeeEscalation:    2,
+		MaxFeeMultiplier: 4,
+		MaxAttempts:      3,
+	}
+}
+
+func TestSubmitSimulatesAndAdjustsGas(t *testing.T) {
+	client := &fakeClient{gasPerByte: 10}
+	results := submitter.New(client, config()).Submit(context.Background(), msgs(100, 200))
+
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	require.Len(t, results[0].Msgs, 2)
+	// 3000 simulated * 1.5
+	require.Equal(t, uint64(4500), results[0].Gas)
+	// 4500 * 0.01
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uqck", 45)), results[0].Fees)
+	require.Len(t, client.broadcasts, 1)
+}
+
+func TestSubmitSplitsOnSimulationFailure(t *testing.T) {
+	client := &fakeClient{gasPerByte: 1, failSim: map[string]bool{"q2": true}}
+	results := submitter.New(client, config()).Submit(context.Background(), msgs(1, 1, 1, 1))
+
+	// [q0 q1 q2 q3] -> [q0 q1] + [q2 q3] -> [q2] + [q3]
+	require.Len(t, results, 3)
+	require.Equal(t, []string{"q0", "q1"}, ids(results[0].Msgs))
+	require.NoError(t, results[0].Err)
+	require.Equal(t, []string{"q2"}, ids(results[1].Msgs))
+	require.ErrorContains(t, results[1].Err, "simulation failed")
+	require.Equal(t, []string{"q3"}, ids(results[2].Msgs))
+	require.NoError(t, results[2].Err)
+
+	// order is preserved across splits.
+	require.Equal(t, [][]string{{"q0", "q1"}, {"q3"}}, [][]string{client.broadcasts[0].ids, client.broadcasts[1].ids})
+}
+
+func TestSubmitSplitsOnSizeAndGasLimits(t *testing.T) {
+	cfg := config()
+	cfg.MaxTxBytes = 300
+	client := &fakeClient{gasPerByte: 1}
+	results := submitter.New(client, cfg).Submit(context.Background(), msgs(100, 100, 100, 100))
+	require.Len(t, results, 2)
+	for _, result := range results {
+		require.NoError(t, result.Err)
+		require.Len(t, result.Msgs, 2)
+	}
+
+	cfg = config()
+	cfg.MaxGas = 400
+	client = &fakeClient{gasPerByte: 1}
+	results = submitter.New(client, cfg).Submit(context.Background(), msgs(100, 100, 100, 500))
+	// [q0 q1] fits at 300 gas; q2 alone fits at 150; q3 alone needs 750.
+	require.Len(t, results, 3)
+	require.Equal(t, []string{"q0", "q1"}, ids(results[0].Msgs))
+	require.NoError(t, results[0].Err)
+	require.Equal(t, []string{"q2"}, ids(results[1].Msgs))
+	require.NoError(t, results[1].Err)
+	require.Equal(t, []string{"q3"}, ids(results[2].Msgs))
+	require.ErrorContains(t, results[2].Err, "exceeds limit")
+}
+
+func TestSubmitEscalatesFees(t *testing.T) {
+	rejected := func(_ uint64, _ sdk.Coins) (*sdk.TxResponse, error) {
+		return nil, sdkerrors.ErrInsufficientFee
+	}
+	client := &fakeClient{gasPerByte: 100, broadcast: []func(uint64, sdk.Coins) (*sdk.TxResponse, error){rejected, rejected}}
+	results := submitter.New(client, config()).Submit(context.Background(), msgs(10))
+
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	require.Len(t, client.broadcasts, 3)
+	// 1500 gas at 0.01, then doubled, then doubled again.
+	require.Equal(t, sdk.NewInt(15), client.broadcasts[0].fees.AmountOf("uqck"))
+	require.Equal(t, sdk.NewInt(30), client.broadcasts[1].fees.AmountOf("uqck"))
+	require.Equal(t, sdk.NewInt(60), client.broadcasts[2].fees.AmountOf("uqck"))
+	require.Equal(t, sdk.NewInt(60), results[0].Fees.AmountOf("uqck"))
+}
+
+func TestSubmitFeeEscalationIsCapped(t *testing.T) {
+	cfg := config()
+	cfg.MaxAttempts = 10
+	full := func(_ uint64, _ sdk.Coins) (*sdk.TxResponse, error) {
+		return &sdk.TxResponse{Codespace: sdkerrors.ErrMempoolIsFull.Codespace(), Code: sdkerrors.ErrMempoolIsFull.ABCICode()}, fmt.Errorf("mempool is full")
+	}
+	client := &fakeClient{gasPerByte: 100, broadcast: []func(uint64, sdk.Coins) (*sdk.TxResponse, error){full, full, full, full, full}}
+	results := submitter.New(client, cfg).Submit(context.Background(), msgs(10))
+
+	// 1x, 2x, 4x, then give up at the cap.
+	require.Len(t, results, 1)
+	require.Error(t, results[0].Err)
+	require.Len(t, client.broadcasts, 3)
+	require.Equal(t, sdk.NewInt(60), client.broadcasts[2].fees.AmountOf("uqck"))
+}
+
+func TestSubmitOutOfGasAndMempoolCache(t *testing.T) {
+	outOfGas := func(_ uint64, _ sdk.Coins) (*sdk.TxResponse, error) {
+		return &sdk.TxResponse{Codespace: sdkerrors.ErrOutOfGas.Codespace(), Code: sdkerrors.ErrOutOfGas.ABCICode()}, fmt.Errorf("transaction failed with code: 11")
+	}
+	inCache := func(_ uint64, _ sdk.Coins) (*sdk.TxResponse, error) {
+		return nil, sdkerrors.ErrTxInMempoolCache
+	}
+	client := &fakeClient{gasPerByte: 100, broadcast: []func(uint64, sdk.Coins) (*sdk.TxResponse, error){outOfGas, inCache}}
+	results := submitter.New(client, config()).Submit(context.Background(), msgs(10))
+
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	require.Len(t, client.broadcasts, 2)
+	require.Equal(t, uint64(1500), client.broadcasts[0].gas)
+	require.Equal(t, uint64(3000), client.broadcasts[1].gas)
+}
+
+func TestSubmitGivesUpOnOtherErrors(t *testing.T) {
+	failed := func(_ uint64, _ sdk.Coins) (*sdk.TxResponse, error) {
+		return nil, sdkerrors.ErrUnauthorized
+	}
+	client := &fakeClient{gasPerByte: 100, broadcast: []func(uint64, sdk.Coins) (*sdk.TxResponse, error){failed}}
+	results := submitter.New(client, config()).Submit(context.Background(), msgs(10, 10))
+
+	require.Len(t, results, 1)
+	require.ErrorIs(t, results[0].Err, sdkerrors.ErrUnauthorized)
+	require.Len(t, client.broadcasts, 1)
+}
+
+func TestSubmitRetriesWrongSequence(t *testing.T) {
+	wrongSequence := func(_ uint64, _ sdk.Coins) (*sdk.TxResponse, error) {
+		return nil, sdkerrors.ErrWrongSequence
+	}
+	client := &fakeClient{gasPerByte: 100, broadcast: []func(uint64, sdk.Coins) (*sdk.TxResponse, error){wrongSequence}}
+	results := submitter.New(client, config()).Submit(context.Background(), msgs(10))
+
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	require.Len(t, client.broadcasts, 2)
+}
+
+func setFrom(msg sdk.Msg, address string) sdk.Msg {
+	signed := *msg.(*qstypes.MsgSubmitQueryResponse)
+	signed.FromAddress = address
+	return &signed
+}
+
+func TestPoolRotatesKeys(t *testing.T) {
+	clients := []*fakeClient{{gasPerByte: 100}, {gasPerByte: 100}}
+	pool := submitter.NewPool([]submitter.Key{
+		{Name: "relayer-0", Address: "quick1relayer0", Client: clients[0]},
+		{Name: "relayer-1", Address: "quick1relayer1", Client: clients[1]},
+	}, config(), setFrom)
+	require.Equal(t, 2, pool.Size())
+
+	keys := []string{}
+	for i := 0; i < 3; i++ {
+		batch := msgs(10)
+		results := pool.Submit(context.Background(), batch)
+		require.Len(t, results, 1)
+		require.NoError(t, results[0].Err)
+		keys = append(keys, results[0].Key)
+		// results carry the msgs given, which are left unsigned.
+		require.Same(t, batch[0], results[0].Msgs[0])
+		require.Equal(t, "quick1relayer", batch[0].(*qstypes.MsgSubmitQueryResponse).FromAddress)
+	}
+	require.Equal(t, []string{"relayer-0", "relayer-1", "relayer-0"}, keys)
+
+	require.Len(t, clients[0].broadcasts, 2)
+	require.Equal(t, "quick1relayer0", clients[0].broadcasts[0].from)
+	require.Len(t, clients[1].broadcasts, 1)
+	require.Equal(t, "quick1relayer1", clients[1].broadcasts[0].from)
+}
+
+// blockingClient broadcasts once released, reporting each broadcast started.
+type blockingClient struct {
+	started chan string
+	release chan struct{}
+	name    string
+}
+
+func (b blockingClient) Simulate(_ context.Context, _ []sdk.Msg) (uint64, error) {
+	return 1000, nil
+}
+
+func (b blockingClient) Broadcast(_ context.Context, _ []sdk.Msg, gas uint64, _ sdk.Coins) (*sdk.TxResponse, error) {
+	b.started <- b.name
+	<-b.release
+	return &sdk.TxResponse{GasWanted: int64(gas)}, nil
+}
+
+func TestPoolSubmitsOneBatchPerKeyAtOnce(t *testing.T) {
+	started, release := make(chan string, 3), make(chan struct{})
+	pool := submitter.NewPool([]submitter.Key{
+		{Name: "relayer-0", Client: blockingClient{started, release, "relayer-0"}},
+		{Name: "relayer-1", Client: blockingClient{started, release, "relayer-1"}},
+	}, config(), nil)
+
+	done := make(chan []submitter.Result, 3)
+	for i := 0; i < 3; i++ {
+		go func() { done <- pool.Submit(context.Background(), msgs(10)) }()
+	}
+
+	// both keys submit concurrently, while the third batch awaits an idle key.
+	require.ElementsMatch(t, []string{"relayer-0", "relayer-1"}, []string{<-started, <-started})
+	select {
+	case name := <-started:
+		require.FailNow(t, "third batch submitted while both keys were busy", name)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	release <- struct{}{}
+	<-started
+	close(release)
+	for i := 0; i < 3; i++ {
+		results := <-done
+		require.NoError(t, results[0].Err)
+	}
+}
+
+func TestSequence(t *testing.T) {
+	fetches := 0
+	fetch := func() (uint64, uint64, error) {
+		fetches++
+		return 7, 10, nil
+	}
+	seq := &submitter.Sequence{}
+
+	_, next, err := seq.Get(fetch)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), next)
+
+	// the sequence advances locally as txs land, without refetching.
+	seq.Done(next, &sdk.TxResponse{Height: 5}, nil)
+	account, next, _ := seq.Get(fetch)
+	require.Equal(t, uint64(7), account)
+	require.Equal(t, uint64(11), next)
+
+	// txs included but failed consume their sequence; those rejected from the
+	// mempool do not.
+	seq.Done(next, &sdk.TxResponse{Height: 6, Code: 5}, fmt.Errorf("transaction failed with code: 5"))
+	_, next, _ = seq.Get(fetch)
+	require.Equal(t, uint64(12), next)
+	seq.Done(next, nil, sdkerrors.ErrInsufficientFee)
+	_, next, _ = seq.Get(fetch)
+	require.Equal(t, uint64(12), next)
+	require.Equal(t, 1, fetches)
+
+	// a mismatch refetches the sequence.
+	seq.Done(next, nil, sdkerrors.ErrWrongSequence)
+	_, next, _ = seq.Get(fetch)
+	require.Equal(t, uint64(10), next)
+	require.Equal(t, 2, fetches)
+
+	// as does an unknown outcome, such as a timeout awaiting inclusion.
+	seq.Done(next, nil, context.DeadlineExceeded)
+	_, _, err = seq.Get(fetch)
+	require.NoError(t, err)
+	require.Equal(t, 3, fetches)
+}
+
+func TestDryRunWritesTxs(t *testing.T) {
+	client := &fakeClient{gasPerByte: 100, failSim: map[string]bool{"q1": true}}
+	dir := t.TempDir()
+	dryRun := &submitter.DryRun{Client: client, ChainID: "quicksilver-2", Codec: mockchain.Codec(), Dir: dir, FallbackGas: 5000}
+	results := submitter.New(dryRun, config()).Submit(context.Background(), msgs(10, 10))
+
+	// a batch failing simulation is written whole, at the fallback gas.
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	require.Contains(t, results[0].Response.RawLog, dir)
+	require.Empty(t, client.broadcasts)
+
+	files, err := filepath.Glob(filepath.Join(dir, "tx-quicksilver-2-*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	bz, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	tx := submitter.DryRunTx{}
+	require.NoError(t, json.Unmarshal(bz, &tx))
+	require.Equal(t, uint64(7500), tx.Gas)
+	require.Equal(t, "simulation of q1 failed", tx.SimulationError)
+	require.Len(t, tx.Msgs, 2)
+	require.Empty(t, tx.SignedTx)
+
+	// fakeClient cannot sign.
+	dryRun.Sign = true
+	results = submitter.New(dryRun, config()).Submit(context.Background(), msgs(10))
+	require.ErrorContains(t, results[0].Err, "cannot sign")
+}
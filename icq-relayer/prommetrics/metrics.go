@@ -11,7 +11,18 @@ type Metrics struct {
 	HistoricQueryRequests prometheus.CounterVec
 	ABCIRequests          prometheus.CounterVec
 	LightBlockRequests    prometheus.CounterVec
+	LightBlockCacheHits   prometheus.CounterVec
+	LightBlockCacheMisses prometheus.CounterVec
+	HeaderReuse           prometheus.CounterVec
 	RemoteBlockHeight     prometheus.GaugeVec
+	GasWanted             prometheus.CounterVec
+	GasUsed               prometheus.CounterVec
+	FeesPaid              prometheus.CounterVec
+	TxBatches             prometheus.CounterVec
+	TxSearchPages         prometheus.CounterVec
+	SignerTxs             prometheus.CounterVec
+	SignerBalance         prometheus.GaugeVec
+	LowBalance            prometheus.GaugeVec
 }
 
 func NewMetrics(reg prometheus.Registerer) *Metrics {
@@ -20,33 +31,33 @@ func NewMetrics(reg prometheus.Registerer) *Metrics {
 			Namespace: "icq",
 			Name:      "requests",
 			Help:      "number of host requests",
-		}, []string{"name", "type"}),
+		}, []string{"name", "type", "controller"}),
 		FailedTxs: *prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: "icq",
 			Name:      "failed_txs",
 			Help:      "number of failed txs",
-		}, []string{"name"}),
+		}, []string{"name", "controller"}),
 		RequestsLatency: *prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: "icq",
 			Name:      "request_duration_seconds",
 			Help:      "Latency of requests",
 			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 15),
-		}, []string{"name", "type"}),
+		}, []string{"name", "type", "controller"}),
 		HistoricQueries: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: "icq",
 			Name:      "historic_queries",
 			Help:      "historic queue size",
-		}, []string{"name"}),
+		}, []string{"name", "controller"}),
 		SendQueue: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: "icq",
 			Name:      "send_queue",
 			Help:      "send queue size",
-		}, []string{"name"}),
+		}, []string{"name", "controller"}),
 		HistoricQueryRequests: *prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: "icq",
 			Name:      "historic_reqs",
 			Help:      "number of historic query requests",
-		}, []string{"name"}),
+		}, []string{"name", "controller"}),
 		ABCIRequests: *prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: "icq",
 			Name:      "abci_reqs",
@@ -57,15 +68,72 @@ func NewMetrics(reg prometheus.Registerer) *Metrics {
 			Name:      "lightblock_reqs",
 			Help:      "number of lightblock requests",
 		}, []string{"name"}),
+		LightBlockCacheHits: *prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "icq",
+			Name:      "lightblock_cache_hits",
+			Help:      "number of light blocks served from the memory or disk cache",
+		}, []string{"name", "chain_id", "cache"}),
+		LightBlockCacheMisses: *prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "icq",
+			Name:      "lightblock_cache_misses",
+			Help:      "number of light blocks fetched from the host rpc",
+		}, []string{"name", "chain_id"}),
+		HeaderReuse: *prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "icq",
+			Name:      "header_reuse",
+			Help:      "number of client update headers reused across queries",
+		}, []string{"name", "chain_id"}),
 		RemoteBlockHeight: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: "icq",
 			Name:      "remote_height",
 			Help:      "remote chain height",
 		}, []string{"name", "chain_id"}),
+		GasWanted: *prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "icq",
+			Name:      "gas_wanted",
+			Help:      "gas limit of submitted txs",
+		}, []string{"name", "controller"}),
+		GasUsed: *prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "icq",
+			Name:      "gas_used",
+			Help:      "gas used by submitted txs",
+		}, []string{"name", "controller"}),
+		FeesPaid: *prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "icq",
+			Name:      "fees_paid",
+			Help:      "fees paid by submitted txs",
+		}, []string{"name", "controller", "denom"}),
+		TxBatches: *prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "icq",
+			Name:      "tx_batches",
+			Help:      "number of txs each flush was split into",
+		}, []string{"name", "controller"}),
+		TxSearchPages: *prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "icq",
+			Name:      "tx_search_pages",
+			Help:      "pages of tx search results fetched",
+		}, []string{"name", "chain"}),
+		SignerTxs: *prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "icq",
+			Name:      "signer_txs",
+			Help:      "number of txs submitted by each signer key",
+		}, []string{"name", "controller", "key"}),
+		SignerBalance: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "icq",
+			Name:      "signer_balance",
+			Help:      "balance of each account paying for submitted txs",
+		}, []string{"name", "controller", "address", "denom"}),
+		LowBalance: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "icq",
+			Name:      "low_balance",
+			Help:      "1 if the balance of an account paying for submitted txs is below min_balance",
+		}, []string{"name", "controller", "address"}),
 	}
 	reg.MustRegister(m.Requests, m.RequestsLatency, m.HistoricQueries, m.SendQueue,
 		m.FailedTxs, m.HistoricQueryRequests, m.LightBlockRequests, m.ABCIRequests,
-		m.RemoteBlockHeight,
+		m.LightBlockCacheHits, m.LightBlockCacheMisses, m.HeaderReuse,
+		m.RemoteBlockHeight, m.GasWanted, m.GasUsed, m.FeesPaid, m.TxBatches,
+		m.TxSearchPages, m.SignerTxs, m.SignerBalance, m.LowBalance,
 	)
 	return m
 }
@@ -86,6 +86,55 @@ func BenchmarkSimulation(b *testing.B) {
 	}
 }
 
+// TestFullAppSimulation runs a single randomized simulation, asserting all registered invariants.
+func TestFullAppSimulation(t *testing.T) {
+	config, db, dir, logger, skip, err := simulation.SetupSimulation("goleveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	})
+
+	quicksilver := app.NewQuicksilver(
+		logger,
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		simulation.FlagPeriodValue,
+		app.MakeEncodingConfig(),
+		wasm.EnableAllProposals,
+		app.EmptyAppOptions{},
+		app.GetWasmOpts(app.EmptyAppOptions{}),
+		false,
+		false,
+	)
+
+	_, simParams, simErr := sdksimulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		quicksilver.GetBaseApp(),
+		simulation.AppStateFn(quicksilver.AppCodec(), quicksilver.SimulationManager()),
+		simulationtypes.RandomAccounts,
+		simulation.Operations(quicksilver, quicksilver.AppCodec(), config),
+		quicksilver.ModuleAccountAddrs(),
+		config,
+		quicksilver.AppCodec(),
+	)
+	require.NoError(t, simErr)
+
+	require.NoError(t, simulation.CheckExportSimulation(quicksilver, config, simParams))
+
+	if config.Commit {
+		simulation.PrintStats(db)
+	}
+}
+
 // TestAppStateDeterminism TODO.
 func TestAppStateDeterminism(t *testing.T) {
 	if !simulation.FlagEnabledValue {
@@ -26,6 +26,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/quicksilver-zone/quicksilver/app"
+	airdroptypes "github.com/quicksilver-zone/quicksilver/x/airdrop/types"
 )
 
 // AppStateFn returns the initial application state using a genesis or the simulation parameters.
@@ -128,6 +129,32 @@ func AppStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simty
 			})
 		}
 
+		// fund the airdrop module account with the allocations of the zone airdrops, unless already funded
+		airdropAddr := authtypes.NewModuleAddress(airdroptypes.ModuleName).String()
+		found = false
+		for _, balance := range bankState.Balances {
+			if balance.Address == airdropAddr {
+				found = true
+				break
+			}
+		}
+		airdropState := new(airdroptypes.GenesisState)
+		if airdropStateBz, ok := rawState[airdroptypes.ModuleName]; ok {
+			cdc.MustUnmarshalJSON(airdropStateBz, airdropState)
+		}
+		airdropTokens := sdk.ZeroInt()
+		for _, zd := range airdropState.ZoneDrops {
+			airdropTokens = airdropTokens.Add(sdk.NewIntFromUint64(zd.Allocation))
+		}
+		if !found && airdropTokens.IsPositive() {
+			airdropCoins := sdk.NewCoins(sdk.NewCoin(stakingState.Params.BondDenom, airdropTokens))
+			bankState.Balances = append(bankState.Balances, banktypes.Balance{
+				Address: airdropAddr,
+				Coins:   airdropCoins,
+			})
+			bankState.Supply = bankState.Supply.Add(airdropCoins...)
+		}
+
 		// change appState back
 		rawState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingState)
 		rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankState)
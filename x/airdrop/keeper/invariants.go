@@ -1,25 +1,32 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/quicksilver-zone/quicksilver/x/airdrop/types"
 )
 
 const (
-	claimRecordInvariantName = "claim-record-max-allocation"
+	claimRecordInvariantName     = "claim-record-max-allocation"
+	zoneDropBalanceInvariantName = "zonedrop-account-balance"
 )
 
 // RegisterInvariants registers all airdrop invariants.
 func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 	ir.RegisterRoute(types.ModuleName, claimRecordInvariantName, ClaimRecordInvariant(k))
+	ir.RegisterRoute(types.ModuleName, zoneDropBalanceInvariantName, ZoneDropBalanceInvariant(k))
 }
 
 // AllInvariants runs all invariants of the module.
 func AllInvariants(k *Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		msg, broke := ClaimRecordInvariant(k)(ctx)
-		return msg, broke
+		if broke {
+			return msg, broke
+		}
+		return ZoneDropBalanceInvariant(k)(ctx)
 	}
 }
 
@@ -46,3 +53,50 @@ func ClaimRecordInvariant(k *Keeper) sdk.Invariant {
 		), false
 	}
 }
+
+// ZoneDropBalanceInvariant checks that the account of each started, unconcluded
+// zone airdrop holds enough to cover the outstanding allocations of its claim
+// records.
+func ZoneDropBalanceInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		k.IterateZoneDrops(ctx, func(_ int64, zd types.ZoneDrop) (stop bool) {
+			if zd.IsConcluded || k.IsFutureZoneDrop(ctx, zd) {
+				return false
+			}
+
+			outstanding := uint64(0)
+			k.IterateClaimRecords(ctx, zd.ChainId, func(_ int64, cr types.ClaimRecord) (stop bool) {
+				claimed := uint64(0)
+				for _, ca := range cr.ActionsCompleted {
+					claimed += ca.ClaimAmount
+				}
+				if cr.MaxAllocation > claimed {
+					outstanding += cr.MaxAllocation - claimed
+				}
+				return false
+			})
+
+			balance := k.GetZoneDropAccountBalance(ctx, zd.ChainId)
+			if balance.Amount.LT(sdk.NewIntFromUint64(outstanding)) {
+				msg += fmt.Sprintf("\tzone airdrop %s: balance %s, outstanding claims %d\n", zd.ChainId, balance, outstanding)
+				broken = true
+			}
+			return false
+		})
+
+		if broken {
+			return sdk.FormatInvariant(
+				types.ModuleName,
+				zoneDropBalanceInvariantName,
+				"zone airdrop account balances do not cover outstanding claims\n"+msg,
+			), true
+		}
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			zoneDropBalanceInvariantName,
+			"\tzone airdrop account balances cover outstanding claims",
+		), false
+	}
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	"github.com/quicksilver-zone/quicksilver/x/airdrop/keeper"
+	"github.com/quicksilver-zone/quicksilver/x/airdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestZoneDropBalanceInvariant() {
+	appA := suite.GetQuicksilverApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	invariant := keeper.ZoneDropBalanceInvariant(appA.AirdropKeeper)
+
+	// no zone airdrops.
+	_, broken := invariant(ctx)
+	suite.Require().False(broken)
+
+	// future zone airdrops need not yet be funded.
+	userAddress := addressutils.GenerateAccAddressForTest().String()
+	zd := suite.getZoneDrop()
+	zd.StartTime = ctx.BlockTime().Add(time.Hour)
+	appA.AirdropKeeper.SetZoneDrop(ctx, zd)
+	suite.setClaimRecord(types.ClaimRecord{
+		ChainId:       zd.ChainId,
+		Address:       userAddress,
+		MaxAllocation: 600000000,
+		BaseValue:     10000000,
+	})
+	_, broken = invariant(ctx)
+	suite.Require().False(broken)
+
+	// started, unfunded zone airdrops are broken.
+	zd.StartTime = ctx.BlockTime().Add(-time.Minute)
+	appA.AirdropKeeper.SetZoneDrop(ctx, zd)
+	_, broken = invariant(ctx)
+	suite.Require().True(broken)
+
+	// partially funded.
+	suite.fundZoneDrop(zd.ChainId, 500000000)
+	_, broken = invariant(ctx)
+	suite.Require().True(broken)
+
+	// completed actions reduce the outstanding allocation.
+	cr, err := appA.AirdropKeeper.GetClaimRecord(ctx, zd.ChainId, userAddress)
+	suite.Require().NoError(err)
+	cr.ActionsCompleted = map[int32]*types.CompletedAction{
+		int32(types.ActionInitialClaim): {CompleteTime: ctx.BlockTime(), ClaimAmount: 100000000},
+	}
+	suite.setClaimRecord(cr)
+	_, broken = invariant(ctx)
+	suite.Require().False(broken)
+
+	// concluded zone airdrops are ignored.
+	suite.setClaimRecord(types.ClaimRecord{
+		ChainId:       zd.ChainId,
+		Address:       addressutils.GenerateAccAddressForTest().String(),
+		MaxAllocation: 100000000,
+		BaseValue:     10000000,
+	})
+	_, broken = invariant(ctx)
+	suite.Require().True(broken)
+	zd.IsConcluded = true
+	appA.AirdropKeeper.SetZoneDrop(ctx, zd)
+	_, broken = invariant(ctx)
+	suite.Require().False(broken)
+}
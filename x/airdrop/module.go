@@ -20,6 +20,7 @@ import (
 
 	"github.com/quicksilver-zone/quicksilver/x/airdrop/client/cli"
 	"github.com/quicksilver-zone/quicksilver/x/airdrop/keeper"
+	"github.com/quicksilver-zone/quicksilver/x/airdrop/simulation"
 	"github.com/quicksilver-zone/quicksilver/x/airdrop/types"
 )
 
@@ -98,16 +99,20 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 // AppModule implements the AppModule interface for the airdrop module.
 type AppModule struct {
 	AppModuleBasic
-	keeper *keeper.Keeper
+	keeper        *keeper.Keeper
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
 }
 
 // NewAppModule return a new AppModule.
-func NewAppModule(cdc codec.Codec, k *keeper.Keeper) AppModule {
+func NewAppModule(cdc codec.Codec, k *keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{
 			cdc: cdc,
 		},
-		keeper: k,
+		keeper:        k,
+		accountKeeper: ak,
+		bankKeeper:    bk,
 	}
 }
 
@@ -175,8 +180,9 @@ func (AppModule) ConsensusVersion() uint64 { return 1 }
 
 // AppModuleSimulation functions
 
-// GenerateGenesisState creates a randomized GenState of the mint module.
-func (AppModule) GenerateGenesisState(_ *module.SimulationState) {
+// GenerateGenesisState creates a randomized GenState of the airdrop module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
 }
 
 // ProposalContents doesn't return any content functions for governance proposals.
@@ -193,7 +199,9 @@ func (AppModule) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
 func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {
 }
 
-// WeightedOperations doesn't return any mint module operation.
-func (AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+// WeightedOperations returns the all the airdrop module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc, am.accountKeeper, am.bankKeeper, am.keeper,
+	)
 }
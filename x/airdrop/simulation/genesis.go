@@ -0,0 +1,89 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/quicksilver-zone/quicksilver/x/airdrop/types"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// RandomizedGenState generates a random GenesisState for airdrop. An active zone airdrop is registered for each
+// interchainstaking zone in the simulated genesis, with claim records for a random subset of the simulation
+// accounts. The airdrop module account must be funded with the sum of the allocations by the app state generator.
+func RandomizedGenState(simState *module.SimulationState) {
+	airdropGenesis := types.DefaultGenesisState()
+
+	icsGenesis := icstypes.DefaultGenesis()
+	if bz, ok := simState.GenState[icstypes.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, icsGenesis)
+	}
+
+	for _, zone := range icsGenesis.Zones {
+		allocation := uint64(0)
+		for _, acc := range simState.Accounts {
+			if simState.Rand.Intn(2) == 0 {
+				continue
+			}
+			cr := &types.ClaimRecord{
+				ChainId:       zone.ChainId,
+				Address:       acc.Address.String(),
+				MaxAllocation: uint64(simState.Rand.Int63n(1_000_000_000) + 1_000_000),
+				BaseValue:     uint64(simState.Rand.Int63n(1_000_000_000) + 1),
+			}
+			allocation += cr.MaxAllocation
+			airdropGenesis.ClaimRecords = append(airdropGenesis.ClaimRecords, cr)
+		}
+
+		if allocation == 0 {
+			continue
+		}
+
+		airdropGenesis.ZoneDrops = append(airdropGenesis.ZoneDrops, &types.ZoneDrop{
+			ChainId:    zone.ChainId,
+			StartTime:  simState.GenTimestamp,
+			Duration:   time.Duration(simState.Rand.Intn(60)+30) * 24 * time.Hour, // [30, 90) days
+			Decay:      time.Duration(simState.Rand.Intn(30)+1) * 24 * time.Hour,  // [1, 30] days
+			Allocation: allocation,
+			Actions:    randomActionWeights(simState),
+		})
+	}
+
+	if err := airdropGenesis.Validate(); err != nil {
+		panic(err)
+	}
+
+	bz, err := json.MarshalIndent(&airdropGenesis.ZoneDrops, "", " ")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Selected deterministically generated airdrop zone drops:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(airdropGenesis)
+}
+
+// randomActionWeights returns random weights in whole percent for each defined action, summing to one. The initial
+// claim is always weighted, so that claims may be simulated without proofs.
+func randomActionWeights(simState *module.SimulationState) []sdk.Dec {
+	actions := len(types.Action_name) - 1
+	weights := make([]sdk.Dec, actions)
+	remaining := 100
+	for i := range weights {
+		weight := remaining
+		if i < actions-1 {
+			weight = simState.Rand.Intn(remaining - (actions - 1 - i))
+			if i == 0 {
+				weight++
+			}
+		}
+		remaining -= weight
+		weights[i] = sdk.NewDecWithPrec(int64(weight), 2)
+	}
+	return weights
+}
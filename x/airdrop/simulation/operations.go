@@ -0,0 +1,95 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdksimtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/quicksilver-zone/quicksilver/test/simulation/simtypes"
+	"github.com/quicksilver-zone/quicksilver/x/airdrop/keeper"
+	"github.com/quicksilver-zone/quicksilver/x/airdrop/types"
+)
+
+const (
+	OpWeightMsgClaim          = "op_weight_msg_claim" // nolint:gosec // not credentials
+	DefaultWeightMsgClaim int = 20
+)
+
+var TypeMsgClaim = sdk.MsgTypeURL(&types.MsgClaim{})
+
+func WeightedOperations(
+	appParams sdksimtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgClaim int
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgClaim, &weightMsgClaim, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaim = DefaultWeightMsgClaim
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgClaim,
+			SimulateMsgClaim(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgClaim generates a MsgClaim of the initial claim action for a random unclaimed claim record of an
+// active zone airdrop. Other actions require host chain proofs or state and are not simulated.
+func SimulateMsgClaim(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper) sdksimtypes.Operation {
+	return func(
+		r *rand.Rand, bApp *baseapp.BaseApp, ctx sdk.Context, accs []sdksimtypes.Account, chainID string,
+	) (sdksimtypes.OperationMsg, []sdksimtypes.FutureOperation, error) {
+		zoneDrops := k.AllActiveZoneDrops(ctx)
+		if len(zoneDrops) == 0 {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeMsgClaim, "no active zone airdrops found"), nil, nil
+		}
+		zd := zoneDrops[r.Intn(len(zoneDrops))]
+
+		acc, found := simtypes.RandomSimAccountWithConstraint(r, func(acc sdksimtypes.Account) bool {
+			cr, err := k.GetClaimRecord(ctx, zd.ChainId, acc.Address.String())
+			if err != nil {
+				return false
+			}
+			_, claimed := cr.ActionsCompleted[int32(types.ActionInitialClaim)]
+			return !claimed
+		}, accs)
+		if !found {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeMsgClaim, "no unclaimed claim records found"), nil, nil
+		}
+
+		msg := &types.MsgClaim{
+			ChainId: zd.ChainId,
+			Action:  int64(types.ActionInitialClaim),
+			Address: acc.Address.String(),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             bApp,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         TypeMsgClaim,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			Context:         ctx,
+			SimAccount:      acc,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -5,6 +5,12 @@ func (Query) ValidateBasic() error {
 	return nil
 }
 
+// IsPending returns true if the query has been emitted, or is yet to be
+// emitted, and no response has been received since.
+func (q Query) IsPending() bool {
+	return q.LastEmission.IsNil() || q.LastEmission.IsZero() || q.LastEmission.GTE(q.LastHeight)
+}
+
 func (DataPoint) ValidateBasic() error {
 	// TODO: implement
 	return nil
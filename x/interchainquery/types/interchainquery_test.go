@@ -81,3 +81,23 @@ func TestDataPoint_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestQuery_IsPending(t *testing.T) {
+	tests := []struct {
+		name         string
+		lastEmission sdkmath.Int
+		lastHeight   sdkmath.Int
+		want         bool
+	}{
+		{"never emitted", sdkmath.Int{}, sdkmath.ZeroInt(), true},
+		{"emitted at zero", sdkmath.ZeroInt(), sdkmath.NewInt(10), true},
+		{"emitted, awaiting response", sdkmath.NewInt(20), sdkmath.NewInt(10), true},
+		{"emitted and answered", sdkmath.NewInt(20), sdkmath.NewInt(21), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := Query{LastEmission: tt.lastEmission, LastHeight: tt.lastHeight}
+			require.Equal(t, tt.want, q.IsPending())
+		})
+	}
+}
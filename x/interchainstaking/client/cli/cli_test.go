@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/suite"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
@@ -12,8 +13,12 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/quicksilver-zone/quicksilver/app"
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/client/cli"
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
 )
@@ -24,6 +29,9 @@ type IntegrationTestSuite struct {
 	cfg     network.Config
 	network *network.Network
 	zones   []types.Zone
+
+	delegator string
+	receipts  []types.Receipt
 }
 
 func (s *IntegrationTestSuite) SetupSuite() {
@@ -50,8 +58,8 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		AccountPrefix:                "cosmos",
 		LocalDenom:                   "uqatom",
 		BaseDenom:                    "uatom",
-		RedemptionRate:               sdk.ZeroDec(),
-		LastRedemptionRate:           sdk.ZeroDec(),
+		RedemptionRate:               sdk.OneDec(),
+		LastRedemptionRate:           sdk.OneDec(),
 		Validators:                   nil,
 		AggregateIntent:              types.ValidatorIntents{},
 		MultiSend:                    false,
@@ -77,7 +85,50 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	// setup basic genesis state
 	newGenesis := types.DefaultGenesis()
 	newGenesis.Zones = []types.Zone{zone}
+	validators := types.ValidatorsForZone{ChainId: zone.ChainId}
+	for _, val := range zone.Validators {
+		val := *val
+		val.Status = stakingtypes.BondStatusBonded
+		validators.Validators = append(validators.Validators, val)
+	}
+	newGenesis.Validators = []types.ValidatorsForZone{validators}
+
+	// seed records so that the record queries have something to page through.
+	s.delegator = addressutils.GenerateAccAddressForTest().String()
+	otherDelegator := addressutils.GenerateAccAddressForTest().String()
+	hostAddress := addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix)
+	s.receipts = []types.Receipt{
+		{ChainId: zone.ChainId, Sender: hostAddress, Txhash: "0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a", Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000)))},
+		{ChainId: zone.ChainId, Sender: hostAddress, Txhash: "0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b", Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(2000)))},
+	}
+	newGenesis.Receipts = s.receipts
+	newGenesis.Delegations = []types.DelegationsForZone{
+		{
+			ChainId: zone.ChainId,
+			Delegations: []*types.Delegation{
+				{DelegationAddress: hostAddress, ValidatorAddress: zone.Validators[0].ValoperAddress, Amount: sdk.NewCoin("uatom", sdk.NewInt(3000))},
+			},
+		},
+	}
+	newGenesis.WithdrawalRecords = []types.WithdrawalRecord{
+		{ChainId: zone.ChainId, Delegator: s.delegator, Recipient: hostAddress, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))), BurnAmount: sdk.NewCoin("uqatom", sdk.NewInt(100)), Txhash: "1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c", Status: types.WithdrawStatusQueued},
+		{ChainId: zone.ChainId, Delegator: s.delegator, Recipient: hostAddress, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(200))), BurnAmount: sdk.NewCoin("uqatom", sdk.NewInt(200)), Txhash: "1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d", Status: types.WithdrawStatusUnbond},
+		{ChainId: zone.ChainId, Delegator: otherDelegator, Recipient: hostAddress, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(300))), BurnAmount: sdk.NewCoin("uqatom", sdk.NewInt(300)), Txhash: "1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e", Status: types.WithdrawStatusQueued},
+	}
+
 	updateGenesisConfigState(types.ModuleName, newGenesis)
+
+	// fund the escrow account with the qAssets burnable by the withdrawal records.
+	escrowed := sdk.NewCoins()
+	for _, record := range newGenesis.WithdrawalRecords {
+		escrowed = escrowed.Add(record.BurnAmount)
+	}
+	bankGenesis := banktypes.GetGenesisStateFromAppState(s.cfg.Codec, s.cfg.GenesisState)
+	bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{Address: authtypes.NewModuleAddress(types.EscrowModuleAccount).String(), Coins: escrowed})
+	if !bankGenesis.Supply.Empty() {
+		bankGenesis.Supply = bankGenesis.Supply.Add(escrowed...)
+	}
+	updateGenesisConfigState(banktypes.ModuleName, bankGenesis)
 	s.zones = []types.Zone{zone}
 
 	net, err := network.New(s.T(), s.T().TempDir(), s.cfg)
@@ -419,6 +470,214 @@ func (s *IntegrationTestSuite) TestGetSignalIntentTxCmd() {
 	}
 }
 
+// runQueryCmd executes the given query command with json output and unmarshals the response into respType.
+func (s *IntegrationTestSuite) runQueryCmd(cmd *cobra.Command, args []string, expectErr bool, respType proto.Message) {
+	clientCtx := s.network.Validators[0].ClientCtx
+
+	args = append(args, fmt.Sprintf("--%s=json", tmcli.OutputFlag))
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+	if expectErr {
+		s.Require().Error(err)
+		return
+	}
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), respType), out.String())
+}
+
+func (s *IntegrationTestSuite) TestGetZoneCmd() {
+	tests := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{"no args", []string{}, true},
+		{"unknown zone", []string{"boguschainid"}, true},
+		{"valid", []string{s.zones[0].ChainId}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+
+		s.Run(tt.name, func() {
+			resp := &types.QueryZoneResponse{}
+			s.runQueryCmd(cli.GetZoneCmd(), tt.args, tt.expectErr, resp)
+			if !tt.expectErr {
+				s.Require().True(s.ZonesEqual(s.zones[0], resp.Zone))
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetZoneValidatorsCmd() {
+	tests := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expected  int
+	}{
+		{"no args", []string{}, true, 0},
+		{"valid", []string{s.zones[0].ChainId}, false, 5},
+		{"by status", []string{s.zones[0].ChainId, fmt.Sprintf("--%s=BOND_STATUS_BONDED", cli.FlagStatus), fmt.Sprintf("--%s=2", flags.FlagLimit)}, false, 2},
+		{"by other status", []string{s.zones[0].ChainId, fmt.Sprintf("--%s=BOND_STATUS_UNBONDED", cli.FlagStatus)}, false, 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+
+		s.Run(tt.name, func() {
+			resp := &types.QueryZoneValidatorsResponse{}
+			s.runQueryCmd(cli.GetZoneValidatorsCmd(), tt.args, tt.expectErr, resp)
+			if !tt.expectErr {
+				s.Require().Len(resp.Validators, tt.expected)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetDelegationsCmd() {
+	tests := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expected  int
+	}{
+		{"no args", []string{}, true, 0},
+		{"unknown zone", []string{"boguschainid"}, true, 0},
+		{"valid", []string{s.zones[0].ChainId}, false, 1},
+	}
+	for _, tt := range tests {
+		tt := tt
+
+		s.Run(tt.name, func() {
+			resp := &types.QueryDelegationsResponse{}
+			s.runQueryCmd(cli.GetDelegationsCmd(), tt.args, tt.expectErr, resp)
+			if !tt.expectErr {
+				s.Require().Len(resp.Delegations, tt.expected)
+				s.Require().Equal(int64(3000), resp.Tvl)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetReceiptsCmd() {
+	tests := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expected  int
+	}{
+		{"no args", []string{}, true, 0},
+		{"unknown zone", []string{"boguschainid"}, true, 0},
+		{"valid", []string{s.zones[0].ChainId}, false, 2},
+		{"paginated", []string{s.zones[0].ChainId, fmt.Sprintf("--%s=1", flags.FlagLimit)}, false, 1},
+	}
+	for _, tt := range tests {
+		tt := tt
+
+		s.Run(tt.name, func() {
+			resp := &types.QueryReceiptsResponse{}
+			s.runQueryCmd(cli.GetReceiptsCmd(), tt.args, tt.expectErr, resp)
+			if !tt.expectErr {
+				s.Require().Len(resp.Receipts, tt.expected)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetTxStatusCmd() {
+	tests := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{"no args", []string{}, true},
+		{"unknown tx", []string{s.zones[0].ChainId, "ffff"}, true},
+		{"valid", []string{s.zones[0].ChainId, s.receipts[0].Txhash}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+
+		s.Run(tt.name, func() {
+			resp := &types.QueryTxStatusResponse{}
+			s.runQueryCmd(cli.GetTxStatusCmd(), tt.args, tt.expectErr, resp)
+			if !tt.expectErr {
+				s.Require().Equal(s.receipts[0].Txhash, resp.Receipt.Txhash)
+				s.Require().Equal(s.receipts[0].Amount, resp.Receipt.Amount)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetWithdrawalRecordsCmd() {
+	tests := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expected  int
+	}{
+		{"no args", []string{}, true, 0},
+		{"invalid status", []string{s.zones[0].ChainId, fmt.Sprintf("--%s=bogus", cli.FlagStatus)}, true, 0},
+		{"all", []string{s.zones[0].ChainId}, false, 3},
+		{"by status name", []string{s.zones[0].ChainId, fmt.Sprintf("--%s=queued", cli.FlagStatus)}, false, 2},
+		{"by status number", []string{s.zones[0].ChainId, fmt.Sprintf("--%s=%d", cli.FlagStatus, types.WithdrawStatusUnbond)}, false, 1},
+		{"by delegator", []string{s.zones[0].ChainId, s.delegator}, false, 2},
+		{"by delegator and status", []string{s.zones[0].ChainId, s.delegator, fmt.Sprintf("--%s=queued", cli.FlagStatus)}, false, 1},
+		{"paginated", []string{s.zones[0].ChainId, fmt.Sprintf("--%s=2", flags.FlagLimit)}, false, 2},
+	}
+	for _, tt := range tests {
+		tt := tt
+
+		s.Run(tt.name, func() {
+			resp := &types.QueryWithdrawalRecordsResponse{}
+			s.runQueryCmd(cli.GetWithdrawalRecordsCmd(), tt.args, tt.expectErr, resp)
+			if !tt.expectErr {
+				s.Require().Len(resp.Withdrawals, tt.expected)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetUserWithdrawalRecordsCmd() {
+	tests := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expected  int
+	}{
+		{"no args", []string{}, true, 0},
+		{"invalid address", []string{"bogus"}, true, 0},
+		{"all", []string{s.delegator}, false, 2},
+		{"by status", []string{s.delegator, fmt.Sprintf("--%s=unbond", cli.FlagStatus)}, false, 1},
+		{"by status; none", []string{s.delegator, fmt.Sprintf("--%s=completed", cli.FlagStatus)}, false, 0},
+		{"paginated", []string{s.delegator, fmt.Sprintf("--%s=1", flags.FlagLimit)}, false, 1},
+	}
+	for _, tt := range tests {
+		tt := tt
+
+		s.Run(tt.name, func() {
+			resp := &types.QueryWithdrawalRecordsResponse{}
+			s.runQueryCmd(cli.GetUserWithdrawalRecordsCmd(), tt.args, tt.expectErr, resp)
+			if !tt.expectErr {
+				s.Require().Len(resp.Withdrawals, tt.expected)
+				for _, record := range resp.Withdrawals {
+					s.Require().Equal(s.delegator, record.Delegator)
+				}
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetUnbondingAndRedelegationRecordsCmd() {
+	unbondings := &types.QueryUnbondingRecordsResponse{}
+	s.runQueryCmd(cli.GetUnbondingRecordsCmd(), []string{s.zones[0].ChainId}, false, unbondings)
+	s.Require().Len(unbondings.Unbondings, 0)
+
+	redelegations := &types.QueryRedelegationRecordsResponse{}
+	s.runQueryCmd(cli.GetRedelegationRecordsCmd(), []string{s.zones[0].ChainId}, false, redelegations)
+	s.Require().Len(redelegations.Redelegations, 0)
+
+	s.runQueryCmd(cli.GetUnbondingRecordsCmd(), []string{}, true, unbondings)
+	s.runQueryCmd(cli.GetRedelegationRecordsCmd(), []string{}, true, redelegations)
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
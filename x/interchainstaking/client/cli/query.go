@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -13,6 +14,38 @@ import (
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
 )
 
+const (
+	FlagFromEpoch = "from-epoch"
+	FlagToEpoch   = "to-epoch"
+	FlagStatus    = "status"
+)
+
+// withdrawalStatuses maps the names accepted by the --status flag of withdrawal record queries to record statuses.
+var withdrawalStatuses = map[string]int32{
+	"tokenize":  types.WithdrawStatusTokenize,
+	"queued":    types.WithdrawStatusQueued,
+	"unbond":    types.WithdrawStatusUnbond,
+	"send":      types.WithdrawStatusSend,
+	"completed": types.WithdrawStatusCompleted,
+	"cancel":    types.WithdrawStatusCancel,
+}
+
+// parseWithdrawalStatus parses a withdrawal record status, by name or number. An empty string returns zero, which
+// does not filter by status.
+func parseWithdrawalStatus(in string) (int32, error) {
+	if in == "" {
+		return 0, nil
+	}
+	if status, ok := withdrawalStatuses[strings.ToLower(in)]; ok {
+		return status, nil
+	}
+	status, err := strconv.ParseInt(in, 10, 32)
+	if err != nil || status < 0 {
+		return 0, fmt.Errorf("invalid withdrawal record status %q; expected one of tokenize, queued, unbond, send, completed or cancel", in)
+	}
+	return int32(status), nil
+}
+
 // GetQueryCmd returns the cli query commands for interchainstaking module.
 func GetQueryCmd() *cobra.Command {
 	// Group epochs queries under a subcommand
@@ -27,9 +60,26 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCmdZones(),
+		GetZoneCmd(),
+		GetZoneValidatorsCmd(),
+		GetDelegationsCmd(),
+		GetReceiptsCmd(),
+		GetTxStatusCmd(),
+		GetWithdrawalRecordsCmd(),
+		GetUserWithdrawalRecordsCmd(),
+		GetUnbondingRecordsCmd(),
+		GetRedelegationRecordsCmd(),
 		GetDelegatorIntentCmd(),
 		GetDepositAccountCmd(),
 		GetMappedAccountsCmd(),
+		GetCircuitBreakerCmd(),
+		GetSlashRecordsCmd(),
+		GetRewardSwapRoutesCmd(),
+		GetZoneWindDownCmd(),
+		GetZoneWindDownsCmd(),
+		GetZoneHistoryCmd(),
+		GetRedemptionQueueCmd(),
+		GetEpochCapsCmd(),
 	)
 
 	return cmd
@@ -76,11 +126,170 @@ func GetCmdZones() *cobra.Command {
 	return cmd
 }
 
-// GetDelegatorIntentCmd returns the intents of the user for the given chainID.
-func GetDelegatorIntentCmd() *cobra.Command {
+// GetZoneCmd returns the zone and statistics for the given chainID.
+func GetZoneCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "intent [chain_id] [delegator_addr]",
-		Short: "Query delegation intent for a given chain.",
+		Use:   "zone [chain_id]",
+		Short: "Query a registered zone and its statistics.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryZoneRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.Zone(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetZoneValidatorsCmd returns the validators of the given chainID (zone),
+// optionally filtered by status.
+func GetZoneValidatorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators [chain_id]",
+		Short: "Query validators for a given chain, optionally filtered by status.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainstaking validators cosmoshub-4 --status BOND_STATUS_BONDED`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			validatorStatus, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryZoneValidatorsRequest{
+				ChainId:    args[0],
+				Status:     validatorStatus,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ZoneValidators(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "validator status to filter by; e.g. BOND_STATUS_BONDED")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validators")
+
+	return cmd
+}
+
+// GetDelegationsCmd returns the delegations of the given chainID (zone).
+func GetDelegationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations [chain_id]",
+		Short: "Query delegations for a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryDelegationsRequest{
+				ChainId:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Delegations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegations")
+
+	return cmd
+}
+
+// GetReceiptsCmd returns the deposit receipts of the given chainID (zone).
+func GetReceiptsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "receipts [chain_id]",
+		Short: "Query deposit receipts for a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryReceiptsRequest{
+				ChainId:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Receipts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "receipts")
+
+	return cmd
+}
+
+// GetTxStatusCmd returns the receipt of the given deposit transaction for the
+// given chainID (zone).
+func GetTxStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-status [chain_id] [tx_hash]",
+		Short: "Query the receipt of a deposit transaction for a given chain.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -88,17 +297,72 @@ func GetDelegatorIntentCmd() *cobra.Command {
 				return err
 			}
 
-			// args
-			chainID := args[0]
-			delegatorAddr := args[1]
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryTxStatusRequest{
+				ChainId: args[0],
+				TxHash:  args[1],
+			}
+
+			res, err := queryClient.TxStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetWithdrawalRecordsCmd returns the withdrawal records of the given chainID
+// (zone), optionally filtered by delegator and status.
+func GetWithdrawalRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawal-records [chain_id] [delegator_address]",
+		Short: "Query withdrawal records for a given chain, optionally filtered by delegator and status.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainstaking withdrawal-records cosmoshub-4 --status queued`,
+				version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			recordStatus, err := parseWithdrawalStatus(statusStr)
+			if err != nil {
+				return err
+			}
 
 			queryClient := types.NewQueryClient(clientCtx)
-			req := &types.QueryDelegatorIntentRequest{
-				ChainId:          chainID,
-				DelegatorAddress: delegatorAddr,
+			req := &types.QueryWithdrawalRecordsRequest{
+				ChainId:    args[0],
+				Status:     recordStatus,
+				Pagination: pageReq,
 			}
 
-			res, err := queryClient.DelegatorIntent(cmd.Context(), req)
+			var res *types.QueryWithdrawalRecordsResponse
+			if len(args) > 1 {
+				req.DelegatorAddress = args[1]
+				res, err = queryClient.ZoneWithdrawalRecords(cmd.Context(), req)
+			} else {
+				res, err = queryClient.WithdrawalRecords(cmd.Context(), req)
+			}
 			if err != nil {
 				return err
 			}
@@ -107,17 +371,69 @@ func GetDelegatorIntentCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagStatus, "", "withdrawal record status to filter by; one of tokenize, queued, unbond, send, completed or cancel")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "withdrawal-records")
 
 	return cmd
 }
 
-// GetDepositAccountCmd returns the deposit account for the given chainID
+// GetUserWithdrawalRecordsCmd returns the withdrawal records of the given
+// address across all zones, optionally filtered by status.
+func GetUserWithdrawalRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user-withdrawal-records [address]",
+		Short: "Query withdrawal records for a given address across all chains, optionally filtered by status.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			recordStatus, err := parseWithdrawalStatus(statusStr)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryUserWithdrawalRecordsRequest{
+				UserAddress: args[0],
+				Status:      recordStatus,
+				Pagination:  pageReq,
+			}
+
+			res, err := queryClient.UserWithdrawalRecords(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "withdrawal record status to filter by; one of tokenize, queued, unbond, send, completed or cancel")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "user-withdrawal-records")
+
+	return cmd
+}
+
+// GetUnbondingRecordsCmd returns the unbonding records of the given chainID
 // (zone).
-func GetDepositAccountCmd() *cobra.Command {
+func GetUnbondingRecordsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "deposit-account [chain_id]",
-		Short: "Query deposit account address for a given chain.",
+		Use:   "unbonding-records [chain_id]",
+		Short: "Query unbonding records for a given chain.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -125,15 +441,18 @@ func GetDepositAccountCmd() *cobra.Command {
 				return err
 			}
 
-			// args
-			chainID := args[0]
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			queryClient := types.NewQueryClient(clientCtx)
-			req := &types.QueryDepositAccountForChainRequest{
-				ChainId: chainID,
+			req := &types.QueryUnbondingRecordsRequest{
+				ChainId:    args[0],
+				Pagination: pageReq,
 			}
 
-			res, err := queryClient.DepositAccount(cmd.Context(), req)
+			res, err := queryClient.UnbondingRecords(cmd.Context(), req)
 			if err != nil {
 				return err
 			}
@@ -143,15 +462,17 @@ func GetDepositAccountCmd() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding-records")
 
 	return cmd
 }
 
-// GetMappedAccountsCmd returns the mapped account for the given address.
-func GetMappedAccountsCmd() *cobra.Command {
+// GetRedelegationRecordsCmd returns the redelegation records of the given
+// chainID (zone).
+func GetRedelegationRecordsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "mapped-accounts [address]",
-		Short: "Query mapped accounts for a given address.",
+		Use:   "redelegation-records [chain_id]",
+		Short: "Query redelegation records for a given chain.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -159,15 +480,444 @@ func GetMappedAccountsCmd() *cobra.Command {
 				return err
 			}
 
-			// args
-			address := args[0]
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			queryClient := types.NewQueryClient(clientCtx)
-			req := &types.QueryMappedAccountsRequest{
-				Address: address,
+			req := &types.QueryRedelegationRecordsRequest{
+				ChainId:    args[0],
+				Pagination: pageReq,
 			}
 
-			res, err := queryClient.MappedAccounts(cmd.Context(), req)
+			res, err := queryClient.RedelegationRecords(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redelegation-records")
+
+	return cmd
+}
+
+// GetDelegatorIntentCmd returns the intents of the user for the given chainID.
+func GetDelegatorIntentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "intent [chain_id] [delegator_addr]",
+		Short: "Query delegation intent for a given chain.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+			delegatorAddr := args[1]
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryDelegatorIntentRequest{
+				ChainId:          chainID,
+				DelegatorAddress: delegatorAddr,
+			}
+
+			res, err := queryClient.DelegatorIntent(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetDepositAccountCmd returns the deposit account for the given chainID
+// (zone).
+func GetDepositAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-account [chain_id]",
+		Short: "Query deposit account address for a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryDepositAccountForChainRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.DepositAccount(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetMappedAccountsCmd returns the mapped account for the given address.
+func GetMappedAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mapped-accounts [address]",
+		Short: "Query mapped accounts for a given address.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// args
+			address := args[0]
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryMappedAccountsRequest{
+				Address: address,
+			}
+
+			res, err := queryClient.MappedAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCircuitBreakerCmd returns the paused actions for the given chainID
+// (zone), and the reason each was paused.
+func GetCircuitBreakerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker [chain_id]",
+		Short: "Query circuit breaker status for a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryCircuitBreakerRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.CircuitBreaker(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSlashRecordsCmd returns the slash history of validators for the given
+// chainID (zone).
+func GetSlashRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-records [chain_id] [validator]",
+		Short: "Query validator slash records for a given chain, optionally filtered by validator.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+			validator := ""
+			if len(args) > 1 {
+				validator = args[1]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QuerySlashRecordsRequest{
+				ChainId:    chainID,
+				Validator:  validator,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SlashRecords(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slash-records")
+
+	return cmd
+}
+
+// GetRewardSwapRoutesCmd returns the reward swap routes for the given chainID
+// (zone), and the cached state of the pools they use.
+func GetRewardSwapRoutesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-swap-routes [chain_id]",
+		Short: "Query reward swap routes for a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryRewardSwapRoutesRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.RewardSwapRoutes(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetZoneWindDownCmd returns the wind-down status of the given chainID (zone).
+func GetZoneWindDownCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wind-down [chain_id]",
+		Short: "Query wind-down status for a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryZoneWindDownRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.ZoneWindDown(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetZoneWindDownsCmd returns the wind-down records of all zones.
+func GetZoneWindDownsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wind-downs",
+		Short: "Query wind-down records of all zones, including removed zones.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryZoneWindDownsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ZoneWindDowns(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "wind-downs")
+
+	return cmd
+}
+
+// GetZoneHistoryCmd returns the per-epoch redemption rate, TVL and APR of the
+// given chainID (zone).
+func GetZoneHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [chain_id]",
+		Short: "Query the per-epoch redemption rate, TVL and APR of a given chain.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainstaking history cosmoshub-4 --from-epoch 100 --to-epoch 120`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			fromEpoch, err := cmd.Flags().GetInt64(FlagFromEpoch)
+			if err != nil {
+				return err
+			}
+
+			toEpoch, err := cmd.Flags().GetInt64(FlagToEpoch)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryZoneHistoryRequest{
+				ChainId:    args[0],
+				FromEpoch:  fromEpoch,
+				ToEpoch:    toEpoch,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ZoneHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromEpoch, 0, "first epoch to return; 0 for the earliest retained epoch")
+	cmd.Flags().Int64(FlagToEpoch, 0, "last epoch to return; 0 for the latest epoch")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history")
+
+	return cmd
+}
+
+// GetRedemptionQueueCmd returns the queued redemptions for the given chainID
+// (zone), in processing order, with estimated unbonding and completion epochs.
+func GetRedemptionQueueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption-queue [chain_id] [delegator_address]",
+		Short: "Query the redemption queue for a given chain, optionally filtered by delegator.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+			delegator := ""
+			if len(args) > 1 {
+				delegator = args[1]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryRedemptionQueueRequest{
+				ChainId:          chainID,
+				DelegatorAddress: delegator,
+			}
+
+			res, err := queryClient.RedemptionQueue(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetEpochCapsCmd returns the per-epoch caps for the given chainID (zone), and
+// their usage in the current epoch, optionally by an address.
+func GetEpochCapsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-caps [chain_id] [address]",
+		Short: "Query the epoch caps for a given chain, and their usage this epoch, optionally by address.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+			address := ""
+			if len(args) > 1 {
+				address = args[1]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryEpochCapsRequest{
+				ChainId: chainID,
+				Address: address,
+			}
+
+			res, err := queryClient.EpochCaps(cmd.Context(), req)
 			if err != nil {
 				return err
 			}
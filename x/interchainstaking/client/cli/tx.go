@@ -19,6 +19,8 @@ import (
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
 )
 
+const FlagPriorityFee = "priority-fee"
+
 // GetTxCmd returns a root CLI command handler for all x/interchainstaking transaction commands.
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -32,7 +34,9 @@ func GetTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(GetSignalIntentTxCmd())
 	txCmd.AddCommand(GetRequestRedemptionTxCmd())
+	txCmd.AddCommand(GetCancelRedemptionTxCmd())
 	txCmd.AddCommand(GetReopenChannelTxCmd())
+	txCmd.AddCommand(GetTripCircuitBreakerTxCmd())
 
 	return txCmd
 }
@@ -85,6 +89,52 @@ func GetRequestRedemptionTxCmd() *cobra.Command {
 
 			msg := types.NewMsgRequestRedemption(coin, destinationAddress, clientCtx.GetFromAddress())
 
+			priorityFee, err := cmd.Flags().GetString(FlagPriorityFee)
+			if err != nil {
+				return err
+			}
+			if priorityFee != "" {
+				fee, err := sdk.ParseCoinNormalized(priorityFee)
+				if err != nil {
+					return fmt.Errorf("unable to parse priority fee %s", priorityFee)
+				}
+				msg.PriorityFee = &fee
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPriorityFee, "", "qAsset fee, burned to move the redemption up the queue")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCancelRedemptionTxCmd returns a CLI command handler for creating a CancelRedemption transaction.
+func GetCancelRedemptionTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-redemption [chain_id] [hash] [amount]",
+		Short: `Cancel a queued or unbonding redemption.`,
+		Long: `Cancel a queued or unbonding redemption. An optional qAsset amount may be
+provided to partially cancel a queued redemption.`,
+		Example: `cancel-redemption cosmoshub-4 [hash] 1000uqatom`,
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelQueuedRedemption(args[0], args[1], clientCtx.GetFromAddress())
+			if len(args) == 3 {
+				coin, err := sdk.ParseCoinNormalized(args[2])
+				if err != nil {
+					return fmt.Errorf("unable to parse coin %s", args[2])
+				}
+				msg = types.NewMsgCancelQueuedRedemptionPartial(args[0], args[1], coin, clientCtx.GetFromAddress())
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -119,6 +169,39 @@ func GetReopenChannelTxCmd() *cobra.Command {
 	return cmd
 }
 
+// GetTripCircuitBreakerTxCmd returns a CLI command handler for creating a TripCircuitBreaker transaction.
+func GetTripCircuitBreakerTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip-circuit-breaker [chain_id] [actions] [reason]",
+		Short: `Pause one or more actions for a zone.`,
+		Long: `Pause one or more actions for a zone. Actions are provided as a comma separated
+list of deposits, redemptions, rebalance, rewards and ica, or "all" to pause every action.
+Only the governance module or the emergency authority may trip a circuit breaker.`,
+		Example: `trip-circuit-breaker cosmoshub-4 deposits,redemptions "unexpected redemption rate"`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID := args[0]
+			actions, err := types.ParseCircuitBreakerActions(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTripCircuitBreaker(chainID, actions, args[2], clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitRegisterProposal implements the command to submit a register-zone proposal.
 func GetCmdSubmitRegisterProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -49,7 +49,7 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 			panic("unable to find zone for delegation")
 		}
 		for _, delegatorIntent := range delegatorIntentsForZone.DelegationIntent {
-			k.SetDelegatorIntent(ctx, &zone, *delegatorIntent, false)
+			k.SetDelegatorIntent(ctx, &zone, *delegatorIntent, delegatorIntentsForZone.Snapshot)
 		}
 	}
 
@@ -60,19 +60,106 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 	for _, withdrawal := range genState.WithdrawalRecords {
 		k.SetWithdrawalRecord(ctx, withdrawal)
 	}
+
+	if genState.WithdrawalRecordSequence != 0 {
+		k.SetWithdrawalRecordSequence(ctx, genState.WithdrawalRecordSequence)
+	}
+
+	for _, unbonding := range genState.UnbondingRecords {
+		k.SetUnbondingRecord(ctx, unbonding)
+	}
+
+	for _, redelegation := range genState.RedelegationRecords {
+		k.SetRedelegationRecord(ctx, redelegation)
+	}
+
+	for _, validatorsForZone := range genState.Validators {
+		for _, validator := range validatorsForZone.Validators {
+			if err := k.SetValidator(ctx, validatorsForZone.ChainId, validator); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	for _, consAddr := range genState.ValidatorConsAddrs {
+		k.SetValidatorAddrByConsAddr(ctx, consAddr.ChainId, consAddr.ValoperAddress, consAddr.ConsAddress)
+	}
+
+	for _, account := range genState.MappedAccounts {
+		k.SetAddressMapPair(ctx, account.LocalAddress, account.RemoteAddress, account.ChainId)
+	}
+
+	for _, mapping := range genState.AddressZoneMappings {
+		k.SetAddressZoneMapping(ctx, mapping.Address, mapping.ChainId)
+	}
+
+	for _, caps := range genState.LsmCaps {
+		k.SetLsmCaps(ctx, caps.ChainId, caps.Caps)
+	}
+
+	for _, caps := range genState.EpochCaps {
+		k.SetEpochCaps(ctx, caps.ChainId, caps.Caps)
+	}
+
+	for _, usage := range genState.EpochCapUsages {
+		k.SetEpochCapUsage(ctx, usage)
+	}
+
+	for _, cb := range genState.CircuitBreakers {
+		k.SetCircuitBreaker(ctx, cb)
+	}
+
+	k.SetEmergencyAuthority(ctx, genState.EmergencyAuthority)
+
+	for _, record := range genState.SlashRecords {
+		k.SetSlashRecord(ctx, record)
+	}
+
+	for _, config := range genState.RewardSwapConfigs {
+		k.SetRewardSwapConfig(ctx, config)
+	}
+
+	for _, pool := range genState.SwapPools {
+		k.SetSwapPool(ctx, pool)
+	}
+
+	for _, windDown := range genState.ZoneWindDowns {
+		k.SetZoneWindDown(ctx, windDown)
+	}
+
+	for _, snapshot := range genState.ZoneSnapshots {
+		k.SetZoneSnapshot(ctx, snapshot)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:                 k.GetParams(ctx),
-		Zones:                  k.AllZones(ctx),
-		Receipts:               k.AllReceipts(ctx),
-		Delegations:            ExportDelegationsPerZone(ctx, k),
-		PerformanceDelegations: ExportPerformanceDelegationsPerZone(ctx, k),
-		DelegatorIntents:       ExportDelegatorIntentsPerZone(ctx, k),
-		PortConnections:        k.AllPortConnections(ctx),
-		WithdrawalRecords:      k.AllWithdrawalRecords(ctx),
+		Params:                   k.GetParams(ctx),
+		Zones:                    k.AllZones(ctx),
+		Receipts:                 k.AllReceipts(ctx),
+		Delegations:              ExportDelegationsPerZone(ctx, k),
+		PerformanceDelegations:   ExportPerformanceDelegationsPerZone(ctx, k),
+		DelegatorIntents:         ExportDelegatorIntentsPerZone(ctx, k),
+		PortConnections:          k.AllPortConnections(ctx),
+		WithdrawalRecords:        k.AllWithdrawalRecords(ctx),
+		UnbondingRecords:         k.AllUnbondingRecords(ctx),
+		RedelegationRecords:      k.AllRedelegationRecords(ctx),
+		Validators:               ExportValidatorsPerZone(ctx, k),
+		ValidatorConsAddrs:       ExportValidatorConsAddrs(ctx, k),
+		MappedAccounts:           ExportMappedAccounts(ctx, k),
+		AddressZoneMappings:      k.AllAddressZoneMappings(ctx),
+		WithdrawalRecordSequence: k.GetWithdrawalRecordSequence(ctx),
+		LsmCaps:                  ExportLsmCaps(ctx, k),
+		CircuitBreakers:          k.AllCircuitBreakers(ctx),
+		EmergencyAuthority:       k.GetEmergencyAuthority(ctx),
+		SlashRecords:             ExportSlashRecords(ctx, k),
+		RewardSwapConfigs:        ExportRewardSwapConfigs(ctx, k),
+		SwapPools:                ExportSwapPools(ctx, k),
+		ZoneWindDowns:            k.AllZoneWindDowns(ctx),
+		ZoneSnapshots:            ExportZoneSnapshots(ctx, k),
+		EpochCaps:                ExportEpochCaps(ctx, k),
+		EpochCapUsages:           ExportEpochCapUsages(ctx, k),
 	}
 }
 
@@ -107,3 +194,101 @@ func ExportDelegatorIntentsPerZone(ctx sdk.Context, k *keeper.Keeper) []types.De
 	})
 	return delegatorIntentsForZones
 }
+
+func ExportValidatorsPerZone(ctx sdk.Context, k *keeper.Keeper) []types.ValidatorsForZone {
+	validatorsForZones := make([]types.ValidatorsForZone, 0)
+	k.IterateZones(ctx, func(_ int64, zone *types.Zone) (stop bool) {
+		validatorsForZones = append(validatorsForZones, types.ValidatorsForZone{ChainId: zone.ChainId, Validators: k.GetValidators(ctx, zone.ChainId)})
+		return false
+	})
+	return validatorsForZones
+}
+
+func ExportValidatorConsAddrs(ctx sdk.Context, k *keeper.Keeper) []types.ValidatorConsAddr {
+	consAddrs := make([]types.ValidatorConsAddr, 0)
+	k.IterateZones(ctx, func(_ int64, zone *types.Zone) (stop bool) {
+		k.IterateValidatorAddrsByConsAddr(ctx, zone.ChainId, func(_ int64, consAddr []byte, valAddr string) (stop bool) {
+			consAddrs = append(consAddrs, types.ValidatorConsAddr{ChainId: zone.ChainId, ConsAddress: consAddr, ValoperAddress: valAddr})
+			return false
+		})
+		return false
+	})
+	return consAddrs
+}
+
+func ExportMappedAccounts(ctx sdk.Context, k *keeper.Keeper) []types.MappedAccount {
+	accounts := make([]types.MappedAccount, 0)
+	k.IterateZones(ctx, func(_ int64, zone *types.Zone) (stop bool) {
+		k.IterateZoneMappedAccounts(ctx, zone.ChainId, func(_ int64, localAddress, remoteAddress []byte) (stop bool) {
+			accounts = append(accounts, types.MappedAccount{ChainId: zone.ChainId, LocalAddress: localAddress, RemoteAddress: remoteAddress})
+			return false
+		})
+		return false
+	})
+	return accounts
+}
+
+func ExportLsmCaps(ctx sdk.Context, k *keeper.Keeper) []types.LsmCapsForZone {
+	lsmCaps := make([]types.LsmCapsForZone, 0)
+	k.IterateLsmCaps(ctx, func(_ int64, chainID string, caps types.LsmCaps) (stop bool) {
+		lsmCaps = append(lsmCaps, types.LsmCapsForZone{ChainId: chainID, Caps: caps})
+		return false
+	})
+	return lsmCaps
+}
+
+func ExportSlashRecords(ctx sdk.Context, k *keeper.Keeper) []types.SlashRecord {
+	records := make([]types.SlashRecord, 0)
+	k.IterateZones(ctx, func(_ int64, zone *types.Zone) (stop bool) {
+		records = append(records, k.AllZoneSlashRecords(ctx, zone.ChainId)...)
+		return false
+	})
+	return records
+}
+
+func ExportRewardSwapConfigs(ctx sdk.Context, k *keeper.Keeper) []types.RewardSwapConfig {
+	configs := make([]types.RewardSwapConfig, 0)
+	k.IterateZones(ctx, func(_ int64, zone *types.Zone) (stop bool) {
+		if config, found := k.GetRewardSwapConfig(ctx, zone.ChainId); found {
+			configs = append(configs, config)
+		}
+		return false
+	})
+	return configs
+}
+
+func ExportSwapPools(ctx sdk.Context, k *keeper.Keeper) []types.SwapPool {
+	pools := make([]types.SwapPool, 0)
+	k.IterateZones(ctx, func(_ int64, zone *types.Zone) (stop bool) {
+		pools = append(pools, k.AllZoneSwapPools(ctx, zone.ChainId)...)
+		return false
+	})
+	return pools
+}
+
+func ExportZoneSnapshots(ctx sdk.Context, k *keeper.Keeper) []types.ZoneSnapshot {
+	snapshots := make([]types.ZoneSnapshot, 0)
+	k.IterateZones(ctx, func(_ int64, zone *types.Zone) (stop bool) {
+		snapshots = append(snapshots, k.AllZoneSnapshots(ctx, zone.ChainId)...)
+		return false
+	})
+	return snapshots
+}
+
+func ExportEpochCaps(ctx sdk.Context, k *keeper.Keeper) []types.EpochCapsForZone {
+	epochCaps := make([]types.EpochCapsForZone, 0)
+	k.IterateEpochCaps(ctx, func(_ int64, chainID string, caps types.EpochCaps) (stop bool) {
+		epochCaps = append(epochCaps, types.EpochCapsForZone{ChainId: chainID, Caps: caps})
+		return false
+	})
+	return epochCaps
+}
+
+func ExportEpochCapUsages(ctx sdk.Context, k *keeper.Keeper) []types.EpochCapUsage {
+	usages := make([]types.EpochCapUsage, 0)
+	k.IterateEpochCapUsages(ctx, "", func(_ int64, usage types.EpochCapUsage) (stop bool) {
+		usages = append(usages, usage)
+		return false
+	})
+	return usages
+}
@@ -92,11 +92,12 @@ func (im IBCModule) OnChanCloseInit(
 }
 
 // OnChanCloseConfirm implements the IBCModule interface.
-func (IBCModule) OnChanCloseConfirm(
-	_ sdk.Context,
-	_,
-	_ string,
+func (im IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
 ) error {
+	im.keeper.HandleChannelClose(ctx, portID, channelID)
 	return nil
 }
 
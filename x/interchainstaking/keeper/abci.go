@@ -29,6 +29,8 @@ func (k *Keeper) BeginBlocker(ctx sdk.Context) {
 		}
 	}
 	k.IterateZones(ctx, func(index int64, zone *types.Zone) (stop bool) {
+		k.SetZoneGauges(ctx, zone)
+
 		if ctx.BlockHeight()%30 == 0 {
 			// for the tasks below, we cannot panic in begin blocker; as this will crash the chain.
 			// and as failing here is not terminal panicking is not necessary, but we should log
@@ -84,3 +86,16 @@ func (k *Keeper) BeginBlocker(ctx sdk.Context) {
 		return false
 	})
 }
+
+// EndBlocker of interchainstaking module.
+func (k *Keeper) EndBlocker(ctx sdk.Context) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if ctx.BlockHeight()%blockInterval == 0 {
+		k.SetPendingQueryGauges(ctx)
+		k.IterateZones(ctx, func(_ int64, zone *types.Zone) (stop bool) {
+			k.SetZoneRecordGauges(ctx, zone)
+			return false
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
@@ -40,6 +42,30 @@ func (k Keeper) IterateUserMappedAccounts(ctx sdk.Context, localAddress []byte,
 	}
 }
 
+// IterateZoneMappedAccounts iterates over the user mapped accounts of the given zone.
+func (k Keeper) IterateZoneMappedAccounts(ctx sdk.Context, chainID string, fn func(index int64, localAddress, remoteAddress []byte) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	zonePrefix := append(append([]byte{}, types.KeyPrefixLocalAddress...), []byte(chainID)...)
+	iterator := sdk.KVStorePrefixIterator(store, zonePrefix)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		localAddress := iterator.Value()
+		remoteAddress := iterator.Key()[len(zonePrefix):]
+		// chain ids are not length prefixed in the key, so check the forward map to guard
+		// against one chain id being a prefix of another.
+		if forward := store.Get(types.GetRemoteAddressKey(localAddress, chainID)); !bytes.Equal(forward, remoteAddress) {
+			continue
+		}
+		stop := fn(i, localAddress, remoteAddress)
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
 // SetRemoteAddressMap sets a remote address using a local address as a map.
 func (k *Keeper) SetRemoteAddressMap(ctx sdk.Context, localAddress, remoteAddress []byte, chainID string) {
 	store := ctx.KVStore(k.storeKey)
@@ -27,6 +27,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
 	tmclienttypes "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
 
+	"github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types/gamm"
 	"github.com/quicksilver-zone/quicksilver/utils"
 	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	icqtypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
@@ -84,7 +85,9 @@ func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 		AddCallback("allbalances", Callback(AllBalancesCallback)).
 		AddCallback("delegationaccountbalance", Callback(DelegationAccountBalanceCallback)).
 		AddCallback("delegationaccountbalances", Callback(DelegationAccountBalancesCallback)).
-		AddCallback("signinginfo", Callback(SigningInfoCallback))
+		AddCallback("signinginfo", Callback(SigningInfoCallback)).
+		AddCallback("unbondingdelegation", Callback(UnbondingDelegationCallback)).
+		AddCallback("swappool", Callback(SwapPoolCallback))
 
 	return a.(Callbacks)
 }
@@ -222,7 +225,39 @@ func delegationCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes.
 		return err
 	}
 
-	return k.UpdateDelegationRecordForAddress(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress, sdk.NewCoin(zone.BaseDenom, val.SharesToTokens(delegation.Shares)), &zone, true, isEpoch)
+	amount := sdk.NewCoin(zone.BaseDenom, val.SharesToTokens(delegation.Shares))
+	if zone.DelegationAddress != nil && delegation.DelegatorAddress == zone.DelegationAddress.Address {
+		// reconcile any outstanding slash against the proven amount, before the record is overwritten.
+		if err := k.ReconcileSlashRecord(ctx, &zone, delegation.ValidatorAddress, amount); err != nil {
+			return err
+		}
+	}
+
+	return k.UpdateDelegationRecordForAddress(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress, amount, &zone, true, isEpoch)
+}
+
+func UnbondingDelegationCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	zone, found := k.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	if len(args) == 0 {
+		// unbonding may already have matured.
+		k.Logger(ctx).Info("unbonding delegation not found", "chain", zone.ChainId, "query", query.Request)
+		return nil
+	}
+
+	ubd := stakingtypes.UnbondingDelegation{}
+	err := k.cdc.Unmarshal(args, &ubd)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Debug("Unbonding delegation callback", "unbonding_delegation", ubd, "chain", zone.ChainId)
+
+	k.SetUnbondingRecordCreationHeights(ctx, &zone, ubd)
+	return nil
 }
 
 func PerfBalanceCallback(k *Keeper, ctx sdk.Context, response []byte, query icqtypes.Query) error {
@@ -255,6 +290,15 @@ func DepositIntervalCallback(k *Keeper, ctx sdk.Context, args []byte, query icqt
 		return fmt.Errorf("chain id %s does not current allow deposits", query.GetChainId())
 	}
 
+	if k.IsCircuitBreakerTripped(ctx, zone.ChainId, types.CircuitBreakerActionDeposits) {
+		return fmt.Errorf("deposits are paused for chain id %s", zone.ChainId)
+	}
+
+	// receipts mint qAssets before the deposit is sent for delegation; do not handle them while that cannot be sent.
+	if k.IsCircuitBreakerTripped(ctx, zone.ChainId, types.CircuitBreakerActionICA) {
+		return fmt.Errorf("ica submission is paused for chain id %s", zone.ChainId)
+	}
+
 	k.Logger(ctx).Debug("Deposit interval callback", "zone", zone.ChainId)
 
 	if len(args) == 0 {
@@ -505,6 +549,15 @@ func DepositTxCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes.Q
 		return fmt.Errorf("chain id %s does not current allow deposits", query.GetChainId())
 	}
 
+	if k.IsCircuitBreakerTripped(ctx, zone.ChainId, types.CircuitBreakerActionDeposits) {
+		return fmt.Errorf("deposits are paused for chain id %s", zone.ChainId)
+	}
+
+	// receipts mint qAssets before the deposit is sent for delegation; do not handle them while that cannot be sent.
+	if k.IsCircuitBreakerTripped(ctx, zone.ChainId, types.CircuitBreakerActionICA) {
+		return fmt.Errorf("ica submission is paused for chain id %s", zone.ChainId)
+	}
+
 	k.Logger(ctx).Debug("DepositTx callback", "zone", zone.ChainId)
 
 	res := icqtypes.GetTxWithProofResponse{}
@@ -875,3 +928,54 @@ func VarintMinLength(n uint64) int {
 		return 10
 	}
 }
+
+// SwapPoolCallback caches the state of a host chain pool used by the zone's reward swap routes.
+func SwapPoolCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	zone, found := k.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	// check query.Request is the expected length (0x02 + 8 bytes for uint64).
+	if len(query.Request) != 9 {
+		return errors.New("query request not sufficient length")
+	}
+	if query.Request[0] != gamm.KeyPrefixPools[0] {
+		return errors.New("query request has unexpected prefix")
+	}
+	poolID := sdk.BigEndianToUint64(query.Request[1:])
+
+	config, found := k.GetRewardSwapConfig(ctx, zone.ChainId)
+	if !found {
+		k.Logger(ctx).Info("received pool state for zone without reward swap routes; ignoring", "chain_id", zone.ChainId, "pool_id", poolID)
+		return nil
+	}
+	inUse := false
+	for _, id := range config.PoolIDs() {
+		if id == poolID {
+			inUse = true
+			break
+		}
+	}
+	if !inUse {
+		k.Logger(ctx).Info("received state for pool not used by reward swap routes; ignoring", "chain_id", zone.ChainId, "pool_id", poolID)
+		return nil
+	}
+
+	var pool gamm.PoolI
+	if err := k.cdc.UnmarshalInterface(args, &pool); err != nil {
+		return err
+	}
+	if pool.GetId() != poolID {
+		return fmt.Errorf("unexpected pool id: expected %d, got %d", poolID, pool.GetId())
+	}
+
+	k.SetSwapPool(ctx, types.SwapPool{
+		ChainId:     zone.ChainId,
+		PoolId:      poolID,
+		Pool:        args,
+		LastUpdated: ctx.BlockTime(),
+	})
+
+	return nil
+}
@@ -1784,6 +1784,64 @@ func TestDelegationCallbackRemove(t *testing.T) {
 	suite.Equal(3, len(quicksilver.InterchainstakingKeeper.GetAllDelegations(ctx, zone.ChainId)))
 }
 
+func TestUnbondingDelegationCallback(t *testing.T) {
+	suite := new(KeeperTestSuite)
+	suite.SetT(t)
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	quicksilver.InterchainstakingKeeper.CallbackHandler().RegisterCallbacks()
+	ctx := suite.chainA.GetContext()
+	cdc := quicksilver.IBCKeeper.Codec()
+
+	zone, found := quicksilver.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	vals := quicksilver.InterchainstakingKeeper.GetValidatorAddresses(ctx, zone.ChainId)
+	completion := ctx.BlockTime().Add(21 * 24 * time.Hour).UTC()
+
+	quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, icstypes.UnbondingRecord{ChainId: zone.ChainId, EpochNumber: 1, Validator: vals[0], RelatedTxhash: []string{"abc"}, Amount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000)), CompletionTime: completion})
+	quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, icstypes.UnbondingRecord{ChainId: zone.ChainId, EpochNumber: 2, Validator: vals[0], RelatedTxhash: []string{"def"}, Amount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000)), CompletionTime: completion.Add(time.Hour)})
+	quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, icstypes.UnbondingRecord{ChainId: zone.ChainId, EpochNumber: 1, Validator: vals[1], RelatedTxhash: []string{"abc"}, Amount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000)), CompletionTime: completion})
+
+	response := stakingtypes.UnbondingDelegation{
+		DelegatorAddress: zone.DelegationAddress.Address,
+		ValidatorAddress: vals[0],
+		Entries: []stakingtypes.UnbondingDelegationEntry{
+			{CreationHeight: 100, CompletionTime: completion, InitialBalance: sdk.NewInt(1000), Balance: sdk.NewInt(1000)},
+		},
+	}
+	data := cdc.MustMarshal(&response)
+
+	delAddr, err := addressutils.AccAddressFromBech32(zone.DelegationAddress.Address, "")
+	suite.NoError(err)
+	valAddr, err := addressutils.ValAddressFromBech32(vals[0], "")
+	suite.NoError(err)
+	bz := stakingtypes.GetUBDKey(delAddr, valAddr)
+
+	err = keeper.UnbondingDelegationCallback(quicksilver.InterchainstakingKeeper, ctx, data, icqtypes.Query{ChainId: suite.chainB.ChainID, Request: bz})
+	suite.NoError(err)
+
+	record, found := quicksilver.InterchainstakingKeeper.GetUnbondingRecord(ctx, zone.ChainId, vals[0], 1)
+	suite.True(found)
+	suite.Equal(int64(100), record.CreationHeight)
+
+	// completion time does not match the entry.
+	record, found = quicksilver.InterchainstakingKeeper.GetUnbondingRecord(ctx, zone.ChainId, vals[0], 2)
+	suite.True(found)
+	suite.Equal(int64(0), record.CreationHeight)
+
+	// different validator.
+	record, found = quicksilver.InterchainstakingKeeper.GetUnbondingRecord(ctx, zone.ChainId, vals[1], 1)
+	suite.True(found)
+	suite.Equal(int64(0), record.CreationHeight)
+
+	// matured unbondings return an empty response.
+	err = keeper.UnbondingDelegationCallback(quicksilver.InterchainstakingKeeper, ctx, []byte{}, icqtypes.Query{ChainId: suite.chainB.ChainID, Request: bz})
+	suite.NoError(err)
+}
+
 func TestDepositIntervalCallback(t *testing.T) {
 	suite := new(KeeperTestSuite)
 	suite.SetT(t)
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// GetCircuitBreaker returns the circuit breaker record for the given zone.
+func (k *Keeper) GetCircuitBreaker(ctx sdk.Context, chainID string) (types.CircuitBreaker, bool) {
+	cb := types.CircuitBreaker{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCircuitBreaker)
+	bz := store.Get([]byte(chainID))
+	if len(bz) == 0 {
+		return cb, false
+	}
+	k.cdc.MustUnmarshal(bz, &cb)
+	return cb, true
+}
+
+// SetCircuitBreaker stores the circuit breaker record for a zone.
+func (k *Keeper) SetCircuitBreaker(ctx sdk.Context, cb types.CircuitBreaker) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCircuitBreaker)
+	bz := k.cdc.MustMarshal(&cb)
+	store.Set([]byte(cb.ChainId), bz)
+}
+
+// DeleteCircuitBreaker deletes the circuit breaker record for a zone.
+func (k *Keeper) DeleteCircuitBreaker(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCircuitBreaker)
+	store.Delete([]byte(chainID))
+}
+
+// IterateCircuitBreakers iterates through the circuit breaker records of all zones.
+func (k *Keeper) IterateCircuitBreakers(ctx sdk.Context, fn func(index int64, cb types.CircuitBreaker) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCircuitBreaker)
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		cb := types.CircuitBreaker{}
+		k.cdc.MustUnmarshal(iterator.Value(), &cb)
+
+		stop := fn(i, cb)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllCircuitBreakers returns every circuit breaker record in the store.
+func (k *Keeper) AllCircuitBreakers(ctx sdk.Context) []types.CircuitBreaker {
+	cbs := []types.CircuitBreaker{}
+	k.IterateCircuitBreakers(ctx, func(_ int64, cb types.CircuitBreaker) (stop bool) {
+		cbs = append(cbs, cb)
+		return false
+	})
+	return cbs
+}
+
+// GetEmergencyAuthority returns the address permitted to trip circuit breakers
+// in addition to governance, or an empty string if none is set.
+func (k *Keeper) GetEmergencyAuthority(ctx sdk.Context) string {
+	return string(ctx.KVStore(k.storeKey).Get(types.KeyEmergencyAuthority))
+}
+
+// SetEmergencyAuthority sets the emergency authority. An empty address removes it.
+func (k *Keeper) SetEmergencyAuthority(ctx sdk.Context, address string) {
+	store := ctx.KVStore(k.storeKey)
+	if address == "" {
+		store.Delete(types.KeyEmergencyAuthority)
+		return
+	}
+	store.Set(types.KeyEmergencyAuthority, []byte(address))
+}
+
+// IsCircuitBreakerTripped returns true if the given action is paused for the zone.
+func (k *Keeper) IsCircuitBreakerTripped(ctx sdk.Context, chainID string, action types.CircuitBreakerAction) bool {
+	cb, found := k.GetCircuitBreaker(ctx, chainID)
+	if !found {
+		return false
+	}
+	return cb.IsTripped(action)
+}
+
+// TripCircuitBreaker pauses the given actions for a zone, recording the reason
+// and tripping party. If actions is empty, every action is paused. Actions that
+// are already paused retain their original trip record.
+func (k *Keeper) TripCircuitBreaker(ctx sdk.Context, chainID string, actions []types.CircuitBreakerAction, reason, trippedBy string) {
+	if len(actions) == 0 {
+		actions = types.AllCircuitBreakerActions()
+	}
+
+	cb, found := k.GetCircuitBreaker(ctx, chainID)
+	if !found {
+		cb = types.CircuitBreaker{ChainId: chainID}
+	}
+
+	events := sdk.Events{}
+	for _, action := range actions {
+		if cb.IsTripped(action) {
+			continue
+		}
+		cb.Trips = append(cb.Trips, types.CircuitBreakerTrip{
+			Action:    action,
+			Reason:    reason,
+			TrippedBy: trippedBy,
+			Height:    ctx.BlockHeight(),
+			Time:      ctx.BlockTime(),
+		})
+		k.Logger(ctx).Error("circuit breaker tripped", "chain_id", chainID, "action", action.ShortName(), "reason", reason, "tripped_by", trippedBy)
+		events = append(events, sdk.NewEvent(
+			types.EventTypeCircuitBreakerTrip,
+			sdk.NewAttribute(types.AttributeKeyChainID, chainID),
+			sdk.NewAttribute(types.AttributeKeyAction, action.ShortName()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyTrippedBy, trippedBy),
+		))
+	}
+
+	if len(events) == 0 {
+		return
+	}
+
+	k.SetCircuitBreaker(ctx, cb)
+	ctx.EventManager().EmitEvents(events)
+}
+
+// ResetCircuitBreaker resumes the given actions for a zone. If actions is
+// empty, every paused action is resumed.
+func (k *Keeper) ResetCircuitBreaker(ctx sdk.Context, chainID string, actions []types.CircuitBreakerAction) error {
+	cb, found := k.GetCircuitBreaker(ctx, chainID)
+	if !found {
+		return fmt.Errorf("no circuit breaker tripped for zone %s", chainID)
+	}
+
+	if len(actions) == 0 {
+		actions = types.AllCircuitBreakerActions()
+	}
+
+	reset := make(map[types.CircuitBreakerAction]bool, len(actions))
+	for _, action := range actions {
+		reset[action] = true
+	}
+
+	events := sdk.Events{}
+	trips := make([]types.CircuitBreakerTrip, 0, len(cb.Trips))
+	for _, trip := range cb.Trips {
+		if !reset[trip.Action] {
+			trips = append(trips, trip)
+			continue
+		}
+		k.Logger(ctx).Info("circuit breaker reset", "chain_id", chainID, "action", trip.Action.ShortName())
+		events = append(events, sdk.NewEvent(
+			types.EventTypeCircuitBreakerReset,
+			sdk.NewAttribute(types.AttributeKeyChainID, chainID),
+			sdk.NewAttribute(types.AttributeKeyAction, trip.Action.ShortName()),
+		))
+	}
+
+	if len(events) == 0 {
+		return fmt.Errorf("none of the requested actions are tripped for zone %s", chainID)
+	}
+
+	if len(trips) == 0 {
+		k.DeleteCircuitBreaker(ctx, chainID)
+	} else {
+		cb.Trips = trips
+		k.SetCircuitBreaker(ctx, cb)
+	}
+
+	ctx.EventManager().EmitEvents(events)
+	return nil
+}
+
+// tripRedemptionRateCircuitBreaker pauses deposits and redemptions for a zone
+// whose redemption rate moved beyond the permitted bounds in a single epoch.
+func (k *Keeper) tripRedemptionRateCircuitBreaker(ctx sdk.Context, zone *types.Zone, ratio sdk.Dec) {
+	k.TripCircuitBreaker(
+		ctx,
+		zone.ChainId,
+		[]types.CircuitBreakerAction{types.CircuitBreakerActionDeposits, types.CircuitBreakerActionRedemptions},
+		fmt.Sprintf("redemption rate moved out of bounds: current %s, calculated %s", zone.RedemptionRate, ratio),
+		types.CircuitBreakerAutoTrip,
+	)
+}
@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	icskeeper "github.com/quicksilver-zone/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+func (suite *KeeperTestSuite) TestCircuitBreakerTripAndReset() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+	chainID := suite.chainB.ChainID
+
+	_, found := icsKeeper.GetCircuitBreaker(ctx, chainID)
+	suite.False(found)
+	suite.Empty(icsKeeper.AllCircuitBreakers(ctx))
+
+	icsKeeper.TripCircuitBreaker(ctx, chainID, []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionDeposits}, "first", testAddress)
+	icsKeeper.TripCircuitBreaker(ctx, chainID, []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionDeposits, icstypes.CircuitBreakerActionRebalance}, "second", icstypes.CircuitBreakerAutoTrip)
+
+	cb, found := icsKeeper.GetCircuitBreaker(ctx, chainID)
+	suite.True(found)
+	suite.Len(cb.Trips, 2)
+
+	// the original trip record is retained for an already tripped action.
+	trip, found := cb.GetTrip(icstypes.CircuitBreakerActionDeposits)
+	suite.True(found)
+	suite.Equal("first", trip.Reason)
+	suite.Equal(testAddress, trip.TrippedBy)
+	suite.Equal(ctx.BlockHeight(), trip.Height)
+
+	trip, found = cb.GetTrip(icstypes.CircuitBreakerActionRebalance)
+	suite.True(found)
+	suite.Equal("second", trip.Reason)
+	suite.Equal(icstypes.CircuitBreakerAutoTrip, trip.TrippedBy)
+
+	suite.True(icsKeeper.IsCircuitBreakerTripped(ctx, chainID, icstypes.CircuitBreakerActionDeposits))
+	suite.False(icsKeeper.IsCircuitBreakerTripped(ctx, chainID, icstypes.CircuitBreakerActionRedemptions))
+	suite.False(icsKeeper.IsCircuitBreakerTripped(ctx, "unknown-1", icstypes.CircuitBreakerActionDeposits))
+	suite.Len(icsKeeper.AllCircuitBreakers(ctx), 1)
+
+	// resetting an action that is not tripped fails.
+	err := icsKeeper.ResetCircuitBreaker(ctx, chainID, []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionICA})
+	suite.Error(err)
+
+	suite.NoError(icsKeeper.ResetCircuitBreaker(ctx, chainID, []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionDeposits}))
+	suite.False(icsKeeper.IsCircuitBreakerTripped(ctx, chainID, icstypes.CircuitBreakerActionDeposits))
+	suite.True(icsKeeper.IsCircuitBreakerTripped(ctx, chainID, icstypes.CircuitBreakerActionRebalance))
+
+	// an empty action list resets everything and removes the record.
+	suite.NoError(icsKeeper.ResetCircuitBreaker(ctx, chainID, nil))
+	_, found = icsKeeper.GetCircuitBreaker(ctx, chainID)
+	suite.False(found)
+
+	suite.Error(icsKeeper.ResetCircuitBreaker(ctx, chainID, nil))
+
+	// an empty action list trips everything.
+	icsKeeper.TripCircuitBreaker(ctx, chainID, nil, "all", testAddress)
+	cb, found = icsKeeper.GetCircuitBreaker(ctx, chainID)
+	suite.True(found)
+	suite.Len(cb.Trips, len(icstypes.AllCircuitBreakerActions()))
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreakerEmergencyAuthority() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	suite.Equal("", icsKeeper.GetEmergencyAuthority(ctx))
+	icsKeeper.SetEmergencyAuthority(ctx, testAddress)
+	suite.Equal(testAddress, icsKeeper.GetEmergencyAuthority(ctx))
+	icsKeeper.SetEmergencyAuthority(ctx, "")
+	suite.Equal("", icsKeeper.GetEmergencyAuthority(ctx))
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreakerBlocksRedemptions() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	params := icsKeeper.GetParams(ctx)
+	params.UnbondingEnabled = true
+	icsKeeper.SetParams(ctx, params)
+	zone.UnbondingEnabled = true
+	icsKeeper.SetZone(ctx, &zone)
+
+	user := suite.chainA.SenderAccount.GetAddress()
+	coins := sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(1000)))
+	suite.NoError(quicksilver.MintKeeper.MintCoins(ctx, coins))
+	suite.NoError(quicksilver.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", user, coins))
+
+	icsKeeper.TripCircuitBreaker(ctx, zone.ChainId, []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionRedemptions}, "test", testAddress)
+
+	msg := icstypes.NewMsgRequestRedemption(coins[0], suite.chainB.SenderAccount.GetAddress().String(), user)
+	msgSrv := icskeeper.NewMsgServerImpl(icsKeeper)
+	_, err := msgSrv.RequestRedemption(sdk.WrapSDKContext(ctx), msg)
+	suite.ErrorContains(err, "redemptions are paused")
+
+	suite.NoError(icsKeeper.ResetCircuitBreaker(ctx, zone.ChainId, nil))
+	_, err = msgSrv.RequestRedemption(sdk.WrapSDKContext(ctx), msg)
+	suite.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreakerBlocksICA() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	msgs := []sdk.Msg{&banktypes.MsgSend{FromAddress: zone.DelegationAddress.Address, ToAddress: zone.WithdrawalAddress.Address, Amount: sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1)))}}
+
+	icsKeeper.TripCircuitBreaker(ctx, zone.ChainId, []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionICA}, "test", testAddress)
+	err := icsKeeper.SubmitTx(ctx, msgs, zone.DelegationAddress, "", zone.MessagesPerTx)
+	suite.ErrorContains(err, "ica submission is paused")
+
+	suite.NoError(icsKeeper.ResetCircuitBreaker(ctx, zone.ChainId, nil))
+	suite.NoError(icsKeeper.SubmitTx(ctx, msgs, zone.DelegationAddress, "", zone.MessagesPerTx))
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreakerTripsOnChannelTimeout() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	var icaChannel channeltypes.IdentifiedChannel
+	for _, channel := range quicksilver.IBCKeeper.ChannelKeeper.GetAllChannels(ctx) {
+		if strings.HasSuffix(channel.PortId, "."+icstypes.ICASuffixDelegate) {
+			icaChannel = channel
+			break
+		}
+	}
+	suite.NotEmpty(icaChannel.ChannelId)
+
+	packet := channeltypes.Packet{SourcePort: icaChannel.PortId, SourceChannel: icaChannel.ChannelId}
+	suite.NoError(icsKeeper.HandleTimeout(ctx, packet))
+
+	cb, found := icsKeeper.GetCircuitBreaker(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	trip, found := cb.GetTrip(icstypes.CircuitBreakerActionICA)
+	suite.True(found)
+	suite.Equal(icstypes.CircuitBreakerAutoTrip, trip.TrippedBy)
+	suite.Contains(trip.Reason, icaChannel.ChannelId)
+
+	// unknown channels are logged and ignored.
+	suite.NoError(icsKeeper.HandleTimeout(ctx, channeltypes.Packet{SourcePort: "unknown", SourceChannel: "channel-99"}))
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreakerICALeavesRedemptionsQueued() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	for _, val := range icsKeeper.GetValidators(ctx, zone.ChainId) {
+		icsKeeper.SetDelegation(ctx, zone.ChainId, icstypes.NewDelegation(zone.DelegationAddress.Address, val.ValoperAddress, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))))
+	}
+
+	record := icstypes.WithdrawalRecord{
+		ChainId:     zone.ChainId,
+		Delegator:   testAddress,
+		Recipient:   zone.DelegationAddress.Address,
+		Amount:      sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(500))),
+		BurnAmount:  sdk.NewCoin(zone.LocalDenom, sdk.NewInt(500)),
+		Txhash:      "aaaa",
+		Status:      icstypes.WithdrawStatusQueued,
+		EpochNumber: 1,
+	}
+	icsKeeper.SetWithdrawalRecord(ctx, record)
+
+	icsKeeper.TripCircuitBreaker(ctx, zone.ChainId, []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionICA}, "test", testAddress)
+	suite.NoError(icsKeeper.AfterEpochEnd(ctx, "epoch", 2))
+
+	// the record is neither unbonded nor counted against the epoch redemption cap.
+	_, found = icsKeeper.GetWithdrawalRecord(ctx, zone.ChainId, record.Txhash, icstypes.WithdrawStatusQueued)
+	suite.True(found)
+	_, found = icsKeeper.GetWithdrawalRecord(ctx, zone.ChainId, record.Txhash, icstypes.WithdrawStatusUnbond)
+	suite.False(found)
+
+	suite.NoError(icsKeeper.ResetCircuitBreaker(ctx, zone.ChainId, nil))
+	suite.NoError(icsKeeper.AfterEpochEnd(ctx, "epoch", 3))
+
+	_, found = icsKeeper.GetWithdrawalRecord(ctx, zone.ChainId, record.Txhash, icstypes.WithdrawStatusQueued)
+	suite.False(found)
+	_, found = icsKeeper.GetWithdrawalRecord(ctx, zone.ChainId, record.Txhash, icstypes.WithdrawStatusUnbond)
+	suite.True(found)
+}
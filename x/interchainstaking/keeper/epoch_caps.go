@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	epochstypes "github.com/quicksilver-zone/quicksilver/x/epochs/types"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// GetEpochCaps returns the per-epoch caps for the given zone.
+func (k *Keeper) GetEpochCaps(ctx sdk.Context, chainID string) (types.EpochCaps, bool) {
+	caps := types.EpochCaps{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochCaps)
+	bz := store.Get([]byte(chainID))
+	if len(bz) == 0 {
+		return caps, false
+	}
+	k.cdc.MustUnmarshal(bz, &caps)
+	return caps, true
+}
+
+// SetEpochCaps stores the per-epoch caps for a zone.
+func (k *Keeper) SetEpochCaps(ctx sdk.Context, chainID string, caps types.EpochCaps) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochCaps)
+	bz := k.cdc.MustMarshal(&caps)
+	store.Set([]byte(chainID), bz)
+}
+
+// DeleteEpochCaps deletes the per-epoch caps for a zone.
+func (k *Keeper) DeleteEpochCaps(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochCaps)
+	store.Delete([]byte(chainID))
+}
+
+// IterateEpochCaps iterates through the per-epoch caps of every zone.
+func (k *Keeper) IterateEpochCaps(ctx sdk.Context, fn func(index int64, chainID string, caps types.EpochCaps) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochCaps)
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		caps := types.EpochCaps{}
+		k.cdc.MustUnmarshal(iterator.Value(), &caps)
+
+		stop := fn(i, string(iterator.Key()), caps)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// GetEpochCapUsage returns the usage of the given zone's epoch caps in the current epoch by the given address, or by
+// the zone as a whole if address is empty. Usage recorded in a previous epoch is disregarded.
+func (k *Keeper) GetEpochCapUsage(ctx sdk.Context, chainID, address string) types.EpochCapUsage {
+	epochNumber := k.EpochsKeeper.GetEpochInfo(ctx, epochstypes.EpochIdentifierEpoch).CurrentEpoch
+
+	usage := types.EpochCapUsage{}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetEpochCapUsageKey(chainID, address))
+	if len(bz) == 0 {
+		return types.NewEpochCapUsage(chainID, address, epochNumber)
+	}
+	k.cdc.MustUnmarshal(bz, &usage)
+	if usage.EpochNumber != epochNumber {
+		return types.NewEpochCapUsage(chainID, address, epochNumber)
+	}
+	return usage
+}
+
+// SetEpochCapUsage stores the usage of a zone's epoch caps.
+func (k *Keeper) SetEpochCapUsage(ctx sdk.Context, usage types.EpochCapUsage) {
+	bz := k.cdc.MustMarshal(&usage)
+	ctx.KVStore(k.storeKey).Set(types.GetEpochCapUsageKey(usage.ChainId, usage.Address), bz)
+}
+
+// DeleteEpochCapUsage deletes the usage of a zone's epoch caps by the given address.
+func (k *Keeper) DeleteEpochCapUsage(ctx sdk.Context, chainID, address string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetEpochCapUsageKey(chainID, address))
+}
+
+// IterateEpochCapUsages iterates through the stored usage of epoch caps of the given zone, or of every zone if chainID
+// is empty.
+func (k *Keeper) IterateEpochCapUsages(ctx sdk.Context, chainID string, fn func(index int64, usage types.EpochCapUsage) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetZoneEpochCapUsagesKey(chainID))
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		usage := types.EpochCapUsage{}
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+
+		// chain ids are not length prefixed in the key.
+		if chainID != "" && usage.ChainId != chainID {
+			continue
+		}
+
+		stop := fn(i, usage)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// PruneEpochCapUsages deletes the usage of the given zone's epoch caps recorded before the given epoch.
+func (k *Keeper) PruneEpochCapUsages(ctx sdk.Context, chainID string, epochNumber int64) {
+	stale := make([]types.EpochCapUsage, 0)
+	k.IterateEpochCapUsages(ctx, chainID, func(_ int64, usage types.EpochCapUsage) (stop bool) {
+		if usage.EpochNumber < epochNumber {
+			stale = append(stale, usage)
+		}
+		return false
+	})
+	for _, usage := range stale {
+		k.DeleteEpochCapUsage(ctx, usage.ChainId, usage.Address)
+	}
+}
+
+// DeleteZoneEpochCaps deletes the epoch caps of the given zone, and their usage.
+func (k *Keeper) DeleteZoneEpochCaps(ctx sdk.Context, chainID string) {
+	k.DeleteEpochCaps(ctx, chainID)
+	usages := make([]types.EpochCapUsage, 0)
+	k.IterateEpochCapUsages(ctx, chainID, func(_ int64, usage types.EpochCapUsage) (stop bool) {
+		usages = append(usages, usage)
+		return false
+	})
+	for _, usage := range usages {
+		k.DeleteEpochCapUsage(ctx, usage.ChainId, usage.Address)
+	}
+}
+
+// depositMintAmount returns the amount of qAssets minted for the given deposited assets at the zone's redemption rate.
+func depositMintAmount(zone *types.Zone, assets sdk.Coins) sdkmath.Int {
+	amount := sdk.ZeroInt()
+	if zone.RedemptionRate.IsZero() {
+		return amount
+	}
+	for _, asset := range assets {
+		amount = amount.Add(sdk.NewDecFromInt(asset.Amount).Quo(zone.RedemptionRate).TruncateInt())
+	}
+	return amount
+}
+
+// CheckEpochDepositCaps returns an error if minting qAssets for the given deposit by address would exceed the zone's
+// deposit cap, or the address cap, for the current epoch.
+func (k *Keeper) CheckEpochDepositCaps(ctx sdk.Context, zone *types.Zone, address string, assets sdk.Coins) error {
+	caps, found := k.GetEpochCaps(ctx, zone.ChainId)
+	if !found {
+		return nil
+	}
+
+	amount := depositMintAmount(zone, assets)
+
+	if remaining, capped := k.GetEpochCapUsage(ctx, zone.ChainId, "").RemainingDeposit(caps.DepositCap); capped && amount.GT(remaining) {
+		return fmt.Errorf("deposit of %s%s exceeds remaining epoch deposit cap of %s%s", amount, zone.LocalDenom, remaining, zone.LocalDenom)
+	}
+
+	if remaining, capped := k.GetEpochCapUsage(ctx, zone.ChainId, address).RemainingDeposit(caps.AddressCap); capped && amount.GT(remaining) {
+		return fmt.Errorf("deposit of %s%s exceeds remaining epoch address cap of %s%s", amount, zone.LocalDenom, remaining, zone.LocalDenom)
+	}
+
+	return nil
+}
+
+// RecordEpochDeposit adds the qAssets minted for a deposit by address to the usage of the zone's epoch caps. Usage is
+// only recorded for zones with caps; usage by address only if the address cap is set.
+func (k *Keeper) RecordEpochDeposit(ctx sdk.Context, zone *types.Zone, address string, amount sdkmath.Int) {
+	caps, found := k.GetEpochCaps(ctx, zone.ChainId)
+	if !found {
+		return
+	}
+
+	usage := k.GetEpochCapUsage(ctx, zone.ChainId, "")
+	usage.Deposited = usage.Deposited.Add(amount)
+	k.SetEpochCapUsage(ctx, usage)
+
+	if !caps.AddressCap.IsZero() {
+		usage := k.GetEpochCapUsage(ctx, zone.ChainId, address)
+		usage.Deposited = usage.Deposited.Add(amount)
+		k.SetEpochCapUsage(ctx, usage)
+	}
+}
+
+// RecordEpochRedemption adds the qAssets burned for a redemption by address to the usage of the zone's epoch caps.
+// Usage is only recorded for zones with caps; usage by address only if the address cap is set.
+func (k *Keeper) RecordEpochRedemption(ctx sdk.Context, zone *types.Zone, address string, amount sdkmath.Int) {
+	caps, found := k.GetEpochCaps(ctx, zone.ChainId)
+	if !found {
+		return
+	}
+
+	usage := k.GetEpochCapUsage(ctx, zone.ChainId, "")
+	usage.Redeemed = usage.Redeemed.Add(amount)
+	k.SetEpochCapUsage(ctx, usage)
+
+	if !caps.AddressCap.IsZero() {
+		usage := k.GetEpochCapUsage(ctx, zone.ChainId, address)
+		usage.Redeemed = usage.Redeemed.Add(amount)
+		k.SetEpochCapUsage(ctx, usage)
+	}
+}
+
+// refundDeposit returns deposited assets to their sender on the host chain, and emits an event recording why.
+func (k *Keeper) refundDeposit(ctx sdk.Context, zone *types.Zone, senderAddress, hash string, assets sdk.Coins, reason string) error {
+	msg := &banktypes.MsgSend{FromAddress: zone.DepositAddress.GetAddress(), ToAddress: senderAddress, Amount: assets}
+	if err := k.SubmitTx(ctx, []sdk.Msg{msg}, zone.DepositAddress, "refund", zone.MessagesPerTx); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventDepositRefunded{
+		ChainId:     zone.ChainId,
+		Sender:      senderAddress,
+		ReceiptHash: hash,
+		Amount:      assets,
+		Reason:      reason,
+	})
+}
+
+// redemptionAllowance tracks the epoch redemption caps remaining to a zone, and to each address, as queued
+// withdrawals are unbonded at the end of an epoch.
+type redemptionAllowance struct {
+	caps      types.EpochCaps
+	zone      types.EpochCapUsage
+	addresses map[string]types.EpochCapUsage
+}
+
+// newRedemptionAllowance returns the redemption allowance of the given zone for the current epoch, or nil if
+// redemptions from the zone are not capped. Zones being wound down are not capped, so that holders are settled
+// promptly.
+func (k *Keeper) newRedemptionAllowance(ctx sdk.Context, zone *types.Zone) *redemptionAllowance {
+	caps, found := k.GetEpochCaps(ctx, zone.ChainId)
+	if !found || (caps.RedemptionCap.IsZero() && caps.AddressCap.IsZero()) || k.IsZoneWindingDown(ctx, zone.ChainId) {
+		return nil
+	}
+	return &redemptionAllowance{
+		caps:      caps,
+		zone:      k.GetEpochCapUsage(ctx, zone.ChainId, ""),
+		addresses: make(map[string]types.EpochCapUsage),
+	}
+}
+
+// remaining returns the qAssets that address may yet redeem this epoch, whether that amount is capped, and whether
+// the zone's redemption cap is exhausted.
+func (a *redemptionAllowance) remaining(ctx sdk.Context, k *Keeper, chainID, address string) (sdkmath.Int, bool, bool) {
+	remaining, capped := a.zone.RemainingRedemption(a.caps.RedemptionCap)
+	if capped && !remaining.IsPositive() {
+		return remaining, true, true
+	}
+
+	usage, found := a.addresses[address]
+	if !found {
+		usage = k.GetEpochCapUsage(ctx, chainID, address)
+		a.addresses[address] = usage
+	}
+	if addressRemaining, addressCapped := usage.RemainingRedemption(a.caps.AddressCap); addressCapped && (!capped || addressRemaining.LT(remaining)) {
+		return addressRemaining, true, false
+	}
+
+	return remaining, capped, false
+}
+
+// add records amount as redeemed by address.
+func (a *redemptionAllowance) add(address string, amount sdkmath.Int) {
+	a.zone.Redeemed = a.zone.Redeemed.Add(amount)
+	usage := a.addresses[address]
+	usage.Redeemed = usage.Redeemed.Add(amount)
+	a.addresses[address] = usage
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	"github.com/quicksilver-zone/quicksilver/utils/randomutils"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+func (suite *KeeperTestSuite) TestEpochCapsDeposits() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	icsKeeper.SetEpochCaps(ctx, zone.ChainId, types.EpochCaps{DepositCap: math.NewInt(2500000), RedemptionCap: math.ZeroInt(), AddressCap: math.NewInt(1500000)})
+
+	deposit := func(fromAddress string) string {
+		msg := banktypes.MsgSend{FromAddress: fromAddress, ToAddress: zone.DepositAddress.Address, Amount: sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, math.NewInt(1000000)))}
+		anymsg, err := codectypes.NewAnyWithValue(&msg)
+		suite.NoError(err)
+		hash := randomutils.GenerateRandomHashAsHex(64)
+		suite.NoError(icsKeeper.HandleReceiptTransaction(ctx, &tx.Tx{Body: &tx.TxBody{Messages: []*codectypes.Any{anymsg}}}, hash, zone))
+		return hash
+	}
+	supply := func() math.Int {
+		return quicksilver.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount
+	}
+
+	first := addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix)
+	second := addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix)
+
+	// rr is 1.0
+	deposit(first)
+	suite.Equal(math.NewInt(1000000), supply())
+
+	// the second deposit from the same address would exceed the address cap.
+	hash := deposit(first)
+	suite.Equal(math.NewInt(1000000), supply())
+	receipt, found := icsKeeper.GetReceipt(ctx, zone.ChainId, hash)
+	suite.True(found)
+	suite.NotNil(receipt.Completed)
+	refund := suite.lastTypedEvent(ctx, &types.EventDepositRefunded{}).(*types.EventDepositRefunded)
+	suite.Equal(hash, refund.ReceiptHash)
+	suite.Equal(first, refund.Sender)
+	suite.Contains(refund.Reason, "address cap")
+
+	deposit(second)
+	suite.Equal(math.NewInt(2000000), supply())
+
+	// a deposit from a new address would exceed the zone cap.
+	hash = deposit(addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix))
+	suite.Equal(math.NewInt(2000000), supply())
+	refund = suite.lastTypedEvent(ctx, &types.EventDepositRefunded{}).(*types.EventDepositRefunded)
+	suite.Equal(hash, refund.ReceiptHash)
+	suite.Contains(refund.Reason, "deposit cap")
+
+	local, err := addressutils.AccAddressFromBech32(first, zone.AccountPrefix)
+	suite.NoError(err)
+	resp, err := icsKeeper.EpochCaps(ctx, &types.QueryEpochCapsRequest{ChainId: zone.ChainId, Address: local.String()})
+	suite.NoError(err)
+	suite.Equal(math.NewInt(2500000), resp.Caps.DepositCap)
+	suite.Equal(math.NewInt(2000000), resp.Usage.Deposited)
+	suite.Equal(math.NewInt(1000000), resp.AddressUsage.Deposited)
+
+	// usage is reset in the next epoch, and stale usage pruned.
+	epochInfo := quicksilver.EpochsKeeper.GetEpochInfo(ctx, "epoch")
+	epochInfo.CurrentEpoch++
+	quicksilver.EpochsKeeper.SetEpochInfo(ctx, epochInfo)
+	suite.True(icsKeeper.GetEpochCapUsage(ctx, zone.ChainId, "").Deposited.IsZero())
+	icsKeeper.PruneEpochCapUsages(ctx, zone.ChainId, epochInfo.CurrentEpoch)
+	count := 0
+	icsKeeper.IterateEpochCapUsages(ctx, zone.ChainId, func(_ int64, _ types.EpochCapUsage) (stop bool) {
+		count++
+		return false
+	})
+	suite.Equal(0, count)
+}
+
+func (suite *KeeperTestSuite) TestEpochCapsRedemptions() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	zone.RedemptionRate = sdk.OneDec()
+	zone.LastRedemptionRate = sdk.OneDec()
+	icsKeeper.SetZone(ctx, &zone)
+
+	for _, val := range icsKeeper.GetValidators(ctx, zone.ChainId) {
+		icsKeeper.SetDelegation(ctx, zone.ChainId, types.NewDelegation(zone.DelegationAddress.Address, val.ValoperAddress, sdk.NewCoin(zone.BaseDenom, math.NewInt(1000))))
+	}
+
+	icsKeeper.SetEpochCaps(ctx, zone.ChainId, types.EpochCaps{DepositCap: math.ZeroInt(), RedemptionCap: math.NewInt(800), AddressCap: math.NewInt(500)})
+
+	first := addressutils.GenerateAccAddressForTest().String()
+	second := addressutils.GenerateAccAddressForTest().String()
+	for i, delegator := range []string{first, second} {
+		icsKeeper.SetWithdrawalRecord(ctx, types.WithdrawalRecord{
+			ChainId:     zone.ChainId,
+			Delegator:   delegator,
+			Recipient:   zone.DelegationAddress.Address,
+			BurnAmount:  sdk.NewCoin(zone.LocalDenom, math.NewInt(600)),
+			Txhash:      []string{"aaaa", "bbbb"}[i],
+			Status:      types.WithdrawStatusQueued,
+			EpochNumber: 1,
+		})
+	}
+
+	suite.NoError(icsKeeper.HandleQueuedUnbondings(ctx, &zone, 1))
+
+	// the first record is limited by the address cap, and the second by the remainder of the zone cap.
+	unbonding, found := icsKeeper.GetWithdrawalRecord(ctx, zone.ChainId, "aaaa", types.WithdrawStatusUnbond)
+	suite.True(found)
+	suite.Equal(math.NewInt(500), unbonding.BurnAmount.Amount)
+	unbonding, found = icsKeeper.GetWithdrawalRecord(ctx, zone.ChainId, "bbbb", types.WithdrawStatusUnbond)
+	suite.True(found)
+	suite.Equal(math.NewInt(300), unbonding.BurnAmount.Amount)
+
+	deferred := map[string]math.Int{}
+	for _, record := range icsKeeper.QueuedWithdrawalRecordsByPriority(ctx, zone.ChainId) {
+		deferred[record.Delegator] = record.BurnAmount.Amount
+	}
+	suite.Equal(map[string]math.Int{first: math.NewInt(100), second: math.NewInt(300)}, deferred)
+	suite.Len(suite.typedEvents(ctx, &types.EventRedemptionDeferred{}), 2)
+
+	resp, err := icsKeeper.EpochCaps(ctx, &types.QueryEpochCapsRequest{ChainId: zone.ChainId, Address: first})
+	suite.NoError(err)
+	suite.Equal(math.NewInt(800), resp.Usage.Redeemed)
+	suite.Equal(math.NewInt(500), resp.AddressUsage.Redeemed)
+
+	// no further redemptions are unbonded this epoch.
+	suite.NoError(icsKeeper.HandleQueuedUnbondings(ctx, &zone, 1))
+	suite.Len(icsKeeper.QueuedWithdrawalRecordsByPriority(ctx, zone.ChainId), 2)
+}
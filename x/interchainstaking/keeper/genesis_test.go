@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/app"
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	ics "github.com/quicksilver-zone/quicksilver/x/interchainstaking"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/keeper"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// TestGenesisRoundTripMidRedemption exports a chain with redemptions in flight, imports the exported genesis into a
+// fresh chain and asserts that the re-exported state is identical.
+func (suite *KeeperTestSuite) TestGenesisRoundTripMidRedemption() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	params := icsKeeper.GetParams(ctx)
+	params.UnbondingEnabled = true
+	icsKeeper.SetParams(ctx, params)
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	zone.UnbondingEnabled = true
+	zone.RedemptionRate = sdk.OneDec()
+	zone.LastRedemptionRate = sdk.OneDec()
+	icsKeeper.SetZone(ctx, &zone)
+
+	validators := icsKeeper.GetValidators(ctx, zone.ChainId)
+	suite.GreaterOrEqual(len(validators), 2)
+	for _, val := range validators {
+		icsKeeper.SetDelegation(ctx, zone.ChainId, types.NewDelegation(zone.DelegationAddress.Address, val.ValoperAddress, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000000))))
+	}
+
+	// request redemptions, and unbond the queue, leaving records in escrow and in the unbonding state.
+	sender := addressutils.GenerateAccAddressForTest()
+	suite.mintQAssets(ctx, zone, sender, 10000000)
+	for _, amount := range []int64{500000, 1500000} {
+		msg := types.MsgRequestRedemption{
+			Value:              sdk.NewCoin(zone.LocalDenom, sdk.NewInt(amount)),
+			DestinationAddress: zone.DelegationAddress.Address,
+			FromAddress:        sender.String(),
+		}
+		_, err := keeper.NewMsgServerImpl(icsKeeper).RequestRedemption(sdk.WrapSDKContext(ctx), &msg)
+		suite.NoError(err)
+	}
+	suite.NoError(icsKeeper.HandleQueuedUnbondings(ctx, &zone, 1))
+	_, err := keeper.NewMsgServerImpl(icsKeeper).RequestRedemption(sdk.WrapSDKContext(ctx), &types.MsgRequestRedemption{
+		Value:              sdk.NewCoin(zone.LocalDenom, sdk.NewInt(250000)),
+		DestinationAddress: zone.DelegationAddress.Address,
+		FromAddress:        sender.String(),
+	})
+	suite.NoError(err)
+
+	completion := ctx.BlockTime().Add(21 * 24 * time.Hour).UTC()
+	icsKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{ChainId: zone.ChainId, EpochNumber: 1, Validator: validators[0].ValoperAddress, RelatedTxhash: []string{"abcd"}, Amount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(500000)), CompletionTime: completion})
+	icsKeeper.SetRedelegationRecord(ctx, types.RedelegationRecord{ChainId: zone.ChainId, EpochNumber: 1, Source: validators[0].ValoperAddress, Destination: validators[1].ValoperAddress, Amount: 1000, CompletionTime: completion})
+	icsKeeper.SetReceipt(ctx, types.Receipt{ChainId: zone.ChainId, Sender: zone.DepositAddress.Address, Txhash: "0a0a", Amount: sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(100)))})
+	icsKeeper.SetAddressMapPair(ctx, sender, addressutils.GenerateAccAddressForTest(), zone.ChainId)
+	icsKeeper.SetValidatorAddrByConsAddr(ctx, zone.ChainId, validators[0].ValoperAddress, sdk.ConsAddress(addressutils.GenerateAccAddressForTest()))
+	icsKeeper.SetLsmCaps(ctx, zone.ChainId, types.LsmCaps{ValidatorCap: sdk.NewDecWithPrec(1, 1), ValidatorBondCap: sdk.NewDec(250), GlobalCap: sdk.NewDecWithPrec(25, 2)})
+	icsKeeper.SetCircuitBreaker(ctx, types.CircuitBreaker{ChainId: zone.ChainId, Trips: []types.CircuitBreakerTrip{{Action: types.CircuitBreakerActionDeposits, Reason: "test", TrippedBy: sender.String(), Height: 1, Time: ctx.BlockTime().UTC()}}})
+	icsKeeper.SetEmergencyAuthority(ctx, sender.String())
+	icsKeeper.SetSlashRecord(ctx, types.SlashRecord{ChainId: zone.ChainId, Validator: validators[0].ValoperAddress, Height: 10, Time: ctx.BlockTime().UTC(), Fraction: sdk.NewDecWithPrec(1, 2), ExpectedAmount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(10)), ReportedAmount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(10))})
+	icsKeeper.SetZoneSnapshot(ctx, types.ZoneSnapshot{ChainId: zone.ChainId, EpochNumber: 1, Height: 10, Timestamp: ctx.BlockTime().UTC(), RedemptionRate: sdk.OneDec(), Supply: sdk.NewInt(10000000), Tvl: sdk.NewInt(10000000), Apr: sdk.ZeroDec()})
+	icsKeeper.SetEpochCaps(ctx, zone.ChainId, types.EpochCaps{DepositCap: sdk.NewInt(1000000), RedemptionCap: sdk.ZeroInt(), AddressCap: sdk.NewInt(1000)})
+	icsKeeper.RecordEpochDeposit(ctx, &zone, sender.String(), sdk.NewInt(500))
+	icsKeeper.SetZoneWindDown(ctx, types.ZoneWindDown{ChainId: "removed-1", Status: types.WindDownStatusRemoved, StartedAt: ctx.BlockTime().UTC(), FinalRedemptionRate: sdk.OneDec()})
+	// current intents differ from those snapshotted at the last epoch.
+	icsKeeper.SetDelegatorIntent(ctx, &zone, types.DelegatorIntent{Delegator: sender.String(), Intents: types.ValidatorIntents{{ValoperAddress: validators[0].ValoperAddress, Weight: sdk.OneDec()}}}, false)
+	icsKeeper.SetDelegatorIntent(ctx, &zone, types.DelegatorIntent{Delegator: sender.String(), Intents: types.ValidatorIntents{{ValoperAddress: validators[1].ValoperAddress, Weight: sdk.OneDec()}}}, true)
+	sequence := icsKeeper.GetNextWithdrawalRecordSequence(ctx) + 1
+
+	exported := ics.ExportGenesis(ctx, icsKeeper)
+	suite.NotEmpty(exported.WithdrawalRecords)
+	suite.NotEmpty(exported.UnbondingRecords)
+	suite.NotEmpty(exported.RedelegationRecords)
+	suite.NotEmpty(exported.MappedAccounts)
+	suite.NotEmpty(exported.AddressZoneMappings)
+	suite.Len(exported.EpochCapUsages, 2)
+	suite.Equal(sequence, exported.WithdrawalRecordSequence)
+
+	// round trip through json, as for a chain restart.
+	cdc := quicksilver.AppCodec()
+	bz := cdc.MustMarshalJSON(exported)
+	imported := types.GenesisState{}
+	cdc.MustUnmarshalJSON(bz, &imported)
+	suite.NoError(imported.Validate())
+
+	fresh := app.Setup(suite.T(), false)
+	freshCtx := fresh.BaseApp.NewContext(false, tmproto.Header{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+	ics.InitGenesis(freshCtx, fresh.InterchainstakingKeeper, imported)
+
+	reexported := ics.ExportGenesis(freshCtx, fresh.InterchainstakingKeeper)
+	suite.Equal(bz, cdc.MustMarshalJSON(reexported))
+
+	// in-flight records are reachable by their keys in the imported state.
+	for _, record := range exported.WithdrawalRecords {
+		_, found := fresh.InterchainstakingKeeper.GetWithdrawalRecord(freshCtx, record.ChainId, record.Txhash, record.Status)
+		suite.True(found)
+	}
+	remote, found := fresh.InterchainstakingKeeper.GetRemoteAddressMap(freshCtx, sender, zone.ChainId)
+	suite.True(found)
+	local, found := fresh.InterchainstakingKeeper.GetLocalAddressMap(freshCtx, remote, zone.ChainId)
+	suite.True(found)
+	suite.Equal(sender.Bytes(), local)
+	importedZone, found := fresh.InterchainstakingKeeper.GetZoneForDelegateAccount(freshCtx, zone.DelegationAddress.Address)
+	suite.True(found)
+	suite.Equal(zone.ChainId, importedZone.ChainId)
+	suite.Equal(sequence, fresh.InterchainstakingKeeper.GetNextWithdrawalRecordSequence(freshCtx))
+	for _, snapshot := range []bool{false, true} {
+		expected, found := icsKeeper.GetDelegatorIntent(ctx, &zone, sender.String(), snapshot)
+		suite.True(found)
+		intent, found := fresh.InterchainstakingKeeper.GetDelegatorIntent(freshCtx, &zone, sender.String(), snapshot)
+		suite.True(found)
+		suite.Equal(expected, intent)
+	}
+}
@@ -87,16 +87,19 @@ func (k Keeper) ZoneValidators(c context.Context, req *types.QueryZoneValidators
 	var validators []types.Validator
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetZoneValidatorsKey(req.ChainId))
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
 		var validator types.Validator
 		if err := k.cdc.Unmarshal(value, &validator); err != nil {
-			return err
+			return false, err
 		}
 
-		if req.Status == "" || req.Status == validator.Status {
+		if req.Status != "" && req.Status != validator.Status {
+			return false, nil
+		}
+		if accumulate {
 			validators = append(validators, validator)
 		}
-		return nil
+		return true, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -180,23 +183,35 @@ func (k *Keeper) Delegations(c context.Context, req *types.QueryDelegationsReque
 		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
 	}
 
-	delegations := make([]types.Delegation, 0)
+	// tvl is the sum of all delegations, irrespective of pagination.
 	sum := sdk.ZeroInt()
-
 	k.IterateAllDelegations(ctx, zone.ChainId, func(delegation types.Delegation) (stop bool) {
-		delegations = append(delegations, delegation)
 		sum = sum.Add(delegation.Amount.Amount)
 		return false
 	})
 
+	delegations := make([]types.Delegation, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixDelegation, []byte(zone.ChainId)...))
+
+	pageRes, err := query.Paginate(store, pageRequestOrAll(req.Pagination), func(_, value []byte) error {
+		delegation, err := types.UnmarshalDelegation(k.cdc, value)
+		if err != nil {
+			return err
+		}
+		delegations = append(delegations, delegation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	if sum.IsInt64() {
-		return &types.QueryDelegationsResponse{Delegations: delegations, Tvl: sum.Int64()}, nil
+		return &types.QueryDelegationsResponse{Delegations: delegations, Tvl: sum.Int64(), Pagination: pageRes}, nil
 	}
-	return &types.QueryDelegationsResponse{Delegations: delegations}, status.Error(codes.OutOfRange, "tvl out of bound Int64")
+	return &types.QueryDelegationsResponse{Delegations: delegations, Pagination: pageRes}, status.Error(codes.OutOfRange, "tvl out of bound Int64")
 }
 
 func (k *Keeper) Receipts(c context.Context, req *types.QueryReceiptsRequest) (*types.QueryReceiptsResponse, error) {
-	// TODO: implement pagination
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -209,17 +224,24 @@ func (k *Keeper) Receipts(c context.Context, req *types.QueryReceiptsRequest) (*
 	}
 
 	receipts := make([]types.Receipt, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixReceipt, []byte(zone.ChainId+"/")...))
 
-	k.IterateZoneReceipts(ctx, zone.ChainId, func(_ int64, receipt types.Receipt) (stop bool) {
+	pageRes, err := query.Paginate(store, pageRequestOrAll(req.Pagination), func(_, value []byte) error {
+		var receipt types.Receipt
+		if err := k.cdc.Unmarshal(value, &receipt); err != nil {
+			return err
+		}
 		receipts = append(receipts, receipt)
-		return false
+		return nil
 	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryReceiptsResponse{Receipts: receipts}, nil
+	return &types.QueryReceiptsResponse{Receipts: receipts, Pagination: pageRes}, nil
 }
 
 func (k *Keeper) TxStatus(c context.Context, req *types.QueryTxStatusRequest) (*types.QueryTxStatusResponse, error) {
-	// TODO: implement pagination
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -238,7 +260,6 @@ func (k *Keeper) TxStatus(c context.Context, req *types.QueryTxStatusRequest) (*
 }
 
 func (k *Keeper) ZoneWithdrawalRecords(c context.Context, req *types.QueryWithdrawalRecordsRequest) (*types.QueryWithdrawalRecordsResponse, error) {
-	// TODO: implement pagination
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -250,32 +271,30 @@ func (k *Keeper) ZoneWithdrawalRecords(c context.Context, req *types.QueryWithdr
 		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
 	}
 
-	withdrawalrecords := make([]types.WithdrawalRecord, 0)
-	k.IterateZoneWithdrawalRecords(ctx, zone.ChainId, func(index int64, record types.WithdrawalRecord) (stop bool) {
-		if record.Delegator == req.DelegatorAddress {
-			withdrawalrecords = append(withdrawalrecords, record)
-		}
-		return false
-	})
+	withdrawalrecords, pageRes, err := k.paginateWithdrawalRecords(ctx, zone.ChainId, req.DelegatorAddress, req.Status, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryWithdrawalRecordsResponse{Withdrawals: withdrawalrecords}, nil
+	return &types.QueryWithdrawalRecordsResponse{Withdrawals: withdrawalrecords, Pagination: pageRes}, nil
 }
 
 func (k *Keeper) WithdrawalRecords(c context.Context, req *types.QueryWithdrawalRecordsRequest) (*types.QueryWithdrawalRecordsResponse, error) {
-	// TODO: implement pagination
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	withdrawalrecords := k.AllZoneWithdrawalRecords(ctx, req.ChainId)
+	withdrawalrecords, pageRes, err := k.paginateWithdrawalRecords(ctx, req.ChainId, req.DelegatorAddress, req.Status, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryWithdrawalRecordsResponse{Withdrawals: withdrawalrecords}, nil
+	return &types.QueryWithdrawalRecordsResponse{Withdrawals: withdrawalrecords, Pagination: pageRes}, nil
 }
 
 func (k *Keeper) UserWithdrawalRecords(c context.Context, req *types.QueryUserWithdrawalRecordsRequest) (*types.QueryWithdrawalRecordsResponse, error) {
-	// TODO: implement pagination
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -286,35 +305,123 @@ func (k *Keeper) UserWithdrawalRecords(c context.Context, req *types.QueryUserWi
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	withdrawalrecords := k.AllUserWithdrawalRecords(ctx, req.UserAddress)
+	withdrawalrecords, pageRes, err := k.paginateWithdrawalRecords(ctx, "", req.UserAddress, req.Status, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWithdrawalRecordsResponse{Withdrawals: withdrawalrecords, Pagination: pageRes}, nil
+}
+
+// paginateWithdrawalRecords returns a page of withdrawal records, optionally restricted to the given zone, delegator
+// and status. Requests without pagination return every matching record.
+func (k *Keeper) paginateWithdrawalRecords(ctx sdk.Context, chainID, delegator string, recordStatus int32, pageReq *query.PageRequest) ([]types.WithdrawalRecord, *query.PageResponse, error) {
+	prefixBytes := types.KeyPrefixWithdrawalRecord
+	switch {
+	case chainID != "" && recordStatus != 0:
+		prefixBytes = types.GetWithdrawalKey(chainID, recordStatus)
+	case chainID != "":
+		prefixBytes = append(types.KeyPrefixWithdrawalRecord, []byte(chainID)...)
+	}
+
+	records := make([]types.WithdrawalRecord, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixBytes)
 
-	return &types.QueryWithdrawalRecordsResponse{Withdrawals: withdrawalrecords}, nil
+	pageRes, err := query.FilteredPaginate(store, pageRequestOrAll(pageReq), func(_, value []byte, accumulate bool) (bool, error) {
+		var record types.WithdrawalRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return false, err
+		}
+
+		if (chainID != "" && record.ChainId != chainID) ||
+			(delegator != "" && record.Delegator != delegator) ||
+			(recordStatus != 0 && record.Status != recordStatus) {
+			return false, nil
+		}
+
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return records, pageRes, nil
+}
+
+// pageRequestOrAll returns the given page request or, absent one, a request for every entry, as the record queries
+// returned every entry before they were paginated, and existing clients may not follow the next key.
+func pageRequestOrAll(pageReq *query.PageRequest) *query.PageRequest {
+	if pageReq == nil {
+		return &query.PageRequest{Limit: query.MaxLimit}
+	}
+	return pageReq
 }
 
 func (k *Keeper) UnbondingRecords(c context.Context, req *types.QueryUnbondingRecordsRequest) (*types.QueryUnbondingRecordsResponse, error) {
-	// TODO: implement pagination
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	unbondings := k.AllZoneUnbondingRecords(ctx, req.ChainId)
+	unbondings := make([]types.UnbondingRecord, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixUnbondingRecord, []byte(req.ChainId)...))
+
+	pageRes, err := query.FilteredPaginate(store, pageRequestOrAll(req.Pagination), func(_, value []byte, accumulate bool) (bool, error) {
+		var record types.UnbondingRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return false, err
+		}
+
+		if req.ChainId != "" && record.ChainId != req.ChainId {
+			return false, nil
+		}
+
+		if accumulate {
+			unbondings = append(unbondings, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryUnbondingRecordsResponse{Unbondings: unbondings}, nil
+	return &types.QueryUnbondingRecordsResponse{Unbondings: unbondings, Pagination: pageRes}, nil
 }
 
 func (k *Keeper) RedelegationRecords(c context.Context, req *types.QueryRedelegationRecordsRequest) (*types.QueryRedelegationRecordsResponse, error) {
-	// TODO: implement pagination
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	redelegations := k.ZoneRedelegationRecords(ctx, req.ChainId)
+	redelegations := make([]types.RedelegationRecord, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixRedelegationRecord, []byte(req.ChainId)...))
 
-	return &types.QueryRedelegationRecordsResponse{Redelegations: redelegations}, nil
+	pageRes, err := query.FilteredPaginate(store, pageRequestOrAll(req.Pagination), func(_, value []byte, accumulate bool) (bool, error) {
+		var record types.RedelegationRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return false, err
+		}
+
+		if req.ChainId != "" && record.ChainId != req.ChainId {
+			return false, nil
+		}
+
+		if accumulate {
+			redelegations = append(redelegations, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedelegationRecordsResponse{Redelegations: redelegations, Pagination: pageRes}, nil
 }
 
 func (k *Keeper) MappedAccounts(c context.Context, req *types.QueryMappedAccountsRequest) (*types.QueryMappedAccountsResponse, error) {
@@ -337,3 +444,254 @@ func (k *Keeper) MappedAccounts(c context.Context, req *types.QueryMappedAccount
 
 	return &types.QueryMappedAccountsResponse{RemoteAddressMap: remoteAddressMap}, nil
 }
+
+// CircuitBreaker returns the paused actions for a zone, along with the reason each was paused.
+func (k *Keeper) CircuitBreaker(c context.Context, req *types.QueryCircuitBreakerRequest) (*types.QueryCircuitBreakerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetZone(ctx, req.ChainId); !found {
+		return nil, fmt.Errorf("no zone found for chain id %s", req.ChainId)
+	}
+
+	cb, found := k.GetCircuitBreaker(ctx, req.ChainId)
+	if !found {
+		cb = types.CircuitBreaker{ChainId: req.ChainId}
+	}
+
+	return &types.QueryCircuitBreakerResponse{
+		CircuitBreaker:     cb,
+		EmergencyAuthority: k.GetEmergencyAuthority(ctx),
+	}, nil
+}
+
+// SlashRecords returns the slash history of a zone's validators, optionally filtered by validator.
+func (k *Keeper) SlashRecords(c context.Context, req *types.QuerySlashRecordsRequest) (*types.QuerySlashRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetZone(ctx, req.ChainId); !found {
+		return nil, fmt.Errorf("no zone found for chain id %s", req.ChainId)
+	}
+
+	records := make([]types.SlashRecord, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetZoneSlashRecordsKey(req.ChainId, req.Validator))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var record types.SlashRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return false, err
+		}
+
+		if record.ChainId != req.ChainId || (req.Validator != "" && record.Validator != req.Validator) {
+			return false, nil
+		}
+
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashRecordsResponse{
+		SlashRecords: records,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// RewardSwapRoutes returns the reward swap routes for the given zone, and the cached state of the pools they use.
+func (k *Keeper) RewardSwapRoutes(c context.Context, req *types.QueryRewardSwapRoutesRequest) (*types.QueryRewardSwapRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetZone(ctx, req.ChainId); !found {
+		return nil, fmt.Errorf("no zone found for chain id %s", req.ChainId)
+	}
+
+	routes := make([]types.RewardSwapRoute, 0)
+	if config, found := k.GetRewardSwapConfig(ctx, req.ChainId); found {
+		routes = config.Routes
+	}
+
+	return &types.QueryRewardSwapRoutesResponse{
+		Routes: routes,
+		Pools:  k.AllZoneSwapPools(ctx, req.ChainId),
+	}, nil
+}
+
+// ZoneWindDown returns the wind-down status of the given zone.
+func (k *Keeper) ZoneWindDown(c context.Context, req *types.QueryZoneWindDownRequest) (*types.QueryZoneWindDownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	windDown, found := k.GetZoneWindDown(ctx, req.ChainId)
+	if !found {
+		if _, found := k.GetZone(ctx, req.ChainId); !found {
+			return nil, fmt.Errorf("no zone found for chain id %s", req.ChainId)
+		}
+		windDown = types.ZoneWindDown{ChainId: req.ChainId, Status: types.WindDownStatusActive}
+	}
+
+	return &types.QueryZoneWindDownResponse{
+		WindDown: windDown,
+	}, nil
+}
+
+// ZoneWindDowns returns the wind-down records of all zones, including removed zones.
+func (k *Keeper) ZoneWindDowns(c context.Context, req *types.QueryZoneWindDownsRequest) (*types.QueryZoneWindDownsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	windDowns := make([]types.ZoneWindDown, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixZoneWindDown)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var windDown types.ZoneWindDown
+		if err := k.cdc.Unmarshal(value, &windDown); err != nil {
+			return err
+		}
+
+		windDowns = append(windDowns, windDown)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryZoneWindDownsResponse{
+		WindDowns:  windDowns,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ZoneHistory returns the snapshots of the given zone, optionally bounded by epoch.
+func (k *Keeper) ZoneHistory(c context.Context, req *types.QueryZoneHistoryRequest) (*types.QueryZoneHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.FromEpoch < 0 || req.ToEpoch < 0 || (req.ToEpoch != 0 && req.ToEpoch < req.FromEpoch) {
+		return nil, status.Error(codes.InvalidArgument, "invalid epoch range")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetZone(ctx, req.ChainId); !found {
+		return nil, fmt.Errorf("no zone found for chain id %s", req.ChainId)
+	}
+
+	snapshots := make([]types.ZoneSnapshot, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetZoneSnapshotsKey(req.ChainId))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var snapshot types.ZoneSnapshot
+		if err := k.cdc.Unmarshal(value, &snapshot); err != nil {
+			return false, err
+		}
+
+		if snapshot.ChainId != req.ChainId || snapshot.EpochNumber < req.FromEpoch || (req.ToEpoch != 0 && snapshot.EpochNumber > req.ToEpoch) {
+			return false, nil
+		}
+
+		if accumulate {
+			snapshots = append(snapshots, snapshot)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryZoneHistoryResponse{
+		Snapshots:  snapshots,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RedemptionQueue returns the queued redemptions of the given zone in processing order, with estimated unbonding and
+// completion epochs.
+func (k *Keeper) RedemptionQueue(c context.Context, req *types.QueryRedemptionQueueRequest) (*types.QueryRedemptionQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetZone(ctx, req.ChainId)
+	if !found {
+		return nil, fmt.Errorf("no zone found for chain id %s", req.ChainId)
+	}
+
+	positions, available, err := k.EstimateRedemptionQueue(ctx, &zone)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if req.DelegatorAddress != "" {
+		filtered := make([]types.RedemptionQueuePosition, 0)
+		for _, position := range positions {
+			if position.Delegator == req.DelegatorAddress {
+				filtered = append(filtered, position)
+			}
+		}
+		positions = filtered
+	}
+
+	return &types.QueryRedemptionQueueResponse{
+		Positions: positions,
+		Available: available,
+	}, nil
+}
+
+// EpochCaps returns the per-epoch caps of the given zone, and their usage in the current epoch by the zone and,
+// optionally, by an address.
+func (k *Keeper) EpochCaps(c context.Context, req *types.QueryEpochCapsRequest) (*types.QueryEpochCapsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetZone(ctx, req.ChainId); !found {
+		return nil, fmt.Errorf("no zone found for chain id %s", req.ChainId)
+	}
+
+	caps, found := k.GetEpochCaps(ctx, req.ChainId)
+	if !found {
+		caps = types.EpochCaps{DepositCap: sdk.ZeroInt(), RedemptionCap: sdk.ZeroInt(), AddressCap: sdk.ZeroInt()}
+	}
+
+	resp := &types.QueryEpochCapsResponse{
+		Caps:  caps,
+		Usage: k.GetEpochCapUsage(ctx, req.ChainId, ""),
+	}
+
+	if req.Address != "" {
+		address, err := addressutils.AccAddressFromBech32(req.Address, "")
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		usage := k.GetEpochCapUsage(ctx, req.ChainId, address.String())
+		resp.AddressUsage = &usage
+	}
+
+	return resp, nil
+}
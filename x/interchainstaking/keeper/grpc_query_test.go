@@ -2,11 +2,13 @@ package keeper_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	"github.com/quicksilver-zone/quicksilver/utils/randomutils"
@@ -1024,6 +1026,45 @@ func (suite *KeeperTestSuite) TestKeeper_WithdrawalRecords() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestKeeper_WithdrawalRecordsWithoutPagination() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	// more records than fit in a default page.
+	records := int(query.DefaultLimit) + 1
+	for i := 0; i < records; i++ {
+		icsKeeper.SetWithdrawalRecord(ctx, types.WithdrawalRecord{
+			ChainId:    zone.ChainId,
+			Delegator:  delegatorAddress,
+			Recipient:  testAddress,
+			BurnAmount: sdk.NewCoin(zone.LocalDenom, math.NewInt(1)),
+			Txhash:     fmt.Sprintf("%064d", i),
+			Status:     types.WithdrawStatusQueued,
+		})
+	}
+
+	// requests without pagination return every record, as before pagination.
+	resp, err := icsKeeper.WithdrawalRecords(ctx, &types.QueryWithdrawalRecordsRequest{ChainId: zone.ChainId})
+	suite.NoError(err)
+	suite.Len(resp.Withdrawals, records)
+	suite.Empty(resp.Pagination.NextKey)
+
+	resp, err = icsKeeper.ZoneWithdrawalRecords(ctx, &types.QueryWithdrawalRecordsRequest{ChainId: zone.ChainId, DelegatorAddress: delegatorAddress})
+	suite.NoError(err)
+	suite.Len(resp.Withdrawals, records)
+
+	resp, err = icsKeeper.WithdrawalRecords(ctx, &types.QueryWithdrawalRecordsRequest{ChainId: zone.ChainId, Pagination: &query.PageRequest{Limit: 10}})
+	suite.NoError(err)
+	suite.Len(resp.Withdrawals, 10)
+	suite.NotEmpty(resp.Pagination.NextKey)
+}
+
 func (suite *KeeperTestSuite) TestKeeper_UnbondingRecords() {
 	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
 	ctx := suite.chainA.GetContext()
@@ -1346,3 +1387,374 @@ func (suite *KeeperTestSuite) TestKeeper_Zone() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestKeeper_CircuitBreaker() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		req      *types.QueryCircuitBreakerRequest
+		wantErr  bool
+		expected int
+	}{
+		{
+			name:     "empty request",
+			malleate: func() {},
+			req:      nil,
+			wantErr:  true,
+		},
+		{
+			name: "zone not found",
+			malleate: func() {
+				suite.SetupTest()
+				suite.setupTestZones()
+			},
+			req:     &types.QueryCircuitBreakerRequest{ChainId: "unknown-1"},
+			wantErr: true,
+		},
+		{
+			name: "zone valid request, not tripped",
+			malleate: func() {
+				suite.SetupTest()
+				suite.setupTestZones()
+			},
+			req:      &types.QueryCircuitBreakerRequest{ChainId: suite.chainB.ChainID},
+			wantErr:  false,
+			expected: 0,
+		},
+		{
+			name: "zone valid request, tripped",
+			malleate: func() {
+				suite.SetupTest()
+				suite.setupTestZones()
+				icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+				icsKeeper.SetEmergencyAuthority(suite.chainA.GetContext(), testAddress)
+				icsKeeper.TripCircuitBreaker(suite.chainA.GetContext(), suite.chainB.ChainID, []types.CircuitBreakerAction{types.CircuitBreakerActionRewards, types.CircuitBreakerActionICA}, "test", testAddress)
+			},
+			req:      &types.QueryCircuitBreakerRequest{ChainId: suite.chainB.ChainID},
+			wantErr:  false,
+			expected: 2,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.malleate()
+			icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+			ctx := suite.chainA.GetContext()
+
+			resp, err := icsKeeper.CircuitBreaker(ctx, tc.req)
+			if tc.wantErr {
+				suite.T().Logf("Error:\n%v\n", err)
+				suite.Error(err)
+				return
+			}
+			suite.NoError(err)
+			suite.NotNil(resp)
+			suite.Equal(tc.req.ChainId, resp.CircuitBreaker.ChainId)
+			suite.Len(resp.CircuitBreaker.Trips, tc.expected)
+			if tc.expected > 0 {
+				suite.Equal("test", resp.CircuitBreaker.Trips[0].Reason)
+				suite.Equal(testAddress, resp.EmergencyAuthority)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SlashRecords() {
+	var valopers []string
+	testCases := []struct {
+		name     string
+		malleate func()
+		req      func() *types.QuerySlashRecordsRequest
+		wantErr  bool
+		expected int
+	}{
+		{
+			name:     "empty request",
+			malleate: func() {},
+			req:      func() *types.QuerySlashRecordsRequest { return nil },
+			wantErr:  true,
+		},
+		{
+			name: "zone not found",
+			malleate: func() {
+				suite.SetupTest()
+				suite.setupTestZones()
+			},
+			req:     func() *types.QuerySlashRecordsRequest { return &types.QuerySlashRecordsRequest{ChainId: "unknown-1"} },
+			wantErr: true,
+		},
+		{
+			name: "zone valid request, no slashes",
+			malleate: func() {
+				suite.SetupTest()
+				suite.setupTestZones()
+			},
+			req: func() *types.QuerySlashRecordsRequest {
+				return &types.QuerySlashRecordsRequest{ChainId: suite.chainB.ChainID}
+			},
+			expected: 0,
+		},
+		{
+			name: "zone valid request, slashes",
+			malleate: func() {
+				suite.SetupTest()
+				suite.setupTestZones()
+				valopers = suite.setupSlashRecords()
+			},
+			req: func() *types.QuerySlashRecordsRequest {
+				return &types.QuerySlashRecordsRequest{ChainId: suite.chainB.ChainID}
+			},
+			expected: 3,
+		},
+		{
+			name: "zone valid request, filtered by validator",
+			malleate: func() {
+				suite.SetupTest()
+				suite.setupTestZones()
+				valopers = suite.setupSlashRecords()
+			},
+			req: func() *types.QuerySlashRecordsRequest {
+				return &types.QuerySlashRecordsRequest{ChainId: suite.chainB.ChainID, Validator: valopers[0]}
+			},
+			expected: 2,
+		},
+		{
+			name: "zone valid request, paginated",
+			malleate: func() {
+				suite.SetupTest()
+				suite.setupTestZones()
+				valopers = suite.setupSlashRecords()
+			},
+			req: func() *types.QuerySlashRecordsRequest {
+				return &types.QuerySlashRecordsRequest{ChainId: suite.chainB.ChainID, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			expected: 1,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.malleate()
+			icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+			ctx := suite.chainA.GetContext()
+
+			req := tc.req()
+			resp, err := icsKeeper.SlashRecords(ctx, req)
+			if tc.wantErr {
+				suite.T().Logf("Error:\n%v\n", err)
+				suite.Error(err)
+				return
+			}
+			suite.NoError(err)
+			suite.NotNil(resp)
+			suite.Len(resp.SlashRecords, tc.expected)
+			for _, record := range resp.SlashRecords {
+				suite.Equal(req.ChainId, record.ChainId)
+				if req.Validator != "" {
+					suite.Equal(req.Validator, record.Validator)
+				}
+			}
+			if req.Pagination != nil {
+				suite.Equal(uint64(3), resp.Pagination.Total)
+			}
+		})
+	}
+}
+
+// setupSlashRecords stores two slash records for the first validator of the
+// test zone and one for the second, returning their valoper addresses.
+func (suite *KeeperTestSuite) setupSlashRecords() []string {
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	vals := icsKeeper.GetValidators(ctx, zone.ChainId)
+
+	for _, r := range []struct {
+		valoper string
+		height  int64
+	}{{vals[0].ValoperAddress, 10}, {vals[0].ValoperAddress, 20}, {vals[1].ValoperAddress, 10}} {
+		icsKeeper.SetSlashRecord(ctx, types.SlashRecord{
+			ChainId:        zone.ChainId,
+			Validator:      r.valoper,
+			Height:         r.height,
+			Time:           ctx.BlockTime(),
+			Fraction:       sdk.MustNewDecFromStr("0.05"),
+			ExpectedAmount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000)),
+			ReportedAmount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(950)),
+			Reconciled:     true,
+		})
+	}
+	// a record for a zone whose chain id extends this one must not be returned.
+	icsKeeper.SetSlashRecord(ctx, types.SlashRecord{
+		ChainId:        zone.ChainId + "0",
+		Validator:      vals[0].ValoperAddress,
+		Height:         10,
+		Fraction:       sdk.MustNewDecFromStr("0.05"),
+		ExpectedAmount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000)),
+		ReportedAmount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(950)),
+	})
+
+	return []string{vals[0].ValoperAddress, vals[1].ValoperAddress}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RewardSwapRoutes() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, err := icsKeeper.RewardSwapRoutes(ctx, nil)
+	suite.Error(err)
+
+	_, err = icsKeeper.RewardSwapRoutes(ctx, &types.QueryRewardSwapRoutesRequest{ChainId: "unknown-1"})
+	suite.Error(err)
+
+	resp, err := icsKeeper.RewardSwapRoutes(ctx, &types.QueryRewardSwapRoutesRequest{ChainId: suite.chainB.ChainID})
+	suite.NoError(err)
+	suite.Empty(resp.Routes)
+	suite.Empty(resp.Pools)
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	routes := []types.RewardSwapRoute{{Denom: "uosmo", Hops: []types.SwapHop{{PoolId: 1, TokenOutDenom: zone.BaseDenom}}, MaxSlippage: sdk.MustNewDecFromStr("0.01")}}
+	suite.NoError(icsKeeper.SetRewardSwapRoutes(ctx, &zone, routes))
+	icsKeeper.SetSwapPool(ctx, types.SwapPool{ChainId: zone.ChainId, PoolId: 1, LastUpdated: ctx.BlockTime()})
+
+	resp, err = icsKeeper.RewardSwapRoutes(ctx, &types.QueryRewardSwapRoutesRequest{ChainId: suite.chainB.ChainID})
+	suite.NoError(err)
+	suite.Equal(routes, resp.Routes)
+	suite.Len(resp.Pools, 1)
+	suite.Equal(uint64(1), resp.Pools[0].PoolId)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ZoneWindDown() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, err := icsKeeper.ZoneWindDown(ctx, nil)
+	suite.Error(err)
+
+	_, err = icsKeeper.ZoneWindDown(ctx, &types.QueryZoneWindDownRequest{ChainId: "unknown-1"})
+	suite.Error(err)
+
+	resp, err := icsKeeper.ZoneWindDown(ctx, &types.QueryZoneWindDownRequest{ChainId: suite.chainB.ChainID})
+	suite.NoError(err)
+	suite.Equal(types.WindDownStatusActive, resp.WindDown.Status)
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	suite.NoError(icsKeeper.StartZoneWindDown(ctx, &zone, ""))
+
+	resp, err = icsKeeper.ZoneWindDown(ctx, &types.QueryZoneWindDownRequest{ChainId: suite.chainB.ChainID})
+	suite.NoError(err)
+	suite.Equal(types.WindDownStatusDraining, resp.WindDown.Status)
+	suite.Equal(zone.ChainId, resp.WindDown.ChainId)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ZoneWindDowns() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, err := icsKeeper.ZoneWindDowns(ctx, nil)
+	suite.Error(err)
+
+	resp, err := icsKeeper.ZoneWindDowns(ctx, &types.QueryZoneWindDownsRequest{})
+	suite.NoError(err)
+	suite.Empty(resp.WindDowns)
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	suite.NoError(icsKeeper.StartZoneWindDown(ctx, &zone, ""))
+
+	resp, err = icsKeeper.ZoneWindDowns(ctx, &types.QueryZoneWindDownsRequest{})
+	suite.NoError(err)
+	suite.Len(resp.WindDowns, 1)
+	suite.Equal(types.WindDownStatusDraining, resp.WindDowns[0].Status)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ZoneHistory() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, err := icsKeeper.ZoneHistory(ctx, nil)
+	suite.Error(err)
+
+	_, err = icsKeeper.ZoneHistory(ctx, &types.QueryZoneHistoryRequest{ChainId: "unknown-1"})
+	suite.Error(err)
+
+	_, err = icsKeeper.ZoneHistory(ctx, &types.QueryZoneHistoryRequest{ChainId: suite.chainB.ChainID, FromEpoch: 5, ToEpoch: 4})
+	suite.Error(err)
+
+	for epoch := int64(1); epoch <= 5; epoch++ {
+		icsKeeper.SetZoneSnapshot(ctx, types.ZoneSnapshot{ChainId: suite.chainB.ChainID, EpochNumber: epoch, RedemptionRate: sdk.OneDec(), Supply: sdk.ZeroInt(), Tvl: sdk.ZeroInt(), Apr: sdk.ZeroDec()})
+	}
+
+	resp, err := icsKeeper.ZoneHistory(ctx, &types.QueryZoneHistoryRequest{ChainId: suite.chainB.ChainID})
+	suite.NoError(err)
+	suite.Len(resp.Snapshots, 5)
+
+	resp, err = icsKeeper.ZoneHistory(ctx, &types.QueryZoneHistoryRequest{ChainId: suite.chainB.ChainID, FromEpoch: 2, ToEpoch: 4})
+	suite.NoError(err)
+	suite.Len(resp.Snapshots, 3)
+	suite.Equal(int64(2), resp.Snapshots[0].EpochNumber)
+	suite.Equal(int64(4), resp.Snapshots[2].EpochNumber)
+
+	resp, err = icsKeeper.ZoneHistory(ctx, &types.QueryZoneHistoryRequest{ChainId: suite.chainB.ChainID, FromEpoch: 4})
+	suite.NoError(err)
+	suite.Len(resp.Snapshots, 2)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RedemptionQueue() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, err := icsKeeper.RedemptionQueue(ctx, nil)
+	suite.Error(err)
+
+	_, err = icsKeeper.RedemptionQueue(ctx, &types.QueryRedemptionQueueRequest{ChainId: "unknown-1"})
+	suite.Error(err)
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	other := addressutils.GenerateAddressForTestWithPrefix("quick")
+	fee := sdk.NewCoin(zone.LocalDenom, sdk.NewInt(1))
+	for _, record := range []types.WithdrawalRecord{
+		{Txhash: "aaaa", Delegator: testAddress, EpochNumber: 1},
+		{Txhash: "bbbb", Delegator: other, EpochNumber: 2, PriorityFee: &fee},
+		{Txhash: "cccc", Delegator: testAddress, EpochNumber: 3},
+	} {
+		record.ChainId = zone.ChainId
+		record.Recipient = zone.DelegationAddress.Address
+		record.BurnAmount = sdk.NewCoin(zone.LocalDenom, sdk.NewInt(100))
+		record.Status = types.WithdrawStatusQueued
+		icsKeeper.SetWithdrawalRecord(ctx, record)
+	}
+
+	resp, err := icsKeeper.RedemptionQueue(ctx, &types.QueryRedemptionQueueRequest{ChainId: zone.ChainId})
+	suite.NoError(err)
+	suite.Len(resp.Positions, 3)
+	suite.Equal("bbbb", resp.Positions[0].Txhash)
+	suite.Equal("aaaa", resp.Positions[1].Txhash)
+	suite.Equal("cccc", resp.Positions[2].Txhash)
+
+	resp, err = icsKeeper.RedemptionQueue(ctx, &types.QueryRedemptionQueueRequest{ChainId: zone.ChainId, DelegatorAddress: testAddress})
+	suite.NoError(err)
+	suite.Len(resp.Positions, 2)
+	suite.Equal(uint32(1), resp.Positions[0].Position)
+	suite.Equal(uint32(2), resp.Positions[1].Position)
+}
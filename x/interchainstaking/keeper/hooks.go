@@ -3,6 +3,7 @@ package keeper
 import (
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -50,6 +51,10 @@ func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNum
 	}
 	k.Logger(ctx).Info("handling epoch end", "epoch_identifier", epochIdentifier, "epoch_number", epochNumber)
 
+	// settle holders of winding down zones ahead of handling queued unbondings,
+	// and remove those that are fully settled.
+	k.HandleZoneWindDowns(ctx)
+
 	epochInfo := k.EpochsKeeper.GetEpochInfo(ctx, epochIdentifier)
 	k.IterateZones(ctx, func(index int64, zone *types.Zone) (stop bool) {
 		k.IterateZoneRedelegationRecords(ctx, zone.ChainId, func(index int64, key []byte, record types.RedelegationRecord) (stop bool) {
@@ -105,7 +110,13 @@ func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNum
 			zone.SetWithdrawalWaitgroup(k.Logger(ctx), 0, "epoch waitgroup was unexpected > 0")
 		}
 
-		if err := k.HandleQueuedUnbondings(ctx, zone, epochNumber); err != nil {
+		if k.IsCircuitBreakerTripped(ctx, zone.ChainId, types.CircuitBreakerActionRedemptions) {
+			k.Logger(ctx).Info("redemptions paused; skipping queued unbondings", "chain_id", zone.ChainId)
+		} else if k.IsCircuitBreakerTripped(ctx, zone.ChainId, types.CircuitBreakerActionICA) {
+			// queued unbondings are moved to UNBOND, and counted against the epoch cap, before the unbonding tx is
+			// submitted; leave them queued rather than have the submission fail.
+			k.Logger(ctx).Info("ica submission paused; skipping queued unbondings", "chain_id", zone.ChainId)
+		} else if err := k.HandleQueuedUnbondings(ctx, zone, epochNumber); err != nil {
 			// we can and need not panic here; logging the error is sufficient.
 			// an error here is not expected, but also not terminal.
 			// we don't return on failure here as we still want to attempt
@@ -119,8 +130,14 @@ func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNum
 			)
 		}
 
-		err = k.Rebalance(ctx, zone, epochNumber)
-		if err != nil {
+		// usage of the epoch caps recorded in earlier epochs is no longer relevant.
+		k.PruneEpochCapUsages(ctx, zone.ChainId, epochNumber)
+
+		if k.IsCircuitBreakerTripped(ctx, zone.ChainId, types.CircuitBreakerActionRebalance) {
+			k.Logger(ctx).Info("rebalancing paused; skipping rebalance", "chain_id", zone.ChainId)
+		} else if k.IsZoneWindingDown(ctx, zone.ChainId) {
+			k.Logger(ctx).Info("zone is winding down; skipping rebalance", "chain_id", zone.ChainId)
+		} else if err := k.Rebalance(ctx, zone, epochNumber); err != nil {
 			// we can and need not panic here; logging the error is sufficient.
 			// an error here is not expected, but also not terminal.
 			// we don't return on failure here as we still want to attempt
@@ -134,6 +151,9 @@ func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNum
 			)
 		}
 
+		// refresh the state of pools used to swap rewards, ahead of distribution.
+		k.EmitRewardSwapPoolQueries(ctx, zone)
+
 		// OnChanOpenAck calls SetWithdrawalAddress (see ibc_module.go)
 		k.Logger(ctx).Info(
 			"withdrawing rewards",
@@ -198,6 +218,10 @@ func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNum
 
 		k.SetZone(ctx, zone)
 
+		k.SetZoneGauges(ctx, zone)
+		k.SetZoneRecordGauges(ctx, zone)
+		telemetry.IncrCounterWithLabels(zoneMetricKey(MetricKeyEpochsHandled), 1, zoneMetricLabels(zone.ChainId))
+
 		return false
 	})
 
@@ -118,3 +118,33 @@ func (k *Keeper) HandleChannelOpenAck(ctx sdk.Context, portID, connectionID stri
 	k.SetZone(ctx, &zone)
 	return nil
 }
+
+// HandleChannelClose trips the ICA circuit breaker for the zone that owns the
+// closed channel. Lookup failures are logged rather than returned, so as not to
+// block the closing of the channel.
+func (k *Keeper) HandleChannelClose(ctx sdk.Context, portID, channelID string) {
+	connectionID, _, err := k.IBCKeeper.ChannelKeeper.GetChannelConnection(ctx, portID, channelID)
+	if err != nil {
+		k.Logger(ctx).Error("unable to obtain connection for closed channel", "port_id", portID, "channel_id", channelID, "error", err)
+		return
+	}
+
+	chainID, err := k.GetChainID(ctx, connectionID)
+	if err != nil {
+		k.Logger(ctx).Error("unable to obtain chain for closed channel", "connection_id", connectionID, "error", err)
+		return
+	}
+
+	if _, found := k.GetZone(ctx, chainID); !found {
+		k.Logger(ctx).Error("unable to obtain zone for closed channel", "chain_id", chainID)
+		return
+	}
+
+	k.TripCircuitBreaker(
+		ctx,
+		chainID,
+		[]types.CircuitBreakerAction{types.CircuitBreakerActionICA},
+		fmt.Sprintf("ica channel %s on port %s closed", channelID, portID),
+		types.CircuitBreakerAutoTrip,
+	)
+}
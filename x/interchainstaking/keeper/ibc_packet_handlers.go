@@ -26,6 +26,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 
+	"github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types/gamm"
 	"github.com/quicksilver-zone/quicksilver/utils"
 	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	querytypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
@@ -126,15 +127,17 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 		switch msg.Type {
 		case "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward":
 			if !success {
-				withdrawalMsg, ok := msg.Msg.(*distrtypes.MsgWithdrawDelegatorReward)
-				if !ok {
-					return errors.New("unable to unmarshal MsgWithdrawDelegatorReward")
-				}
-				k.Logger(ctx).Error("failed to withdraw rewards; will try again next epoch", "validator", withdrawalMsg.ValidatorAddress)
-				return nil
+				return k.HandleFailedWithdrawRewards(ctx, msg.Msg, connectionID)
+			}
+			response := distrtypes.MsgWithdrawDelegatorRewardResponse{}
+			err = proto.Unmarshal(msgResponse, &response)
+			if err != nil {
+				k.Logger(ctx).Error("unable to unpack MsgWithdrawDelegatorReward response", "error", err)
+				return err
 			}
-			k.Logger(ctx).Info("Rewards withdrawn")
-			if err := k.HandleWithdrawRewards(ctx, msg.Msg, connectionID); err != nil {
+
+			k.Logger(ctx).Info("Rewards withdrawn", "response", response)
+			if err := k.HandleWithdrawRewards(ctx, msg.Msg, response.Amount, connectionID); err != nil {
 				return err
 			}
 			continue
@@ -237,6 +240,25 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 			}
 			continue
 
+		case "/cosmos.staking.v1beta1.MsgCancelUnbondingDelegation":
+			if !success {
+				if err := k.HandleFailedCancelUnbondingDelegation(ctx, msg.Msg, packetData.Memo); err != nil {
+					return err
+				}
+				continue
+			}
+			response := stakingtypes.MsgCancelUnbondingDelegationResponse{}
+			err = proto.Unmarshal(msgResponse, &response)
+			if err != nil {
+				k.Logger(ctx).Error("unable to unpack MsgCancelUnbondingDelegation response", "error", err)
+				return err
+			}
+
+			k.Logger(ctx).Info("Unbonding cancelled", "response", response)
+			if err := k.HandleCancelUnbondingDelegation(ctx, msg.Msg, packetData.Memo); err != nil {
+				return err
+			}
+			continue
 		case "/cosmos.bank.v1beta1.MsgSend":
 			if !success {
 				if err := k.HandleFailedBankSend(ctx, msg.Msg, packetData.Memo, connectionID); err != nil {
@@ -273,6 +295,22 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 			if err := k.HandleUpdatedWithdrawAddress(ctx, msg.Msg); err != nil {
 				return err
 			}
+		case "/osmosis.gamm.v1beta1.MsgSwapExactAmountIn":
+			if !success {
+				// the swapped balance remains in the withdrawal account; we'll try again at the next distribution.
+				k.Logger(ctx).Info("reward swap failed; will retry next distribution", "connection_id", connectionID)
+				continue
+			}
+			response := gamm.MsgSwapExactAmountInResponse{}
+			err = proto.Unmarshal(msgResponse, &response)
+			if err != nil {
+				k.Logger(ctx).Error("unable to unpack MsgSwapExactAmountIn response", "error", err)
+				return err
+			}
+
+			if err := k.HandleRewardSwap(ctx, msg.Msg, response.TokenOutAmount, connectionID); err != nil {
+				return err
+			}
 		case "/ibc.applications.transfer.v1.MsgTransfer":
 			k.Logger(ctx).Debug("Received MsgTransfer acknowledgement; no action")
 			return nil
@@ -285,7 +323,10 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 	return nil
 }
 
-func (*Keeper) HandleTimeout(_ sdk.Context, _ channeltypes.Packet) error {
+// HandleTimeout handles ICA packet timeouts. ICA channels are ordered, so a
+// timeout closes the channel and the zone's ICA circuit breaker is tripped.
+func (k *Keeper) HandleTimeout(ctx sdk.Context, packet channeltypes.Packet) error {
+	k.HandleChannelClose(ctx, packet.SourcePort, packet.SourceChannel)
 	return nil
 }
 
@@ -350,6 +391,16 @@ func (k *Keeper) HandleCompleteSend(ctx sdk.Context, msg sdk.Msg, memo string, c
 		return err
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSend{
+		ChainId:     zone.ChainId,
+		FromAddress: sMsg.FromAddress,
+		ToAddress:   sMsg.ToAddress,
+		Amount:      sMsg.Amount,
+		Memo:        memo,
+	}); err != nil {
+		return err
+	}
+
 	// checks here are specific to ensure future extensibility;
 	switch {
 	case zone.IsDelegateAddress(sMsg.ToAddress) && zone.IsWithdrawalAddress(sMsg.FromAddress):
@@ -426,9 +477,7 @@ func (k *Keeper) HandleWithdrawForUser(ctx sdk.Context, zone *types.Zone, msg *b
 		return errors.New("no matching withdrawal record found")
 	}
 
-	// case 1: total amount - native unbonding
-	// this statement is ridiculous, but currently calling coins.Equals against coins with different denoms panics; which is pretty useless.
-	if len(withdrawalRecord.Amount) == 1 && len(msg.Amount) == 1 && msg.Amount[0].Denom == withdrawalRecord.Amount[0].Denom && withdrawalRecord.Amount.IsEqual(msg.Amount) {
+	complete := func() error {
 		k.Logger(ctx).Info("found matching withdrawal; marking as completed")
 		k.UpdateWithdrawalRecordStatus(ctx, &withdrawalRecord, types.WithdrawStatusCompleted)
 		if err := k.BankKeeper.BurnCoins(ctx, types.EscrowModuleAccount, sdk.NewCoins(withdrawalRecord.BurnAmount)); err != nil {
@@ -436,6 +485,23 @@ func (k *Keeper) HandleWithdrawForUser(ctx sdk.Context, zone *types.Zone, msg *b
 			return err
 		}
 		k.Logger(ctx).Info("burned coins post-withdrawal", "coins", withdrawalRecord.BurnAmount)
+		return ctx.EventManager().EmitTypedEvent(&types.EventWithdrawalCompleted{
+			ChainId:     zone.ChainId,
+			Hash:        withdrawalRecord.Txhash,
+			Delegator:   withdrawalRecord.Delegator,
+			Recipient:   withdrawalRecord.Recipient,
+			Amount:      withdrawalRecord.Amount,
+			BurnAmount:  withdrawalRecord.BurnAmount,
+			EpochNumber: withdrawalRecord.EpochNumber,
+		})
+	}
+
+	// case 1: total amount - native unbonding
+	// this statement is ridiculous, but currently calling coins.Equals against coins with different denoms panics; which is pretty useless.
+	if len(withdrawalRecord.Amount) == 1 && len(msg.Amount) == 1 && msg.Amount[0].Denom == withdrawalRecord.Amount[0].Denom && withdrawalRecord.Amount.IsEqual(msg.Amount) {
+		if err := complete(); err != nil {
+			return err
+		}
 	} else {
 
 		// case 2: per validator amounts - LSM unbonding
@@ -447,13 +513,9 @@ func (k *Keeper) HandleWithdrawForUser(ctx sdk.Context, zone *types.Zone, msg *b
 				// matched amount
 				if len(withdrawalRecord.Distribution) == len(dlist) {
 					// we just removed the last element
-					k.Logger(ctx).Info("found matching withdrawal; marking as completed")
-					k.UpdateWithdrawalRecordStatus(ctx, &withdrawalRecord, types.WithdrawStatusCompleted)
-					if err := k.BankKeeper.BurnCoins(ctx, types.EscrowModuleAccount, sdk.NewCoins(withdrawalRecord.BurnAmount)); err != nil {
-						// if we can't burn the coins, fail.
+					if err := complete(); err != nil {
 						return err
 					}
-					k.Logger(ctx).Info("burned coins post-withdrawal", "coins", withdrawalRecord.BurnAmount)
 				}
 				break
 			}
@@ -598,6 +660,18 @@ func (k *Keeper) HandleBeginRedelegate(ctx sdk.Context, msg sdk.Msg, completion
 		k.SetRedelegationRecord(ctx, record)
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRedelegate{
+		ChainId:              zone.ChainId,
+		Delegator:            redelegateMsg.DelegatorAddress,
+		SourceValidator:      redelegateMsg.ValidatorSrcAddress,
+		DestinationValidator: redelegateMsg.ValidatorDstAddress,
+		Amount:               redelegateMsg.Amount,
+		EpochNumber:          epochNumber,
+		CompletionTime:       completion,
+	}); err != nil {
+		return err
+	}
+
 	tgtDelegation, found := k.GetDelegation(ctx, zone.ChainId, redelegateMsg.DelegatorAddress, redelegateMsg.ValidatorDstAddress)
 	if !found {
 		tgtDelegation = types.NewDelegation(redelegateMsg.DelegatorAddress, redelegateMsg.ValidatorDstAddress, redelegateMsg.Amount)
@@ -688,7 +762,14 @@ func (k *Keeper) HandleFailedBeginRedelegate(ctx sdk.Context, msg sdk.Msg, memo
 	}
 	k.DeleteRedelegationRecord(ctx, zone.ChainId, redelegateMsg.ValidatorSrcAddress, redelegateMsg.ValidatorDstAddress, epochNumber)
 	k.Logger(ctx).Info("cleaning up redelegation record")
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventRedelegateFailed{
+		ChainId:              zone.ChainId,
+		Delegator:            redelegateMsg.DelegatorAddress,
+		SourceValidator:      redelegateMsg.ValidatorSrcAddress,
+		DestinationValidator: redelegateMsg.ValidatorDstAddress,
+		Amount:               redelegateMsg.Amount,
+		EpochNumber:          epochNumber,
+	})
 }
 
 func (k *Keeper) HandleUndelegate(ctx sdk.Context, msg sdk.Msg, completion time.Time, memo string) error {
@@ -719,6 +800,9 @@ func (k *Keeper) HandleUndelegate(ctx sdk.Context, msg sdk.Msg, completion time.
 		return fmt.Errorf("unbonding record for %s not found for epoch %d", undelegateMsg.ValidatorAddress, epochNumber)
 	}
 
+	ubr.CompletionTime = completion
+	k.SetUnbondingRecord(ctx, ubr)
+
 	for _, hash := range ubr.RelatedTxhash {
 		k.Logger(ctx).Info("MsgUndelegate", "del", undelegateMsg.DelegatorAddress, "val", undelegateMsg.ValidatorAddress, "hash", hash, "chain", zone.ChainId)
 
@@ -736,6 +820,18 @@ func (k *Keeper) HandleUndelegate(ctx sdk.Context, msg sdk.Msg, completion time.
 		k.UpdateWithdrawalRecordStatus(ctx, &record, types.WithdrawStatusUnbond)
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUndelegate{
+		ChainId:          zone.ChainId,
+		Delegator:        undelegateMsg.DelegatorAddress,
+		Validator:        undelegateMsg.ValidatorAddress,
+		Amount:           undelegateMsg.Amount,
+		EpochNumber:      epochNumber,
+		CompletionTime:   completion,
+		WithdrawalHashes: ubr.RelatedTxhash,
+	}); err != nil {
+		return err
+	}
+
 	delAddr, err := addressutils.AccAddressFromBech32(undelegateMsg.DelegatorAddress, "")
 	if err != nil {
 		return err
@@ -765,6 +861,171 @@ func (k *Keeper) HandleUndelegate(ctx sdk.Context, msg sdk.Msg, completion time.
 	}
 	k.SetZone(ctx, zone)
 
+	// send request to determine the creation height of the unbonding entry, required for cancellation.
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"store/staking/key",
+		stakingtypes.GetUBDKey(delAddr, valAddr),
+		sdk.NewInt(-1),
+		types.ModuleName,
+		"unbondingdelegation",
+		0,
+	)
+
+	return nil
+}
+
+// HandleCancelUnbondingDelegation handles a successful MsgCancelUnbondingDelegation acknowledgement. The cancelled
+// distribution is removed from the withdrawal record, and the related qAssets are returned to the user at the current
+// redemption rate, with any remainder burned.
+func (k *Keeper) HandleCancelUnbondingDelegation(ctx sdk.Context, msg sdk.Msg, memo string) error {
+	k.Logger(ctx).Info("Received MsgCancelUnbondingDelegation acknowledgement")
+	// first, type assertion. we should have stakingtypes.MsgCancelUnbondingDelegation
+	cancelMsg, ok := msg.(*stakingtypes.MsgCancelUnbondingDelegation)
+	if !ok {
+		k.Logger(ctx).Error("unable to cast source message to MsgCancelUnbondingDelegation")
+		return errors.New("unable to cast source message to MsgCancelUnbondingDelegation")
+	}
+
+	hash, err := types.ParseTxMsgMemo(memo, types.MsgTypeCancelUnbond)
+	if err != nil {
+		return err
+	}
+
+	zone, found := k.GetZoneForDelegateAccount(ctx, cancelMsg.DelegatorAddress)
+	if !found {
+		return fmt.Errorf("zone for delegate account %s not found", cancelMsg.DelegatorAddress)
+	}
+
+	record, found := k.GetWithdrawalRecord(ctx, zone.ChainId, hash, types.WithdrawStatusCancel)
+	if !found {
+		// a failed acknowledgement for another message of the same cancellation may have reverted the record.
+		record, found = k.GetWithdrawalRecord(ctx, zone.ChainId, hash, types.WithdrawStatusUnbond)
+		if !found {
+			return fmt.Errorf("unable to lookup withdrawal record; chain: %s, hash: %s", zone.ChainId, hash)
+		}
+	}
+
+	newDistribution := make([]*types.Distribution, 0)
+	cancelledAmount := sdkmath.ZeroInt()
+	matched := false
+	for _, dist := range record.Distribution {
+		if !matched && dist.Valoper == cancelMsg.ValidatorAddress {
+			cancelledAmount = sdk.NewIntFromUint64(dist.Amount)
+			matched = true
+			continue
+		}
+		newDistribution = append(newDistribution, dist)
+	}
+
+	if !matched {
+		return fmt.Errorf("no distribution for validator %s in withdrawal record %s", cancelMsg.ValidatorAddress, hash)
+	}
+
+	// determine the qAssets related to the cancelled distribution.
+	relatedQAsset := record.BurnAmount.Amount
+	if len(newDistribution) > 0 {
+		relatedQAsset = sdk.NewDecFromInt(record.BurnAmount.Amount).MulInt(cancelledAmount).QuoInt(record.Amount.AmountOf(zone.BaseDenom)).TruncateInt()
+	}
+
+	// credit qAssets at the current redemption rate, never exceeding the escrowed amount.
+	creditAmount := relatedQAsset
+	if zone.RedemptionRate.IsPositive() {
+		creditAmount = sdkmath.MinInt(sdk.NewDecFromInt(cancelledAmount).Quo(zone.RedemptionRate).TruncateInt(), relatedQAsset)
+	}
+	credit := sdk.NewCoin(zone.LocalDenom, creditAmount)
+	burn := sdk.NewCoin(zone.LocalDenom, relatedQAsset.Sub(creditAmount))
+
+	delegator, err := addressutils.AddressFromBech32(record.Delegator, "")
+	if err != nil {
+		return err
+	}
+
+	if credit.IsPositive() {
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowModuleAccount, delegator, sdk.NewCoins(credit)); err != nil {
+			return fmt.Errorf("unable to return coins from escrow account: %w", err)
+		}
+	}
+
+	if burn.IsPositive() {
+		if err := k.BankKeeper.BurnCoins(ctx, types.EscrowModuleAccount, sdk.NewCoins(burn)); err != nil {
+			return err
+		}
+	}
+
+	if len(newDistribution) == 0 {
+		k.Logger(ctx).Info("unbonding cancelled; deleting withdrawal record", "hash", hash)
+		k.DeleteWithdrawalRecord(ctx, zone.ChainId, hash, record.Status)
+	} else {
+		k.Logger(ctx).Info("unbonding cancelled; awaiting additional messages", "hash", hash, "validator", cancelMsg.ValidatorAddress)
+		record.Distribution = newDistribution
+		record.Amount = record.Amount.Sub(sdk.NewCoin(zone.BaseDenom, cancelledAmount))
+		record.BurnAmount = record.BurnAmount.SubAmount(relatedQAsset)
+		k.SetWithdrawalRecord(ctx, record)
+	}
+
+	if ubr, found := k.GetUnbondingRecordForWithdrawal(ctx, zone.ChainId, cancelMsg.ValidatorAddress, hash); found {
+		relatedTxhash := make([]string, 0, len(ubr.RelatedTxhash))
+		for _, h := range ubr.RelatedTxhash {
+			if h != hash {
+				relatedTxhash = append(relatedTxhash, h)
+			}
+		}
+		if len(relatedTxhash) == 0 || ubr.Amount.Amount.LTE(cancelledAmount) {
+			k.DeleteUnbondingRecord(ctx, ubr.ChainId, ubr.Validator, ubr.EpochNumber)
+		} else {
+			ubr.RelatedTxhash = relatedTxhash
+			ubr.Amount = ubr.Amount.SubAmount(cancelledAmount)
+			k.SetUnbondingRecord(ctx, ubr)
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnbondingCancellation,
+			sdk.NewAttribute(types.AttributeKeyHash, hash),
+			sdk.NewAttribute(types.AttributeKeyValidator, cancelMsg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyReturnedAmount, credit.String()),
+			sdk.NewAttribute(types.AttributeKeyBurnAmount, burn.String()),
+			sdk.NewAttribute(types.AttributeKeyUser, record.Delegator),
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+		),
+	})
+
+	return k.UpdateDelegationRecordForAddress(ctx, cancelMsg.DelegatorAddress, cancelMsg.ValidatorAddress, cancelMsg.Amount, zone, false, false)
+}
+
+// HandleFailedCancelUnbondingDelegation handles a failed MsgCancelUnbondingDelegation acknowledgement, reverting the
+// withdrawal record to status UNBOND, such that the unbonding completes as normal.
+func (k *Keeper) HandleFailedCancelUnbondingDelegation(ctx sdk.Context, msg sdk.Msg, memo string) error {
+	k.Logger(ctx).Error("Received MsgCancelUnbondingDelegation failure acknowledgement")
+	// first, type assertion. we should have stakingtypes.MsgCancelUnbondingDelegation
+	cancelMsg, ok := msg.(*stakingtypes.MsgCancelUnbondingDelegation)
+	if !ok {
+		k.Logger(ctx).Error("unable to cast source message to MsgCancelUnbondingDelegation")
+		return errors.New("unable to cast source message to MsgCancelUnbondingDelegation")
+	}
+
+	hash, err := types.ParseTxMsgMemo(memo, types.MsgTypeCancelUnbond)
+	if err != nil {
+		return err
+	}
+
+	zone, found := k.GetZoneForDelegateAccount(ctx, cancelMsg.DelegatorAddress)
+	if !found {
+		return fmt.Errorf("zone for delegate account %s not found", cancelMsg.DelegatorAddress)
+	}
+
+	record, found := k.GetWithdrawalRecord(ctx, zone.ChainId, hash, types.WithdrawStatusCancel)
+	if !found {
+		// already reverted by a prior message in the same packet.
+		return nil
+	}
+
+	k.Logger(ctx).Info("reverting withdrawal record to unbond status", "hash", hash, "validator", cancelMsg.ValidatorAddress)
+	k.UpdateWithdrawalRecordStatus(ctx, &record, types.WithdrawStatusUnbond)
 	return nil
 }
 
@@ -783,6 +1044,16 @@ func (k *Keeper) HandleFailedBankSend(ctx sdk.Context, msg sdk.Msg, memo string,
 		return err
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSendFailed{
+		ChainId:     zone.ChainId,
+		FromAddress: sMsg.FromAddress,
+		ToAddress:   sMsg.ToAddress,
+		Amount:      sMsg.Amount,
+		Memo:        memo,
+	}); err != nil {
+		return err
+	}
+
 	// checks here are specific to ensure future extensibility;
 	switch {
 	case zone.IsDelegateAddress(sMsg.ToAddress) && zone.IsWithdrawalAddress(sMsg.FromAddress):
@@ -825,7 +1096,15 @@ func (k *Keeper) HandleFailedUnbondSend(ctx sdk.Context, sendMsg *banktypes.MsgS
 	wdr.DelayCompletion(ctx, types.DefaultWithdrawalRequeueDelay)
 	k.UpdateWithdrawalRecordStatus(ctx, &wdr, types.WithdrawStatusUnbond)
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventWithdrawalSendFailed{
+		ChainId:        chainID,
+		Hash:           wdr.Txhash,
+		Delegator:      wdr.Delegator,
+		Recipient:      wdr.Recipient,
+		Amount:         sendMsg.Amount,
+		EpochNumber:    wdr.EpochNumber,
+		CompletionTime: wdr.CompletionTime,
+	})
 }
 
 func (k *Keeper) HandleFailedUndelegate(ctx sdk.Context, msg sdk.Msg, memo string) error {
@@ -850,6 +1129,7 @@ func (k *Keeper) HandleFailedUndelegate(ctx sdk.Context, msg sdk.Msg, memo strin
 		return fmt.Errorf("cannot find unbonding record for %s/%s/%d", zone.ChainId, undelegateMsg.ValidatorAddress, epochNumber)
 	}
 
+	requeued := sdk.NewCoin(zone.LocalDenom, sdk.ZeroInt())
 	for _, hash := range ubr.RelatedTxhash {
 		wdr, found := k.GetWithdrawalRecord(ctx, zone.ChainId, hash, types.WithdrawStatusUnbond)
 		if !found {
@@ -905,11 +1185,20 @@ func (k *Keeper) HandleFailedUndelegate(ctx sdk.Context, msg sdk.Msg, memo strin
 			record.BurnAmount = record.BurnAmount.Add(sdk.NewCoin(zone.LocalDenom, relatedQAsset))
 		}
 		k.SetWithdrawalRecord(ctx, record)
+		requeued = requeued.AddAmount(relatedQAsset)
 	}
 
 	k.DeleteUnbondingRecord(ctx, zone.ChainId, undelegateMsg.ValidatorAddress, epochNumber)
 	k.Logger(ctx).Info("cleaning up unbonding record")
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventUndelegateFailed{
+		ChainId:          zone.ChainId,
+		Delegator:        undelegateMsg.DelegatorAddress,
+		Validator:        undelegateMsg.ValidatorAddress,
+		Amount:           undelegateMsg.Amount,
+		EpochNumber:      epochNumber,
+		WithdrawalHashes: ubr.RelatedTxhash,
+		RequeuedAmount:   requeued,
+	})
 }
 
 func (k *Keeper) HandleRedeemTokens(ctx sdk.Context, msg sdk.Msg, amount sdk.Coin, memo string, connectionID string) error {
@@ -929,6 +1218,21 @@ func (k *Keeper) HandleRedeemTokens(ctx sdk.Context, msg sdk.Msg, amount sdk.Coi
 		return fmt.Errorf("zone for delegate account %s not found", redeemMsg.DelegatorAddress)
 	}
 
+	event := types.EventRedeemTokens{
+		ChainId:   zone.ChainId,
+		Delegator: redeemMsg.DelegatorAddress,
+		Validator: validatorAddress,
+		Shares:    redeemMsg.Amount,
+		Amount:    amount,
+		Memo:      memo,
+	}
+	if !strings.HasPrefix(memo, "batch") {
+		event.ReceiptHash = memo
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		return err
+	}
+
 	switch {
 	case strings.HasPrefix(memo, "batch"):
 		k.Logger(ctx).Debug("batch delegation", "memo", memo, "tx", redeemMsg)
@@ -976,6 +1280,15 @@ func (k *Keeper) HandleFailedRedeemTokens(ctx sdk.Context, msg sdk.Msg, memo str
 		return fmt.Errorf("unable to find zone for address %s", redeemMsg.DelegatorAddress)
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRedeemTokensFailed{
+		ChainId:   zone.ChainId,
+		Delegator: redeemMsg.DelegatorAddress,
+		Shares:    redeemMsg.Amount,
+		Memo:      memo,
+	}); err != nil {
+		return err
+	}
+
 	switch {
 	case strings.HasPrefix(memo, "batch"):
 		k.Logger(ctx).Error("batch token redemption failed", "memo", memo, "tx", redeemMsg)
@@ -1015,6 +1328,21 @@ func (k *Keeper) HandleDelegate(ctx sdk.Context, msg sdk.Msg, memo string) error
 		}
 		return fmt.Errorf("unable to find zone for address %s", delegateMsg.DelegatorAddress)
 	}
+
+	event := types.EventDelegate{
+		ChainId:   zone.ChainId,
+		Delegator: delegateMsg.DelegatorAddress,
+		Validator: delegateMsg.ValidatorAddress,
+		Amount:    delegateMsg.Amount,
+		Memo:      memo,
+	}
+	if !isBatchOrRewards(memo) {
+		event.ReceiptHash = memo
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		return err
+	}
+
 	switch {
 	case memo == "rewards":
 	case strings.HasPrefix(memo, "batch"):
@@ -1069,6 +1397,16 @@ func (k *Keeper) HandleFailedDelegate(ctx sdk.Context, msg sdk.Msg, memo string)
 		return fmt.Errorf("unable to find zone for address %s", delegateMsg.DelegatorAddress)
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDelegateFailed{
+		ChainId:   zone.ChainId,
+		Delegator: delegateMsg.DelegatorAddress,
+		Validator: delegateMsg.ValidatorAddress,
+		Amount:    delegateMsg.Amount,
+		Memo:      memo,
+	}); err != nil {
+		return err
+	}
+
 	switch {
 	case strings.HasPrefix(memo, "batch"):
 		k.Logger(ctx).Error("batch delegation failed", "memo", memo, "tx", delegateMsg)
@@ -1296,7 +1634,7 @@ func (k *Keeper) UpdateDelegationRecordForAddress(
 	return nil
 }
 
-func (k *Keeper) HandleWithdrawRewards(ctx sdk.Context, msg sdk.Msg, connectionID string) error {
+func (k *Keeper) HandleWithdrawRewards(ctx sdk.Context, msg sdk.Msg, amount sdk.Coins, connectionID string) error {
 	withdrawalMsg, ok := msg.(*distrtypes.MsgWithdrawDelegatorReward)
 	if !ok {
 		k.Logger(ctx).Error("unable to cast source message to MsgWithdrawDelegatorReward")
@@ -1309,6 +1647,16 @@ func (k *Keeper) HandleWithdrawRewards(ctx sdk.Context, msg sdk.Msg, connectionI
 		k.Logger(ctx).Error(err.Error())
 		return err
 	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWithdrawRewards{
+		ChainId:   zone.ChainId,
+		Delegator: withdrawalMsg.DelegatorAddress,
+		Validator: withdrawalMsg.ValidatorAddress,
+		Amount:    amount,
+	}); err != nil {
+		return err
+	}
+
 	// decrement withdrawal waitgroup
 	// We are specifically looking for protocol delegator:validator pairs
 	// and must not decrement the waitgroup for the performance address as it
@@ -1333,6 +1681,27 @@ func (k *Keeper) HandleWithdrawRewards(ctx sdk.Context, msg sdk.Msg, connectionI
 	}
 }
 
+// HandleFailedWithdrawRewards handles a failed MsgWithdrawDelegatorReward acknowledgement. No state is changed, as
+// rewards are withdrawn again the next epoch.
+func (k *Keeper) HandleFailedWithdrawRewards(ctx sdk.Context, msg sdk.Msg, connectionID string) error {
+	withdrawalMsg, ok := msg.(*distrtypes.MsgWithdrawDelegatorReward)
+	if !ok {
+		return errors.New("unable to unmarshal MsgWithdrawDelegatorReward")
+	}
+	k.Logger(ctx).Error("failed to withdraw rewards; will try again next epoch", "validator", withdrawalMsg.ValidatorAddress)
+
+	zone, err := k.GetZoneFromConnectionID(ctx, connectionID)
+	if err != nil {
+		k.Logger(ctx).Error(err.Error())
+		return nil
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventWithdrawRewardsFailed{
+		ChainId:   zone.ChainId,
+		Delegator: withdrawalMsg.DelegatorAddress,
+		Validator: withdrawalMsg.ValidatorAddress,
+	})
+}
+
 func (k *Keeper) TriggerRedemptionRate(ctx sdk.Context, zone *types.Zone) error {
 	// interface assertion
 	balanceQuery := banktypes.QueryAllBalancesRequest{Address: zone.WithdrawalAddress.Address}
@@ -1362,6 +1731,15 @@ func DistributeRewardsFromWithdrawAccount(k *Keeper, ctx sdk.Context, args []byt
 		return fmt.Errorf("unable to find zone for %s", query.ChainId)
 	}
 
+	if k.IsCircuitBreakerTripped(ctx, zone.ChainId, types.CircuitBreakerActionRewards) {
+		return fmt.Errorf("reward distribution is paused for zone %s", zone.ChainId)
+	}
+
+	// the redemption rate is fixed while a zone winds down, so rewards are not distributed.
+	if k.IsZoneWindingDown(ctx, zone.ChainId) {
+		return fmt.Errorf("reward distribution is disabled for zone %s, which is winding down", zone.ChainId)
+	}
+
 	// query all balances as chains can accumulate fees in different denoms.
 	withdrawBalance := banktypes.QueryAllBalancesResponse{}
 
@@ -1385,8 +1763,11 @@ func DistributeRewardsFromWithdrawAccount(k *Keeper, ctx sdk.Context, args []byt
 		msgs = append(msgs, k.prepareRewardsDistributionMsgs(zone, rewards.Amount))
 	}
 
-	// multiDenomFee is the balance of withdrawal account minus the redelegated rewards.
-	multiDenomFee := withdrawBalance.Balances.Sub(sdk.Coins{rewards}...)
+	swapMsgs, routed := k.PrepareRewardSwapMsgs(ctx, &zone, withdrawBalance.Balances)
+
+	// multiDenomFee is the balance of withdrawal account minus the redelegated
+	// rewards and any balances routed to be swapped into the base denom.
+	multiDenomFee := withdrawBalance.Balances.Sub(sdk.Coins{rewards}...).Sub(routed...)
 
 	var remotePort string
 	var remoteChannel string
@@ -1422,7 +1803,13 @@ func DistributeRewardsFromWithdrawAccount(k *Keeper, ctx sdk.Context, args []byt
 	k.UpdateRedemptionRate(ctx, &zone, rewards.Amount)
 
 	// send tx
-	return k.SubmitTx(ctx, msgs, zone.WithdrawalAddress, "", zone.MessagesPerTx)
+	if err := k.SubmitTx(ctx, msgs, zone.WithdrawalAddress, "", zone.MessagesPerTx); err != nil {
+		return err
+	}
+
+	// each swap is submitted in a tx of its own, as a host chain tx fails as a whole; a swap that fails its minimum
+	// output must not revert the distribution of rewards already accounted for in the redemption rate, nor other swaps.
+	return k.SubmitTx(ctx, swapMsgs, zone.WithdrawalAddress, "", 1)
 }
 
 func (*Keeper) prepareRewardsDistributionMsgs(zone types.Zone, rewards sdkmath.Int) sdk.Msg {
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
@@ -285,7 +286,7 @@ func (suite *KeeperTestSuite) TestHandleQueuedUnbondings() {
 			expectError:      false,
 		},
 		{
-			name: "invalid - locked tokens",
+			name: "valid - locked tokens; split across epochs",
 			records: func(ctx sdk.Context, qs *app.Quicksilver, zone *types.Zone) []types.WithdrawalRecord {
 				vals := qs.InterchainstakingKeeper.GetValidatorAddresses(ctx, zone.ChainId)
 				return []types.WithdrawalRecord{
@@ -344,7 +345,7 @@ func (suite *KeeperTestSuite) TestHandleQueuedUnbondings() {
 					},
 				}
 			},
-			expectTransition: []bool{false},
+			expectTransition: []bool{true},
 			expectError:      false,
 		},
 		{
@@ -646,6 +647,23 @@ func (suite *KeeperTestSuite) TestHandleWithdrawForUser() {
 				}
 				return false
 			})
+
+			if !test.err {
+				for _, record := range records {
+					if record.Txhash != hash {
+						continue
+					}
+					suite.Equal(&types.EventWithdrawalCompleted{
+						ChainId:     zone.ChainId,
+						Hash:        record.Txhash,
+						Delegator:   record.Delegator,
+						Recipient:   record.Recipient,
+						Amount:      record.Amount,
+						BurnAmount:  record.BurnAmount,
+						EpochNumber: record.EpochNumber,
+					}, suite.lastTypedEvent(ctx, &types.EventWithdrawalCompleted{}))
+				}
+			}
 		})
 	}
 }
@@ -911,7 +929,7 @@ func (suite *KeeperTestSuite) TestHandleWithdrawRewards() {
 				}
 			}
 
-			err := quicksilver.InterchainstakingKeeper.HandleWithdrawRewards(ctx, test.msg(&zone), zone.ConnectionId)
+			err := quicksilver.InterchainstakingKeeper.HandleWithdrawRewards(ctx, test.msg(&zone), nil, zone.ConnectionId)
 			if test.err {
 				suite.Error(err)
 			} else {
@@ -1421,6 +1439,239 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate() {
 			}
 
 			suite.ElementsMatch(quicksilver.InterchainstakingKeeper.AllZoneWithdrawalRecords(ctx, suite.chainB.ChainID), test.expected(ctx, quicksilver))
+
+			failed := make([]*stakingtypes.MsgUndelegate, 0)
+			for _, tx := range txs {
+				for _, msg := range tx.msgs {
+					if undelegateMsg, ok := msg.(*stakingtypes.MsgUndelegate); ok && !tx.success {
+						failed = append(failed, undelegateMsg)
+					}
+				}
+			}
+			events := suite.typedEvents(ctx, &types.EventUndelegateFailed{})
+			suite.Len(events, len(failed))
+			for idx, event := range events {
+				undelegateFailed, ok := event.(*types.EventUndelegateFailed)
+				suite.True(ok)
+				suite.Equal(failed[idx].ValidatorAddress, undelegateFailed.Validator)
+				suite.Equal(failed[idx].Amount, undelegateFailed.Amount)
+				suite.True(undelegateFailed.RequeuedAmount.IsPositive())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleCancelUnbondingDelegation() {
+	user := addressutils.GenerateAddressForTestWithPrefix("quick")
+	beneficiary := addressutils.GenerateAddressForTestWithPrefix("cosmos")
+	hash := randomutils.GenerateRandomHashAsHex(32)
+	completion := time.Now().AddDate(0, 0, 21).UTC()
+
+	tests := []struct {
+		name                   string
+		redemptionRate         sdk.Dec
+		distribution           func(vals []string) []*types.Distribution
+		txs                    func(zone types.Zone, vals []string) []txAck
+		expected               func(vals []string) []types.WithdrawalRecord
+		expectedBalance        math.Int
+		expectedEscrow         math.Int
+		expectedUnbondingCount int
+	}{
+		{
+			name:           "single distribution, full cancellation",
+			redemptionRate: sdk.OneDec(),
+			distribution: func(vals []string) []*types.Distribution {
+				return []*types.Distribution{{Valoper: vals[0], Amount: 1000}}
+			},
+			txs: func(zone types.Zone, vals []string) []txAck {
+				return []txAck{
+					{
+						msgs: []sdk.Msg{
+							&stakingtypes.MsgCancelUnbondingDelegation{DelegatorAddress: zone.DelegationAddress.Address, ValidatorAddress: vals[0], Amount: sdk.NewCoin("uatom", math.NewInt(1000)), CreationHeight: 100},
+						},
+						memo:    types.TxCancelUnbondMemo(hash),
+						success: true,
+					},
+				}
+			},
+			expected: func(vals []string) []types.WithdrawalRecord {
+				return []types.WithdrawalRecord{}
+			},
+			// credit capped at the escrowed amount
+			expectedBalance:        math.NewInt(900),
+			expectedEscrow:         math.ZeroInt(),
+			expectedUnbondingCount: 0,
+		},
+		{
+			name:           "multi distribution, one acknowledged, credited at current rate",
+			redemptionRate: sdk.MustNewDecFromStr("1.25"),
+			distribution: func(vals []string) []*types.Distribution {
+				return []*types.Distribution{{Valoper: vals[0], Amount: 500}, {Valoper: vals[1], Amount: 500}}
+			},
+			txs: func(zone types.Zone, vals []string) []txAck {
+				return []txAck{
+					{
+						msgs: []sdk.Msg{
+							&stakingtypes.MsgCancelUnbondingDelegation{DelegatorAddress: zone.DelegationAddress.Address, ValidatorAddress: vals[0], Amount: sdk.NewCoin("uatom", math.NewInt(500)), CreationHeight: 100},
+						},
+						memo:    types.TxCancelUnbondMemo(hash),
+						success: true,
+					},
+				}
+			},
+			expected: func(vals []string) []types.WithdrawalRecord {
+				return []types.WithdrawalRecord{
+					{
+						ChainId:        suite.chainB.ChainID,
+						Delegator:      user,
+						Distribution:   []*types.Distribution{{Valoper: vals[1], Amount: 500}},
+						Recipient:      beneficiary,
+						Amount:         sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(500))),
+						BurnAmount:     sdk.NewCoin("uqatom", math.NewInt(450)),
+						Txhash:         hash,
+						Status:         types.WithdrawStatusCancel,
+						CompletionTime: completion,
+						Acknowledged:   true,
+						EpochNumber:    1,
+					},
+				}
+			},
+			// 500 / 1.25 = 400 credited; 50 burned.
+			expectedBalance:        math.NewInt(400),
+			expectedEscrow:         math.NewInt(450),
+			expectedUnbondingCount: 1,
+		},
+		{
+			name:           "multi distribution, both acknowledged",
+			redemptionRate: sdk.MustNewDecFromStr("1.25"),
+			distribution: func(vals []string) []*types.Distribution {
+				return []*types.Distribution{{Valoper: vals[0], Amount: 500}, {Valoper: vals[1], Amount: 500}}
+			},
+			txs: func(zone types.Zone, vals []string) []txAck {
+				return []txAck{
+					{
+						msgs: []sdk.Msg{
+							&stakingtypes.MsgCancelUnbondingDelegation{DelegatorAddress: zone.DelegationAddress.Address, ValidatorAddress: vals[0], Amount: sdk.NewCoin("uatom", math.NewInt(500)), CreationHeight: 100},
+							&stakingtypes.MsgCancelUnbondingDelegation{DelegatorAddress: zone.DelegationAddress.Address, ValidatorAddress: vals[1], Amount: sdk.NewCoin("uatom", math.NewInt(500)), CreationHeight: 100},
+						},
+						memo:    types.TxCancelUnbondMemo(hash),
+						success: true,
+					},
+				}
+			},
+			expected: func(vals []string) []types.WithdrawalRecord {
+				return []types.WithdrawalRecord{}
+			},
+			expectedBalance:        math.NewInt(800),
+			expectedEscrow:         math.ZeroInt(),
+			expectedUnbondingCount: 0,
+		},
+		{
+			name:           "failed acknowledgement reverts to unbond",
+			redemptionRate: sdk.OneDec(),
+			distribution: func(vals []string) []*types.Distribution {
+				return []*types.Distribution{{Valoper: vals[0], Amount: 1000}}
+			},
+			txs: func(zone types.Zone, vals []string) []txAck {
+				return []txAck{
+					{
+						msgs: []sdk.Msg{
+							&stakingtypes.MsgCancelUnbondingDelegation{DelegatorAddress: zone.DelegationAddress.Address, ValidatorAddress: vals[0], Amount: sdk.NewCoin("uatom", math.NewInt(1000)), CreationHeight: 100},
+						},
+						memo:    types.TxCancelUnbondMemo(hash),
+						success: false,
+					},
+				}
+			},
+			expected: func(vals []string) []types.WithdrawalRecord {
+				return []types.WithdrawalRecord{
+					{
+						ChainId:        suite.chainB.ChainID,
+						Delegator:      user,
+						Distribution:   []*types.Distribution{{Valoper: vals[0], Amount: 1000}},
+						Recipient:      beneficiary,
+						Amount:         sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(1000))),
+						BurnAmount:     sdk.NewCoin("uqatom", math.NewInt(900)),
+						Txhash:         hash,
+						Status:         types.WithdrawStatusUnbond,
+						CompletionTime: completion,
+						Acknowledged:   true,
+						EpochNumber:    1,
+					},
+				}
+			},
+			expectedBalance:        math.ZeroInt(),
+			expectedEscrow:         math.NewInt(900),
+			expectedUnbondingCount: 1,
+		},
+	}
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			suite.setupTestZones()
+
+			quicksilver := suite.GetQuicksilverApp(suite.chainA)
+			ctx := suite.chainA.GetContext()
+
+			zone, found := quicksilver.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+			suite.True(found)
+			zone.RedemptionRate = test.redemptionRate
+			quicksilver.InterchainstakingKeeper.SetZone(ctx, &zone)
+			vals := quicksilver.InterchainstakingKeeper.GetValidatorAddresses(ctx, zone.ChainId)
+
+			distribution := test.distribution(vals)
+			total := uint64(0)
+			for _, dist := range distribution {
+				total += dist.Amount
+				quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
+					ChainId:        zone.ChainId,
+					EpochNumber:    1,
+					Validator:      dist.Valoper,
+					RelatedTxhash:  []string{hash},
+					Amount:         sdk.NewCoin("uatom", math.NewIntFromUint64(dist.Amount)),
+					CompletionTime: completion,
+					CreationHeight: 100,
+				})
+			}
+
+			quicksilver.InterchainstakingKeeper.SetWithdrawalRecord(ctx, types.WithdrawalRecord{
+				ChainId:        zone.ChainId,
+				Delegator:      user,
+				Distribution:   distribution,
+				Recipient:      beneficiary,
+				Amount:         sdk.NewCoins(sdk.NewCoin("uatom", math.NewIntFromUint64(total))),
+				BurnAmount:     sdk.NewCoin("uqatom", math.NewInt(900)),
+				Txhash:         hash,
+				Status:         types.WithdrawStatusCancel,
+				CompletionTime: completion,
+				Acknowledged:   true,
+				EpochNumber:    1,
+			})
+
+			escrowed := sdk.NewCoins(sdk.NewCoin("uqatom", math.NewInt(900)))
+			suite.NoError(quicksilver.BankKeeper.MintCoins(ctx, types.ModuleName, escrowed))
+			suite.NoError(quicksilver.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.EscrowModuleAccount, escrowed))
+
+			for _, tx := range test.txs(zone, vals) {
+				packet, err := makePacketFromMsgs(quicksilver.AppCodec(), tx.msgs, tx.memo)
+				suite.NoError(err)
+				ack, err := makeAckForMsgs(ctx, quicksilver.AppCodec(), tx.msgs, tx.success)
+				suite.NoError(err)
+				bz, err := quicksilver.AppCodec().MarshalJSON(&ack)
+				suite.NoError(err)
+
+				err = quicksilver.InterchainstakingKeeper.HandleAcknowledgement(ctx, packet, bz, suite.chainB.ChainID)
+				suite.NoError(err)
+			}
+
+			suite.ElementsMatch(test.expected(vals), quicksilver.InterchainstakingKeeper.AllZoneWithdrawalRecords(ctx, zone.ChainId))
+
+			userAddr, err := addressutils.AccAddressFromBech32(user, "")
+			suite.NoError(err)
+			suite.Equal(test.expectedBalance, quicksilver.BankKeeper.GetBalance(ctx, userAddr, "uqatom").Amount)
+			escrowAddr := quicksilver.AccountKeeper.GetModuleAddress(types.EscrowModuleAccount)
+			suite.Equal(test.expectedEscrow, quicksilver.BankKeeper.GetBalance(ctx, escrowAddr, "uqatom").Amount)
+			suite.Len(quicksilver.InterchainstakingKeeper.AllZoneUnbondingRecords(ctx, zone.ChainId), test.expectedUnbondingCount)
 		})
 	}
 }
@@ -1453,6 +1704,15 @@ func makeAckForMsgs(ctx sdk.Context, cdc codec.Codec, msgs []sdk.Msg, success bo
 			// Append the response to the MsgData responses
 			msgData.MsgResponses = append(msgData.MsgResponses, respAny)
 		}
+
+		// MsgCancelUnbondingDelegation has an empty response
+		if actualMsg.TypeUrl == "/cosmos.staking.v1beta1.MsgCancelUnbondingDelegation" {
+			respAny, err := codectypes.NewAnyWithValue(&stakingtypes.MsgCancelUnbondingDelegationResponse{})
+			if err != nil {
+				return channeltypes.Acknowledgement{}, err
+			}
+			msgData.MsgResponses = append(msgData.MsgResponses, respAny)
+		}
 	}
 
 	// Marshal the msgData into bytes for the acknowledgement payload
@@ -1465,6 +1725,29 @@ func makeAckForMsgs(ctx sdk.Context, cdc codec.Codec, msgs []sdk.Msg, success bo
 	return channeltypes.NewResultAcknowledgement(bz), nil
 }
 
+// typedEvents returns the typed events emitted on ctx of the same type as event.
+func (suite *KeeperTestSuite) typedEvents(ctx sdk.Context, event proto.Message) []proto.Message {
+	var msgs []proto.Message
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		if abciEvent.Type != proto.MessageName(event) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		suite.Require().NoError(err)
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+// lastTypedEvent returns the last typed event emitted on ctx of the same type as event.
+func (suite *KeeperTestSuite) lastTypedEvent(ctx sdk.Context, event proto.Message) proto.Message {
+	msgs := suite.typedEvents(ctx, event)
+	if len(msgs) == 0 {
+		suite.FailNowf("event not found", "no %s event emitted", proto.MessageName(event))
+	}
+	return msgs[len(msgs)-1]
+}
+
 func makePacketFromMsgs(cdc codec.Codec, msgs []sdk.Msg, memo string) (channeltypes.Packet, error) {
 	data, err := icatypes.SerializeCosmosTx(cdc, msgs)
 	if err != nil {
@@ -1674,6 +1957,15 @@ func (suite *KeeperTestSuite) TestReceiveAckErrForBeginRedelegate() {
 
 	_, found = quicksilver.InterchainstakingKeeper.GetRedelegationRecord(ctx, zone.ChainId, validators[0].ValoperAddress, validators[1].ValoperAddress, 1)
 	suite.False(found)
+
+	suite.Equal(&types.EventRedelegateFailed{
+		ChainId:              zone.ChainId,
+		Delegator:            zone.DelegationAddress.Address,
+		SourceValidator:      validators[0].ValoperAddress,
+		DestinationValidator: validators[1].ValoperAddress,
+		Amount:               redelegate.Amount,
+		EpochNumber:          1,
+	}, suite.lastTypedEvent(ctx, &types.EventRedelegateFailed{}))
 }
 
 func (suite *KeeperTestSuite) TestRebalanceDueToIntentChange() {
@@ -2626,6 +2918,16 @@ func (suite *KeeperTestSuite) TestReceiveAckForBeginUndelegate() {
 			err = quicksilver.InterchainstakingKeeper.HandleAcknowledgement(ctx, packet, ackBytes, zone.ConnectionId)
 			suite.NoError(err)
 
+			events := suite.typedEvents(ctx, &types.EventUndelegate{})
+			suite.Len(events, len(msgs))
+			for idx, event := range events {
+				undelegate, ok := event.(*types.EventUndelegate)
+				suite.True(ok)
+				suite.Equal(msgs[idx].(*stakingtypes.MsgUndelegate).ValidatorAddress, undelegate.Validator)
+				suite.Equal(test.epoch, undelegate.EpochNumber)
+				suite.True(test.completionTime.Equal(undelegate.CompletionTime))
+			}
+
 			for idx, ewdr := range test.expectedWithdrawalRecords(ctx, quicksilver, zone) {
 				wdr, found := quicksilver.InterchainstakingKeeper.GetWithdrawalRecord(ctx, zone.ChainId, ewdr.Txhash, ewdr.Status)
 				suite.True(found)
@@ -2724,6 +3026,16 @@ func (suite *KeeperTestSuite) TestReceiveAckForBeginRedelegateNonNilCompletion()
 	suite.Equal(complete.Unix(), afterTarget.RedelegationEnd)
 	// target did not exist before redelegation
 	suite.Equal(redelegate.Amount, afterTarget.Amount)
+
+	suite.Equal(&types.EventRedelegate{
+		ChainId:              zone.ChainId,
+		Delegator:            zone.DelegationAddress.Address,
+		SourceValidator:      validators[0].ValoperAddress,
+		DestinationValidator: validators[1].ValoperAddress,
+		Amount:               redelegate.Amount,
+		EpochNumber:          1,
+		CompletionTime:       complete,
+	}, suite.lastTypedEvent(ctx, &types.EventRedelegate{}))
 }
 
 func (suite *KeeperTestSuite) TestReceiveAckForBeginRedelegateNilCompletion() {
@@ -2935,7 +3247,7 @@ func (suite *KeeperTestSuite) TestReceiveAckForWithdrawReward() {
 
 	packet := channeltypes.Packet{Data: quicksilver.InterchainstakingKeeper.GetCodec().MustMarshalJSON(&packetData)}
 
-	response := distrtypes.MsgWithdrawDelegatorRewardResponse{}
+	response := distrtypes.MsgWithdrawDelegatorRewardResponse{Amount: sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(500)))}
 
 	anyResponse, err := codectypes.NewAnyWithValue(&response)
 	suite.NoError(err)
@@ -2969,6 +3281,49 @@ func (suite *KeeperTestSuite) TestReceiveAckForWithdrawReward() {
 	}
 
 	suite.Equal(prevAllBalancesQueryCnt+1, allBalancesQueryCnt)
+
+	suite.Equal(&types.EventWithdrawRewards{
+		ChainId:   zone.ChainId,
+		Delegator: user,
+		Validator: val,
+		Amount:    response.Amount,
+	}, suite.lastTypedEvent(ctx, &types.EventWithdrawRewards{}))
+}
+
+func (suite *KeeperTestSuite) TestReceiveAckErrForWithdrawReward() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+
+	zone, found := quicksilver.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+	if !found {
+		suite.Fail("unable to retrieve zone for test")
+	}
+	zone.SetWithdrawalWaitgroup(quicksilver.Logger(), 1, "init")
+	quicksilver.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+	withdrawReward := &distrtypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: zone.DelegationAddress.Address,
+		ValidatorAddress: addressutils.GenerateValAddressForTest().String(),
+	}
+	packet, err := makePacketFromMsgs(quicksilver.AppCodec(), []sdk.Msg{withdrawReward}, "")
+	suite.NoError(err)
+
+	ackBytes := []byte("{\"error\":\"ABCI code: 32: error handling packet on host chain: see events for details\"}")
+	err = quicksilver.InterchainstakingKeeper.HandleAcknowledgement(ctx, packet, ackBytes, zone.ConnectionId)
+	suite.NoError(err)
+
+	// the waitgroup is untouched; rewards are withdrawn again next epoch.
+	zone, _ = quicksilver.InterchainstakingKeeper.GetZone(ctx, zone.ChainId)
+	suite.Equal(uint32(1), zone.GetWithdrawalWaitgroup())
+
+	suite.Equal(&types.EventWithdrawRewardsFailed{
+		ChainId:   zone.ChainId,
+		Delegator: withdrawReward.DelegatorAddress,
+		Validator: withdrawReward.ValidatorAddress,
+	}, suite.lastTypedEvent(ctx, &types.EventWithdrawRewardsFailed{}))
 }
 
 func (suite *KeeperTestSuite) TestReceiveAckForRedeemTokens() {
@@ -3040,6 +3395,16 @@ func (suite *KeeperTestSuite) TestReceiveAckForRedeemTokens() {
 	delegationRecord, found = quicksilver.InterchainstakingKeeper.GetDelegation(ctx, zone.ChainId, zone.DelegationAddress.Address, vals[0])
 	suite.True(found)
 	suite.Equal(delegationRecord.Amount, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1100)))
+
+	suite.Equal(&types.EventRedeemTokens{
+		ChainId:     zone.ChainId,
+		Delegator:   zone.DelegationAddress.Address,
+		Validator:   vals[0],
+		Shares:      redeemTokens.Amount,
+		Amount:      response.Amount,
+		ReceiptHash: txHash,
+		Memo:        txHash,
+	}, suite.lastTypedEvent(ctx, &types.EventRedeemTokens{}))
 }
 
 func (suite *KeeperTestSuite) TestReceiveAckForTokenizedShares() {
@@ -3200,6 +3565,15 @@ func (suite *KeeperTestSuite) TestReceiveAckForDelegate() {
 	delegation, found := quicksilver.InterchainstakingKeeper.GetDelegation(ctx, zone.ChainId, zone.DelegationAddress.Address, vals[0])
 	suite.True(found)
 	suite.Equal(delegation.Amount, sdk.NewCoin("uatom", sdk.NewInt(1000)))
+
+	suite.Equal(&types.EventDelegate{
+		ChainId:     zone.ChainId,
+		Delegator:   zone.DelegationAddress.Address,
+		Validator:   vals[0],
+		Amount:      withdrawReward.Amount,
+		ReceiptHash: txHash,
+		Memo:        txHash,
+	}, suite.lastTypedEvent(ctx, &types.EventDelegate{}))
 }
 
 func (suite *KeeperTestSuite) TestReceiveAckForBankSend() {
@@ -3251,6 +3625,14 @@ func (suite *KeeperTestSuite) TestReceiveAckForBankSend() {
 
 	err = quicksilver.InterchainstakingKeeper.HandleAcknowledgement(ctx, packet, ackBytes, zone.ConnectionId)
 	suite.NoError(err)
+
+	suite.Equal(&types.EventSend{
+		ChainId:     zone.ChainId,
+		FromAddress: withdrawReward.FromAddress,
+		ToAddress:   withdrawReward.ToAddress,
+		Amount:      withdrawReward.Amount,
+		Memo:        packetData.Memo,
+	}, suite.lastTypedEvent(ctx, &types.EventSend{}))
 }
 
 func (suite *KeeperTestSuite) TestReceiveAckErrForBankSend() {
@@ -3312,6 +3694,21 @@ func (suite *KeeperTestSuite) TestReceiveAckErrForBankSend() {
 		suite.Fail("unable to retrieve new withdrawal record for test")
 	}
 	suite.Equal(ctx.BlockTime().Add(types.DefaultWithdrawalRequeueDelay), newRecord.CompletionTime)
+
+	suite.Equal(&types.EventSendFailed{
+		ChainId:     zone.ChainId,
+		FromAddress: send.FromAddress,
+		Amount:      send.Amount,
+		Memo:        packetData.Memo,
+	}, suite.lastTypedEvent(ctx, &types.EventSendFailed{}))
+	suite.Equal(&types.EventWithdrawalSendFailed{
+		ChainId:        zone.ChainId,
+		Hash:           withdrawalRecord.Txhash,
+		Delegator:      withdrawalRecord.Delegator,
+		Recipient:      withdrawalRecord.Recipient,
+		Amount:         send.Amount,
+		CompletionTime: newRecord.CompletionTime,
+	}, suite.lastTypedEvent(ctx, &types.EventWithdrawalSendFailed{}))
 }
 
 func (suite *KeeperTestSuite) TestHandleMaturedUbondings() {
@@ -4757,6 +5154,44 @@ func (suite *KeeperTestSuite) TestHandleFailedDelegate_Batch_OK() {
 	zone, found = app.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
 	suite.True(found)
 	suite.Equal(uint32(99), zone.GetWithdrawalWaitgroup())
+
+	suite.Equal(&types.EventDelegateFailed{
+		ChainId:   zone.ChainId,
+		Delegator: msg.DelegatorAddress,
+		Validator: msg.ValidatorAddress,
+		Amount:    msg.Amount,
+		Memo:      "batch/12345678",
+	}, suite.lastTypedEvent(ctx, &types.EventDelegateFailed{}))
+}
+
+func (suite *KeeperTestSuite) TestHandleFailedRedeemTokens_Batch_OK() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	app := suite.GetQuicksilverApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+
+	zone, found := app.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	zone.SetWithdrawalWaitgroup(app.Logger(), 100, "init")
+	app.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+	vals := app.InterchainstakingKeeper.GetValidatorAddresses(ctx, suite.chainB.ChainID)
+	msg := lsmstakingtypes.MsgRedeemTokensForShares{DelegatorAddress: zone.DelegationAddress.Address, Amount: sdk.NewCoin(vals[0]+"/1", sdk.NewInt(100))}
+	err := app.InterchainstakingKeeper.HandleFailedRedeemTokens(ctx, &msg, "batch/12345678")
+	suite.NoError(err)
+
+	zone, found = app.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	suite.Equal(uint32(99), zone.GetWithdrawalWaitgroup())
+
+	suite.Equal(&types.EventRedeemTokensFailed{
+		ChainId:   zone.ChainId,
+		Delegator: msg.DelegatorAddress,
+		Shares:    msg.Amount,
+		Memo:      "batch/12345678",
+	}, suite.lastTypedEvent(ctx, &types.EventRedeemTokensFailed{}))
 }
 
 func (suite *KeeperTestSuite) TestHandleFailedDelegate_PerfAddress_OK() {
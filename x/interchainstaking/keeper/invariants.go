@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+const (
+	escrowInvariantName         = "escrow-withdrawal-records"
+	redemptionRateInvariantName = "redemption-rate-bounds"
+	supplyInvariantName         = "qasset-supply-delegations"
+)
+
+var (
+	// maxRedemptionRateIncrease and maxRedemptionRateDecrease mirror the per-epoch caps applied by
+	// UpdateRedemptionRate.
+	maxRedemptionRateIncrease = sdk.NewDecWithPrec(102, 2)
+	maxRedemptionRateDecrease = sdk.NewDecWithPrec(95, 2)
+)
+
+// RegisterInvariants registers all interchainstaking invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, escrowInvariantName, EscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, redemptionRateInvariantName, RedemptionRateInvariant(k))
+	ir.RegisterRoute(types.ModuleName, supplyInvariantName, SupplyInvariant(k))
+}
+
+// AllInvariants runs all registered invariants of the module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{EscrowInvariant(k), RedemptionRateInvariant(k), SupplyInvariant(k)} {
+			if msg, broken := invariant(ctx); broken {
+				return msg, broken
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "all", "\tall invariants hold"), false
+	}
+}
+
+// escrowedWithdrawalStatus returns true if records of the given status hold qAssets in the escrow account. Escrowed
+// qAssets are burned only once the native assets reach the recipient, so records remain escrowed until completion;
+// records awaiting the acknowledgement of a cancellation remain escrowed until it is returned.
+func escrowedWithdrawalStatus(status int32) bool {
+	switch status {
+	case types.WithdrawStatusTokenize, types.WithdrawStatusQueued, types.WithdrawStatusUnbond, types.WithdrawStatusSend, types.WithdrawStatusCancel:
+		return true
+	default:
+		return false
+	}
+}
+
+// EscrowInvariant checks that the escrow account holds exactly the qAssets burnable by incomplete withdrawal records.
+func EscrowInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		k.IterateWithdrawalRecords(ctx, func(_ int64, record types.WithdrawalRecord) (stop bool) {
+			if escrowedWithdrawalStatus(record.Status) {
+				expected = expected.Add(record.BurnAmount)
+			}
+			return false
+		})
+
+		escrowed := k.BankKeeper.GetAllBalances(ctx, k.AccountKeeper.GetModuleAddress(types.EscrowModuleAccount))
+		var msg string
+		broken := false
+		k.IterateZones(ctx, func(_ int64, zone *types.Zone) (stop bool) {
+			if !escrowed.AmountOf(zone.LocalDenom).Equal(expected.AmountOf(zone.LocalDenom)) {
+				msg += fmt.Sprintf("\tzone %s: escrowed %s%s, withdrawal records %s%s\n", zone.ChainId, escrowed.AmountOf(zone.LocalDenom), zone.LocalDenom, expected.AmountOf(zone.LocalDenom), zone.LocalDenom)
+				broken = true
+			}
+			return false
+		})
+
+		if broken {
+			return sdk.FormatInvariant(types.ModuleName, escrowInvariantName, "escrow balance does not match withdrawal records\n"+msg), true
+		}
+		return sdk.FormatInvariant(types.ModuleName, escrowInvariantName, "\tescrow balances match withdrawal records"), false
+	}
+}
+
+// RedemptionRateInvariant checks that redemption rates are positive for zones with issued qAssets, and that the
+// current rate has not moved beyond the per-epoch caps relative to the last rate. As UpdateRedemptionRate caps the
+// ratio of the new rate to the last, the check is made on that ratio, tolerating rates set to exactly the capped
+// value. A rate of exactly one is permitted regardless, as the rate is reset to one when all qAssets are redeemed, as
+// is a rate set by OverrideRedemptionRateNoCap, until the next capped update.
+func RedemptionRateInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		k.IterateZones(ctx, func(_ int64, zone *types.Zone) (stop bool) {
+			if zone.RedemptionRate.IsNil() || zone.LastRedemptionRate.IsNil() || zone.RedemptionRate.IsNegative() || zone.LastRedemptionRate.IsNegative() {
+				msg += fmt.Sprintf("\tzone %s: invalid redemption rates %s (last %s)\n", zone.ChainId, zone.RedemptionRate, zone.LastRedemptionRate)
+				broken = true
+				return false
+			}
+
+			if zone.RedemptionRate.IsZero() {
+				if k.BankKeeper.GetSupply(ctx, zone.LocalDenom).IsPositive() {
+					msg += fmt.Sprintf("\tzone %s: zero redemption rate with outstanding qAssets\n", zone.ChainId)
+					broken = true
+				}
+				return false
+			}
+
+			if zone.LastRedemptionRate.IsZero() || zone.RedemptionRate.Equal(sdk.OneDec()) || zone.RedemptionRateUncapped {
+				return false
+			}
+
+			delta := zone.RedemptionRate.Quo(zone.LastRedemptionRate)
+			if (delta.GT(maxRedemptionRateIncrease) && zone.RedemptionRate.GT(zone.LastRedemptionRate.Mul(maxRedemptionRateIncrease))) ||
+				(delta.LT(maxRedemptionRateDecrease) && zone.RedemptionRate.LT(zone.LastRedemptionRate.Mul(maxRedemptionRateDecrease))) {
+				msg += fmt.Sprintf("\tzone %s: redemption rate %s out of bounds of last rate %s\n", zone.ChainId, zone.RedemptionRate, zone.LastRedemptionRate)
+				broken = true
+			}
+			return false
+		})
+
+		if broken {
+			return sdk.FormatInvariant(types.ModuleName, redemptionRateInvariantName, "redemption rates out of bounds\n"+msg), true
+		}
+		return sdk.FormatInvariant(types.ModuleName, redemptionRateInvariantName, "\tredemption rates within bounds"), false
+	}
+}
+
+// SupplyInvariant checks that the native assets claimable by qAsset holders at the current redemption rate are
+// backed by the zone's delegations, deposits in process, unbondings and unbonded balance. qAssets of records whose
+// native assets are already in transit to the recipient are excluded. Rewards are reflected in the redemption rate
+// ahead of being delegated, and a slash only once the redemption rate is next updated, at most 5% at a time, so a
+// shortfall of up to 5% of the claim plus the losses recorded by the zone's slash records is tolerated.
+func SupplyInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		inTransit := sdk.NewCoins()
+		k.IterateWithdrawalRecords(ctx, func(_ int64, record types.WithdrawalRecord) (stop bool) {
+			if record.Status == types.WithdrawStatusTokenize || record.Status == types.WithdrawStatusSend {
+				inTransit = inTransit.Add(record.BurnAmount)
+			}
+			return false
+		})
+
+		var msg string
+		broken := false
+		k.IterateZones(ctx, func(_ int64, zone *types.Zone) (stop bool) {
+			supply := k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount.Sub(inTransit.AmountOf(zone.LocalDenom))
+			if !supply.IsPositive() || zone.RedemptionRate.IsNil() || !zone.RedemptionRate.IsPositive() {
+				return false
+			}
+
+			backing := k.GetDelegatedAmount(ctx, zone).Amount.Add(k.GetDelegationsInProcess(ctx, zone.ChainId))
+			unbonding, _ := k.GetUnbondingTokensAndCount(ctx, zone)
+			backing = backing.Add(unbonding.Amount)
+			if zone.DelegationAddress != nil {
+				backing = backing.Add(zone.DelegationAddress.Balance.AmountOf(zone.BaseDenom))
+			}
+
+			slashed := sdk.ZeroInt()
+			k.IterateZoneSlashRecords(ctx, zone.ChainId, "", func(_ int64, record types.SlashRecord) (stop bool) {
+				slashed = slashed.Add(record.Loss().Amount)
+				return false
+			})
+
+			claimable := sdk.NewDecFromInt(supply).Mul(zone.RedemptionRate)
+			if claimable.Mul(maxRedemptionRateDecrease).GT(sdk.NewDecFromInt(backing.Add(slashed))) {
+				msg += fmt.Sprintf("\tzone %s: qAssets claim %s%s, backed by %s%s, with recorded slash losses of %s%s\n", zone.ChainId, claimable.TruncateInt(), zone.BaseDenom, backing, zone.BaseDenom, slashed, zone.BaseDenom)
+				broken = true
+			}
+			return false
+		})
+
+		if broken {
+			return sdk.FormatInvariant(types.ModuleName, supplyInvariantName, "qAsset supply exceeds zone backing\n"+msg), true
+		}
+		return sdk.FormatInvariant(types.ModuleName, supplyInvariantName, "\tqAsset supply backed by zone assets"), false
+	}
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+func (suite *KeeperTestSuite) TestEscrowInvariant() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+	invariant := keeper.EscrowInvariant(icsKeeper)
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	_, broken := invariant(ctx)
+	suite.False(broken)
+
+	user := addressutils.GenerateAccAddressForTest()
+	suite.mintQAssets(ctx, zone, user, 1000)
+	suite.NoError(quicksilver.BankKeeper.SendCoinsFromAccountToModule(ctx, user, icstypes.EscrowModuleAccount, sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdkmath.NewInt(700)))))
+
+	// escrowed qAssets without records.
+	_, broken = invariant(ctx)
+	suite.True(broken)
+
+	record := icstypes.WithdrawalRecord{
+		ChainId:    zone.ChainId,
+		Delegator:  user.String(),
+		Recipient:  addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix),
+		Amount:     sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(500))),
+		BurnAmount: sdk.NewCoin(zone.LocalDenom, sdkmath.NewInt(500)),
+		Txhash:     "a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90",
+		Status:     icstypes.WithdrawStatusQueued,
+	}
+	icsKeeper.SetWithdrawalRecord(ctx, record)
+	_, broken = invariant(ctx)
+	suite.True(broken)
+
+	// records remain escrowed until completion.
+	record.Txhash = "b1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90"
+	record.BurnAmount = sdk.NewCoin(zone.LocalDenom, sdkmath.NewInt(200))
+	record.Status = icstypes.WithdrawStatusSend
+	icsKeeper.SetWithdrawalRecord(ctx, record)
+	_, broken = invariant(ctx)
+	suite.False(broken)
+
+	// including while a cancellation awaits acknowledgement.
+	sending := record
+	icsKeeper.UpdateWithdrawalRecordStatus(ctx, &sending, icstypes.WithdrawStatusCancel)
+	_, broken = invariant(ctx)
+	suite.False(broken)
+
+	record.Txhash = "c1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90"
+	record.Status = icstypes.WithdrawStatusCompleted
+	icsKeeper.SetWithdrawalRecord(ctx, record)
+	_, broken = invariant(ctx)
+	suite.False(broken)
+}
+
+func (suite *KeeperTestSuite) TestRedemptionRateInvariant() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+	invariant := keeper.RedemptionRateInvariant(icsKeeper)
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	tests := []struct {
+		name     string
+		rate     sdk.Dec
+		lastRate sdk.Dec
+		uncapped bool
+		supply   int64
+		broken   bool
+	}{
+		{"unchanged", sdk.OneDec(), sdk.OneDec(), false, 0, false},
+		{"within upper cap", sdk.MustNewDecFromStr("1.122"), sdk.MustNewDecFromStr("1.1"), false, 0, false},
+		{"above upper cap", sdk.MustNewDecFromStr("1.1221"), sdk.MustNewDecFromStr("1.1"), false, 0, true},
+		{"capped upwards", sdk.MustNewDecFromStr("0.333333333333333333").Mul(sdk.NewDecWithPrec(102, 2)), sdk.MustNewDecFromStr("0.333333333333333333"), false, 0, false},
+		{"within lower cap", sdk.MustNewDecFromStr("1.045"), sdk.MustNewDecFromStr("1.1"), false, 0, false},
+		{"below lower cap", sdk.MustNewDecFromStr("1.0449"), sdk.MustNewDecFromStr("1.1"), false, 0, true},
+		{"capped downwards", sdk.MustNewDecFromStr("0.333333333333333333").Mul(sdk.NewDecWithPrec(95, 2)), sdk.MustNewDecFromStr("0.333333333333333333"), false, 0, false},
+		{"reset to one", sdk.OneDec(), sdk.MustNewDecFromStr("1.1"), false, 0, false},
+		{"overridden without caps", sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("1.1"), true, 0, false},
+		{"negative", sdk.MustNewDecFromStr("-1"), sdk.OneDec(), false, 0, true},
+		{"zero without supply", sdk.ZeroDec(), sdk.ZeroDec(), false, 0, false},
+		{"zero with supply", sdk.ZeroDec(), sdk.ZeroDec(), false, 1000, true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			ctx, _ := ctx.CacheContext()
+			zone.RedemptionRate = tt.rate
+			zone.LastRedemptionRate = tt.lastRate
+			zone.RedemptionRateUncapped = tt.uncapped
+			icsKeeper.SetZone(ctx, &zone)
+			if tt.supply > 0 {
+				suite.mintQAssets(ctx, zone, addressutils.GenerateAccAddressForTest(), tt.supply)
+			}
+
+			_, broken := invariant(ctx)
+			suite.Equal(tt.broken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSupplyInvariant() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+	invariant := keeper.SupplyInvariant(icsKeeper)
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	zone.RedemptionRate = sdk.MustNewDecFromStr("1.2")
+	icsKeeper.SetZone(ctx, &zone)
+
+	// 1000 qAssets claim 1200 native assets.
+	suite.mintQAssets(ctx, zone, addressutils.GenerateAccAddressForTest(), 1000)
+	_, broken := invariant(ctx)
+	suite.True(broken)
+
+	// delegations and receipts in process back the claim; 1140 is within the tolerated 5% shortfall.
+	vals := icsKeeper.GetValidators(ctx, zone.ChainId)
+	icsKeeper.SetDelegation(ctx, zone.ChainId, icstypes.NewDelegation(zone.DelegationAddress.Address, vals[0].ValoperAddress, sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(1000))))
+	now := ctx.BlockTime()
+	icsKeeper.SetReceipt(ctx, icstypes.Receipt{ChainId: zone.ChainId, Sender: addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix), Txhash: "d1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90", Amount: sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(139))), FirstSeen: &now})
+	_, broken = invariant(ctx)
+	suite.True(broken)
+
+	icsKeeper.SetReceipt(ctx, icstypes.Receipt{ChainId: zone.ChainId, Sender: addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix), Txhash: "e1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90", Amount: sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(1))), FirstSeen: &now})
+	_, broken = invariant(ctx)
+	suite.False(broken)
+
+	// completed receipts no longer count as in process.
+	completed := now.Add(time.Minute)
+	icsKeeper.SetReceipt(ctx, icstypes.Receipt{ChainId: zone.ChainId, Sender: addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix), Txhash: "e1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90", Amount: sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(1))), FirstSeen: &now, Completed: &completed})
+	_, broken = invariant(ctx)
+	suite.True(broken)
+
+	// qAssets of records in transit to the recipient are excluded from the supply.
+	icsKeeper.SetWithdrawalRecord(ctx, icstypes.WithdrawalRecord{
+		ChainId:    zone.ChainId,
+		Delegator:  addressutils.GenerateAccAddressForTest().String(),
+		Recipient:  addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix),
+		Amount:     sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(120))),
+		BurnAmount: sdk.NewCoin(zone.LocalDenom, sdkmath.NewInt(100)),
+		Txhash:     "f1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90",
+		Status:     icstypes.WithdrawStatusSend,
+	})
+	_, broken = invariant(ctx)
+	suite.False(broken)
+
+	// a recorded slash loss covers a shortfall beyond 5% until reflected in the redemption rate.
+	icsKeeper.SetDelegation(ctx, zone.ChainId, icstypes.NewDelegation(zone.DelegationAddress.Address, vals[0].ValoperAddress, sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(800))))
+	_, broken = invariant(ctx)
+	suite.True(broken)
+
+	icsKeeper.SetSlashRecord(ctx, icstypes.SlashRecord{
+		ChainId:        zone.ChainId,
+		Validator:      vals[0].ValoperAddress,
+		Height:         ctx.BlockHeight(),
+		Time:           ctx.BlockTime(),
+		Fraction:       sdk.NewDecWithPrec(2, 1),
+		ExpectedAmount: sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(1000)),
+		ReportedAmount: sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(800)),
+		Reconciled:     true,
+	})
+	_, broken = invariant(ctx)
+	suite.False(broken)
+}
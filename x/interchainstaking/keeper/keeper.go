@@ -340,6 +340,14 @@ func (k *Keeper) SetValidatorForZone(ctx sdk.Context, zone *types.Zone, data []b
 	if err != nil {
 		return err
 	}
+
+	// record the consensus address of every validator, not just those jailed,
+	// so that signing info queried for validator scoring may be attributed.
+	var pk cryptotypes.PubKey
+	if err := k.cdc.UnpackAny(validator.ConsensusPubkey, &pk); err == nil && pk != nil {
+		k.SetValidatorAddrByConsAddr(ctx, zone.ChainId, validator.OperatorAddress, sdk.ConsAddress(pk.Address().Bytes()))
+	}
+
 	val, found := k.GetValidator(ctx, zone.ChainId, valAddrBytes)
 	if !found {
 		k.Logger(ctx).Debug("Unable to find validator - adding...", "valoper", validator.OperatorAddress)
@@ -409,14 +417,6 @@ func (k *Keeper) SetValidatorForZone(ctx sdk.Context, zone *types.Zone, data []b
 			if !validator.Tokens.IsPositive() {
 				return fmt.Errorf("incoming voting power must be greater than zero, received %s", validator.Tokens)
 			}
-			// determine difference between previous vp/shares ratio and new ratio.
-			prevRatio := val.DelegatorShares.Quo(sdk.NewDecFromInt(val.VotingPower))
-			newRatio := validator.DelegatorShares.Quo(sdk.NewDecFromInt(validator.Tokens))
-			delta := newRatio.Quo(prevRatio)
-			err = k.UpdateWithdrawalRecordsForSlash(ctx, zone, val.ValoperAddress, delta)
-			if err != nil {
-				return err
-			}
 		} else if val.Jailed && !validator.IsJailed() {
 			k.Logger(ctx).Debug("Transitioning validator to unjailed state", "valoper", validator.OperatorAddress)
 
@@ -424,6 +424,11 @@ func (k *Keeper) SetValidatorForZone(ctx sdk.Context, zone *types.Zone, data []b
 			val.JailedSince = time.Time{}
 		}
 
+		// compare against the stored state before it is overwritten below.
+		if err := k.DetectValidatorSlash(ctx, zone, val, validator); err != nil {
+			return err
+		}
+
 		if !val.CommissionRate.Equal(validator.GetCommission()) {
 			k.Logger(ctx).Debug("Validator commission rate change; updating...", "valoper", validator.OperatorAddress, "oldRate", val.CommissionRate, "newRate", validator.GetCommission())
 			val.CommissionRate = validator.GetCommission()
@@ -502,13 +507,17 @@ func (k *Keeper) GetCommissionRate(ctx sdk.Context) sdk.Dec {
 	return out
 }
 
-// MigrateParams fetches params, adds ClaimsEnabled field and re-sets params.
+// MigrateParams fetches params, defaults any fields added since they were set and re-sets params.
 func (k *Keeper) MigrateParams(ctx sdk.Context) {
 	params := types.Params{}
 	params.DepositInterval = k.GetParam(ctx, types.KeyDepositInterval)
 	params.CommissionRate = k.GetCommissionRate(ctx)
 	params.ValidatorsetInterval = k.GetParam(ctx, types.KeyValidatorSetInterval)
-	params.UnbondingEnabled = false
+	params.UnbondingEnabled = k.GetUnbondingEnabled(ctx)
+	params.ZoneHistoryRetentionEpochs = types.DefaultZoneHistoryRetentionEpochs
+	if k.paramStore.Has(ctx, types.KeyZoneHistoryRetentionEpochs) {
+		params.ZoneHistoryRetentionEpochs = k.GetParam(ctx, types.KeyZoneHistoryRetentionEpochs)
+	}
 
 	k.paramStore.SetParamSet(ctx, &params)
 }
@@ -675,19 +684,24 @@ func (k *Keeper) UpdateRedemptionRate(ctx sdk.Context, zone *types.Zone, epochRe
 	k.Logger(ctx).Info("Redemption Rate Update", "chain", zone.ChainId, "epochly_rewards", epochRewards, "last_rate", zone.LastRedemptionRate, "current_rate", zone.RedemptionRate, "new_rate", ratio, "supply", k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount, "lv", k.GetDelegatedAmount(ctx, zone).Amount.Add(epochRewards).Add(delegationsInProcess))
 
 	// TODO: make max deltas params.
-	// soft cap redemption rate, instead of panicking.
+	// soft cap redemption rate, instead of panicking, and pause deposits and
+	// redemptions until governance has reviewed the anomaly.
 	delta := ratio.Quo(zone.RedemptionRate)
 	if delta.GT(sdk.NewDecWithPrec(102, 2)) {
 		k.Logger(ctx).Error("ratio diverged by more than 2% upwards in the last epoch; capping at 1.02...")
+		k.tripRedemptionRateCircuitBreaker(ctx, zone, ratio)
 		ratio = zone.RedemptionRate.Mul(sdk.NewDecWithPrec(102, 2))
 	} else if delta.LT(sdk.NewDecWithPrec(95, 2)) && !isZero { // we allow a bigger downshift if all assets were withdrawn and we revert to zero.
 		k.Logger(ctx).Error("ratio diverged by more than 5% downwards in the last epoch; 5% is the theoretical max if _all_ controlled tokens were tombstoned. capping at 0.95...")
+		k.tripRedemptionRateCircuitBreaker(ctx, zone, ratio)
 		ratio = zone.RedemptionRate.Mul(sdk.NewDecWithPrec(95, 2))
 	}
 
 	zone.LastRedemptionRate = zone.RedemptionRate
 	zone.RedemptionRate = ratio
+	zone.RedemptionRateUncapped = false
 	k.SetZone(ctx, zone)
+	k.RecordZoneSnapshot(ctx, zone)
 }
 
 func (k *Keeper) OverrideRedemptionRateNoCap(ctx sdk.Context, zone *types.Zone) {
@@ -697,6 +711,8 @@ func (k *Keeper) OverrideRedemptionRateNoCap(ctx sdk.Context, zone *types.Zone)
 
 	zone.LastRedemptionRate = zone.RedemptionRate
 	zone.RedemptionRate = ratio
+	// the rate is not bound by the per-epoch caps relative to the last rate; see RedemptionRateInvariant.
+	zone.RedemptionRateUncapped = true
 	k.SetZone(ctx, zone)
 }
 
@@ -731,6 +747,7 @@ func (k *Keeper) GetAggregateIntentOrDefault(ctx sdk.Context, zone *types.Zone)
 	}
 
 	jailedThreshold := k.EpochsKeeper.GetEpochInfo(ctx, "epoch").Duration * 2
+	slashTimes := k.LatestSlashTimes(ctx, zone.ChainId)
 
 	// filter intents here...
 	// check validators for tombstoned
@@ -759,6 +776,14 @@ func (k *Keeper) GetAggregateIntentOrDefault(ctx sdk.Context, zone *types.Zone)
 		// if in deny list {
 		// continue
 		// }
+
+		// de-weight validators slashed within the unbonding period; copy, as intents may be shared with the zone.
+		if slashTime, ok := slashTimes[validatorIntent.ValoperAddress]; ok && slashTime.Add(time.Duration(zone.UnbondingPeriod)).After(ctx.BlockTime()) {
+			validatorIntent = &types.ValidatorIntent{
+				ValoperAddress: validatorIntent.ValoperAddress,
+				Weight:         validatorIntent.Weight.Mul(types.SlashedValidatorWeightMultiplier),
+			}
+		}
 		filteredIntents = append(filteredIntents, validatorIntent)
 	}
 
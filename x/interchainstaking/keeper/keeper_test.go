@@ -561,6 +561,7 @@ func (suite *KeeperTestSuite) TestUpdateRedemptionRate() {
 	zone, found = icsKeeper.GetZone(ctx, suite.chainB.ChainID)
 	suite.True(found)
 	suite.Equal(sdk.NewDecWithPrec(101, 2), zone.RedemptionRate)
+	suite.False(icsKeeper.IsCircuitBreakerTripped(ctx, zone.ChainId, icstypes.CircuitBreakerActionDeposits))
 
 	// add >2%; cap at 2%
 	icsKeeper.UpdateRedemptionRate(ctx, &zone, sdk.NewInt(500))
@@ -575,6 +576,15 @@ func (suite *KeeperTestSuite) TestUpdateRedemptionRate() {
 	// should be capped at 2% increase. (1.01*1.02 == 1.0302)
 	suite.Equal(sdk.NewDecWithPrec(10302, 4), zone.RedemptionRate)
 
+	// capping trips the circuit breaker for deposits and redemptions.
+	cb, found := icsKeeper.GetCircuitBreaker(ctx, zone.ChainId)
+	suite.True(found)
+	suite.Len(cb.Trips, 2)
+	suite.True(cb.IsTripped(icstypes.CircuitBreakerActionDeposits))
+	suite.True(cb.IsTripped(icstypes.CircuitBreakerActionRedemptions))
+	suite.False(cb.IsTripped(icstypes.CircuitBreakerActionICA))
+	suite.Equal(icstypes.CircuitBreakerAutoTrip, cb.Trips[0].TrippedBy)
+
 	// add nothing, still cap at 2%
 	icsKeeper.UpdateRedemptionRate(ctx, &zone, sdk.ZeroInt())
 	zone, found = icsKeeper.GetZone(ctx, suite.chainB.ChainID)
@@ -639,6 +649,7 @@ func (suite *KeeperTestSuite) TestOverrideRedemptionRateNoCap() {
 	zone, found = icsKeeper.GetZone(ctx, suite.chainB.ChainID)
 	suite.True(found)
 	suite.Equal(sdk.NewDecWithPrec(101, 2), zone.RedemptionRate)
+	suite.False(icsKeeper.IsCircuitBreakerTripped(ctx, zone.ChainId, icstypes.CircuitBreakerActionDeposits))
 
 	// add >2%; no cap
 	delegationA.Amount.Amount = delegationA.Amount.Amount.AddRaw(166)
@@ -670,6 +681,14 @@ func (suite *KeeperTestSuite) TestOverrideRedemptionRateNoCap() {
 	suite.True(found)
 
 	suite.Equal(sdk.NewDecWithPrec(676666666666666667, 18), zone.RedemptionRate)
+	suite.True(zone.RedemptionRateUncapped)
+
+	// the next capped update is bound by the overridden rate.
+	icsKeeper.UpdateRedemptionRate(ctx, &zone, sdk.ZeroInt())
+	zone, found = icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	suite.Equal(sdk.NewDecWithPrec(676666666666666667, 18), zone.LastRedemptionRate)
+	suite.False(zone.RedemptionRateUncapped)
 }
 
 func (suite *KeeperTestSuite) TestIteratePortConnection() {
@@ -47,6 +47,10 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 		return nil, fmt.Errorf("unbonding currently disabled for zone %s", zone.ChainId)
 	}
 
+	if k.Keeper.IsCircuitBreakerTripped(ctx, zone.ChainId, types.CircuitBreakerActionRedemptions) {
+		return nil, fmt.Errorf("redemptions are paused for zone %s", zone.ChainId)
+	}
+
 	// does destination address match the prefix registered against the zone?
 	if _, err := addressutils.AccAddressFromBech32(msg.DestinationAddress, zone.AccountPrefix); err != nil {
 		return nil, fmt.Errorf("destination address %s does not match expected prefix %s [%w]", msg.DestinationAddress, zone.AccountPrefix, err)
@@ -54,8 +58,12 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 
 	sender, _ := sdk.AccAddressFromBech32(msg.FromAddress) // already validated
 
-	// does the user have sufficient assets to burn
-	if !k.BankKeeper.HasBalance(ctx, sender, msg.Value) {
+	// does the user have sufficient assets to burn, including any priority fee
+	required := msg.Value
+	if msg.PriorityFee != nil {
+		required = required.Add(*msg.PriorityFee)
+	}
+	if !k.BankKeeper.HasBalance(ctx, sender, required) {
 		return nil, errors.New("account has insufficient balance of qasset to burn")
 	}
 
@@ -68,10 +76,25 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 		return nil, fmt.Errorf("unable to send coins to escrow account: %w", err)
 	}
 
-	if err := k.queueRedemption(ctx, zone, sender, msg.DestinationAddress, msg.Value, hashString); err != nil {
+	// the priority fee is burned immediately, accruing to the remaining holders via the redemption rate.
+	if msg.PriorityFee != nil {
+		if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.EscrowModuleAccount, sdk.NewCoins(*msg.PriorityFee)); err != nil {
+			return nil, fmt.Errorf("unable to send priority fee to escrow account: %w", err)
+		}
+		if err := k.BankKeeper.BurnCoins(ctx, types.EscrowModuleAccount, sdk.NewCoins(*msg.PriorityFee)); err != nil {
+			return nil, fmt.Errorf("unable to burn priority fee: %w", err)
+		}
+	}
+
+	if err := k.queueRedemption(ctx, zone, sender, msg.DestinationAddress, msg.Value, msg.PriorityFee, hashString); err != nil {
 		return nil, fmt.Errorf("unable to queue redemption: %w", err)
 	}
 
+	priorityFee := ""
+	if msg.PriorityFee != nil {
+		priorityFee = msg.PriorityFee.String()
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -82,27 +105,58 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 			sdk.NewAttribute(types.AttributeKeyBurnAmount, msg.Value.String()),
 			sdk.NewAttribute(types.AttributeKeyRecipientAddress, msg.DestinationAddress),
 			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyPriorityFee, priorityFee),
 		),
 	})
 
 	return &types.MsgRequestRedemptionResponse{}, nil
 }
 
+// CancelRedemption handles MsgCancelQueuedRedemption. Queued records may be cancelled in full or in part, with the
+// escrowed qAssets returned immediately. Unbonding records are cancelled on the host chain, with qAssets credited
+// back upon acknowledgement.
 func (k msgServer) CancelRedemption(goCtx context.Context, msg *types.MsgCancelQueuedRedemption) (*types.MsgCancelQueuedRedemptionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	record, found := k.GetWithdrawalRecord(ctx, msg.ChainId, msg.Hash, types.WithdrawStatusQueued)
-
 	if !found {
-		return nil, fmt.Errorf("no queued record with hash \"%s\" found", msg.Hash)
+		return k.cancelUnbondingRedemption(ctx, msg)
 	}
 
 	if record.Delegator != msg.FromAddress {
 		return nil, fmt.Errorf("incorrect user for record with hash \"%s\"", msg.Hash)
 	}
 
-	// all good. delete!
-	k.DeleteWithdrawalRecord(ctx, msg.ChainId, msg.Hash, types.WithdrawStatusQueued)
+	returned := record.BurnAmount
+	if msg.Amount != nil {
+		if msg.Amount.Denom != record.BurnAmount.Denom {
+			return nil, fmt.Errorf("cancellation denom %s does not match record denom %s", msg.Amount.Denom, record.BurnAmount.Denom)
+		}
+		if record.BurnAmount.IsLT(*msg.Amount) {
+			return nil, fmt.Errorf("cancellation amount %s exceeds queued amount %s", msg.Amount, record.BurnAmount)
+		}
+		returned = *msg.Amount
+	}
+
+	if returned.IsLT(record.BurnAmount) {
+		// partial cancellation; leave the remainder queued, with the priority fee reduced in proportion so the
+		// remainder does not keep the priority paid for the whole record.
+		remaining := record.BurnAmount.Sub(returned)
+		if record.PriorityFee != nil {
+			remainingFee := sdk.NewDecFromInt(record.PriorityFee.Amount).MulInt(remaining.Amount).QuoInt(record.BurnAmount.Amount).TruncateInt()
+			if remainingFee.IsPositive() {
+				fee := sdk.NewCoin(record.PriorityFee.Denom, remainingFee)
+				record.PriorityFee = &fee
+			} else {
+				record.PriorityFee = nil
+			}
+		}
+		record.BurnAmount = remaining
+		k.SetWithdrawalRecord(ctx, record)
+	} else {
+		// all good. delete!
+		k.DeleteWithdrawalRecord(ctx, msg.ChainId, msg.Hash, types.WithdrawStatusQueued)
+	}
 
 	userAccAddress, err := addressutils.AddressFromBech32(record.Delegator, "")
 	if err != nil {
@@ -110,7 +164,7 @@ func (k msgServer) CancelRedemption(goCtx context.Context, msg *types.MsgCancelQ
 	}
 
 	// return coins
-	if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowModuleAccount, userAccAddress, sdk.NewCoins(record.BurnAmount)); err != nil {
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowModuleAccount, userAccAddress, sdk.NewCoins(returned)); err != nil {
 		return nil, fmt.Errorf("unable to return coins from escrow account: %w", err)
 	}
 
@@ -121,13 +175,54 @@ func (k msgServer) CancelRedemption(goCtx context.Context, msg *types.MsgCancelQ
 		),
 		sdk.NewEvent(
 			types.EventTypeRedemptionCancellation,
-			sdk.NewAttribute(types.AttributeKeyReturnedAmount, record.BurnAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyReturnedAmount, returned.String()),
 			sdk.NewAttribute(types.AttributeKeyUser, msg.FromAddress),
 			sdk.NewAttribute(types.AttributeKeyChainID, msg.ChainId),
 		),
 	})
 
-	return &types.MsgCancelQueuedRedemptionResponse{Returned: record.BurnAmount}, nil
+	return &types.MsgCancelQueuedRedemptionResponse{Returned: returned}, nil
+}
+
+// cancelUnbondingRedemption handles cancellation of a withdrawal record in the UNBOND state, by submitting
+// MsgCancelUnbondingDelegation for each of the record's distributions.
+func (k msgServer) cancelUnbondingRedemption(ctx sdk.Context, msg *types.MsgCancelQueuedRedemption) (*types.MsgCancelQueuedRedemptionResponse, error) {
+	record, found := k.GetWithdrawalRecord(ctx, msg.ChainId, msg.Hash, types.WithdrawStatusUnbond)
+	if !found {
+		return nil, fmt.Errorf("no queued or unbonding record with hash \"%s\" found", msg.Hash)
+	}
+
+	if record.Delegator != msg.FromAddress {
+		return nil, fmt.Errorf("incorrect user for record with hash \"%s\"", msg.Hash)
+	}
+
+	if msg.Amount != nil {
+		return nil, fmt.Errorf("partial cancellation is not supported for unbonding record with hash \"%s\"", msg.Hash)
+	}
+
+	zone, found := k.GetZone(ctx, msg.ChainId)
+	if !found {
+		return nil, fmt.Errorf("invalid chain id \"%s\"", msg.ChainId)
+	}
+
+	if err := k.CancelUnbondingForWithdrawalRecord(ctx, &zone, record); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeRedemptionCancellation,
+			sdk.NewAttribute(types.AttributeKeyHash, msg.Hash),
+			sdk.NewAttribute(types.AttributeKeyUser, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyChainID, msg.ChainId),
+		),
+	})
+
+	return &types.MsgCancelQueuedRedemptionResponse{Returned: sdk.NewCoin(record.BurnAmount.Denom, sdk.ZeroInt()), Pending: true}, nil
 }
 
 func (k msgServer) SignalIntent(goCtx context.Context, msg *types.MsgSignalIntent) (*types.MsgSignalIntentResponse, error) {
@@ -299,3 +394,202 @@ func (k msgServer) GovSetLsmCaps(goCtx context.Context, msg *types.MsgGovSetLsmC
 
 	return &types.MsgGovSetLsmCapsResponse{}, nil
 }
+
+// TripCircuitBreaker pauses one or more actions for a zone. It may be signed by
+// either the governance module or the emergency authority.
+func (k msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	emergencyAuthority := k.Keeper.GetEmergencyAuthority(ctx)
+	if msg.Authority != k.Keeper.GetGovAuthority(ctx) && (emergencyAuthority == "" || msg.Authority != emergencyAuthority) {
+		return nil,
+			govtypes.ErrInvalidSigner.Wrapf(
+				"invalid authority: expected %s or emergency authority, got %s",
+				k.Keeper.GetGovAuthority(ctx), msg.Authority,
+			)
+	}
+
+	if _, found := k.Keeper.GetZone(ctx, msg.ChainId); !found {
+		return nil, fmt.Errorf("no zone found for: %s", msg.ChainId)
+	}
+
+	k.Keeper.TripCircuitBreaker(ctx, msg.ChainId, msg.Actions, msg.Reason, msg.Authority)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// GovResetCircuitBreaker resumes paused actions for a zone. Only governance may
+// reset a circuit breaker.
+func (k msgServer) GovResetCircuitBreaker(goCtx context.Context, msg *types.MsgGovResetCircuitBreaker) (*types.MsgGovResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// checking msg authority is the gov module address
+	if k.Keeper.GetGovAuthority(ctx) != msg.Authority {
+		return nil,
+			govtypes.ErrInvalidSigner.Wrapf(
+				"invalid authority: expected %s, got %s",
+				k.Keeper.GetGovAuthority(ctx), msg.Authority,
+			)
+	}
+
+	if err := k.Keeper.ResetCircuitBreaker(ctx, msg.ChainId, msg.Actions); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgGovResetCircuitBreakerResponse{}, nil
+}
+
+// GovSetEmergencyAuthority sets the address permitted to trip circuit breakers.
+func (k msgServer) GovSetEmergencyAuthority(goCtx context.Context, msg *types.MsgGovSetEmergencyAuthority) (*types.MsgGovSetEmergencyAuthorityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// checking msg authority is the gov module address
+	if k.Keeper.GetGovAuthority(ctx) != msg.Authority {
+		return nil,
+			govtypes.ErrInvalidSigner.Wrapf(
+				"invalid authority: expected %s, got %s",
+				k.Keeper.GetGovAuthority(ctx), msg.Authority,
+			)
+	}
+
+	k.Keeper.SetEmergencyAuthority(ctx, msg.EmergencyAuthority)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetEmergencyAuthority,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.EmergencyAuthority),
+		),
+	})
+
+	return &types.MsgGovSetEmergencyAuthorityResponse{}, nil
+}
+
+// GovSetRewardSwapRoutes sets the routes used to swap non-base reward denoms
+// into the zone's base denom on the host chain.
+func (k msgServer) GovSetRewardSwapRoutes(goCtx context.Context, msg *types.MsgGovSetRewardSwapRoutes) (*types.MsgGovSetRewardSwapRoutesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// checking msg authority is the gov module address
+	if k.Keeper.GetGovAuthority(ctx) != msg.Authority {
+		return nil,
+			govtypes.ErrInvalidSigner.Wrapf(
+				"invalid authority: expected %s, got %s",
+				k.Keeper.GetGovAuthority(ctx), msg.Authority,
+			)
+	}
+
+	zone, found := k.Keeper.GetZone(ctx, msg.ChainId)
+	if !found {
+		return nil, fmt.Errorf("no zone found for chain id %s", msg.ChainId)
+	}
+
+	if err := k.Keeper.SetRewardSwapRoutes(ctx, &zone, msg.Routes); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetRewardSwapRoutes,
+			sdk.NewAttribute(types.AttributeKeyChainID, msg.ChainId),
+		),
+	})
+
+	return &types.MsgGovSetRewardSwapRoutesResponse{}, nil
+}
+
+// GovSetEpochCaps sets the per-epoch deposit, redemption and address caps for a zone. Caps that are all zero are
+// removed.
+func (k msgServer) GovSetEpochCaps(goCtx context.Context, msg *types.MsgGovSetEpochCaps) (*types.MsgGovSetEpochCapsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// checking msg authority is the gov module address
+	if k.Keeper.GetGovAuthority(ctx) != msg.Authority {
+		return nil,
+			govtypes.ErrInvalidSigner.Wrapf(
+				"invalid authority: expected %s, got %s",
+				k.Keeper.GetGovAuthority(ctx), msg.Authority,
+			)
+	}
+
+	zone, found := k.Keeper.GetZone(ctx, msg.ChainId)
+	if !found {
+		return nil, fmt.Errorf("no zone found for chain id %s", msg.ChainId)
+	}
+
+	if msg.Caps.IsZero() {
+		k.DeleteEpochCaps(ctx, zone.ChainId)
+	} else {
+		k.SetEpochCaps(ctx, zone.ChainId, msg.Caps)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetEpochCaps,
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyDepositCap, msg.Caps.DepositCap.String()),
+			sdk.NewAttribute(types.AttributeKeyRedemptionCap, msg.Caps.RedemptionCap.String()),
+			sdk.NewAttribute(types.AttributeKeyAddressCap, msg.Caps.AddressCap.String()),
+		),
+	})
+
+	return &types.MsgGovSetEpochCapsResponse{}, nil
+}
+
+// GovDeregisterZone begins winding down a zone, optionally migrating it to a
+// new connection once all holders are settled.
+func (k msgServer) GovDeregisterZone(goCtx context.Context, msg *types.MsgGovDeregisterZone) (*types.MsgGovDeregisterZoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// checking msg authority is the gov module address
+	if k.Keeper.GetGovAuthority(ctx) != msg.Authority {
+		return nil,
+			govtypes.ErrInvalidSigner.Wrapf(
+				"invalid authority: expected %s, got %s",
+				k.Keeper.GetGovAuthority(ctx), msg.Authority,
+			)
+	}
+
+	zone, found := k.Keeper.GetZone(ctx, msg.ChainId)
+	if !found {
+		return nil, fmt.Errorf("no zone found for chain id %s", msg.ChainId)
+	}
+
+	if err := k.Keeper.StartZoneWindDown(ctx, &zone, msg.MigrationConnectionId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgGovDeregisterZoneResponse{}, nil
+}
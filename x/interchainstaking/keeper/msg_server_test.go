@@ -207,6 +207,38 @@ func (suite *KeeperTestSuite) TestRequestRedemption() {
 			"account has insufficient balance of qasset to burn",
 			"account has insufficient balance of qasset to burn",
 		},
+		{
+			"valid - priority fee",
+			func() {
+				addr, err := addressutils.EncodeAddressToBech32("cosmos", addressutils.GenerateAccAddressForTest())
+				suite.NoError(err)
+				fee := sdk.NewCoin("uqatom", sdk.NewInt(100000))
+				msg = icstypes.MsgRequestRedemption{
+					Value:              sdk.NewCoin("uqatom", sdk.NewInt(5000000)),
+					DestinationAddress: addr,
+					FromAddress:        testAddress,
+					PriorityFee:        &fee,
+				}
+			},
+			"",
+			"",
+		},
+		{
+			"invalid - insufficient funds for priority fee",
+			func() {
+				addr, err := addressutils.EncodeAddressToBech32("cosmos", addressutils.GenerateAccAddressForTest())
+				suite.NoError(err)
+				fee := sdk.NewCoin("uqatom", sdk.OneInt())
+				msg = icstypes.MsgRequestRedemption{
+					Value:              sdk.NewCoin("uqatom", sdk.NewInt(10000000)),
+					DestinationAddress: addr,
+					FromAddress:        testAddress,
+					PriorityFee:        &fee,
+				}
+			},
+			"account has insufficient balance of qasset to burn",
+			"account has insufficient balance of qasset to burn",
+		},
 		{
 			"invalid - bad prefix",
 			func() {
@@ -817,7 +849,7 @@ func (suite *KeeperTestSuite) TestMsgCancelQueuedRedemeption() {
 					FromAddress: addressutils.GenerateAddressForTestWithPrefix("quick"),
 				}
 			},
-			fmt.Sprintf("no queued record with hash \"%s\" found", hash),
+			fmt.Sprintf("no queued or unbonding record with hash \"%s\" found", hash),
 		},
 		{
 			"no hash exists",
@@ -828,7 +860,7 @@ func (suite *KeeperTestSuite) TestMsgCancelQueuedRedemeption() {
 					FromAddress: addressutils.GenerateAddressForTestWithPrefix("quick"),
 				}
 			},
-			fmt.Sprintf("no queued record with hash \"%s\" found", hash),
+			fmt.Sprintf("no queued or unbonding record with hash \"%s\" found", hash),
 		},
 		{
 			"hash exists but not in correct status",
@@ -840,7 +872,7 @@ func (suite *KeeperTestSuite) TestMsgCancelQueuedRedemeption() {
 					ChainId:        s.chainB.ChainID,
 					Delegator:      address,
 					BurnAmount:     sdk.NewCoin("uqatom", math.NewInt(500)),
-					Status:         icstypes.WithdrawStatusUnbond,
+					Status:         icstypes.WithdrawStatusSend,
 					CompletionTime: ctx.BlockHeader().Time.Add(time.Hour * 72),
 					Txhash:         hash,
 				})
@@ -851,7 +883,7 @@ func (suite *KeeperTestSuite) TestMsgCancelQueuedRedemeption() {
 					FromAddress: address,
 				}
 			},
-			fmt.Sprintf("no queued record with hash \"%s\" found", hash),
+			fmt.Sprintf("no queued or unbonding record with hash \"%s\" found", hash),
 		},
 		{
 			"hash exists in correct status but different user",
@@ -932,3 +964,500 @@ func (suite *KeeperTestSuite) TestMsgCancelQueuedRedemeption() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgCancelQueuedRedemptionPartial() {
+	hash := randomutils.GenerateRandomHashAsHex(64)
+	tests := []struct {
+		name              string
+		amount            sdk.Coin
+		expectErr         string
+		expectedRemaining math.Int
+		expectedFee       math.Int
+	}{
+		{
+			"partial",
+			sdk.NewCoin("uqatom", math.NewInt(200)),
+			"",
+			math.NewInt(300),
+			math.NewInt(60),
+		},
+		{
+			"full amount specified",
+			sdk.NewCoin("uqatom", math.NewInt(500)),
+			"",
+			math.ZeroInt(),
+			math.ZeroInt(),
+		},
+		{
+			"amount exceeds record",
+			sdk.NewCoin("uqatom", math.NewInt(501)),
+			"cancellation amount 501uqatom exceeds queued amount 500uqatom",
+			math.NewInt(500),
+			math.NewInt(100),
+		},
+		{
+			"denom mismatch",
+			sdk.NewCoin("uqosmo", math.NewInt(200)),
+			"cancellation denom uqosmo does not match record denom uqatom",
+			math.NewInt(500),
+			math.NewInt(100),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			suite.setupTestZones()
+
+			ctx := suite.chainA.GetContext()
+			k := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+			address := addressutils.GenerateAddressForTestWithPrefix("quick")
+			priorityFee := sdk.NewCoin("uqatom", math.NewInt(100))
+			k.SetWithdrawalRecord(ctx, icstypes.WithdrawalRecord{
+				ChainId:     suite.chainB.ChainID,
+				Delegator:   address,
+				BurnAmount:  sdk.NewCoin("uqatom", math.NewInt(500)),
+				Status:      icstypes.WithdrawStatusQueued,
+				Txhash:      hash,
+				PriorityFee: &priorityFee,
+			})
+			suite.NoError(k.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(sdk.NewCoin("uqatom", math.NewInt(500)))))
+			suite.NoError(k.BankKeeper.SendCoinsFromModuleToModule(ctx, icstypes.ModuleName, icstypes.EscrowModuleAccount, sdk.NewCoins(sdk.NewCoin("uqatom", math.NewInt(500)))))
+
+			msg := &icstypes.MsgCancelQueuedRedemption{
+				ChainId:     suite.chainB.ChainID,
+				Hash:        hash,
+				FromAddress: address,
+				Amount:      &tt.amount,
+			}
+
+			msgSrv := icskeeper.NewMsgServerImpl(k)
+			res, err := msgSrv.CancelRedemption(sdk.WrapSDKContext(ctx), msg)
+			if len(tt.expectErr) != 0 {
+				suite.ErrorContains(err, tt.expectErr)
+				suite.Nil(res)
+			} else {
+				suite.NoError(err)
+				suite.Equal(tt.amount, res.Returned)
+				suite.False(res.Pending)
+
+				userAddress, err := addressutils.AccAddressFromBech32(address, "")
+				suite.NoError(err)
+				suite.Equal(tt.amount, suite.GetQuicksilverApp(suite.chainA).BankKeeper.GetBalance(ctx, userAddress, "uqatom"))
+			}
+
+			record, found := k.GetWithdrawalRecord(ctx, suite.chainB.ChainID, hash, icstypes.WithdrawStatusQueued)
+			if tt.expectedRemaining.IsZero() {
+				suite.False(found)
+			} else {
+				suite.True(found)
+				suite.Equal(tt.expectedRemaining, record.BurnAmount.Amount)
+				// the priority fee is reduced in proportion to the amount cancelled.
+				suite.Equal(tt.expectedFee, record.PriorityFee.Amount)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgCancelUnbondingRedemption() {
+	hash := randomutils.GenerateRandomHashAsHex(64)
+	tests := []struct {
+		name           string
+		malleate       func(s *KeeperTestSuite, address string)
+		amount         *sdk.Coin
+		expectErr      string
+		expectedStatus int32
+	}{
+		{
+			"partial cancellation not supported",
+			func(s *KeeperTestSuite, address string) {},
+			&sdk.Coin{Denom: "uqatom", Amount: math.NewInt(100)},
+			fmt.Sprintf("partial cancellation is not supported for unbonding record with hash \"%s\"", hash),
+			icstypes.WithdrawStatusUnbond,
+		},
+		{
+			"unacknowledged unbonding",
+			func(s *KeeperTestSuite, address string) {
+				ctx := s.chainA.GetContext()
+				k := s.GetQuicksilverApp(s.chainA).InterchainstakingKeeper
+				record, found := k.GetWithdrawalRecord(ctx, s.chainB.ChainID, hash, icstypes.WithdrawStatusUnbond)
+				s.True(found)
+				record.Acknowledged = false
+				k.SetWithdrawalRecord(ctx, record)
+			},
+			nil,
+			fmt.Sprintf("unbonding for record with hash \"%s\" has not been acknowledged", hash),
+			icstypes.WithdrawStatusUnbond,
+		},
+		{
+			"matured unbonding",
+			func(s *KeeperTestSuite, address string) {
+				ctx := s.chainA.GetContext()
+				k := s.GetQuicksilverApp(s.chainA).InterchainstakingKeeper
+				record, found := k.GetWithdrawalRecord(ctx, s.chainB.ChainID, hash, icstypes.WithdrawStatusUnbond)
+				s.True(found)
+				record.CompletionTime = ctx.BlockTime().Add(-time.Hour)
+				k.SetWithdrawalRecord(ctx, record)
+			},
+			nil,
+			fmt.Sprintf("unbonding for record with hash \"%s\" has already matured", hash),
+			icstypes.WithdrawStatusUnbond,
+		},
+		{
+			"unknown creation height",
+			func(s *KeeperTestSuite, address string) {
+				ctx := s.chainA.GetContext()
+				k := s.GetQuicksilverApp(s.chainA).InterchainstakingKeeper
+				for _, ubr := range k.AllZoneUnbondingRecords(ctx, s.chainB.ChainID) {
+					ubr.CreationHeight = 0
+					k.SetUnbondingRecord(ctx, ubr)
+				}
+			},
+			nil,
+			"is not yet known; try again later",
+			icstypes.WithdrawStatusUnbond,
+		},
+		{
+			"valid",
+			func(s *KeeperTestSuite, address string) {},
+			nil,
+			"",
+			icstypes.WithdrawStatusCancel,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			suite.setupTestZones()
+
+			ctx := suite.chainA.GetContext()
+			k := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+			vals := k.GetValidatorAddresses(ctx, suite.chainB.ChainID)
+			address := addressutils.GenerateAddressForTestWithPrefix("quick")
+			completion := ctx.BlockTime().Add(time.Hour * 72)
+
+			k.SetWithdrawalRecord(ctx, icstypes.WithdrawalRecord{
+				ChainId:        suite.chainB.ChainID,
+				Delegator:      address,
+				Distribution:   []*icstypes.Distribution{{Valoper: vals[0], Amount: 300}, {Valoper: vals[1], Amount: 250}},
+				Amount:         sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(550))),
+				BurnAmount:     sdk.NewCoin("uqatom", math.NewInt(500)),
+				Status:         icstypes.WithdrawStatusUnbond,
+				CompletionTime: completion,
+				Acknowledged:   true,
+				Txhash:         hash,
+			})
+			k.SetUnbondingRecord(ctx, icstypes.UnbondingRecord{ChainId: suite.chainB.ChainID, EpochNumber: 1, Validator: vals[0], RelatedTxhash: []string{hash}, Amount: sdk.NewCoin("uatom", math.NewInt(300)), CompletionTime: completion, CreationHeight: 10})
+			k.SetUnbondingRecord(ctx, icstypes.UnbondingRecord{ChainId: suite.chainB.ChainID, EpochNumber: 1, Validator: vals[1], RelatedTxhash: []string{hash}, Amount: sdk.NewCoin("uatom", math.NewInt(250)), CompletionTime: completion, CreationHeight: 10})
+
+			tt.malleate(suite, address)
+
+			msg := &icstypes.MsgCancelQueuedRedemption{
+				ChainId:     suite.chainB.ChainID,
+				Hash:        hash,
+				FromAddress: address,
+				Amount:      tt.amount,
+			}
+
+			msgSrv := icskeeper.NewMsgServerImpl(k)
+			res, err := msgSrv.CancelRedemption(sdk.WrapSDKContext(ctx), msg)
+			if len(tt.expectErr) != 0 {
+				suite.ErrorContains(err, tt.expectErr)
+				suite.Nil(res)
+			} else {
+				suite.NoError(err)
+				suite.True(res.Pending)
+				suite.True(res.Returned.IsZero())
+			}
+
+			_, found := k.GetWithdrawalRecord(ctx, suite.chainB.ChainID, hash, tt.expectedStatus)
+			suite.True(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTripCircuitBreaker() {
+	emergencyAuthority := addressutils.GenerateAddressForTestWithPrefix("quick")
+
+	tests := []struct {
+		name      string
+		malleate  func(s *KeeperTestSuite) *icstypes.MsgTripCircuitBreaker
+		expectErr string
+	}{
+		{
+			"invalid authority",
+			func(s *KeeperTestSuite) *icstypes.MsgTripCircuitBreaker {
+				return &icstypes.MsgTripCircuitBreaker{
+					ChainId:   s.chainB.ChainID,
+					Actions:   []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionDeposits},
+					Reason:    "test",
+					Authority: testAddress,
+				}
+			},
+			"invalid authority",
+		},
+		{
+			"unset emergency authority",
+			func(s *KeeperTestSuite) *icstypes.MsgTripCircuitBreaker {
+				return &icstypes.MsgTripCircuitBreaker{
+					ChainId:   s.chainB.ChainID,
+					Actions:   []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionDeposits},
+					Reason:    "test",
+					Authority: "",
+				}
+			},
+			"invalid authority",
+		},
+		{
+			"invalid zone",
+			func(s *KeeperTestSuite) *icstypes.MsgTripCircuitBreaker {
+				return &icstypes.MsgTripCircuitBreaker{
+					ChainId:   "unknownzone-1",
+					Actions:   []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionDeposits},
+					Reason:    "test",
+					Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+				}
+			},
+			"no zone found",
+		},
+		{
+			"valid - governance",
+			func(s *KeeperTestSuite) *icstypes.MsgTripCircuitBreaker {
+				return &icstypes.MsgTripCircuitBreaker{
+					ChainId:   s.chainB.ChainID,
+					Actions:   []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionDeposits},
+					Reason:    "test",
+					Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+				}
+			},
+			"",
+		},
+		{
+			"valid - emergency authority",
+			func(s *KeeperTestSuite) *icstypes.MsgTripCircuitBreaker {
+				s.GetQuicksilverApp(s.chainA).InterchainstakingKeeper.SetEmergencyAuthority(s.chainA.GetContext(), emergencyAuthority)
+				return &icstypes.MsgTripCircuitBreaker{
+					ChainId:   s.chainB.ChainID,
+					Actions:   []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionDeposits},
+					Reason:    "test",
+					Authority: emergencyAuthority,
+				}
+			},
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			suite.setupTestZones()
+
+			msg := tt.malleate(suite)
+			ctx := suite.chainA.GetContext()
+			icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+
+			msgSrv := icskeeper.NewMsgServerImpl(icsKeeper)
+			res, err := msgSrv.TripCircuitBreaker(sdk.WrapSDKContext(ctx), msg)
+			if tt.expectErr != "" {
+				suite.ErrorContains(err, tt.expectErr)
+				suite.Nil(res)
+				suite.False(icsKeeper.IsCircuitBreakerTripped(ctx, suite.chainB.ChainID, icstypes.CircuitBreakerActionDeposits))
+				return
+			}
+
+			suite.NoError(err)
+			suite.NotNil(res)
+
+			cb, found := icsKeeper.GetCircuitBreaker(ctx, suite.chainB.ChainID)
+			suite.True(found)
+			trip, found := cb.GetTrip(icstypes.CircuitBreakerActionDeposits)
+			suite.True(found)
+			suite.Equal(msg.Reason, trip.Reason)
+			suite.Equal(msg.Authority, trip.TrippedBy)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGovResetCircuitBreaker() {
+	emergencyAuthority := addressutils.GenerateAddressForTestWithPrefix("quick")
+
+	tests := []struct {
+		name      string
+		malleate  func(s *KeeperTestSuite) *icstypes.MsgGovResetCircuitBreaker
+		expectErr string
+	}{
+		{
+			"emergency authority may not reset",
+			func(s *KeeperTestSuite) *icstypes.MsgGovResetCircuitBreaker {
+				return &icstypes.MsgGovResetCircuitBreaker{
+					ChainId:   s.chainB.ChainID,
+					Authority: emergencyAuthority,
+				}
+			},
+			"invalid authority",
+		},
+		{
+			"not tripped",
+			func(s *KeeperTestSuite) *icstypes.MsgGovResetCircuitBreaker {
+				return &icstypes.MsgGovResetCircuitBreaker{
+					ChainId:   s.chainB.ChainID,
+					Actions:   []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionICA},
+					Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+				}
+			},
+			"none of the requested actions are tripped",
+		},
+		{
+			"valid",
+			func(s *KeeperTestSuite) *icstypes.MsgGovResetCircuitBreaker {
+				return &icstypes.MsgGovResetCircuitBreaker{
+					ChainId:   s.chainB.ChainID,
+					Actions:   []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionDeposits},
+					Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+				}
+			},
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			suite.setupTestZones()
+
+			ctx := suite.chainA.GetContext()
+			icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+			icsKeeper.SetEmergencyAuthority(ctx, emergencyAuthority)
+			icsKeeper.TripCircuitBreaker(ctx, suite.chainB.ChainID, []icstypes.CircuitBreakerAction{icstypes.CircuitBreakerActionDeposits}, "test", emergencyAuthority)
+
+			msg := tt.malleate(suite)
+
+			msgSrv := icskeeper.NewMsgServerImpl(icsKeeper)
+			res, err := msgSrv.GovResetCircuitBreaker(sdk.WrapSDKContext(ctx), msg)
+			if tt.expectErr != "" {
+				suite.ErrorContains(err, tt.expectErr)
+				suite.Nil(res)
+				suite.True(icsKeeper.IsCircuitBreakerTripped(ctx, suite.chainB.ChainID, icstypes.CircuitBreakerActionDeposits))
+				return
+			}
+
+			suite.NoError(err)
+			suite.NotNil(res)
+			suite.False(icsKeeper.IsCircuitBreakerTripped(ctx, suite.chainB.ChainID, icstypes.CircuitBreakerActionDeposits))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGovSetEmergencyAuthority() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	ctx := suite.chainA.GetContext()
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	msgSrv := icskeeper.NewMsgServerImpl(icsKeeper)
+	emergencyAuthority := addressutils.GenerateAddressForTestWithPrefix("quick")
+
+	_, err := msgSrv.GovSetEmergencyAuthority(sdk.WrapSDKContext(ctx), &icstypes.MsgGovSetEmergencyAuthority{EmergencyAuthority: emergencyAuthority, Authority: testAddress})
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+	suite.Equal("", icsKeeper.GetEmergencyAuthority(ctx))
+
+	_, err = msgSrv.GovSetEmergencyAuthority(sdk.WrapSDKContext(ctx), &icstypes.MsgGovSetEmergencyAuthority{EmergencyAuthority: emergencyAuthority, Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"})
+	suite.NoError(err)
+	suite.Equal(emergencyAuthority, icsKeeper.GetEmergencyAuthority(ctx))
+
+	_, err = msgSrv.GovSetEmergencyAuthority(sdk.WrapSDKContext(ctx), &icstypes.MsgGovSetEmergencyAuthority{Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"})
+	suite.NoError(err)
+	suite.Equal("", icsKeeper.GetEmergencyAuthority(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGovSetRewardSwapRoutes() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	ctx := suite.chainA.GetContext()
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	msgSrv := icskeeper.NewMsgServerImpl(icsKeeper)
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	routes := []icstypes.RewardSwapRoute{{Denom: "uosmo", Hops: []icstypes.SwapHop{{PoolId: 1, TokenOutDenom: zone.BaseDenom}}, MaxSlippage: sdk.MustNewDecFromStr("0.01")}}
+
+	_, err := msgSrv.GovSetRewardSwapRoutes(sdk.WrapSDKContext(ctx), &icstypes.MsgGovSetRewardSwapRoutes{ChainId: zone.ChainId, Routes: routes, Authority: testAddress})
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgSrv.GovSetRewardSwapRoutes(sdk.WrapSDKContext(ctx), &icstypes.MsgGovSetRewardSwapRoutes{ChainId: "unknown-1", Routes: routes, Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"})
+	suite.ErrorContains(err, "no zone found")
+
+	_, err = msgSrv.GovSetRewardSwapRoutes(sdk.WrapSDKContext(ctx), &icstypes.MsgGovSetRewardSwapRoutes{ChainId: zone.ChainId, Routes: routes, Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"})
+	suite.NoError(err)
+	config, found := icsKeeper.GetRewardSwapConfig(ctx, zone.ChainId)
+	suite.True(found)
+	suite.Equal(routes, config.Routes)
+
+	_, err = msgSrv.GovSetRewardSwapRoutes(sdk.WrapSDKContext(ctx), &icstypes.MsgGovSetRewardSwapRoutes{ChainId: zone.ChainId, Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"})
+	suite.NoError(err)
+	_, found = icsKeeper.GetRewardSwapConfig(ctx, zone.ChainId)
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestGovSetEpochCaps() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	ctx := suite.chainA.GetContext()
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	msgSrv := icskeeper.NewMsgServerImpl(icsKeeper)
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	caps := icstypes.EpochCaps{DepositCap: sdk.NewInt(1000), RedemptionCap: sdk.ZeroInt(), AddressCap: sdk.NewInt(100)}
+
+	_, err := msgSrv.GovSetEpochCaps(sdk.WrapSDKContext(ctx), &icstypes.MsgGovSetEpochCaps{ChainId: zone.ChainId, Caps: caps, Authority: testAddress})
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgSrv.GovSetEpochCaps(sdk.WrapSDKContext(ctx), &icstypes.MsgGovSetEpochCaps{ChainId: "unknown-1", Caps: caps, Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"})
+	suite.ErrorContains(err, "no zone found")
+
+	_, err = msgSrv.GovSetEpochCaps(sdk.WrapSDKContext(ctx), &icstypes.MsgGovSetEpochCaps{ChainId: zone.ChainId, Caps: caps, Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"})
+	suite.NoError(err)
+	stored, found := icsKeeper.GetEpochCaps(ctx, zone.ChainId)
+	suite.True(found)
+	suite.Equal(caps, stored)
+
+	// zero caps remove the caps.
+	_, err = msgSrv.GovSetEpochCaps(sdk.WrapSDKContext(ctx), &icstypes.MsgGovSetEpochCaps{ChainId: zone.ChainId, Caps: icstypes.EpochCaps{DepositCap: sdk.ZeroInt(), RedemptionCap: sdk.ZeroInt(), AddressCap: sdk.ZeroInt()}, Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"})
+	suite.NoError(err)
+	_, found = icsKeeper.GetEpochCaps(ctx, zone.ChainId)
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestGovDeregisterZone() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	ctx := suite.chainA.GetContext()
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	msgSrv := icskeeper.NewMsgServerImpl(icsKeeper)
+
+	_, err := msgSrv.GovDeregisterZone(sdk.WrapSDKContext(ctx), &icstypes.MsgGovDeregisterZone{ChainId: suite.chainB.ChainID, Authority: testAddress})
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgSrv.GovDeregisterZone(sdk.WrapSDKContext(ctx), &icstypes.MsgGovDeregisterZone{ChainId: "unknown-1", Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"})
+	suite.ErrorContains(err, "no zone found")
+
+	_, err = msgSrv.GovDeregisterZone(sdk.WrapSDKContext(ctx), &icstypes.MsgGovDeregisterZone{ChainId: suite.chainB.ChainID, Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"})
+	suite.NoError(err)
+	suite.True(icsKeeper.IsZoneWindingDown(ctx, suite.chainB.ChainID))
+
+	_, err = msgSrv.GovDeregisterZone(sdk.WrapSDKContext(ctx), &icstypes.MsgGovDeregisterZone{ChainId: suite.chainB.ChainID, Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"})
+	suite.ErrorContains(err, "already winding down")
+}
@@ -143,6 +143,10 @@ func (k *Keeper) HandleUpdateZoneProposal(ctx sdk.Context, p *types.UpdateZonePr
 		return err
 	}
 
+	if k.IsZoneWindingDown(ctx, zone.ChainId) {
+		return fmt.Errorf("zone %s is winding down and cannot be updated", zone.ChainId)
+	}
+
 	for _, change := range p.Changes {
 		switch change.Key {
 		case "base_denom":
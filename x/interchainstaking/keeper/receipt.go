@@ -8,6 +8,7 @@ import (
 	sdkioerrors "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -93,6 +94,12 @@ func (k *Keeper) HandleReceiptTransaction(ctx sdk.Context, txn *tx.Tx, hash stri
 
 	k.Logger(ctx).Info("found new deposit tx", "deposit_address", zone.DepositAddress.GetAddress(), "senderAddress", senderAddress, "local", senderAccAddress.String(), "chain id", zone.ChainId, "assets", assets, "hash", hash)
 
+	if err := k.CheckEpochDepositCaps(ctx, &zone, senderAccAddress.String(), assets); err != nil {
+		k.Logger(ctx).Info("deposit exceeds epoch caps; refunding.", "senderAddress", senderAddress, "zone", zone.ChainId, "assets", assets, "reason", err.Error())
+		k.SetReceipt(ctx, *k.NewCompletedReceipt(ctx, &zone, senderAddress, hash, assets)) // completed receipt will stop this hash being submitted again
+		return k.refundDeposit(ctx, &zone, senderAddress, hash, assets, err.Error())
+	}
+
 	var (
 		memoIntent    types.ValidatorIntents
 		memoFields    types.MemoFields
@@ -129,6 +136,7 @@ func (k *Keeper) HandleReceiptTransaction(ctx sdk.Context, txn *tx.Tx, hash stri
 	// create receipt
 	receipt := k.NewReceipt(ctx, &zone, senderAddress, hash, assets)
 	k.SetReceipt(ctx, *receipt)
+	telemetry.IncrCounterWithLabels(zoneMetricKey(MetricKeyReceiptsProcessed), 1, zoneMetricLabels(zone.ChainId))
 
 	return nil
 }
@@ -208,6 +216,7 @@ func (k *Keeper) MintAndSendQAsset(ctx sdk.Context, sender sdk.AccAddress, sende
 	if err != nil {
 		return err
 	}
+	k.RecordEpochDeposit(ctx, zone, sender.String(), qAssets.AmountOf(zone.LocalDenom))
 
 	switch {
 	case zone.ReturnToSender || memoRTS:
@@ -249,6 +258,11 @@ func (k *Keeper) TransferToDelegate(ctx sdk.Context, zone *types.Zone, coins sdk
 }
 
 func (k *Keeper) SubmitTx(ctx sdk.Context, msgs []sdk.Msg, account *types.ICAAccount, memo string, messagesPerTx int64) error {
+	if len(msgs) > 0 && account != nil {
+		if chainID, found := k.GetAddressZoneMapping(ctx, account.Address); found && k.IsCircuitBreakerTripped(ctx, chainID, types.CircuitBreakerActionICA) {
+			return fmt.Errorf("ica submission is paused for zone %s", chainID)
+		}
+	}
 	return k.txSubmit(ctx, k, msgs, account, memo, messagesPerTx)
 }
 
@@ -83,28 +83,30 @@ import (
 // }
 
 // queueRedemption will determine based on zone intent, the tokens to unbond, and add a withdrawal record with status QUEUED.
+// The optional priority fee is recorded against the withdrawal record, and determines its position in the queue.
 func (k *Keeper) queueRedemption(
 	ctx sdk.Context,
 	zone *types.Zone,
 	sender sdk.AccAddress,
 	destination string,
 	burnAmount sdk.Coin,
+	priorityFee *sdk.Coin,
 	hash string,
 ) error { //nolint:unparam // we know that the error is always nil
-	distributions := make([]*types.Distribution, 0)
-
-	k.AddWithdrawalRecord(
-		ctx,
-		zone.ChainId,
-		sender.String(),
-		distributions,
-		destination,
-		burnAmount,
-		hash,
-		types.WithdrawStatusQueued,
-		time.Time{},
-		k.EpochsKeeper.GetEpochInfo(ctx, epochstypes.EpochIdentifierEpoch).CurrentEpoch,
-	)
+	record := types.WithdrawalRecord{
+		ChainId:        zone.ChainId,
+		Delegator:      sender.String(),
+		Distribution:   make([]*types.Distribution, 0),
+		Recipient:      destination,
+		Status:         types.WithdrawStatusQueued,
+		BurnAmount:     burnAmount,
+		Txhash:         hash,
+		CompletionTime: time.Time{},
+		EpochNumber:    k.EpochsKeeper.GetEpochInfo(ctx, epochstypes.EpochIdentifierEpoch).CurrentEpoch,
+		PriorityFee:    priorityFee,
+	}
+	k.Logger(ctx).Info("addWithdrawalRecord", "record", record)
+	k.SetWithdrawalRecord(ctx, record)
 
 	return nil
 }
@@ -128,9 +130,12 @@ func (k *Keeper) GetUnlockedTokensForZone(ctx sdk.Context, zone *types.Zone) (ma
 		}
 	}
 
-	// for each redelegation, remove the amount being redelegated to from the destination,
+	// for each redelegation that is yet to complete, remove the amount being redelegated to from the destination,
 	// as this cannot be available for unbonding or redelegation.
 	for _, redelegation := range k.ZoneRedelegationRecords(ctx, zone.ChainId) {
+		if !redelegation.IsLocked(ctx.BlockTime()) {
+			continue
+		}
 		thisAvailable, found := availablePerValidator[redelegation.Destination]
 		if found {
 			availablePerValidator[redelegation.Destination] = thisAvailable.Sub(sdk.NewInt(redelegation.Amount))
@@ -165,9 +170,12 @@ func (k *Keeper) HandleQueuedUnbondings(ctx sdk.Context, zone *types.Zone, epoch
 	// get min of LastRedemptionRate (N-1) and RedemptionRate (N)
 	rate := sdk.MinDec(zone.LastRedemptionRate, zone.RedemptionRate)
 
-	// iterate all withdrawal records for the zone in the QUEUED state.
-	k.IterateZoneStatusWithdrawalRecords(ctx, zone.ChainId, types.WithdrawStatusQueued, func(idx int64, withdrawal types.WithdrawalRecord) bool {
-		k.Logger(ctx).Info("handling queued withdrawal request", "from", withdrawal.Delegator, "to", withdrawal.Recipient, "amount", withdrawal.Amount)
+	// redemptions in excess of the zone's epoch caps remain queued for a subsequent epoch.
+	allowance := k.newRedemptionAllowance(ctx, zone)
+
+	// iterate all withdrawal records for the zone in the QUEUED state, in order of priority.
+	for _, withdrawal := range k.QueuedWithdrawalRecordsByPriority(ctx, zone.ChainId) {
+		k.Logger(ctx).Info("handling queued withdrawal request", "from", withdrawal.Delegator, "to", withdrawal.Recipient, "amount", withdrawal.Amount, "priority_fee", withdrawal.PriorityFee)
 
 		nativeTokens := sdk.NewDecFromInt(withdrawal.BurnAmount.Amount).Mul(rate).TruncateInt()
 		amount := sdk.NewCoin(zone.BaseDenom, nativeTokens)
@@ -175,30 +183,63 @@ func (k *Keeper) HandleQueuedUnbondings(ctx sdk.Context, zone *types.Zone, epoch
 
 		if !amount.IsPositive() {
 			k.Logger(ctx).Error("withdrawal %s attempting to withdraw non-positive amount; cannot process...", withdrawal.Txhash)
-			return false
+			continue
 		}
 
 		withdrawal.Amount = sdk.NewCoins(amount)
 		k.SetWithdrawalRecord(ctx, withdrawal)
 
+		if allowance != nil {
+			remaining, capped, exhausted := allowance.remaining(ctx, k, zone.ChainId, withdrawal.Delegator)
+			if exhausted {
+				k.Logger(ctx).Info("epoch redemption cap reached; deferring further unbondings", "chain_id", zone.ChainId)
+				break
+			}
+			if capped && remaining.LT(withdrawal.BurnAmount.Amount) {
+				deferred := withdrawal.BurnAmount.SubAmount(remaining)
+				split, ok := k.splitQueuedWithdrawalRecord(ctx, zone, withdrawal, sdk.NewDecFromInt(remaining).Mul(rate).TruncateInt(), rate)
+				if !ok {
+					// the address cap is exhausted; leave this withdrawal queued, but continue with others.
+					k.emitRedemptionDeferred(ctx, zone, withdrawal, withdrawal.BurnAmount, epoch)
+					continue
+				}
+				k.emitRedemptionDeferred(ctx, zone, withdrawal, deferred, epoch)
+				withdrawal = split
+			}
+		}
+
 		// check whether the running total of withdrawals can be satisfied by the available unlocked tokens.
-		// if not return true to stop iterating and return all records up until now.
+		// if not, split the withdrawal such that the remaining unlocked tokens are unbonded now, and the remainder is
+		// queued for a subsequent epoch.
+		exhausted := false
 		if totalAvailable.LT(totalToWithdraw.Amount.Add(withdrawal.Amount[0].Amount)) {
 			k.Logger(ctx).Error("unable to satisfy further unbondings this epoch")
-			// do not process this or subsequent withdrawals this epoch.
-			return true
+			split, ok := k.splitQueuedWithdrawalRecord(ctx, zone, withdrawal, totalAvailable.Sub(totalToWithdraw.Amount), rate)
+			if !ok {
+				// do not process this or subsequent withdrawals this epoch.
+				break
+			}
+			withdrawal = split
+			exhausted = true
 		}
 
 		// increment total to withdraw by the withdrawal amount
 		totalToWithdraw = totalToWithdraw.Add(withdrawal.Amount[0])
+		if allowance != nil {
+			allowance.add(withdrawal.Delegator, withdrawal.BurnAmount.Amount)
+		}
 
 		// set per withdrawal amount
 		amountToWithdrawPerWithdrawal[withdrawal.Txhash] = withdrawal.Amount[0]
 
 		// initialise empty distribution slice per withdrawal
 		distributionsPerWithdrawal[withdrawal.Txhash] = make([]*types.Distribution, 0)
-		return false
-	})
+
+		if exhausted {
+			// unlocked tokens have been exhausted; subsequent withdrawals must wait for a later epoch.
+			break
+		}
+	}
 
 	// no undelegations to attempt
 	if len(amountToWithdrawPerWithdrawal) == 0 {
@@ -222,6 +263,7 @@ func (k *Keeper) HandleQueuedUnbondings(ctx sdk.Context, zone *types.Zone, epoch
 		}
 		record.Distribution = distributionsPerWithdrawal[hash]
 		k.UpdateWithdrawalRecordStatus(ctx, &record, types.WithdrawStatusUnbond)
+		k.RecordEpochRedemption(ctx, zone, record.Delegator, record.BurnAmount.Amount)
 	}
 
 	if len(txHashesPerValidator) == 0 {
@@ -258,6 +300,178 @@ func (k *Keeper) HandleQueuedUnbondings(ctx sdk.Context, zone *types.Zone, epoch
 	return nil
 }
 
+// emitRedemptionDeferred emits an event recording that the given amount of a queued withdrawal was left queued at the
+// end of the epoch, due to the zone's epoch caps.
+func (k *Keeper) emitRedemptionDeferred(ctx sdk.Context, zone *types.Zone, record types.WithdrawalRecord, deferred sdk.Coin, epoch int64) {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRedemptionDeferred{
+		ChainId:        zone.ChainId,
+		Hash:           record.Txhash,
+		Delegator:      record.Delegator,
+		DeferredAmount: deferred,
+		EpochNumber:    epoch,
+	}); err != nil {
+		k.Logger(ctx).Error("unable to emit redemption deferred event", "error", err)
+	}
+}
+
+// splitQueuedWithdrawalRecord reduces a queued withdrawal record to the burn amount that can be satisfied by the given
+// amount of native tokens at the given rate, and queues the remainder as a new record, to be processed in a subsequent
+// epoch. The priority fee is split pro rata, such that both records retain their position in the queue. It returns the
+// reduced record, or false if no part of the record can be satisfied.
+func (k *Keeper) splitQueuedWithdrawalRecord(ctx sdk.Context, zone *types.Zone, record types.WithdrawalRecord, available sdkmath.Int, rate sdk.Dec) (types.WithdrawalRecord, bool) {
+	if !available.IsPositive() || !rate.IsPositive() {
+		return record, false
+	}
+
+	burnAmount := sdk.NewDecFromInt(available).Quo(rate).TruncateInt()
+	nativeTokens := sdk.NewDecFromInt(burnAmount).Mul(rate).TruncateInt()
+	if !burnAmount.IsPositive() || !nativeTokens.IsPositive() || burnAmount.GTE(record.BurnAmount.Amount) {
+		return record, false
+	}
+
+	remainder := types.WithdrawalRecord{
+		ChainId:     record.ChainId,
+		Delegator:   record.Delegator,
+		Recipient:   record.Recipient,
+		BurnAmount:  record.BurnAmount.SubAmount(burnAmount),
+		Txhash:      fmt.Sprintf("%064d", k.GetNextWithdrawalRecordSequence(ctx)),
+		Status:      types.WithdrawStatusQueued,
+		EpochNumber: record.EpochNumber,
+	}
+	if record.PriorityFee != nil {
+		remainderFee := sdk.NewDecFromInt(record.PriorityFee.Amount).MulInt(remainder.BurnAmount.Amount).QuoInt(record.BurnAmount.Amount).TruncateInt()
+		if remainderFee.IsPositive() {
+			fee := sdk.NewCoin(record.PriorityFee.Denom, remainderFee)
+			remainder.PriorityFee = &fee
+			fee = record.PriorityFee.SubAmount(remainderFee)
+			record.PriorityFee = &fee
+		}
+	}
+	k.SetWithdrawalRecord(ctx, remainder)
+
+	record.BurnAmount = record.BurnAmount.SubAmount(remainder.BurnAmount.Amount)
+	record.Amount = sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, nativeTokens))
+	k.SetWithdrawalRecord(ctx, record)
+
+	k.Logger(ctx).Info("split queued withdrawal record", "txhash", record.Txhash, "burn_amount", record.BurnAmount, "remainder_txhash", remainder.Txhash, "remainder_burn_amount", remainder.BurnAmount)
+
+	return record, true
+}
+
+// EstimateRedemptionQueue returns the queued withdrawal records of the zone in processing order, with the epoch at
+// the end of which each is expected to be unbonded, and the epoch in which that unbonding is expected to complete.
+// Tokens currently locked by redelegations are assumed to become available as those redelegations complete; new
+// deposits and rewards are ignored, so estimates are conservative. Records that cannot be satisfied by the tokens
+// delegated have zero estimates.
+func (k *Keeper) EstimateRedemptionQueue(ctx sdk.Context, zone *types.Zone) ([]types.RedemptionQueuePosition, sdkmath.Int, error) {
+	_, available, err := k.GetUnlockedTokensForZone(ctx, zone)
+	if err != nil {
+		return nil, sdkmath.ZeroInt(), err
+	}
+
+	// tokens locked by redelegations, in order of unlock.
+	unlocks := make([]types.RedelegationRecord, 0)
+	for _, record := range k.ZoneRedelegationRecords(ctx, zone.ChainId) {
+		if record.IsLocked(ctx.BlockTime()) {
+			unlocks = append(unlocks, record)
+		}
+	}
+	sort.SliceStable(unlocks, func(i, j int) bool {
+		return unlocks[i].CompletionTime.Before(unlocks[j].CompletionTime)
+	})
+
+	epochInfo := k.EpochsKeeper.GetEpochInfo(ctx, epochstypes.EpochIdentifierEpoch)
+	epochEnd := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+	epochForTime := func(t time.Time) int64 {
+		if !t.After(epochEnd) || epochInfo.Duration <= 0 {
+			return epochInfo.CurrentEpoch
+		}
+		return epochInfo.CurrentEpoch + int64((t.Sub(epochEnd)+epochInfo.Duration-1)/epochInfo.Duration)
+	}
+	unbondingEpochs := int64(0)
+	if epochInfo.Duration > 0 {
+		unbondingEpochs = int64((time.Duration(zone.UnbondingPeriod) + epochInfo.Duration - 1) / epochInfo.Duration)
+	}
+
+	rate := sdk.MinDec(zone.LastRedemptionRate, zone.RedemptionRate)
+	capacity := available
+	required := sdk.ZeroInt()
+	unlockIdx := 0
+	unlockTime := time.Time{}
+
+	positions := make([]types.RedemptionQueuePosition, 0)
+	for idx, record := range k.QueuedWithdrawalRecordsByPriority(ctx, zone.ChainId) {
+		amount := sdk.NewCoin(zone.BaseDenom, sdk.NewDecFromInt(record.BurnAmount.Amount).Mul(rate).TruncateInt())
+		required = required.Add(amount.Amount)
+
+		// release locked tokens until the running total of withdrawals can be satisfied.
+		for capacity.LT(required) && unlockIdx < len(unlocks) {
+			capacity = capacity.Add(sdk.NewInt(unlocks[unlockIdx].Amount))
+			unlockTime = unlocks[unlockIdx].CompletionTime
+			unlockIdx++
+		}
+
+		position := types.RedemptionQueuePosition{
+			Txhash:          record.Txhash,
+			Delegator:       record.Delegator,
+			Position:        uint32(idx),
+			BurnAmount:      record.BurnAmount,
+			PriorityFee:     record.PriorityFee,
+			EstimatedAmount: amount,
+		}
+		if capacity.GTE(required) {
+			position.EstimatedUnbondEpoch = epochForTime(unlockTime)
+			position.EstimatedCompletionEpoch = position.EstimatedUnbondEpoch + unbondingEpochs
+		}
+		positions = append(positions, position)
+	}
+
+	return positions, available, nil
+}
+
+// CancelUnbondingForWithdrawalRecord submits MsgCancelUnbondingDelegation for each distribution of an unbonding
+// withdrawal record, returning the unbonding tokens to delegation on the host chain. The record is moved to status
+// CANCEL until the host acknowledges the cancellation.
+func (k *Keeper) CancelUnbondingForWithdrawalRecord(ctx sdk.Context, zone *types.Zone, record types.WithdrawalRecord) error {
+	if !record.Acknowledged {
+		return fmt.Errorf("unbonding for record with hash \"%s\" has not been acknowledged", record.Txhash)
+	}
+
+	if !ctx.BlockTime().Before(record.CompletionTime) {
+		return fmt.Errorf("unbonding for record with hash \"%s\" has already matured", record.Txhash)
+	}
+
+	msgs := make([]sdk.Msg, 0, len(record.Distribution))
+	for _, dist := range record.Distribution {
+		ubr, found := k.GetUnbondingRecordForWithdrawal(ctx, zone.ChainId, dist.Valoper, record.Txhash)
+		if !found {
+			return fmt.Errorf("unable to find unbonding record for validator %s and hash %s", dist.Valoper, record.Txhash)
+		}
+		if ubr.CreationHeight == 0 {
+			return fmt.Errorf("creation height of unbonding from %s is not yet known; try again later", dist.Valoper)
+		}
+		msgs = append(msgs, &stakingtypes.MsgCancelUnbondingDelegation{
+			DelegatorAddress: zone.DelegationAddress.Address,
+			ValidatorAddress: dist.Valoper,
+			Amount:           sdk.NewCoin(zone.BaseDenom, sdk.NewIntFromUint64(dist.Amount)),
+			CreationHeight:   ubr.CreationHeight,
+		})
+	}
+
+	if len(msgs) == 0 {
+		return fmt.Errorf("no distributions to cancel for record with hash \"%s\"", record.Txhash)
+	}
+
+	k.Logger(ctx).Info("cancel unbonding messages to send", "msg", msgs)
+
+	if err := k.SubmitTx(ctx, msgs, zone.DelegationAddress, types.TxCancelUnbondMemo(record.Txhash), zone.MessagesPerTx); err != nil {
+		return err
+	}
+
+	k.UpdateWithdrawalRecordStatus(ctx, &record, types.WithdrawStatusCancel)
+	return nil
+}
+
 func (k *Keeper) GCCompletedUnbondings(ctx sdk.Context, zone *types.Zone) error {
 	var err error
 
@@ -19,6 +19,7 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v5/modules/core/03-connection/types"
 	tmclienttypes "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
 
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	"github.com/quicksilver-zone/quicksilver/utils/ica"
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/keeper"
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
@@ -390,3 +391,255 @@ func TestDetermineAllocationsForUndelegationReturnsNoNegatives(t *testing.T) {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestRequestRedemptionPriorityFee() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+
+	params := quicksilver.InterchainstakingKeeper.GetParams(ctx)
+	params.UnbondingEnabled = true
+	quicksilver.InterchainstakingKeeper.SetParams(ctx, params)
+
+	zone, found := quicksilver.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	zone.UnbondingEnabled = true
+	quicksilver.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+	sender := addressutils.GenerateAccAddressForTest()
+	suite.mintQAssets(ctx, zone, sender, 10000000)
+	supply := quicksilver.BankKeeper.GetSupply(ctx, zone.LocalDenom)
+
+	fee := sdk.NewCoin(zone.LocalDenom, sdk.NewInt(100000))
+	msg := types.MsgRequestRedemption{
+		Value:              sdk.NewCoin(zone.LocalDenom, sdk.NewInt(5000000)),
+		DestinationAddress: zone.DelegationAddress.Address,
+		FromAddress:        sender.String(),
+		PriorityFee:        &fee,
+	}
+	_, err := keeper.NewMsgServerImpl(quicksilver.InterchainstakingKeeper).RequestRedemption(sdk.WrapSDKContext(ctx), &msg)
+	suite.NoError(err)
+
+	// the fee is burned immediately; the redeemed amount is held in escrow until unbonded.
+	suite.Equal(supply.Amount.Sub(fee.Amount), quicksilver.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount)
+	suite.Equal(sdk.NewInt(4900000), quicksilver.BankKeeper.GetBalance(ctx, sender, zone.LocalDenom).Amount)
+
+	records := quicksilver.InterchainstakingKeeper.QueuedWithdrawalRecordsByPriority(ctx, zone.ChainId)
+	suite.Len(records, 1)
+	suite.Equal(msg.Value, records[0].BurnAmount)
+	suite.Equal(&fee, records[0].PriorityFee)
+}
+
+func (suite *KeeperTestSuite) TestHandleQueuedUnbondingsPriority() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+
+	zone, found := quicksilver.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	for _, val := range quicksilver.InterchainstakingKeeper.GetValidators(ctx, zone.ChainId) {
+		quicksilver.InterchainstakingKeeper.SetDelegation(ctx, zone.ChainId, types.NewDelegation(zone.DelegationAddress.Address, val.ValoperAddress, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))))
+	}
+	available := sdk.NewInt(1000).MulRaw(int64(len(quicksilver.InterchainstakingKeeper.GetValidators(ctx, zone.ChainId))))
+
+	fee := sdk.NewCoin(zone.LocalDenom, sdk.NewInt(10))
+	// the earlier record, without a fee, would be processed first were it not for the later record's fee; only one
+	// of the two can be satisfied in full.
+	fifo := types.WithdrawalRecord{
+		ChainId:     zone.ChainId,
+		Delegator:   testAddress,
+		Recipient:   zone.DelegationAddress.Address,
+		BurnAmount:  sdk.NewCoin(zone.LocalDenom, available.SubRaw(100)),
+		Txhash:      "aaaa",
+		Status:      types.WithdrawStatusQueued,
+		EpochNumber: 1,
+	}
+	priority := types.WithdrawalRecord{
+		ChainId:     zone.ChainId,
+		Delegator:   testAddress,
+		Recipient:   zone.DelegationAddress.Address,
+		BurnAmount:  sdk.NewCoin(zone.LocalDenom, sdk.NewInt(500)),
+		Txhash:      "bbbb",
+		Status:      types.WithdrawStatusQueued,
+		EpochNumber: 2,
+		PriorityFee: &fee,
+	}
+	quicksilver.InterchainstakingKeeper.SetWithdrawalRecord(ctx, fifo)
+	quicksilver.InterchainstakingKeeper.SetWithdrawalRecord(ctx, priority)
+
+	records := quicksilver.InterchainstakingKeeper.QueuedWithdrawalRecordsByPriority(ctx, zone.ChainId)
+	suite.Len(records, 2)
+	suite.Equal(priority.Txhash, records[0].Txhash)
+	suite.Equal(fifo.Txhash, records[1].Txhash)
+
+	suite.NoError(quicksilver.InterchainstakingKeeper.HandleQueuedUnbondings(ctx, &zone, 1))
+
+	_, found = quicksilver.InterchainstakingKeeper.GetWithdrawalRecord(ctx, zone.ChainId, priority.Txhash, types.WithdrawStatusUnbond)
+	suite.True(found)
+
+	// the earlier record is split; the remaining unlocked tokens are unbonded now, and the remainder is queued.
+	unbonding, found := quicksilver.InterchainstakingKeeper.GetWithdrawalRecord(ctx, zone.ChainId, fifo.Txhash, types.WithdrawStatusUnbond)
+	suite.True(found)
+	suite.Equal(available.SubRaw(500), unbonding.BurnAmount.Amount)
+
+	records = quicksilver.InterchainstakingKeeper.QueuedWithdrawalRecordsByPriority(ctx, zone.ChainId)
+	suite.Len(records, 1)
+	suite.Equal(sdk.NewInt(400), records[0].BurnAmount.Amount)
+	suite.Equal(fifo.Delegator, records[0].Delegator)
+	suite.Equal(fifo.EpochNumber, records[0].EpochNumber)
+}
+
+func (suite *KeeperTestSuite) TestEstimateRedemptionQueue() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+
+	zone, found := quicksilver.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	zone.RedemptionRate = sdk.OneDec()
+	zone.LastRedemptionRate = sdk.OneDec()
+	quicksilver.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+	vals := quicksilver.InterchainstakingKeeper.GetValidators(ctx, zone.ChainId)
+	for _, val := range vals[:3] {
+		quicksilver.InterchainstakingKeeper.SetDelegation(ctx, zone.ChainId, types.NewDelegation(zone.DelegationAddress.Address, val.ValoperAddress, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(100))))
+	}
+
+	epochInfo := quicksilver.EpochsKeeper.GetEpochInfo(ctx, "epoch")
+	epochInfo.CurrentEpochStartTime = ctx.BlockTime()
+	quicksilver.EpochsKeeper.SetEpochInfo(ctx, epochInfo)
+	epochEnd := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+	// 50 tokens are locked until part way through the second epoch after this one.
+	quicksilver.InterchainstakingKeeper.SetRedelegationRecord(ctx, types.RedelegationRecord{
+		ChainId:        zone.ChainId,
+		EpochNumber:    1,
+		Source:         vals[0].ValoperAddress,
+		Destination:    vals[2].ValoperAddress,
+		Amount:         50,
+		CompletionTime: epochEnd.Add(epochInfo.Duration + epochInfo.Duration/2),
+	})
+
+	fee := sdk.NewCoin(zone.LocalDenom, sdk.NewInt(10))
+	for _, record := range []types.WithdrawalRecord{
+		{Txhash: "aaaa", BurnAmount: sdk.NewCoin(zone.LocalDenom, sdk.NewInt(80)), EpochNumber: 1},
+		{Txhash: "bbbb", BurnAmount: sdk.NewCoin(zone.LocalDenom, sdk.NewInt(200)), EpochNumber: 2, PriorityFee: &fee},
+		{Txhash: "cccc", BurnAmount: sdk.NewCoin(zone.LocalDenom, sdk.NewInt(100)), EpochNumber: 2},
+	} {
+		record.ChainId = zone.ChainId
+		record.Delegator = testAddress
+		record.Recipient = zone.DelegationAddress.Address
+		record.Status = types.WithdrawStatusQueued
+		quicksilver.InterchainstakingKeeper.SetWithdrawalRecord(ctx, record)
+	}
+
+	positions, available, err := quicksilver.InterchainstakingKeeper.EstimateRedemptionQueue(ctx, &zone)
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt(250), available)
+	suite.Len(positions, 3)
+
+	unbondingEpochs := int64((time.Duration(zone.UnbondingPeriod) + epochInfo.Duration - 1) / epochInfo.Duration)
+
+	// the fee paying record is first, and can be satisfied by unlocked tokens this epoch.
+	suite.Equal("bbbb", positions[0].Txhash)
+	suite.Equal(uint32(0), positions[0].Position)
+	suite.Equal(&fee, positions[0].PriorityFee)
+	suite.Equal(sdk.NewInt(200), positions[0].EstimatedAmount.Amount)
+	suite.Equal(epochInfo.CurrentEpoch, positions[0].EstimatedUnbondEpoch)
+	suite.Equal(epochInfo.CurrentEpoch+unbondingEpochs, positions[0].EstimatedCompletionEpoch)
+
+	// the next record requires the redelegated tokens to unlock.
+	suite.Equal("aaaa", positions[1].Txhash)
+	suite.Equal(epochInfo.CurrentEpoch+2, positions[1].EstimatedUnbondEpoch)
+	suite.Equal(epochInfo.CurrentEpoch+2+unbondingEpochs, positions[1].EstimatedCompletionEpoch)
+
+	// the last record cannot be satisfied by the tokens delegated.
+	suite.Equal("cccc", positions[2].Txhash)
+	suite.Equal(int64(0), positions[2].EstimatedUnbondEpoch)
+	suite.Equal(int64(0), positions[2].EstimatedCompletionEpoch)
+}
+
+// TestHandleQueuedUnbondingsRandomLocks asserts, for random delegations, redelegation locks and queued withdrawals,
+// that no validator is asked to undelegate more than its unlocked amount, and that withdrawals blocked by locked
+// tokens are split, rather than lost.
+func (suite *KeeperTestSuite) TestHandleQueuedUnbondingsRandomLocks() {
+	r := rand.New(rand.NewSource(1)) //nolint:gosec // deterministic randomness is intended here.
+	for i := 0; i < 10; i++ {
+		suite.SetupTest()
+		suite.setupTestZones()
+
+		quicksilver := suite.GetQuicksilverApp(suite.chainA)
+		ctx := suite.chainA.GetContext()
+
+		zone, found := quicksilver.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+		suite.True(found)
+		zone.RedemptionRate = sdk.OneDec()
+		zone.LastRedemptionRate = sdk.OneDec()
+		quicksilver.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+		vals := quicksilver.InterchainstakingKeeper.GetValidatorAddresses(ctx, zone.ChainId)
+		for _, val := range vals {
+			quicksilver.InterchainstakingKeeper.SetDelegation(ctx, zone.ChainId, types.NewDelegation(zone.DelegationAddress.Address, val, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000+r.Int63n(100000)))))
+		}
+
+		// redelegations are unacknowledged, incomplete or complete.
+		for idx, val := range vals {
+			delegation, found := quicksilver.InterchainstakingKeeper.GetDelegation(ctx, zone.ChainId, zone.DelegationAddress.Address, val)
+			suite.True(found)
+			completion := []time.Time{{}, ctx.BlockTime().Add(time.Hour), ctx.BlockTime().Add(-time.Hour)}[r.Intn(3)]
+			quicksilver.InterchainstakingKeeper.SetRedelegationRecord(ctx, types.RedelegationRecord{
+				ChainId:        zone.ChainId,
+				EpochNumber:    1,
+				Source:         vals[(idx+1)%len(vals)],
+				Destination:    val,
+				Amount:         r.Int63n(delegation.Amount.Amount.Int64() + 1),
+				CompletionTime: completion,
+			})
+		}
+
+		availablePerValidator, available, err := quicksilver.InterchainstakingKeeper.GetUnlockedTokensForZone(ctx, &zone)
+		suite.NoError(err)
+
+		requested := sdk.ZeroInt()
+		for j := 0; j < 1+r.Intn(5); j++ {
+			burnAmount := sdk.NewInt(1 + r.Int63n(available.Int64()))
+			requested = requested.Add(burnAmount)
+			quicksilver.InterchainstakingKeeper.SetWithdrawalRecord(ctx, types.WithdrawalRecord{
+				ChainId:     zone.ChainId,
+				Delegator:   testAddress,
+				Recipient:   zone.DelegationAddress.Address,
+				BurnAmount:  sdk.NewCoin(zone.LocalDenom, burnAmount),
+				Txhash:      fmt.Sprintf("%064x", 0xabcdef00+j),
+				Status:      types.WithdrawStatusQueued,
+				EpochNumber: int64(j),
+			})
+		}
+
+		suite.NoError(quicksilver.InterchainstakingKeeper.HandleQueuedUnbondings(ctx, &zone, 1))
+
+		unbonded := sdk.ZeroInt()
+		for _, val := range vals {
+			record, found := quicksilver.InterchainstakingKeeper.GetUnbondingRecord(ctx, zone.ChainId, val, 1)
+			if !found {
+				continue
+			}
+			suite.True(record.Amount.Amount.LTE(availablePerValidator[val]), "iteration %d: unbonding %s exceeds unlocked %s for %s", i, record.Amount.Amount, availablePerValidator[val], val)
+			unbonded = unbonded.Add(record.Amount.Amount)
+		}
+		suite.True(unbonded.LTE(available))
+		suite.Equal(sdk.MinInt(requested, available), unbonded, "iteration %d", i)
+
+		// the sum of burn amounts is unchanged by splitting.
+		burned := sdk.ZeroInt()
+		for _, record := range quicksilver.InterchainstakingKeeper.AllZoneWithdrawalRecords(ctx, zone.ChainId) {
+			burned = burned.Add(record.BurnAmount.Amount)
+		}
+		suite.Equal(requested, burned, "iteration %d", i)
+	}
+}
@@ -0,0 +1,297 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types/gamm"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// GetRewardSwapConfig returns the reward swap routes for the given zone.
+func (k *Keeper) GetRewardSwapConfig(ctx sdk.Context, chainID string) (types.RewardSwapConfig, bool) {
+	config := types.RewardSwapConfig{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardSwapConfig)
+	bz := store.Get([]byte(chainID))
+	if len(bz) == 0 {
+		return config, false
+	}
+	k.cdc.MustUnmarshal(bz, &config)
+	return config, true
+}
+
+// SetRewardSwapConfig stores the reward swap routes for a zone.
+func (k *Keeper) SetRewardSwapConfig(ctx sdk.Context, config types.RewardSwapConfig) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardSwapConfig)
+	bz := k.cdc.MustMarshal(&config)
+	store.Set([]byte(config.ChainId), bz)
+}
+
+// DeleteRewardSwapConfig deletes the reward swap routes for a zone.
+func (k *Keeper) DeleteRewardSwapConfig(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardSwapConfig)
+	store.Delete([]byte(chainID))
+}
+
+// GetSwapPool returns the cached state of a host chain pool.
+func (k *Keeper) GetSwapPool(ctx sdk.Context, chainID string, poolID uint64) (types.SwapPool, bool) {
+	pool := types.SwapPool{}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSwapPoolKey(chainID, poolID))
+	if len(bz) == 0 {
+		return pool, false
+	}
+	k.cdc.MustUnmarshal(bz, &pool)
+	return pool, true
+}
+
+// SetSwapPool stores the cached state of a host chain pool.
+func (k *Keeper) SetSwapPool(ctx sdk.Context, pool types.SwapPool) {
+	bz := k.cdc.MustMarshal(&pool)
+	ctx.KVStore(k.storeKey).Set(types.GetSwapPoolKey(pool.ChainId, pool.PoolId), bz)
+}
+
+// DeleteSwapPool deletes the cached state of a host chain pool.
+func (k *Keeper) DeleteSwapPool(ctx sdk.Context, chainID string, poolID uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetSwapPoolKey(chainID, poolID))
+}
+
+// IterateZoneSwapPools iterates through the cached pools of the given zone.
+func (k *Keeper) IterateZoneSwapPools(ctx sdk.Context, chainID string, fn func(index int64, pool types.SwapPool) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetZoneSwapPoolsKey(chainID))
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		pool := types.SwapPool{}
+		k.cdc.MustUnmarshal(iterator.Value(), &pool)
+
+		// chain ids are not length prefixed in the key.
+		if pool.ChainId != chainID {
+			continue
+		}
+
+		stop := fn(i, pool)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllZoneSwapPools returns every cached pool for the given zone.
+func (k *Keeper) AllZoneSwapPools(ctx sdk.Context, chainID string) []types.SwapPool {
+	pools := []types.SwapPool{}
+	k.IterateZoneSwapPools(ctx, chainID, func(_ int64, pool types.SwapPool) (stop bool) {
+		pools = append(pools, pool)
+		return false
+	})
+	return pools
+}
+
+// SetRewardSwapRoutes replaces the reward swap routes for a zone, after checking
+// that each route swaps a non-base denom into the zone's base denom. Empty
+// routes disable reward swapping for the zone. Cached pools no longer used by
+// any route are removed, and the state of the pools in use is requested.
+func (k *Keeper) SetRewardSwapRoutes(ctx sdk.Context, zone *types.Zone, routes []types.RewardSwapRoute) error {
+	if err := types.ValidateRewardSwapRoutes(routes); err != nil {
+		return err
+	}
+
+	for _, route := range routes {
+		if route.Denom == zone.BaseDenom {
+			return fmt.Errorf("cannot swap base denom %s", zone.BaseDenom)
+		}
+		if route.OutDenom() != zone.BaseDenom {
+			return fmt.Errorf("route for %s must end in base denom %s, got %s", route.Denom, zone.BaseDenom, route.OutDenom())
+		}
+	}
+
+	config := types.RewardSwapConfig{ChainId: zone.ChainId, Routes: routes}
+	if len(routes) == 0 {
+		k.DeleteRewardSwapConfig(ctx, zone.ChainId)
+	} else {
+		k.SetRewardSwapConfig(ctx, config)
+	}
+
+	inUse := make(map[uint64]struct{})
+	for _, poolID := range config.PoolIDs() {
+		inUse[poolID] = struct{}{}
+	}
+	for _, pool := range k.AllZoneSwapPools(ctx, zone.ChainId) {
+		if _, ok := inUse[pool.PoolId]; !ok {
+			k.DeleteSwapPool(ctx, zone.ChainId, pool.PoolId)
+		}
+	}
+
+	k.EmitRewardSwapPoolQueries(ctx, zone)
+	return nil
+}
+
+// EmitRewardSwapPoolQueries requests the current state of every pool used by
+// the zone's reward swap routes.
+func (k *Keeper) EmitRewardSwapPoolQueries(ctx sdk.Context, zone *types.Zone) {
+	config, found := k.GetRewardSwapConfig(ctx, zone.ChainId)
+	if !found {
+		return
+	}
+
+	for _, poolID := range config.PoolIDs() {
+		k.ICQKeeper.MakeRequest(
+			ctx,
+			zone.ConnectionId,
+			zone.ChainId,
+			"store/gamm/key",
+			gamm.GetKeyPrefixPools(poolID),
+			sdk.NewInt(-1),
+			types.ModuleName,
+			"swappool",
+			0,
+		)
+	}
+}
+
+// getFreshSwapPool returns the cached state of a pool if it was updated
+// within maxAge.
+func (k *Keeper) getFreshSwapPool(ctx sdk.Context, chainID string, poolID uint64, maxAge time.Duration) (gamm.PoolI, error) {
+	cached, found := k.GetSwapPool(ctx, chainID, poolID)
+	if !found {
+		return nil, fmt.Errorf("no state for pool %d", poolID)
+	}
+	if cached.LastUpdated.Add(maxAge).Before(ctx.BlockTime()) {
+		return nil, fmt.Errorf("state for pool %d is stale; last updated %s", poolID, cached.LastUpdated)
+	}
+
+	var pool gamm.PoolI
+	if err := k.cdc.UnmarshalInterface(cached.Pool, &pool); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+// EstimateRewardSwap calculates the output of swapping tokenIn along the route,
+// using cached pool state no older than maxAge.
+func (k *Keeper) EstimateRewardSwap(ctx sdk.Context, chainID string, route types.RewardSwapRoute, tokenIn sdk.Coin, maxAge time.Duration) (sdk.Coin, error) {
+	out := tokenIn
+	for _, hop := range route.Hops {
+		pool, err := k.getFreshSwapPool(ctx, chainID, hop.PoolId, maxAge)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		if !pool.IsActive(ctx) {
+			return sdk.Coin{}, fmt.Errorf("pool %d is not active", hop.PoolId)
+		}
+		out, err = pool.CalcOutAmtGivenIn(ctx, sdk.NewCoins(out), hop.TokenOutDenom, pool.GetSwapFee(ctx))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+	return out, nil
+}
+
+// PrepareRewardSwapMsgs returns swap messages for each balance that has a
+// reward swap route, along with the balances that have a route. Routed
+// balances that cannot be swapped now, because pool state is missing or older
+// than RewardSwapPoolMaxAge or the amount is too small, remain in the
+// withdrawal account to be swapped at a later distribution.
+func (k *Keeper) PrepareRewardSwapMsgs(ctx sdk.Context, zone *types.Zone, balances sdk.Coins) ([]sdk.Msg, sdk.Coins) {
+	config, found := k.GetRewardSwapConfig(ctx, zone.ChainId)
+	if !found {
+		return nil, sdk.Coins{}
+	}
+
+	msgs := make([]sdk.Msg, 0)
+	routed := sdk.Coins{}
+	for _, coin := range balances {
+		if coin.Denom == zone.BaseDenom {
+			continue
+		}
+		route, found := config.GetRoute(coin.Denom)
+		if !found {
+			continue
+		}
+		routed = routed.Add(coin)
+
+		minOut, err := k.rewardSwapMinOut(ctx, zone, route, coin, types.RewardSwapPoolMaxAge)
+		if err != nil {
+			k.Logger(ctx).Info("unable to swap rewards; retaining for next distribution", "chain_id", zone.ChainId, "denom", coin.Denom, "amount", coin.Amount, "reason", err)
+			continue
+		}
+
+		swapRoutes := make([]gamm.SwapAmountInRoute, 0, len(route.Hops))
+		for _, hop := range route.Hops {
+			swapRoutes = append(swapRoutes, gamm.SwapAmountInRoute{PoolId: hop.PoolId, TokenOutDenom: hop.TokenOutDenom})
+		}
+
+		msgs = append(msgs, &gamm.MsgSwapExactAmountIn{
+			Sender:            zone.WithdrawalAddress.Address,
+			Routes:            swapRoutes,
+			TokenIn:           coin,
+			TokenOutMinAmount: minOut,
+		})
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRewardSwap,
+				sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+				sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+				sdk.NewAttribute(types.AttributeKeyAmount, coin.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyMinOutAmount, minOut.String()),
+			),
+		)
+	}
+
+	return msgs, routed
+}
+
+// rewardSwapMinOut returns the minimum acceptable output of a swap, given the
+// route's max slippage.
+func (k *Keeper) rewardSwapMinOut(ctx sdk.Context, zone *types.Zone, route types.RewardSwapRoute, coin sdk.Coin, maxAge time.Duration) (sdkmath.Int, error) {
+	estimate, err := k.EstimateRewardSwap(ctx, zone.ChainId, route, coin, maxAge)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	minOut := sdk.NewDecFromInt(estimate.Amount).Mul(sdk.OneDec().Sub(route.MaxSlippage)).TruncateInt()
+	if !minOut.IsPositive() {
+		return sdkmath.ZeroInt(), errors.New("swap output too small")
+	}
+	return minOut, nil
+}
+
+// HandleRewardSwap handles the acknowledgement of a reward swap. The output
+// remains in the withdrawal account and is distributed as rewards in the base
+// denom at the next distribution.
+func (k *Keeper) HandleRewardSwap(ctx sdk.Context, msg sdk.Msg, tokenOut sdkmath.Int, connectionID string) error {
+	swapMsg, ok := msg.(*gamm.MsgSwapExactAmountIn)
+	if !ok {
+		return errors.New("unable to cast source message to MsgSwapExactAmountIn")
+	}
+
+	zone, err := k.GetZoneFromConnectionID(ctx, connectionID)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("rewards swapped", "chain_id", zone.ChainId, "token_in", swapMsg.TokenIn, "token_out", tokenOut, "min_out", swapMsg.TokenOutMinAmount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardSwapComplete,
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyDenom, swapMsg.TokenIn.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, swapMsg.TokenIn.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyOutAmount, tokenOut.String()),
+		),
+	)
+
+	return nil
+}
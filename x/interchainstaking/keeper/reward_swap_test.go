@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	"github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types/gamm"
+	"github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types/gamm/pool-models/balancer"
+	"github.com/quicksilver-zone/quicksilver/utils/ica"
+	icqtypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+	icskeeper "github.com/quicksilver-zone/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// marshalledBalancerPool returns an interface-encoded two asset balancer pool
+// of equal weights, as it would be returned by the host chain.
+func (suite *KeeperTestSuite) marshalledBalancerPool(poolID uint64, assets sdk.Coins) []byte {
+	poolAssets := make([]balancer.PoolAsset, 0, len(assets))
+	for _, asset := range assets {
+		poolAssets = append(poolAssets, balancer.PoolAsset{Token: asset, Weight: sdkmath.NewInt(100)})
+	}
+	pool, err := balancer.NewBalancerPool(poolID, balancer.PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec()}, poolAssets, "", time.Now())
+	suite.NoError(err)
+
+	var poolI gamm.PoolI = &pool
+	bz, err := suite.GetQuicksilverApp(suite.chainA).AppCodec().MarshalInterface(poolI)
+	suite.NoError(err)
+	return bz
+}
+
+func (suite *KeeperTestSuite) TestSetRewardSwapRoutes() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	slippage := sdk.MustNewDecFromStr("0.01")
+
+	// routes must swap a non-base denom into the base denom.
+	err := icsKeeper.SetRewardSwapRoutes(ctx, &zone, []icstypes.RewardSwapRoute{{Denom: zone.BaseDenom, Hops: []icstypes.SwapHop{{PoolId: 1, TokenOutDenom: "uosmo"}}, MaxSlippage: slippage}})
+	suite.ErrorContains(err, "cannot swap base denom")
+	err = icsKeeper.SetRewardSwapRoutes(ctx, &zone, []icstypes.RewardSwapRoute{{Denom: "ujuno", Hops: []icstypes.SwapHop{{PoolId: 1, TokenOutDenom: "uosmo"}}, MaxSlippage: slippage}})
+	suite.ErrorContains(err, "must end in base denom")
+	_, found = icsKeeper.GetRewardSwapConfig(ctx, zone.ChainId)
+	suite.False(found)
+
+	routes := []icstypes.RewardSwapRoute{
+		{Denom: "uosmo", Hops: []icstypes.SwapHop{{PoolId: 1, TokenOutDenom: zone.BaseDenom}}, MaxSlippage: slippage},
+		{Denom: "ujuno", Hops: []icstypes.SwapHop{{PoolId: 2, TokenOutDenom: "uosmo"}, {PoolId: 1, TokenOutDenom: zone.BaseDenom}}, MaxSlippage: slippage},
+	}
+	suite.NoError(icsKeeper.SetRewardSwapRoutes(ctx, &zone, routes))
+	config, found := icsKeeper.GetRewardSwapConfig(ctx, zone.ChainId)
+	suite.True(found)
+	suite.Equal(routes, config.Routes)
+
+	// cached pools no longer in use are pruned.
+	for _, poolID := range []uint64{1, 2} {
+		icsKeeper.SetSwapPool(ctx, icstypes.SwapPool{ChainId: zone.ChainId, PoolId: poolID, LastUpdated: ctx.BlockTime()})
+	}
+	suite.NoError(icsKeeper.SetRewardSwapRoutes(ctx, &zone, routes[:1]))
+	pools := icsKeeper.AllZoneSwapPools(ctx, zone.ChainId)
+	suite.Len(pools, 1)
+	suite.Equal(uint64(1), pools[0].PoolId)
+
+	// empty routes disable reward swapping.
+	suite.NoError(icsKeeper.SetRewardSwapRoutes(ctx, &zone, nil))
+	_, found = icsKeeper.GetRewardSwapConfig(ctx, zone.ChainId)
+	suite.False(found)
+	suite.Empty(icsKeeper.AllZoneSwapPools(ctx, zone.ChainId))
+}
+
+func (suite *KeeperTestSuite) TestSwapPoolCallback() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	bz := suite.marshalledBalancerPool(1, sdk.NewCoins(sdk.NewCoin("uosmo", sdkmath.NewInt(1_000_000)), sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(1_000_000))))
+	query := icqtypes.Query{ChainId: zone.ChainId, Request: gamm.GetKeyPrefixPools(1)}
+
+	// pools not used by a route are ignored.
+	suite.NoError(icskeeper.SwapPoolCallback(icsKeeper, ctx, bz, query))
+	_, found = icsKeeper.GetSwapPool(ctx, zone.ChainId, 1)
+	suite.False(found)
+
+	suite.NoError(icsKeeper.SetRewardSwapRoutes(ctx, &zone, []icstypes.RewardSwapRoute{
+		{Denom: "uosmo", Hops: []icstypes.SwapHop{{PoolId: 1, TokenOutDenom: zone.BaseDenom}}, MaxSlippage: sdk.MustNewDecFromStr("0.01")},
+		{Denom: "ujuno", Hops: []icstypes.SwapHop{{PoolId: 2, TokenOutDenom: "uosmo"}, {PoolId: 1, TokenOutDenom: zone.BaseDenom}}, MaxSlippage: sdk.MustNewDecFromStr("0.01")},
+	}))
+
+	suite.ErrorContains(icskeeper.SwapPoolCallback(icsKeeper, ctx, bz, icqtypes.Query{ChainId: zone.ChainId, Request: []byte{0x02}}), "not sufficient length")
+	suite.ErrorContains(icskeeper.SwapPoolCallback(icsKeeper, ctx, bz, icqtypes.Query{ChainId: zone.ChainId, Request: gamm.GetKeyPrefixPools(2)}), "unexpected pool id")
+	suite.Error(icskeeper.SwapPoolCallback(icsKeeper, ctx, []byte{0x01}, query))
+
+	suite.NoError(icskeeper.SwapPoolCallback(icsKeeper, ctx, bz, query))
+	pool, found := icsKeeper.GetSwapPool(ctx, zone.ChainId, 1)
+	suite.True(found)
+	suite.Equal(bz, pool.Pool)
+	suite.Equal(ctx.BlockTime(), pool.LastUpdated)
+}
+
+func (suite *KeeperTestSuite) TestPrepareRewardSwapMsgs() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	balances := sdk.NewCoins(
+		sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(10_000)),
+		sdk.NewCoin("uosmo", sdkmath.NewInt(1_000)),
+		sdk.NewCoin("ujuno", sdkmath.NewInt(1_000)),
+	)
+
+	// without routes, nothing is swapped.
+	msgs, routed := icsKeeper.PrepareRewardSwapMsgs(ctx, &zone, balances)
+	suite.Empty(msgs)
+	suite.True(routed.IsZero())
+
+	suite.NoError(icsKeeper.SetRewardSwapRoutes(ctx, &zone, []icstypes.RewardSwapRoute{{Denom: "uosmo", Hops: []icstypes.SwapHop{{PoolId: 1, TokenOutDenom: zone.BaseDenom}}, MaxSlippage: sdk.MustNewDecFromStr("0.05")}}))
+
+	// without pool state, routed balances are retained.
+	msgs, routed = icsKeeper.PrepareRewardSwapMsgs(ctx, &zone, balances)
+	suite.Empty(msgs)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("uosmo", sdkmath.NewInt(1_000))), routed)
+
+	bz := suite.marshalledBalancerPool(1, sdk.NewCoins(sdk.NewCoin("uosmo", sdkmath.NewInt(1_000_000)), sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(1_000_000))))
+	icsKeeper.SetSwapPool(ctx, icstypes.SwapPool{ChainId: zone.ChainId, PoolId: 1, Pool: bz, LastUpdated: ctx.BlockTime()})
+
+	msgs, routed = icsKeeper.PrepareRewardSwapMsgs(ctx, &zone, balances)
+	suite.Len(msgs, 1)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("uosmo", sdkmath.NewInt(1_000))), routed)
+
+	swapMsg, ok := msgs[0].(*gamm.MsgSwapExactAmountIn)
+	suite.True(ok)
+	suite.Equal(zone.WithdrawalAddress.Address, swapMsg.Sender)
+	suite.Equal(sdk.NewCoin("uosmo", sdkmath.NewInt(1_000)), swapMsg.TokenIn)
+	suite.Equal([]gamm.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: zone.BaseDenom}}, swapMsg.Routes)
+	// 1000 in against 1m/1m yields 999 out; less 5% slippage.
+	suite.Equal(sdkmath.NewInt(949), swapMsg.TokenOutMinAmount)
+
+	// stale pool state is not used.
+	staleCtx := ctx.WithBlockTime(ctx.BlockTime().Add(icstypes.RewardSwapPoolMaxAge + time.Second))
+	msgs, routed = icsKeeper.PrepareRewardSwapMsgs(staleCtx, &zone, balances)
+	suite.Empty(msgs)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("uosmo", sdkmath.NewInt(1_000))), routed)
+}
+
+func (suite *KeeperTestSuite) TestDistributeRewardsSubmitsSwapsSeparately() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	txk := ica.TxKeeper{}
+	icsKeeper.OverrideTxSubmit(ica.GetTestSubmitTxFn(&txk))
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	channelID := quicksilver.IBCKeeper.ChannelKeeper.GenerateChannelIdentifier(ctx)
+	quicksilver.IBCKeeper.ChannelKeeper.SetChannel(ctx, icstypes.TransferPort, channelID, channeltypes.Channel{State: channeltypes.OPEN, Ordering: channeltypes.ORDERED, Counterparty: channeltypes.Counterparty{PortId: icstypes.TransferPort, ChannelId: channelID}, ConnectionHops: []string{zone.ConnectionId}})
+
+	suite.NoError(icsKeeper.SetRewardSwapRoutes(ctx, &zone, []icstypes.RewardSwapRoute{{Denom: "uosmo", Hops: []icstypes.SwapHop{{PoolId: 1, TokenOutDenom: zone.BaseDenom}}, MaxSlippage: sdk.MustNewDecFromStr("0.05")}}))
+	bz := suite.marshalledBalancerPool(1, sdk.NewCoins(sdk.NewCoin("uosmo", sdkmath.NewInt(1_000_000)), sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(1_000_000))))
+	icsKeeper.SetSwapPool(ctx, icstypes.SwapPool{ChainId: zone.ChainId, PoolId: 1, Pool: bz, LastUpdated: ctx.BlockTime()})
+
+	response := banktypes.QueryAllBalancesResponse{Balances: sdk.NewCoins(
+		sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(10_000)),
+		sdk.NewCoin("uosmo", sdkmath.NewInt(1_000)),
+		sdk.NewCoin("ujuno", sdkmath.NewInt(1_000)),
+	)}
+	respbz, err := quicksilver.AppCodec().Marshal(&response)
+	suite.NoError(err)
+
+	suite.NoError(icskeeper.DistributeRewardsFromWithdrawAccount(icsKeeper, ctx, respbz, icqtypes.Query{ChainId: zone.ChainId}))
+
+	// the swap is not submitted alongside the distribution of base denom rewards and the transfer of unrouted
+	// balances, so that its failure does not revert them.
+	suite.Len(txk.Txs, 2)
+	suite.Len(txk.Txs[0].Msgs, 3)
+	_, ok := txk.Txs[0].Msgs[0].(*banktypes.MsgSend)
+	suite.True(ok)
+	for _, msg := range txk.Txs[0].Msgs[1:] {
+		_, ok = msg.(*ibctransfertypes.MsgTransfer)
+		suite.True(ok)
+	}
+	suite.Len(txk.Txs[1].Msgs, 1)
+	_, ok = txk.Txs[1].Msgs[0].(*gamm.MsgSwapExactAmountIn)
+	suite.True(ok)
+}
+
+func (suite *KeeperTestSuite) TestHandleRewardSwap() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	msg := &gamm.MsgSwapExactAmountIn{
+		Sender:            zone.WithdrawalAddress.Address,
+		Routes:            []gamm.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: zone.BaseDenom}},
+		TokenIn:           sdk.NewCoin("uosmo", sdkmath.NewInt(1_000)),
+		TokenOutMinAmount: sdkmath.NewInt(949),
+	}
+	suite.NoError(icsKeeper.HandleRewardSwap(ctx, msg, sdkmath.NewInt(999), zone.ConnectionId))
+
+	complete := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == icstypes.EventTypeRewardSwapComplete {
+			complete = true
+		}
+	}
+	suite.True(complete)
+
+	suite.Error(icsKeeper.HandleRewardSwap(ctx, msg, sdkmath.NewInt(999), "connection-999"))
+}
@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+	lsmstakingtypes "github.com/quicksilver-zone/quicksilver/x/lsmtypes"
+)
+
+// GetSlashRecord returns the slash record for the given zone, validator and height.
+func (k *Keeper) GetSlashRecord(ctx sdk.Context, chainID, validator string, height int64) (types.SlashRecord, bool) {
+	record := types.SlashRecord{}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSlashRecordKey(chainID, validator, height))
+	if len(bz) == 0 {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetSlashRecord stores a slash record.
+func (k *Keeper) SetSlashRecord(ctx sdk.Context, record types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetSlashRecordKey(record.ChainId, record.Validator, record.Height), bz)
+}
+
+// DeleteSlashRecord deletes a slash record.
+func (k *Keeper) DeleteSlashRecord(ctx sdk.Context, chainID, validator string, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSlashRecordKey(chainID, validator, height))
+}
+
+// IterateZoneSlashRecords iterates through the slash records of the given zone,
+// in order of detection height per validator. If validator is non-empty, only
+// that validator's records are visited.
+func (k *Keeper) IterateZoneSlashRecords(ctx sdk.Context, chainID, validator string, fn func(index int64, record types.SlashRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetZoneSlashRecordsKey(chainID, validator))
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.SlashRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		// chain ids are not length prefixed in the key, so guard against one
+		// chain id being a prefix of another.
+		if record.ChainId != chainID || (validator != "" && record.Validator != validator) {
+			continue
+		}
+
+		stop := fn(i, record)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllZoneSlashRecords returns every slash record for the given zone.
+func (k *Keeper) AllZoneSlashRecords(ctx sdk.Context, chainID string) []types.SlashRecord {
+	records := []types.SlashRecord{}
+	k.IterateZoneSlashRecords(ctx, chainID, "", func(_ int64, record types.SlashRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// LatestSlashTimes returns, for each validator of the zone that has been
+// slashed, the time at which its most recent slash was detected.
+func (k *Keeper) LatestSlashTimes(ctx sdk.Context, chainID string) map[string]time.Time {
+	out := make(map[string]time.Time)
+	k.IterateZoneSlashRecords(ctx, chainID, "", func(_ int64, record types.SlashRecord) (stop bool) {
+		if latest, ok := out[record.Validator]; !ok || record.Time.After(latest) {
+			out[record.Validator] = record.Time
+		}
+		return false
+	})
+	return out
+}
+
+// DetectValidatorSlash compares a validator's stored state against its
+// incoming host chain state. A slash removes tokens from a validator without
+// removing shares, so an increase in shares per token beyond
+// SlashDetectionThreshold is handled as a slash.
+func (k *Keeper) DetectValidatorSlash(ctx sdk.Context, zone *types.Zone, previous types.Validator, current lsmstakingtypes.Validator) error {
+	if !previous.VotingPower.IsPositive() || !current.Tokens.IsPositive() {
+		return nil
+	}
+	if previous.DelegatorShares.IsNil() || !previous.DelegatorShares.IsPositive() || current.DelegatorShares.IsNil() || !current.DelegatorShares.IsPositive() {
+		return nil
+	}
+
+	// determine difference between previous vp/shares ratio and new ratio.
+	prevRatio := previous.DelegatorShares.Quo(sdk.NewDecFromInt(previous.VotingPower))
+	newRatio := current.DelegatorShares.Quo(sdk.NewDecFromInt(current.Tokens))
+	delta := newRatio.Quo(prevRatio)
+
+	if delta.LTE(sdk.OneDec().Add(types.SlashDetectionThreshold)) {
+		return nil
+	}
+
+	return k.HandleValidatorSlash(ctx, zone, previous.ValoperAddress, delta)
+}
+
+// HandleValidatorSlash records a slash of the given validator, adjusts any
+// unbonding withdrawal records that were distributed from it, and requests a
+// proof of the zone's delegation so that the loss may be reconciled. delta is
+// the ratio of the validator's new shares per token to its previous shares per
+// token.
+func (k *Keeper) HandleValidatorSlash(ctx sdk.Context, zone *types.Zone, valoper string, delta sdk.Dec) error {
+	fraction := types.SlashFractionFromDelta(delta)
+
+	expected := sdk.NewCoin(zone.BaseDenom, sdk.ZeroInt())
+	delegated := false
+	if zone.DelegationAddress != nil {
+		if delegation, found := k.GetDelegation(ctx, zone.ChainId, zone.DelegationAddress.Address, valoper); found {
+			expected = delegation.Amount
+			delegated = expected.IsPositive()
+		}
+	}
+	reported := sdk.NewCoin(zone.BaseDenom, sdk.NewDecFromInt(expected.Amount).Mul(sdk.OneDec().Sub(fraction)).TruncateInt())
+
+	record := types.SlashRecord{
+		ChainId:        zone.ChainId,
+		Validator:      valoper,
+		Height:         ctx.BlockHeight(),
+		Time:           ctx.BlockTime(),
+		Fraction:       fraction,
+		ExpectedAmount: expected,
+		ReportedAmount: reported,
+		// nothing to reconcile if we have no exposure to this validator.
+		Reconciled: !delegated,
+	}
+	k.SetSlashRecord(ctx, record)
+
+	k.Logger(ctx).Error("validator slash detected", "chain_id", zone.ChainId, "valoper", valoper, "fraction", fraction, "expected", expected, "estimated", reported)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorSlash,
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyValidator, valoper),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyExpectedAmount, expected.String()),
+			sdk.NewAttribute(types.AttributeKeyReportedAmount, reported.String()),
+		),
+	)
+
+	if err := k.UpdateWithdrawalRecordsForSlash(ctx, zone, valoper, delta); err != nil {
+		return err
+	}
+
+	if !delegated {
+		return nil
+	}
+
+	_, delAddr, err := bech32.DecodeAndConvert(zone.DelegationAddress.Address)
+	if err != nil {
+		return err
+	}
+	valAddr, err := addressutils.ValAddressFromBech32(valoper, zone.GetValoperPrefix())
+	if err != nil {
+		return err
+	}
+
+	// fetch a proof of the post-slash delegation; the delegation callback will reconcile the record.
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"store/staking/key",
+		stakingtypes.GetDelegationKey(delAddr, valAddr),
+		sdk.NewInt(-1),
+		types.ModuleName,
+		"delegation",
+		0,
+	)
+
+	return nil
+}
+
+// ReconcileSlashRecord settles the most recent unreconciled slash record for
+// the given validator against a proven delegation amount, recording the loss
+// incurred by the zone. It is a no-op if there is no record to reconcile.
+func (k *Keeper) ReconcileSlashRecord(ctx sdk.Context, zone *types.Zone, valoper string, reported sdk.Coin) error {
+	var record *types.SlashRecord
+	k.IterateZoneSlashRecords(ctx, zone.ChainId, valoper, func(_ int64, r types.SlashRecord) (stop bool) {
+		if !r.Reconciled {
+			r := r
+			record = &r
+		}
+		return false
+	})
+	if record == nil {
+		return nil
+	}
+
+	if reported.Denom != zone.BaseDenom {
+		return fmt.Errorf("unexpected denom for reported delegation: expected %s, got %s", zone.BaseDenom, reported.Denom)
+	}
+
+	if delegation, found := k.GetDelegation(ctx, zone.ChainId, zone.DelegationAddress.Address, valoper); found {
+		record.ExpectedAmount = delegation.Amount
+	}
+	record.ReportedAmount = reported
+	record.Reconciled = true
+	k.SetSlashRecord(ctx, *record)
+
+	loss := record.Loss()
+	k.Logger(ctx).Error("validator slash reconciled", "chain_id", zone.ChainId, "valoper", valoper, "expected", record.ExpectedAmount, "reported", reported, "loss", loss)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashReconciled,
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyValidator, valoper),
+			sdk.NewAttribute(types.AttributeKeyExpectedAmount, record.ExpectedAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyReportedAmount, reported.String()),
+			sdk.NewAttribute(types.AttributeKeyLossAmount, loss.String()),
+		),
+	)
+
+	return nil
+}
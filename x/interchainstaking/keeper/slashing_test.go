@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	icqtypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+	icskeeper "github.com/quicksilver-zone/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+func (suite *KeeperTestSuite) TestValidatorSlashDetectionAndReconciliation() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	icsKeeper.CallbackHandler().RegisterCallbacks()
+	ctx := suite.chainA.GetContext()
+
+	pkAny, err := codectypes.NewAnyWithValue(PKs[0])
+	suite.NoError(err)
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	val := icsKeeper.GetValidators(ctx, zone.ChainId)[0]
+	icsKeeper.SetDelegation(ctx, zone.ChainId, icstypes.NewDelegation(zone.DelegationAddress.Address, val.ValoperAddress, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))))
+	// shares held by the delegation, prior to the slash.
+	shares := sdk.NewDec(1000).Mul(val.DelegatorShares).QuoInt(val.VotingPower)
+
+	// tokens and shares growing together is not a slash.
+	unslashed := stakingtypes.Validator{OperatorAddress: val.ValoperAddress, ConsensusPubkey: pkAny, Status: stakingtypes.Bonded, Tokens: val.VotingPower.MulRaw(2), DelegatorShares: val.DelegatorShares.MulInt64(2), Commission: stakingtypes.NewCommission(val.CommissionRate, sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.5"))}
+	bz, err := quicksilver.AppCodec().Marshal(&unslashed)
+	suite.NoError(err)
+	suite.NoError(icskeeper.ValidatorCallback(icsKeeper, ctx, bz, icqtypes.Query{ChainId: zone.ChainId}))
+	suite.Empty(icsKeeper.AllZoneSlashRecords(ctx, zone.ChainId))
+
+	// a 5% slash, without the validator being jailed.
+	val, found = icsKeeper.GetValidator(ctx, zone.ChainId, addressutils.MustValAddressFromBech32(val.ValoperAddress, ""))
+	suite.True(found)
+	slashed := unslashed
+	slashed.Tokens = val.VotingPower.MulRaw(19).QuoRaw(20)
+	bz, err = quicksilver.AppCodec().Marshal(&slashed)
+	suite.NoError(err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.NoError(icskeeper.ValidatorCallback(icsKeeper, ctx, bz, icqtypes.Query{ChainId: zone.ChainId}))
+
+	records := icsKeeper.AllZoneSlashRecords(ctx, zone.ChainId)
+	suite.Len(records, 1)
+	record := records[0]
+	suite.Equal(val.ValoperAddress, record.Validator)
+	suite.Equal(ctx.BlockHeight(), record.Height)
+	// voting power is truncated, so the fraction is not exact.
+	suite.True(record.Fraction.Sub(sdk.MustNewDecFromStr("0.05")).Abs().LT(sdk.NewDecWithPrec(1, 9)))
+	suite.Equal(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000)), record.ExpectedAmount)
+	suite.Equal(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(950)), record.ReportedAmount)
+	suite.False(record.Reconciled)
+
+	slashEvent := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == icstypes.EventTypeValidatorSlash {
+			slashEvent = true
+		}
+	}
+	suite.True(slashEvent)
+
+	// the proven delegation reconciles the record.
+	delAddr, err := addressutils.AccAddressFromBech32(zone.DelegationAddress.Address, "")
+	suite.NoError(err)
+	valAddr, err := addressutils.ValAddressFromBech32(val.ValoperAddress, "")
+	suite.NoError(err)
+	response := stakingtypes.Delegation{DelegatorAddress: zone.DelegationAddress.Address, ValidatorAddress: val.ValoperAddress, Shares: shares}
+	data := quicksilver.IBCKeeper.Codec().MustMarshal(&response)
+	err = icskeeper.DelegationCallback(icsKeeper, ctx, data, icqtypes.Query{ChainId: zone.ChainId, Request: stakingtypes.GetDelegationKey(delAddr, valAddr)})
+	suite.NoError(err)
+
+	delegation, found := icsKeeper.GetDelegation(ctx, zone.ChainId, zone.DelegationAddress.Address, val.ValoperAddress)
+	suite.True(found)
+
+	record, found = icsKeeper.GetSlashRecord(ctx, zone.ChainId, val.ValoperAddress, record.Height)
+	suite.True(found)
+	suite.True(record.Reconciled)
+	suite.Equal(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000)), record.ExpectedAmount)
+	suite.Equal(delegation.Amount, record.ReportedAmount)
+	suite.True(record.Loss().Amount.GTE(sdk.NewInt(50)))
+	suite.True(record.Loss().Amount.LTE(sdk.NewInt(51)))
+
+	// subsequent delegation updates do not alter a reconciled record.
+	suite.NoError(icsKeeper.ReconcileSlashRecord(ctx, &zone, val.ValoperAddress, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1))))
+	unchanged, found := icsKeeper.GetSlashRecord(ctx, zone.ChainId, val.ValoperAddress, record.Height)
+	suite.True(found)
+	suite.Equal(record, unchanged)
+}
+
+func (suite *KeeperTestSuite) TestValidatorSlashWithoutDelegation() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	valoper := icsKeeper.GetValidators(ctx, zone.ChainId)[1].ValoperAddress
+	// ensure the zone holds no delegation to the validator.
+	if delegation, found := icsKeeper.GetDelegation(ctx, zone.ChainId, zone.DelegationAddress.Address, valoper); found {
+		suite.NoError(icsKeeper.RemoveDelegation(ctx, zone.ChainId, delegation))
+	}
+
+	suite.NoError(icsKeeper.HandleValidatorSlash(ctx, &zone, valoper, sdk.MustNewDecFromStr("1.25")))
+
+	record, found := icsKeeper.GetSlashRecord(ctx, zone.ChainId, valoper, ctx.BlockHeight())
+	suite.True(found)
+	suite.Equal(sdk.MustNewDecFromStr("0.2"), record.Fraction)
+	suite.True(record.ExpectedAmount.IsZero())
+	suite.True(record.Reconciled)
+	suite.True(record.Loss().IsZero())
+}
+
+func (suite *KeeperTestSuite) TestSlashedValidatorIntentDeweighting() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	zone.UnbondingPeriod = int64(21 * 24 * time.Hour)
+
+	before, err := icsKeeper.GetAggregateIntentOrDefault(ctx, &zone)
+	suite.NoError(err)
+	suite.NotEmpty(before)
+	slashed := before[0].ValoperAddress
+
+	icsKeeper.SetSlashRecord(ctx, icstypes.SlashRecord{
+		ChainId:        zone.ChainId,
+		Validator:      slashed,
+		Height:         ctx.BlockHeight(),
+		Time:           ctx.BlockTime(),
+		Fraction:       sdk.MustNewDecFromStr("0.05"),
+		ExpectedAmount: sdk.NewCoin(zone.BaseDenom, sdk.ZeroInt()),
+		ReportedAmount: sdk.NewCoin(zone.BaseDenom, sdk.ZeroInt()),
+		Reconciled:     true,
+	})
+
+	after, err := icsKeeper.GetAggregateIntentOrDefault(ctx, &zone)
+	suite.NoError(err)
+	suite.Len(after, len(before))
+	for i, intent := range after {
+		suite.Equal(before[i].ValoperAddress, intent.ValoperAddress)
+		if intent.ValoperAddress == slashed {
+			suite.Equal(before[i].Weight.Mul(icstypes.SlashedValidatorWeightMultiplier), intent.Weight)
+		} else {
+			suite.Equal(before[i].Weight, intent.Weight)
+		}
+	}
+
+	// the zone's own aggregate intent is not modified.
+	for _, intent := range zone.AggregateIntent {
+		if intent.ValoperAddress == slashed {
+			suite.Equal(before[0].Weight, intent.Weight)
+		}
+	}
+
+	// once the unbonding period has elapsed, the validator's weight is restored.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(zone.UnbondingPeriod) + time.Second))
+	restored, err := icsKeeper.GetAggregateIntentOrDefault(ctx, &zone)
+	suite.NoError(err)
+	suite.Equal(before[0].Weight, restored[0].Weight)
+}
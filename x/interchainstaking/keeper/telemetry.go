@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/armon/go-metrics"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils"
+	icqtypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// Keys of the per zone metrics. Each metric is published as
+// interchainstaking_zone_<key>, labelled with the zone chain id.
+const (
+	MetricKeyRedemptionRate     = "redemption_rate"
+	MetricKeyLastRedemptionRate = "last_redemption_rate"
+	MetricKeyWithdrawalWg       = "withdrawal_waitgroup"
+	MetricKeyPendingICAMessages = "pending_ica_messages"
+	MetricKeyTVL                = "tvl"
+	MetricKeySupply             = "supply"
+	MetricKeyQueuedAmount       = "queued_withdrawal_amount"
+	MetricKeyQueuedCount        = "queued_withdrawal_count"
+	MetricKeyUnbondingAmount    = "unbonding_withdrawal_amount"
+	MetricKeyUnbondingCount     = "unbonding_withdrawal_count"
+	MetricKeyPendingQueries     = "pending_queries"
+	MetricKeyReceiptsProcessed  = "receipts_processed"
+	MetricKeyEpochsHandled      = "epochs_handled"
+
+	MetricLabelChainID  = "chain_id"
+	MetricLabelAccount  = "account"
+	MetricLabelCallback = "callback"
+)
+
+func zoneMetricKey(key string) []string {
+	return []string{types.ModuleName, "zone", key}
+}
+
+func zoneMetricLabels(chainID string, labels ...metrics.Label) []metrics.Label {
+	return append([]metrics.Label{telemetry.NewLabel(MetricLabelChainID, chainID)}, labels...)
+}
+
+func decToFloat32(d sdk.Dec) float32 {
+	f, err := d.Float64()
+	if err != nil {
+		return 0
+	}
+	return float32(f)
+}
+
+func intToFloat32(i sdkmath.Int) float32 {
+	return decToFloat32(sdk.NewDecFromInt(i))
+}
+
+// SetZoneGauges sets the gauges of the given zone that are cheap enough to be
+// updated every block: redemption rates, withdrawal waitgroup and the number
+// of ICA messages awaiting acknowledgement on each of the zone's accounts.
+func (k *Keeper) SetZoneGauges(ctx sdk.Context, zone *types.Zone) {
+	labels := zoneMetricLabels(zone.ChainId)
+	telemetry.SetGaugeWithLabels(zoneMetricKey(MetricKeyRedemptionRate), decToFloat32(zone.RedemptionRate), labels)
+	telemetry.SetGaugeWithLabels(zoneMetricKey(MetricKeyLastRedemptionRate), decToFloat32(zone.LastRedemptionRate), labels)
+	telemetry.SetGaugeWithLabels(zoneMetricKey(MetricKeyWithdrawalWg), float32(zone.GetWithdrawalWaitgroup()), labels)
+
+	for _, account := range []*types.ICAAccount{zone.DepositAddress, zone.WithdrawalAddress, zone.PerformanceAddress, zone.DelegationAddress} {
+		if account == nil {
+			continue
+		}
+		pending, found := k.pendingICAMessages(ctx, zone.ConnectionId, account.GetPortName())
+		if !found {
+			continue
+		}
+		accountLabel := account.GetPortName()[strings.LastIndex(account.GetPortName(), ".")+1:]
+		telemetry.SetGaugeWithLabels(
+			zoneMetricKey(MetricKeyPendingICAMessages),
+			float32(pending),
+			zoneMetricLabels(zone.ChainId, telemetry.NewLabel(MetricLabelAccount, accountLabel)),
+		)
+	}
+}
+
+// pendingICAMessages returns the number of packets sent on the active channel
+// of the given ICA port that are yet to be acknowledged. ICA channels are
+// ordered, so this is the difference of the next send and ack sequences.
+func (k *Keeper) pendingICAMessages(ctx sdk.Context, connectionID, portID string) (uint64, bool) {
+	channelID, found := k.ICAControllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return 0, false
+	}
+	sendSeq, found := k.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return 0, false
+	}
+	ackSeq, found := k.IBCKeeper.ChannelKeeper.GetNextSequenceAck(ctx, portID, channelID)
+	if !found || ackSeq > sendSeq {
+		return 0, false
+	}
+	return sendSeq - ackSeq, true
+}
+
+// SetZoneRecordGauges sets the gauges of the given zone that are derived from
+// its supply and withdrawal records: TVL, and the queued and unbonding
+// withdrawal amounts and counts.
+func (k *Keeper) SetZoneRecordGauges(ctx sdk.Context, zone *types.Zone) {
+	labels := zoneMetricLabels(zone.ChainId)
+	supply := k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount
+	telemetry.SetGaugeWithLabels(zoneMetricKey(MetricKeySupply), intToFloat32(supply), labels)
+	telemetry.SetGaugeWithLabels(zoneMetricKey(MetricKeyTVL), decToFloat32(zone.RedemptionRate.MulInt(supply)), labels)
+
+	queued, queuedCount := k.GetQueuedTokensAndCount(ctx, zone)
+	telemetry.SetGaugeWithLabels(zoneMetricKey(MetricKeyQueuedAmount), intToFloat32(queued.Amount), labels)
+	telemetry.SetGaugeWithLabels(zoneMetricKey(MetricKeyQueuedCount), float32(queuedCount), labels)
+
+	unbonding, unbondingCount := k.GetUnbondingTokensAndCount(ctx, zone)
+	telemetry.SetGaugeWithLabels(zoneMetricKey(MetricKeyUnbondingAmount), intToFloat32(unbonding.Amount), labels)
+	telemetry.SetGaugeWithLabels(zoneMetricKey(MetricKeyUnbondingCount), float32(unbondingCount), labels)
+}
+
+// SetPendingQueryGauges sets, for each zone, the number of interchain queries
+// awaiting a response, per callback. It iterates every query, so is not run
+// every block.
+func (k *Keeper) SetPendingQueryGauges(ctx sdk.Context) {
+	pending := make(map[string]map[string]int)
+	k.IterateZones(ctx, func(_ int64, zone *types.Zone) (stop bool) {
+		// zones with no pending queries report zero for the callbacks seen.
+		pending[zone.ChainId] = make(map[string]int)
+		return false
+	})
+
+	k.ICQKeeper.IterateQueries(ctx, func(_ int64, query icqtypes.Query) (stop bool) {
+		callbacks, ok := pending[query.ChainId]
+		if !ok {
+			return false
+		}
+		if _, ok := callbacks[query.CallbackId]; !ok {
+			callbacks[query.CallbackId] = 0
+		}
+		if query.IsPending() {
+			callbacks[query.CallbackId]++
+		}
+		return false
+	})
+
+	for _, chainID := range utils.Keys(pending) {
+		for _, callback := range utils.Keys(pending[chainID]) {
+			telemetry.SetGaugeWithLabels(
+				zoneMetricKey(MetricKeyPendingQueries),
+				float32(pending[chainID][callback]),
+				zoneMetricLabels(chainID, telemetry.NewLabel(MetricLabelCallback, callback)),
+			)
+		}
+	}
+}
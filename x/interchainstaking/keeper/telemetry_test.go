@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	"github.com/quicksilver-zone/quicksilver/utils/randomutils"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+func (suite *KeeperTestSuite) TestZoneTelemetry() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	_, err := metrics.NewGlobal(cfg, sink)
+	suite.NoError(err)
+	defer func() {
+		_, _ = metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+	}()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	zone.RedemptionRate = sdk.NewDecWithPrec(11, 1)
+	zone.SetWithdrawalWaitgroup(quicksilver.Logger(), 3, "test")
+	icsKeeper.SetZone(ctx, &zone)
+
+	icsKeeper.SetWithdrawalRecord(ctx, types.WithdrawalRecord{
+		ChainId:    zone.ChainId,
+		Delegator:  addressutils.GenerateAccAddressForTest().String(),
+		Recipient:  addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix),
+		Amount:     sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))),
+		BurnAmount: sdk.NewCoin(zone.LocalDenom, sdk.NewInt(900)),
+		Txhash:     randomutils.GenerateRandomHashAsHex(32),
+		Status:     types.WithdrawStatusQueued,
+	})
+	// three packets sent on the delegate channel, of which one is acknowledged.
+	portID := zone.DelegationAddress.PortName
+	channelID, found := quicksilver.ICAControllerKeeper.GetActiveChannelID(ctx, zone.ConnectionId, portID)
+	suite.True(found)
+	quicksilver.IBCKeeper.ChannelKeeper.SetNextSequenceSend(ctx, portID, channelID, 4)
+	quicksilver.IBCKeeper.ChannelKeeper.SetNextSequenceAck(ctx, portID, channelID, 2)
+
+	icsKeeper.ICQKeeper.MakeRequest(ctx, zone.ConnectionId, zone.ChainId, "cosmos.bank.v1beta1.Query/AllBalances", []byte{0x01}, sdk.NewInt(-1), types.ModuleName, "delegation", 0)
+
+	icsKeeper.SetZoneGauges(ctx, &zone)
+	icsKeeper.SetZoneRecordGauges(ctx, &zone)
+	icsKeeper.SetPendingQueryGauges(ctx)
+
+	gauges := sink.Data()[0].Gauges
+	gauge := func(key string, labels ...string) float32 {
+		name := "test.interchainstaking.zone." + key + ";chain_id=" + zone.ChainId
+		for i := 0; i+1 < len(labels); i += 2 {
+			name += fmt.Sprintf(";%s=%s", labels[i], labels[i+1])
+		}
+		value, found := gauges[name]
+		suite.True(found, name)
+		return value.Value
+	}
+
+	suite.InDelta(1.1, gauge("redemption_rate"), 0.0001)
+	suite.Equal(float32(3), gauge("withdrawal_waitgroup"))
+	suite.Equal(float32(2), gauge("pending_ica_messages", "account", "delegate"))
+	suite.Equal(float32(1000), gauge("queued_withdrawal_amount"))
+	suite.Equal(float32(1), gauge("queued_withdrawal_count"))
+	suite.Equal(float32(0), gauge("unbonding_withdrawal_count"))
+	suite.Equal(float32(1), gauge("pending_queries", "callback", "delegation"))
+}
@@ -120,6 +120,25 @@ func (k Keeper) SetValidatorAddrByConsAddr(ctx sdk.Context, chainID string, valA
 	store.Set(consAddr, []byte(valAddr))
 }
 
+// IterateValidatorAddrsByConsAddr iterates through the validator addresses by consensus address of the given zone.
+func (k Keeper) IterateValidatorAddrsByConsAddr(ctx sdk.Context, chainID string, fn func(index int64, consAddr []byte, valAddr string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetZoneValidatorAddrsByConsAddrKey(chainID))
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		stop := fn(i, iterator.Key(), string(iterator.Value()))
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
 // DeleteValidatorAddrByConsAddr delete validator address by Consensus address.
 func (k Keeper) DeleteValidatorAddrByConsAddr(ctx sdk.Context, chainID string, consAddr []byte) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetZoneValidatorAddrsByConsAddrKey(chainID))
@@ -0,0 +1,414 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	"github.com/quicksilver-zone/quicksilver/utils"
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// denomHoldersPageLimit is the number of holders read from the bank module's
+// denom owners index at a time.
+const denomHoldersPageLimit = 1000
+
+// GetZoneWindDown returns the wind-down record for the given zone.
+func (k *Keeper) GetZoneWindDown(ctx sdk.Context, chainID string) (types.ZoneWindDown, bool) {
+	windDown := types.ZoneWindDown{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixZoneWindDown)
+	bz := store.Get([]byte(chainID))
+	if len(bz) == 0 {
+		return windDown, false
+	}
+	k.cdc.MustUnmarshal(bz, &windDown)
+	return windDown, true
+}
+
+// SetZoneWindDown stores the wind-down record for a zone.
+func (k *Keeper) SetZoneWindDown(ctx sdk.Context, windDown types.ZoneWindDown) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixZoneWindDown)
+	bz := k.cdc.MustMarshal(&windDown)
+	store.Set([]byte(windDown.ChainId), bz)
+}
+
+// DeleteZoneWindDown deletes the wind-down record for a zone.
+func (k *Keeper) DeleteZoneWindDown(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixZoneWindDown)
+	store.Delete([]byte(chainID))
+}
+
+// IterateZoneWindDowns iterates through the wind-down records of all zones.
+func (k *Keeper) IterateZoneWindDowns(ctx sdk.Context, fn func(index int64, windDown types.ZoneWindDown) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixZoneWindDown)
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		windDown := types.ZoneWindDown{}
+		k.cdc.MustUnmarshal(iterator.Value(), &windDown)
+
+		stop := fn(i, windDown)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllZoneWindDowns returns every wind-down record in the store.
+func (k *Keeper) AllZoneWindDowns(ctx sdk.Context) []types.ZoneWindDown {
+	windDowns := []types.ZoneWindDown{}
+	k.IterateZoneWindDowns(ctx, func(_ int64, windDown types.ZoneWindDown) (stop bool) {
+		windDowns = append(windDowns, windDown)
+		return false
+	})
+	return windDowns
+}
+
+// IsZoneWindingDown returns true if the zone is draining ahead of removal.
+func (k *Keeper) IsZoneWindingDown(ctx sdk.Context, chainID string) bool {
+	windDown, found := k.GetZoneWindDown(ctx, chainID)
+	return found && windDown.Status == types.WindDownStatusDraining
+}
+
+// StartZoneWindDown begins winding down a zone. Deposits are disabled, and the
+// redemption rate is fixed at the lower of the zone's current and last rates,
+// at which all remaining holders are settled. If migrationConnectionID is set,
+// it must be a connection to the same host chain; once wound down, the zone is
+// registered afresh on it.
+func (k *Keeper) StartZoneWindDown(ctx sdk.Context, zone *types.Zone, migrationConnectionID string) error {
+	if k.IsZoneWindingDown(ctx, zone.ChainId) {
+		return fmt.Errorf("zone %s is already winding down", zone.ChainId)
+	}
+
+	if migrationConnectionID != "" {
+		if migrationConnectionID == zone.ConnectionId {
+			return fmt.Errorf("zone %s is already registered on %s", zone.ChainId, migrationConnectionID)
+		}
+		chainID, err := k.GetChainID(ctx, migrationConnectionID)
+		if err != nil {
+			return fmt.Errorf("unable to obtain chain id for migration connection: %w", err)
+		}
+		if chainID != zone.ChainId {
+			return fmt.Errorf("migration connection %s is to chain %s, expected %s", migrationConnectionID, chainID, zone.ChainId)
+		}
+	}
+
+	rate := sdk.MinDec(zone.LastRedemptionRate, zone.RedemptionRate)
+
+	windDown := types.ZoneWindDown{
+		ChainId:               zone.ChainId,
+		Status:                types.WindDownStatusDraining,
+		MigrationConnectionId: migrationConnectionID,
+		StartedHeight:         ctx.BlockHeight(),
+		StartedAt:             ctx.BlockTime(),
+		FinalRedemptionRate:   rate,
+		DepositsEnabled:       zone.DepositsEnabled,
+	}
+
+	zone.DepositsEnabled = false
+	zone.UnbondingEnabled = true
+	zone.RedemptionRate = rate
+	zone.LastRedemptionRate = rate
+	k.SetZone(ctx, zone)
+	k.SetZoneWindDown(ctx, windDown)
+
+	k.Logger(ctx).Info("zone wind-down started", "chain_id", zone.ChainId, "redemption_rate", rate, "migration_connection_id", migrationConnectionID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeZoneWindDownStarted,
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyRedemptionRate, rate.String()),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, migrationConnectionID),
+		),
+	)
+
+	return nil
+}
+
+// SettleZoneHolders queues a redemption of the entire qAsset balance of every
+// holder of a winding down zone's local denom for whom a host chain address
+// can be determined. Holders for whom no address can be determined are
+// recorded against the wind-down, and must redeem manually.
+func (k *Keeper) SettleZoneHolders(ctx sdk.Context, zone *types.Zone) error {
+	windDown, found := k.GetZoneWindDown(ctx, zone.ChainId)
+	if !found || windDown.Status != types.WindDownStatusDraining {
+		return fmt.Errorf("zone %s is not winding down", zone.ChainId)
+	}
+
+	// collect holders before moving any balances, as the bank store may not be
+	// written to while being iterated.
+	holders, err := k.denomHolders(ctx, zone.LocalDenom)
+	if err != nil {
+		return fmt.Errorf("unable to obtain holders of %s: %w", zone.LocalDenom, err)
+	}
+
+	addresses := make([]string, 0, len(holders))
+	for address := range holders {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	unsettled := make([]string, 0)
+	for _, address := range addresses {
+		holder := sdk.MustAccAddressFromBech32(address)
+		destination, ok := k.windDownDestination(ctx, zone, holder)
+		if !ok {
+			acc := k.AccountKeeper.GetAccount(ctx, holder)
+			// module accounts, such as the escrow account, are not holders.
+			if _, isModule := acc.(authtypes.ModuleAccountI); !isModule {
+				unsettled = append(unsettled, address)
+			}
+			continue
+		}
+
+		burnAmount := holders[address]
+		if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.EscrowModuleAccount, sdk.NewCoins(burnAmount)); err != nil {
+			return fmt.Errorf("unable to send coins to escrow account: %w", err)
+		}
+
+		hash := sha256.Sum256([]byte(fmt.Sprintf("winddown/%s/%s/%d", zone.ChainId, address, ctx.BlockHeight())))
+		if err := k.queueRedemption(ctx, zone, holder, destination, burnAmount, nil, hex.EncodeToString(hash[:])); err != nil {
+			return fmt.Errorf("unable to queue redemption: %w", err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeZoneWindDownSettlement,
+				sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+				sdk.NewAttribute(types.AttributeKeyUser, address),
+				sdk.NewAttribute(types.AttributeKeyBurnAmount, burnAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipientAddress, destination),
+			),
+		)
+	}
+
+	windDown.UnsettledHolders = unsettled
+	k.SetZoneWindDown(ctx, windDown)
+
+	return nil
+}
+
+// denomHolders returns the balance of every holder of the given denom, read
+// from the bank module's denom owners index rather than by iterating every
+// balance on chain.
+func (k *Keeper) denomHolders(ctx sdk.Context, denom string) (map[string]sdk.Coin, error) {
+	holders := make(map[string]sdk.Coin)
+	req := &banktypes.QueryDenomOwnersRequest{Denom: denom, Pagination: &query.PageRequest{Limit: denomHoldersPageLimit}}
+	for {
+		res, err := k.BankKeeper.DenomOwners(sdk.WrapSDKContext(ctx), req)
+		if err != nil {
+			return nil, err
+		}
+		for _, owner := range res.DenomOwners {
+			if owner.Balance.IsPositive() {
+				holders[owner.Address] = owner.Balance
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return holders, nil
+		}
+		req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: denomHoldersPageLimit}
+	}
+}
+
+// windDownDestination returns the host chain address to which a holder's
+// settlement is paid. A mapped remote address is preferred; otherwise, for
+// zones sharing Quicksilver's coin type, an account that has signed a
+// transaction is paid at the same address on the host chain. Accounts without
+// a public key, such as module accounts, contracts and IBC escrow accounts,
+// are not controlled by a key and cannot be settled automatically.
+func (k *Keeper) windDownDestination(ctx sdk.Context, zone *types.Zone, holder sdk.AccAddress) (string, bool) {
+	if remote, found := k.GetRemoteAddressMap(ctx, holder, zone.ChainId); found {
+		address, err := addressutils.EncodeAddressToBech32(zone.AccountPrefix, sdk.AccAddress(remote))
+		if err != nil {
+			return "", false
+		}
+		return address, true
+	}
+
+	if !zone.Is_118 {
+		return "", false
+	}
+
+	acc := k.AccountKeeper.GetAccount(ctx, holder)
+	if acc == nil || acc.GetPubKey() == nil {
+		return "", false
+	}
+	if _, isModule := acc.(authtypes.ModuleAccountI); isModule {
+		return "", false
+	}
+
+	address, err := addressutils.EncodeAddressToBech32(zone.AccountPrefix, holder)
+	if err != nil {
+		return "", false
+	}
+	return address, true
+}
+
+// IsZoneWindDownComplete returns true if a winding down zone has no qAssets
+// held by holders that can be settled, no deposits in process and no
+// redemptions outstanding. qAssets held by holders that cannot be settled
+// automatically, such as IBC escrow accounts, contracts and module accounts,
+// do not prevent completion; such holders are recorded against the wind-down.
+func (k *Keeper) IsZoneWindDownComplete(ctx sdk.Context, zone *types.Zone) bool {
+	holders, err := k.denomHolders(ctx, zone.LocalDenom)
+	if err != nil {
+		k.Logger(ctx).Error("unable to obtain holders", "denom", zone.LocalDenom, "error", err)
+		return false
+	}
+	for _, address := range utils.Keys(holders) {
+		if _, ok := k.windDownDestination(ctx, zone, sdk.MustAccAddressFromBech32(address)); ok {
+			return false
+		}
+	}
+
+	if !k.GetDelegationsInProcess(ctx, zone.ChainId).IsZero() {
+		return false
+	}
+
+	outstanding := false
+	k.IterateZoneWithdrawalRecords(ctx, zone.ChainId, func(_ int64, record types.WithdrawalRecord) (stop bool) {
+		// records being cancelled await the acknowledgement of the cancellation.
+		if record.Status != types.WithdrawStatusCompleted {
+			outstanding = true
+			return true
+		}
+		return false
+	})
+
+	return !outstanding
+}
+
+// CompleteZoneWindDown closes the interchain account channels of a wound down
+// zone and removes its state, including its interchain queries. If a
+// migration connection was given, the zone is then registered on it. Any
+// residual balances remain in the zone's host chain accounts.
+func (k *Keeper) CompleteZoneWindDown(ctx sdk.Context, chainID string) error {
+	windDown, found := k.GetZoneWindDown(ctx, chainID)
+	if !found || windDown.Status != types.WindDownStatusDraining {
+		return fmt.Errorf("zone %s is not winding down", chainID)
+	}
+
+	zone, found := k.GetZone(ctx, chainID)
+	if !found {
+		return fmt.Errorf("no zone found for chain id %s", chainID)
+	}
+
+	k.closeZoneICAChannels(ctx, &zone)
+	k.RemoveZoneAndAssociatedRecords(ctx, zone.ChainId)
+
+	windDown.Status = types.WindDownStatusRemoved
+	windDown.CompletedHeight = ctx.BlockHeight()
+
+	if windDown.MigrationConnectionId != "" {
+		// register in a cached context, so a failed registration leaves no partial state.
+		cacheCtx, write := ctx.CacheContext()
+		err := k.HandleRegisterZoneProposal(cacheCtx, &types.RegisterZoneProposal{
+			ConnectionId:     windDown.MigrationConnectionId,
+			BaseDenom:        zone.BaseDenom,
+			LocalDenom:       zone.LocalDenom,
+			AccountPrefix:    zone.AccountPrefix,
+			ReturnToSender:   zone.ReturnToSender,
+			UnbondingEnabled: zone.UnbondingEnabled,
+			DepositsEnabled:  windDown.DepositsEnabled,
+			LiquidityModule:  zone.LiquidityModule,
+			Decimals:         zone.Decimals,
+			MessagesPerTx:    zone.MessagesPerTx,
+			Is_118:           zone.Is_118,
+		})
+		if err != nil {
+			k.Logger(ctx).Error("unable to register zone on migration connection; zone removed", "chain_id", chainID, "connection_id", windDown.MigrationConnectionId, "error", err)
+		} else {
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			windDown.Status = types.WindDownStatusMigrated
+		}
+	}
+
+	k.SetZoneWindDown(ctx, windDown)
+
+	k.Logger(ctx).Info("zone wind-down complete", "chain_id", chainID, "status", windDown.Status)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeZoneWindDownComplete,
+			sdk.NewAttribute(types.AttributeKeyChainID, chainID),
+			sdk.NewAttribute(types.AttributeKeyStatus, windDown.Status.String()),
+		),
+	)
+
+	return nil
+}
+
+// closeZoneICAChannels closes the active channel of each of the zone's
+// interchain accounts.
+func (k *Keeper) closeZoneICAChannels(ctx sdk.Context, zone *types.Zone) {
+	for _, account := range []*types.ICAAccount{zone.DepositAddress, zone.WithdrawalAddress, zone.PerformanceAddress, zone.DelegationAddress} {
+		if account == nil {
+			continue
+		}
+		portID := account.GetPortName()
+		channelID, found := k.ICAControllerKeeper.GetActiveChannelID(ctx, zone.ConnectionId, portID)
+		if !found {
+			continue
+		}
+		chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+		if !found {
+			k.Logger(ctx).Error("module does not own channel capability", "port_id", portID, "channel_id", channelID)
+			continue
+		}
+		if err := k.IBCKeeper.ChannelKeeper.ChanCloseInit(ctx, portID, channelID, chanCap); err != nil {
+			k.Logger(ctx).Error("unable to close ica channel", "port_id", portID, "channel_id", channelID, "error", err)
+		}
+	}
+}
+
+// HandleZoneWindDowns settles the holders of each winding down zone, and
+// completes the wind-down of any that are fully settled. Holders are settled
+// first, so that the holders that cannot be settled are recorded as of
+// completion.
+func (k *Keeper) HandleZoneWindDowns(ctx sdk.Context) {
+	draining := make([]string, 0)
+	k.IterateZoneWindDowns(ctx, func(_ int64, windDown types.ZoneWindDown) (stop bool) {
+		if windDown.Status == types.WindDownStatusDraining {
+			draining = append(draining, windDown.ChainId)
+		}
+		return false
+	})
+
+	for _, chainID := range draining {
+		zone, found := k.GetZone(ctx, chainID)
+		if !found {
+			k.Logger(ctx).Error("no zone found for wind-down", "chain_id", chainID)
+			continue
+		}
+
+		if err := k.SettleZoneHolders(ctx, &zone); err != nil {
+			k.Logger(ctx).Error("unable to settle zone holders", "chain_id", chainID, "error", err)
+			continue
+		}
+
+		if k.IsZoneWindDownComplete(ctx, &zone) {
+			if err := k.CompleteZoneWindDown(ctx, chainID); err != nil {
+				k.Logger(ctx).Error("unable to complete zone wind-down", "chain_id", chainID, "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	icqtypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+	icskeeper "github.com/quicksilver-zone/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// mintQAssets mints the given amount of the zone's qAsset to address.
+func (suite *KeeperTestSuite) mintQAssets(ctx sdk.Context, zone icstypes.Zone, address sdk.AccAddress, amount int64) {
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	coins := sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdkmath.NewInt(amount)))
+	suite.NoError(quicksilver.BankKeeper.MintCoins(ctx, icstypes.ModuleName, coins))
+	suite.NoError(quicksilver.BankKeeper.SendCoinsFromModuleToAccount(ctx, icstypes.ModuleName, address, coins))
+}
+
+func (suite *KeeperTestSuite) TestStartZoneWindDown() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	zone.RedemptionRate = sdk.MustNewDecFromStr("1.1")
+	zone.LastRedemptionRate = sdk.MustNewDecFromStr("1.05")
+	icsKeeper.SetZone(ctx, &zone)
+
+	// the migration connection must differ from the current connection, and be to the same chain.
+	suite.ErrorContains(icsKeeper.StartZoneWindDown(ctx, &zone, zone.ConnectionId), "already registered")
+	suite.ErrorContains(icsKeeper.StartZoneWindDown(ctx, &zone, "connection-99"), "unable to obtain chain id")
+	suite.False(icsKeeper.IsZoneWindingDown(ctx, zone.ChainId))
+
+	suite.NoError(icsKeeper.StartZoneWindDown(ctx, &zone, ""))
+	suite.True(icsKeeper.IsZoneWindingDown(ctx, zone.ChainId))
+
+	zone, found = icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	suite.False(zone.DepositsEnabled)
+	suite.True(zone.UnbondingEnabled)
+	suite.Equal(sdk.MustNewDecFromStr("1.05"), zone.RedemptionRate)
+	suite.Equal(sdk.MustNewDecFromStr("1.05"), zone.LastRedemptionRate)
+
+	windDown, found := icsKeeper.GetZoneWindDown(ctx, zone.ChainId)
+	suite.True(found)
+	suite.Equal(icstypes.WindDownStatusDraining, windDown.Status)
+	suite.Equal(sdk.MustNewDecFromStr("1.05"), windDown.FinalRedemptionRate)
+	suite.True(windDown.DepositsEnabled)
+	suite.Equal(ctx.BlockHeight(), windDown.StartedHeight)
+
+	suite.ErrorContains(icsKeeper.StartZoneWindDown(ctx, &zone, ""), "already winding down")
+
+	// a winding down zone may not be updated, nor distribute rewards.
+	err := icsKeeper.HandleUpdateZoneProposal(ctx, &icstypes.UpdateZoneProposal{ChainId: zone.ChainId, Changes: []*icstypes.UpdateZoneValue{{Key: "deposits_enabled", Value: "true"}}})
+	suite.ErrorContains(err, "winding down")
+	err = icskeeper.DistributeRewardsFromWithdrawAccount(icsKeeper, ctx, nil, icqtypes.Query{ChainId: zone.ChainId})
+	suite.ErrorContains(err, "winding down")
+}
+
+func (suite *KeeperTestSuite) TestSettleZoneHolders() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	suite.ErrorContains(icsKeeper.SettleZoneHolders(ctx, &zone), "not winding down")
+
+	// a holder that has signed a transaction is settled at the same address on the host.
+	signer := addressutils.GenerateAccAddressForTest()
+	acc := quicksilver.AccountKeeper.NewAccountWithAddress(ctx, signer)
+	suite.NoError(acc.SetPubKey(secp256k1.GenPrivKey().PubKey()))
+	quicksilver.AccountKeeper.SetAccount(ctx, acc)
+	suite.mintQAssets(ctx, zone, signer, 1000)
+
+	// a holder with a mapped account is settled at the mapped address.
+	mapped := addressutils.GenerateAccAddressForTest()
+	remote := addressutils.GenerateAccAddressForTest()
+	icsKeeper.SetAddressMapPair(ctx, mapped, remote, zone.ChainId)
+	suite.mintQAssets(ctx, zone, mapped, 2000)
+
+	// a holder without a public key cannot be settled automatically.
+	unknown := addressutils.GenerateAccAddressForTest()
+	suite.mintQAssets(ctx, zone, unknown, 3000)
+
+	suite.NoError(icsKeeper.StartZoneWindDown(ctx, &zone, ""))
+	suite.NoError(icsKeeper.SettleZoneHolders(ctx, &zone))
+
+	expected := map[string]string{
+		signer.String(): addressutils.MustEncodeAddressToBech32(zone.AccountPrefix, signer),
+		mapped.String(): addressutils.MustEncodeAddressToBech32(zone.AccountPrefix, remote),
+	}
+	records := icsKeeper.AllZoneWithdrawalRecords(ctx, zone.ChainId)
+	suite.Len(records, 2)
+	for _, record := range records {
+		suite.Equal(icstypes.WithdrawStatusQueued, record.Status)
+		suite.Equal(expected[record.Delegator], record.Recipient)
+	}
+
+	suite.True(quicksilver.BankKeeper.GetBalance(ctx, signer, zone.LocalDenom).IsZero())
+	suite.True(quicksilver.BankKeeper.GetBalance(ctx, mapped, zone.LocalDenom).IsZero())
+	suite.Equal(sdkmath.NewInt(3000), quicksilver.BankKeeper.GetBalance(ctx, unknown, zone.LocalDenom).Amount)
+	escrow := quicksilver.AccountKeeper.GetModuleAddress(icstypes.EscrowModuleAccount)
+	suite.Equal(sdkmath.NewInt(3000), quicksilver.BankKeeper.GetBalance(ctx, escrow, zone.LocalDenom).Amount)
+
+	windDown, found := icsKeeper.GetZoneWindDown(ctx, zone.ChainId)
+	suite.True(found)
+	suite.Equal([]string{unknown.String()}, windDown.UnsettledHolders)
+
+	// settled holders are not settled again, and escrowed qAssets are not treated as a holder.
+	suite.NoError(icsKeeper.SettleZoneHolders(ctx, &zone))
+	suite.Len(icsKeeper.AllZoneWithdrawalRecords(ctx, zone.ChainId), 2)
+	windDown, _ = icsKeeper.GetZoneWindDown(ctx, zone.ChainId)
+	suite.Equal([]string{unknown.String()}, windDown.UnsettledHolders)
+
+	// the wind-down is not complete while settled redemptions are outstanding.
+	suite.False(icsKeeper.IsZoneWindDownComplete(ctx, &zone))
+}
+
+func (suite *KeeperTestSuite) TestCompleteZoneWindDownWithUnsettledHolder() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	// a holder without a public key, such as an IBC escrow account, cannot be settled.
+	unsettled := addressutils.GenerateAccAddressForTest()
+	suite.mintQAssets(ctx, zone, unsettled, 3000)
+
+	// a holder that can be settled blocks completion until settled.
+	signer := addressutils.GenerateAccAddressForTest()
+	acc := quicksilver.AccountKeeper.NewAccountWithAddress(ctx, signer)
+	suite.NoError(acc.SetPubKey(secp256k1.GenPrivKey().PubKey()))
+	quicksilver.AccountKeeper.SetAccount(ctx, acc)
+	suite.mintQAssets(ctx, zone, signer, 1000)
+
+	suite.NoError(icsKeeper.StartZoneWindDown(ctx, &zone, ""))
+	suite.False(icsKeeper.IsZoneWindDownComplete(ctx, &zone))
+
+	icsKeeper.HandleZoneWindDowns(ctx)
+	suite.True(icsKeeper.IsZoneWindingDown(ctx, zone.ChainId))
+	records := icsKeeper.AllZoneWithdrawalRecords(ctx, zone.ChainId)
+	suite.Len(records, 1)
+
+	// once the settlement completes, only the unsettled holder's qAssets remain in supply.
+	icsKeeper.UpdateWithdrawalRecordStatus(ctx, &records[0], icstypes.WithdrawStatusCompleted)
+	suite.NoError(quicksilver.BankKeeper.BurnCoins(ctx, icstypes.EscrowModuleAccount, sdk.NewCoins(records[0].BurnAmount)))
+	suite.Equal(sdkmath.NewInt(3000), quicksilver.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount)
+	suite.True(icsKeeper.IsZoneWindDownComplete(ctx, &zone))
+
+	icsKeeper.HandleZoneWindDowns(ctx)
+
+	_, found = icsKeeper.GetZone(ctx, zone.ChainId)
+	suite.False(found)
+	windDown, found := icsKeeper.GetZoneWindDown(ctx, zone.ChainId)
+	suite.True(found)
+	suite.Equal(icstypes.WindDownStatusRemoved, windDown.Status)
+	suite.Equal([]string{unsettled.String()}, windDown.UnsettledHolders)
+}
+
+func (suite *KeeperTestSuite) TestCompleteZoneWindDown() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	suite.ErrorContains(icsKeeper.CompleteZoneWindDown(ctx, zone.ChainId), "not winding down")
+
+	suite.NoError(icsKeeper.StartZoneWindDown(ctx, &zone, ""))
+	icsKeeper.SetCircuitBreaker(ctx, icstypes.CircuitBreaker{ChainId: zone.ChainId})
+
+	// an outstanding redemption blocks completion.
+	record := icstypes.WithdrawalRecord{ChainId: zone.ChainId, Delegator: addressutils.GenerateAccAddressForTest().String(), Txhash: "abcd", Status: icstypes.WithdrawStatusUnbond, BurnAmount: sdk.NewCoin(zone.LocalDenom, sdkmath.NewInt(1))}
+	icsKeeper.SetWithdrawalRecord(ctx, record)
+	suite.False(icsKeeper.IsZoneWindDownComplete(ctx, &zone))
+	icsKeeper.HandleZoneWindDowns(ctx)
+	suite.True(icsKeeper.IsZoneWindingDown(ctx, zone.ChainId))
+
+	// as does a cancellation awaiting acknowledgement.
+	icsKeeper.UpdateWithdrawalRecordStatus(ctx, &record, icstypes.WithdrawStatusCancel)
+	suite.False(icsKeeper.IsZoneWindDownComplete(ctx, &zone))
+
+	icsKeeper.UpdateWithdrawalRecordStatus(ctx, &record, icstypes.WithdrawStatusCompleted)
+	suite.True(icsKeeper.IsZoneWindDownComplete(ctx, &zone))
+
+	icsKeeper.HandleZoneWindDowns(ctx)
+
+	_, found = icsKeeper.GetZone(ctx, zone.ChainId)
+	suite.False(found)
+	suite.Empty(icsKeeper.GetValidators(ctx, zone.ChainId))
+	suite.Empty(icsKeeper.AllZoneWithdrawalRecords(ctx, zone.ChainId))
+	_, found = icsKeeper.GetCircuitBreaker(ctx, zone.ChainId)
+	suite.False(found)
+	_, found = icsKeeper.GetAddressZoneMapping(ctx, zone.DepositAddress.Address)
+	suite.False(found)
+
+	windDown, found := icsKeeper.GetZoneWindDown(ctx, zone.ChainId)
+	suite.True(found)
+	suite.Equal(icstypes.WindDownStatusRemoved, windDown.Status)
+	suite.Equal(ctx.BlockHeight(), windDown.CompletedHeight)
+	suite.False(icsKeeper.IsZoneWindingDown(ctx, zone.ChainId))
+}
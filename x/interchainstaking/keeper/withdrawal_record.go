@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
 )
@@ -24,6 +25,23 @@ func (k *Keeper) InitWithdrawalRecordSequence(ctx sdk.Context) {
 	store.Set(types.KeyPrefixRequeuedWithdrawalRecordSeq, bz)
 }
 
+// GetWithdrawalRecordSequence returns the global withdrawal record sequence without incrementing it.
+func (k *Keeper) GetWithdrawalRecordSequence(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixRequeuedWithdrawalRecordSeq)
+	if bz == nil {
+		return 0
+	}
+	val := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// SetWithdrawalRecordSequence sets the global withdrawal record sequence.
+func (k *Keeper) SetWithdrawalRecordSequence(ctx sdk.Context, sequence uint64) {
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: sequence})
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixRequeuedWithdrawalRecordSeq, bz)
+}
+
 // GetNextWithdrawalRecordSequence returns and increments the global withdrawal record seqeuence.
 func (k *Keeper) GetNextWithdrawalRecordSequence(ctx sdk.Context) uint64 {
 	var sequence uint64
@@ -188,6 +206,18 @@ func (k *Keeper) AllZoneWithdrawalRecords(ctx sdk.Context, chainID string) []typ
 	return records
 }
 
+// QueuedWithdrawalRecordsByPriority returns the queued records for the specified zone, in the order in which they
+// are to be processed.
+func (k *Keeper) QueuedWithdrawalRecordsByPriority(ctx sdk.Context, chainID string) []types.WithdrawalRecord {
+	records := []types.WithdrawalRecord{}
+	k.IterateZoneStatusWithdrawalRecords(ctx, chainID, types.WithdrawStatusQueued, func(_ int64, record types.WithdrawalRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	types.SortWithdrawalRecordsByPriority(records)
+	return records
+}
+
 // GetUnbondingRecord returns unbonding record info by zone, validator and epoch.
 func (k *Keeper) GetUnbondingRecord(ctx sdk.Context, chainID, validator string, epochNumber int64) (types.UnbondingRecord, bool) {
 	record := types.UnbondingRecord{}
@@ -261,6 +291,48 @@ func (k *Keeper) AllZoneUnbondingRecords(ctx sdk.Context, chainID string) []type
 	return records
 }
 
+// GetUnbondingRecordForWithdrawal returns the unbonding record for the given zone and validator that relates to the
+// withdrawal record with the given hash.
+func (k *Keeper) GetUnbondingRecordForWithdrawal(ctx sdk.Context, chainID, validator, txhash string) (types.UnbondingRecord, bool) {
+	var (
+		record types.UnbondingRecord
+		found  bool
+	)
+	k.IteratePrefixedUnbondingRecords(ctx, append([]byte(chainID), []byte(validator)...), func(_ int64, ubr types.UnbondingRecord) (stop bool) {
+		if ubr.ChainId != chainID || ubr.Validator != validator {
+			return false
+		}
+		for _, hash := range ubr.RelatedTxhash {
+			if hash == txhash {
+				record = ubr
+				found = true
+				return true
+			}
+		}
+		return false
+	})
+	return record, found
+}
+
+// SetUnbondingRecordCreationHeights sets the host chain creation height on unbonding records for the given
+// unbonding delegation, matching entries on completion time. The creation height is required to cancel an unbonding.
+func (k *Keeper) SetUnbondingRecordCreationHeights(ctx sdk.Context, zone *types.Zone, ubd stakingtypes.UnbondingDelegation) {
+	k.IteratePrefixedUnbondingRecords(ctx, append([]byte(zone.ChainId), []byte(ubd.ValidatorAddress)...), func(_ int64, record types.UnbondingRecord) (stop bool) {
+		if record.ChainId != zone.ChainId || record.Validator != ubd.ValidatorAddress || record.CreationHeight != 0 || record.CompletionTime.IsZero() {
+			return false
+		}
+		for _, entry := range ubd.Entries {
+			if entry.CompletionTime.Equal(record.CompletionTime) {
+				k.Logger(ctx).Info("setting creation height for unbonding record", "chain", record.ChainId, "validator", record.Validator, "epoch", record.EpochNumber, "height", entry.CreationHeight)
+				record.CreationHeight = entry.CreationHeight
+				k.SetUnbondingRecord(ctx, record)
+				break
+			}
+		}
+		return false
+	})
+}
+
 func (k *Keeper) UpdateWithdrawalRecordsForSlash(ctx sdk.Context, zone *types.Zone, valoper string, delta sdk.Dec) error {
 	var err error
 	k.IterateZoneStatusWithdrawalRecords(ctx, zone.ChainId, types.WithdrawStatusUnbond, func(_ int64, record types.WithdrawalRecord) bool {
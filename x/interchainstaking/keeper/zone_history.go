@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/quicksilver-zone/quicksilver/x/epochs/types"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// GetZoneSnapshot returns the snapshot of the given zone at the given epoch.
+func (k *Keeper) GetZoneSnapshot(ctx sdk.Context, chainID string, epochNumber int64) (types.ZoneSnapshot, bool) {
+	snapshot := types.ZoneSnapshot{}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetZoneSnapshotKey(chainID, epochNumber))
+	if len(bz) == 0 {
+		return snapshot, false
+	}
+	k.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot, true
+}
+
+// SetZoneSnapshot stores a zone snapshot.
+func (k *Keeper) SetZoneSnapshot(ctx sdk.Context, snapshot types.ZoneSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.GetZoneSnapshotKey(snapshot.ChainId, snapshot.EpochNumber), bz)
+}
+
+// DeleteZoneSnapshot deletes a zone snapshot.
+func (k *Keeper) DeleteZoneSnapshot(ctx sdk.Context, chainID string, epochNumber int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetZoneSnapshotKey(chainID, epochNumber))
+}
+
+// IterateZoneSnapshots iterates through the snapshots of the given zone, in
+// ascending order of epoch, or descending order if reverse is set.
+func (k *Keeper) IterateZoneSnapshots(ctx sdk.Context, chainID string, reverse bool, fn func(index int64, snapshot types.ZoneSnapshot) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetZoneSnapshotsKey(chainID))
+
+	var iterator sdk.Iterator
+	if reverse {
+		iterator = sdk.KVStoreReversePrefixIterator(store, nil)
+	} else {
+		iterator = sdk.KVStorePrefixIterator(store, nil)
+	}
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		snapshot := types.ZoneSnapshot{}
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+
+		// chain ids are not length prefixed in the key, so guard against one
+		// chain id being a prefix of another.
+		if snapshot.ChainId != chainID {
+			continue
+		}
+
+		stop := fn(i, snapshot)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllZoneSnapshots returns every retained snapshot of the given zone, in
+// ascending order of epoch.
+func (k *Keeper) AllZoneSnapshots(ctx sdk.Context, chainID string) []types.ZoneSnapshot {
+	snapshots := []types.ZoneSnapshot{}
+	k.IterateZoneSnapshots(ctx, chainID, false, func(_ int64, snapshot types.ZoneSnapshot) (stop bool) {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+	return snapshots
+}
+
+// GetZoneHistoryRetentionEpochs returns the number of epochs for which zone
+// snapshots are retained, falling back to the default if the param is unset.
+func (k *Keeper) GetZoneHistoryRetentionEpochs(ctx sdk.Context) uint64 {
+	var out uint64
+	k.paramStore.GetIfExists(ctx, types.KeyZoneHistoryRetentionEpochs, &out)
+	if out == 0 {
+		return types.DefaultZoneHistoryRetentionEpochs
+	}
+	return out
+}
+
+// RecordZoneSnapshot records the redemption rate, supply and TVL of the given
+// zone for the current epoch, along with the APR implied since the previous
+// snapshot, and prunes snapshots older than the retention window.
+func (k *Keeper) RecordZoneSnapshot(ctx sdk.Context, zone *types.Zone) {
+	epochNumber := k.EpochsKeeper.GetEpochInfo(ctx, epochstypes.EpochIdentifierEpoch).CurrentEpoch
+	supply := k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount
+
+	snapshot := types.ZoneSnapshot{
+		ChainId:        zone.ChainId,
+		EpochNumber:    epochNumber,
+		Height:         ctx.BlockHeight(),
+		Timestamp:      ctx.BlockTime(),
+		RedemptionRate: zone.RedemptionRate,
+		Supply:         supply,
+		Tvl:            zone.RedemptionRate.MulInt(supply).TruncateInt(),
+		Apr:            sdk.ZeroDec(),
+	}
+
+	k.IterateZoneSnapshots(ctx, zone.ChainId, true, func(_ int64, previous types.ZoneSnapshot) (stop bool) {
+		if previous.EpochNumber >= epochNumber {
+			return false
+		}
+		snapshot.Apr = types.AnnualisedRate(previous.RedemptionRate, snapshot.RedemptionRate, snapshot.Timestamp.Sub(previous.Timestamp))
+		return true
+	})
+
+	k.SetZoneSnapshot(ctx, snapshot)
+	k.PruneZoneSnapshots(ctx, zone.ChainId, epochNumber-int64(k.GetZoneHistoryRetentionEpochs(ctx)))
+}
+
+// PruneZoneSnapshots deletes the snapshots of the given zone at or before
+// the given epoch.
+func (k *Keeper) PruneZoneSnapshots(ctx sdk.Context, chainID string, epochNumber int64) {
+	var epochs []int64
+	k.IterateZoneSnapshots(ctx, chainID, false, func(_ int64, snapshot types.ZoneSnapshot) (stop bool) {
+		if snapshot.EpochNumber > epochNumber {
+			return true
+		}
+		epochs = append(epochs, snapshot.EpochNumber)
+		return false
+	})
+
+	for _, epoch := range epochs {
+		k.DeleteZoneSnapshot(ctx, chainID, epoch)
+	}
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	epochstypes "github.com/quicksilver-zone/quicksilver/x/epochs/types"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// setEpochNumber sets the current epoch number of the epoch identifier.
+func (suite *KeeperTestSuite) setEpochNumber(ctx sdk.Context, epochNumber int64) {
+	epochsKeeper := suite.GetQuicksilverApp(suite.chainA).EpochsKeeper
+	epochInfo := epochsKeeper.GetEpochInfo(ctx, epochstypes.EpochIdentifierEpoch)
+	epochInfo.CurrentEpoch = epochNumber
+	epochsKeeper.SetEpochInfo(ctx, epochInfo)
+}
+
+func (suite *KeeperTestSuite) TestRecordZoneSnapshot() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	suite.mintQAssets(ctx, zone, addressutils.GenerateAccAddressForTest(), 1000)
+
+	// the first snapshot has no apr.
+	suite.setEpochNumber(ctx, 10)
+	zone.RedemptionRate = sdk.OneDec()
+	icsKeeper.RecordZoneSnapshot(ctx, &zone)
+
+	snapshot, found := icsKeeper.GetZoneSnapshot(ctx, zone.ChainId, 10)
+	suite.True(found)
+	suite.Equal(sdk.OneDec(), snapshot.RedemptionRate)
+	suite.Equal(sdkmath.NewInt(1000), snapshot.Supply)
+	suite.Equal(sdkmath.NewInt(1000), snapshot.Tvl)
+	suite.True(snapshot.Apr.IsZero())
+	suite.Equal(ctx.BlockHeight(), snapshot.Height)
+
+	// a later snapshot records the apr implied since the previous snapshot.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(icstypes.SecondsPerYear) * time.Second / 2))
+	suite.setEpochNumber(ctx, 11)
+	zone.RedemptionRate = sdk.MustNewDecFromStr("1.05")
+	icsKeeper.RecordZoneSnapshot(ctx, &zone)
+
+	snapshot, found = icsKeeper.GetZoneSnapshot(ctx, zone.ChainId, 11)
+	suite.True(found)
+	suite.Equal(sdkmath.NewInt(1050), snapshot.Tvl)
+	suite.Equal(sdk.MustNewDecFromStr("0.1"), snapshot.Apr)
+
+	stats, err := icsKeeper.CollectStatsForZone(ctx, &zone)
+	suite.NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("0.1"), stats.Apr)
+	suite.Equal(sdk.MustNewDecFromStr("0.1025"), stats.Apy)
+
+	// snapshots outside of the retention window are pruned.
+	params := icsKeeper.GetParams(ctx)
+	params.ZoneHistoryRetentionEpochs = 2
+	icsKeeper.SetParams(ctx, params)
+
+	suite.setEpochNumber(ctx, 12)
+	icsKeeper.RecordZoneSnapshot(ctx, &zone)
+	suite.Len(icsKeeper.AllZoneSnapshots(ctx, zone.ChainId), 2)
+	_, found = icsKeeper.GetZoneSnapshot(ctx, zone.ChainId, 10)
+	suite.False(found)
+
+	// snapshots are removed with the zone.
+	icsKeeper.RemoveZoneAndAssociatedRecords(ctx, zone.ChainId)
+	suite.Empty(icsKeeper.AllZoneSnapshots(ctx, zone.ChainId))
+}
+
+func (suite *KeeperTestSuite) TestUpdateRedemptionRateRecordsSnapshot() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	suite.setEpochNumber(ctx, 5)
+	icsKeeper.UpdateRedemptionRate(ctx, &zone, sdkmath.ZeroInt())
+
+	snapshot, found := icsKeeper.GetZoneSnapshot(ctx, zone.ChainId, 5)
+	suite.True(found)
+	suite.Equal(zone.RedemptionRate, snapshot.RedemptionRate)
+}
@@ -86,6 +86,35 @@ func (k *Keeper) DeleteAddressZoneMapping(ctx sdk.Context, address string) {
 	store.Delete([]byte(address))
 }
 
+// IterateAddressZoneMappings iterates through zone <-> address mappings.
+func (k *Keeper) IterateAddressZoneMappings(ctx sdk.Context, fn func(index int64, address, chainID string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAddressZoneMapping)
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		stop := fn(i, string(iterator.Key()), string(iterator.Value()))
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllAddressZoneMappings returns every zone <-> address mapping in the store.
+func (k *Keeper) AllAddressZoneMappings(ctx sdk.Context) []types.AddressZoneMapping {
+	mappings := []types.AddressZoneMapping{}
+	k.IterateAddressZoneMappings(ctx, func(_ int64, address, chainID string) (stop bool) {
+		mappings = append(mappings, types.AddressZoneMapping{Address: address, ChainId: chainID})
+		return false
+	})
+	return mappings
+}
+
 func (k *Keeper) GetDelegatedAmount(ctx sdk.Context, zone *types.Zone) sdk.Coin {
 	out := sdk.NewCoin(zone.BaseDenom, sdk.ZeroInt())
 	k.IterateAllDelegations(ctx, zone.ChainId, func(delegation types.Delegation) (stop bool) {
@@ -461,6 +490,9 @@ func (k *Keeper) CollectStatsForZone(ctx sdk.Context, zone *types.Zone) (*types.
 	out.UnbondingAmount, out.UnbondingCount = k.GetUnbondingTokensAndCount(ctx, zone)
 	out.QueuedAmount, out.QueuedCount = k.GetQueuedTokensAndCount(ctx, zone)
 	out.UnbondRecordCount = k.GetUnbondRecordCount(ctx, zone)
+
+	// Yield info
+	out.Apr, out.Apy = types.ZoneYield(k.AllZoneSnapshots(ctx, zone.ChainId))
 	return out, nil
 }
 
@@ -527,6 +559,39 @@ func (k *Keeper) RemoveZoneAndAssociatedRecords(ctx sdk.Context, chainID string)
 				return false
 			})
 
+			// remove intents
+			for _, snapshot := range []bool{false, true} {
+				for _, intent := range k.AllDelegatorIntents(ctx, zone, snapshot) {
+					k.DeleteDelegatorIntent(ctx, zone, intent.Delegator, snapshot)
+				}
+			}
+
+			// remove slash records
+			for _, record := range k.AllZoneSlashRecords(ctx, chainID) {
+				k.DeleteSlashRecord(ctx, chainID, record.Validator, record.Height)
+			}
+
+			// remove reward swap routes and pools
+			for _, pool := range k.AllZoneSwapPools(ctx, chainID) {
+				k.DeleteSwapPool(ctx, chainID, pool.PoolId)
+			}
+			k.DeleteRewardSwapConfig(ctx, chainID)
+
+			// remove zone history
+			for _, snapshot := range k.AllZoneSnapshots(ctx, chainID) {
+				k.DeleteZoneSnapshot(ctx, chainID, snapshot.EpochNumber)
+			}
+
+			k.DeleteCircuitBreaker(ctx, chainID)
+			k.DeleteLsmCaps(ctx, chainID)
+			k.DeleteZoneEpochCaps(ctx, chainID)
+
+			for _, account := range []*types.ICAAccount{zone.DepositAddress, zone.WithdrawalAddress, zone.PerformanceAddress, zone.DelegationAddress} {
+				if account != nil {
+					k.DeleteAddressZoneMapping(ctx, account.Address)
+				}
+			}
+
 			k.DeleteDenomZoneMapping(ctx, zone.LocalDenom)
 
 			k.DeleteZone(ctx, zone.ChainId)
@@ -0,0 +1,78 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+const (
+	// SimZoneChainID is the chain id of the zone registered at genesis. The zone has no IBC connection; its host chain
+	// is mocked by the ICA and ICQ operations of this package.
+	SimZoneChainID    = "simzone-1"
+	SimZoneLocalDenom = "uqsim"
+	SimZoneBaseDenom  = "usim"
+	SimZonePrefix     = "cosmos"
+)
+
+// RandomizedGenState generates a random GenesisState for interchainstaking, registering a single mock zone.
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.NewParams(
+		uint64(simState.Rand.Intn(50)+1),                      // [1, 50]
+		uint64(simState.Rand.Intn(500)+1),                     // [1, 500]
+		sdk.NewDecWithPrec(int64(simState.Rand.Intn(100)), 3), // [0, 0.1)
+		true,                              // redemptions are simulated
+		uint64(simState.Rand.Intn(365)+1), // [1, 365]
+	)
+
+	// the redemption rate is only ever updated at epoch boundaries, so last and current rates are equal at genesis.
+	rate := sdk.OneDec().Add(sdk.NewDecWithPrec(int64(simState.Rand.Intn(200)), 3)) // [1, 1.2)
+	zone := types.Zone{
+		ConnectionId:       "connection-0",
+		ChainId:            SimZoneChainID,
+		DepositAddress:     simICAAccount(SimZoneChainID + ".deposit"),
+		DelegationAddress:  simICAAccount(SimZoneChainID + ".delegate"),
+		AccountPrefix:      SimZonePrefix,
+		LocalDenom:         SimZoneLocalDenom,
+		BaseDenom:          SimZoneBaseDenom,
+		RedemptionRate:     rate,
+		LastRedemptionRate: rate,
+		Tvl:                sdk.ZeroDec(),
+		UnbondingPeriod:    int64(time.Duration(simState.Rand.Intn(14)+14) * 24 * time.Hour), // [14, 28) days
+		MessagesPerTx:      5,
+		Decimals:           6,
+		UnbondingEnabled:   true,
+		DepositsEnabled:    true,
+		Is_118:             true,
+	}
+
+	icsGenesis := types.NewGenesisState(params, []types.Zone{zone})
+	if err := icsGenesis.Validate(); err != nil {
+		panic(err)
+	}
+
+	bz, err := json.MarshalIndent(&icsGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Selected deterministically generated interchainstaking parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(icsGenesis)
+}
+
+// simICAAccount returns an interchain account with an address deterministically derived from the port name.
+func simICAAccount(portName string) *types.ICAAccount {
+	return &types.ICAAccount{
+		Address:  addressutils.MustEncodeAddressToBech32(SimZonePrefix, authtypes.NewModuleAddress(portName)),
+		PortName: portName,
+	}
+}
@@ -0,0 +1,525 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdksimtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/quicksilver-zone/quicksilver/test/simulation/simtypes"
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/keeper"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+const (
+	OpWeightMsgRequestRedemption               = "op_weight_msg_request_redemption"       // nolint:gosec // not credentials
+	OpWeightMsgCancelQueuedRedemption          = "op_weight_msg_cancel_queued_redemption" // nolint:gosec // not credentials
+	OpWeightMsgSignalIntent                    = "op_weight_msg_signal_intent"            // nolint:gosec // not credentials
+	OpWeightValidatorQuery                     = "op_weight_validator_query"              // nolint:gosec // not credentials
+	OpWeightDepositQuery                       = "op_weight_deposit_query"                // nolint:gosec // not credentials
+	OpWeightDelegateAck                        = "op_weight_delegate_ack"                 // nolint:gosec // not credentials
+	OpWeightUndelegateAck                      = "op_weight_undelegate_ack"               // nolint:gosec // not credentials
+	OpWeightWithdrawalAck                      = "op_weight_withdrawal_ack"               // nolint:gosec // not credentials
+	DefaultWeightMsgRequestRedemption      int = 20
+	DefaultWeightMsgCancelQueuedRedemption int = 5
+	DefaultWeightMsgSignalIntent           int = 10
+	DefaultWeightValidatorQuery            int = 5
+	DefaultWeightDepositQuery              int = 30
+	DefaultWeightDelegateAck               int = 20
+	DefaultWeightUndelegateAck             int = 15
+	DefaultWeightWithdrawalAck             int = 15
+
+	// maxSimValidators caps the number of host chain validators returned by the mock validator set query.
+	maxSimValidators = 16
+)
+
+var (
+	TypeMsgRequestRedemption      = sdk.MsgTypeURL(&types.MsgRequestRedemption{})
+	TypeMsgCancelQueuedRedemption = sdk.MsgTypeURL(&types.MsgCancelQueuedRedemption{})
+	TypeMsgSignalIntent           = sdk.MsgTypeURL(&types.MsgSignalIntent{})
+	TypeValidatorQuery            = "mock_icq_validator"
+	TypeDepositQuery              = "mock_icq_deposit"
+	TypeDelegateAck               = "mock_ica_delegate_ack"
+	TypeUndelegateAck             = "mock_ica_undelegate_ack"
+	TypeWithdrawalAck             = "mock_ica_withdrawal_ack"
+)
+
+// WeightedOperations returns the simulation operations of the interchainstaking module. Besides the user facing
+// messages, the host chain of each zone is mocked: the ICQ operations stand in for validator set and deposit
+// query callbacks, and the ICA operations for the acknowledgements of delegations, undelegations and withdrawals.
+func WeightedOperations(
+	appParams sdksimtypes.AppParams,
+	cdc codec.JSONCodec,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgRequestRedemption      int
+		weightMsgCancelQueuedRedemption int
+		weightMsgSignalIntent           int
+		weightValidatorQuery            int
+		weightDepositQuery              int
+		weightDelegateAck               int
+		weightUndelegateAck             int
+		weightWithdrawalAck             int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRequestRedemption, &weightMsgRequestRedemption, nil,
+		func(_ *rand.Rand) {
+			weightMsgRequestRedemption = DefaultWeightMsgRequestRedemption
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelQueuedRedemption, &weightMsgCancelQueuedRedemption, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelQueuedRedemption = DefaultWeightMsgCancelQueuedRedemption
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSignalIntent, &weightMsgSignalIntent, nil,
+		func(_ *rand.Rand) {
+			weightMsgSignalIntent = DefaultWeightMsgSignalIntent
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightValidatorQuery, &weightValidatorQuery, nil,
+		func(_ *rand.Rand) {
+			weightValidatorQuery = DefaultWeightValidatorQuery
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightDepositQuery, &weightDepositQuery, nil,
+		func(_ *rand.Rand) {
+			weightDepositQuery = DefaultWeightDepositQuery
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightDelegateAck, &weightDelegateAck, nil,
+		func(_ *rand.Rand) {
+			weightDelegateAck = DefaultWeightDelegateAck
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightUndelegateAck, &weightUndelegateAck, nil,
+		func(_ *rand.Rand) {
+			weightUndelegateAck = DefaultWeightUndelegateAck
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightWithdrawalAck, &weightWithdrawalAck, nil,
+		func(_ *rand.Rand) {
+			weightWithdrawalAck = DefaultWeightWithdrawalAck
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgRequestRedemption,
+			SimulateMsgRequestRedemption(k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelQueuedRedemption,
+			SimulateMsgCancelQueuedRedemption(k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSignalIntent,
+			SimulateMsgSignalIntent(k),
+		),
+		simulation.NewWeightedOperation(
+			weightValidatorQuery,
+			SimulateValidatorQuery(k),
+		),
+		simulation.NewWeightedOperation(
+			weightDepositQuery,
+			SimulateDepositQuery(k),
+		),
+		simulation.NewWeightedOperation(
+			weightDelegateAck,
+			SimulateDelegateAck(k),
+		),
+		simulation.NewWeightedOperation(
+			weightUndelegateAck,
+			SimulateUndelegateAck(k),
+		),
+		simulation.NewWeightedOperation(
+			weightWithdrawalAck,
+			SimulateWithdrawalAck(k),
+		),
+	}
+}
+
+// SimulateMsgRequestRedemption generates a MsgRequestRedemption for a random portion of an account's qAssets,
+// optionally paying a priority fee.
+func SimulateMsgRequestRedemption(k *keeper.Keeper) sdksimtypes.Operation {
+	return func(
+		r *rand.Rand, bApp *baseapp.BaseApp, ctx sdk.Context, accs []sdksimtypes.Account, chainID string,
+	) (sdksimtypes.OperationMsg, []sdksimtypes.FutureOperation, error) {
+		zone, found := randomZone(ctx, r, k)
+		if !found {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeMsgRequestRedemption, "no zone found"), nil, nil
+		}
+
+		if !k.GetUnbondingEnabled(ctx) || !zone.UnbondingEnabled || k.IsCircuitBreakerTripped(ctx, zone.ChainId, types.CircuitBreakerActionRedemptions) {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeMsgRequestRedemption, "redemptions disabled"), nil, nil
+		}
+
+		acc, found := simtypes.RandomSimAccountWithConstraint(r, func(acc sdksimtypes.Account) bool {
+			return k.BankKeeper.SpendableCoins(ctx, acc.Address).AmountOf(zone.LocalDenom).GT(sdk.OneInt())
+		}, accs)
+		if !found {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeMsgRequestRedemption, "no account with qAssets found"), nil, nil
+		}
+		balance := sdk.NewCoin(zone.LocalDenom, k.BankKeeper.SpendableCoins(ctx, acc.Address).AmountOf(zone.LocalDenom))
+
+		value, err := simtypes.RandPositiveInt(r, balance.Amount.QuoRaw(2))
+		if err != nil {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeMsgRequestRedemption, "error creating random sdkmath.Int"), nil, err
+		}
+
+		msg := &types.MsgRequestRedemption{
+			Value:              sdk.NewCoin(zone.LocalDenom, value),
+			DestinationAddress: addressutils.MustEncodeAddressToBech32(zone.AccountPrefix, acc.Address),
+			FromAddress:        acc.Address.String(),
+		}
+		spent := sdk.NewCoins(msg.Value)
+
+		if r.Intn(4) == 0 && balance.Amount.Sub(value).IsPositive() {
+			fee := sdk.NewCoin(zone.LocalDenom, simtypes.RandomAmount(r, sdkmath.MinInt(balance.Amount.Sub(value), value.QuoRaw(100).AddRaw(1))))
+			if fee.IsPositive() {
+				msg.PriorityFee = &fee
+				spent = spent.Add(fee)
+			}
+		}
+
+		return deliverTx(r, bApp, ctx, k, acc, msg, TypeMsgRequestRedemption, spent)
+	}
+}
+
+// SimulateMsgCancelQueuedRedemption generates a MsgCancelQueuedRedemption for a random queued withdrawal record owned
+// by a simulation account, cancelling it either in full or in part.
+func SimulateMsgCancelQueuedRedemption(k *keeper.Keeper) sdksimtypes.Operation {
+	return func(
+		r *rand.Rand, bApp *baseapp.BaseApp, ctx sdk.Context, accs []sdksimtypes.Account, chainID string,
+	) (sdksimtypes.OperationMsg, []sdksimtypes.FutureOperation, error) {
+		zone, found := randomZone(ctx, r, k)
+		if !found {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeMsgCancelQueuedRedemption, "no zone found"), nil, nil
+		}
+
+		records := make([]types.WithdrawalRecord, 0)
+		k.IterateZoneStatusWithdrawalRecords(ctx, zone.ChainId, types.WithdrawStatusQueued, func(_ int64, record types.WithdrawalRecord) (stop bool) {
+			records = append(records, record)
+			return false
+		})
+		if len(records) == 0 {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeMsgCancelQueuedRedemption, "no queued withdrawal records found"), nil, nil
+		}
+
+		record := records[r.Intn(len(records))]
+		delegator, err := sdk.AccAddressFromBech32(record.Delegator)
+		if err != nil {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeMsgCancelQueuedRedemption, "invalid delegator address"), nil, err
+		}
+		acc, found := simtypes.FindAccount(delegator, accs)
+		if !found {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeMsgCancelQueuedRedemption, "delegator is not a simulation account"), nil, nil
+		}
+
+		msg := &types.MsgCancelQueuedRedemption{
+			ChainId:     zone.ChainId,
+			Hash:        record.Txhash,
+			FromAddress: acc.Address.String(),
+		}
+		if r.Intn(2) == 0 {
+			amount := sdk.NewCoin(record.BurnAmount.Denom, simtypes.RandomAmount(r, record.BurnAmount.Amount))
+			if amount.IsPositive() {
+				msg.Amount = &amount
+			}
+		}
+
+		return deliverTx(r, bApp, ctx, k, acc, msg, TypeMsgCancelQueuedRedemption, nil)
+	}
+}
+
+// SimulateMsgSignalIntent generates a MsgSignalIntent with random weights across a random subset of the zone's
+// validators.
+func SimulateMsgSignalIntent(k *keeper.Keeper) sdksimtypes.Operation {
+	return func(
+		r *rand.Rand, bApp *baseapp.BaseApp, ctx sdk.Context, accs []sdksimtypes.Account, chainID string,
+	) (sdksimtypes.OperationMsg, []sdksimtypes.FutureOperation, error) {
+		zone, found := randomZone(ctx, r, k)
+		if !found {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeMsgSignalIntent, "no zone found"), nil, nil
+		}
+
+		validators := k.GetValidators(ctx, zone.ChainId)
+		if len(validators) == 0 {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeMsgSignalIntent, "no validators found"), nil, nil
+		}
+
+		r.Shuffle(len(validators), func(i, j int) { validators[i], validators[j] = validators[j], validators[i] })
+		validators = validators[:r.Intn(len(validators))+1]
+
+		// weights are expressed in percent and sum to one.
+		intents := make([]string, len(validators))
+		remaining := 100
+		for i, validator := range validators {
+			weight := remaining
+			if i < len(validators)-1 {
+				weight = r.Intn(remaining-(len(validators)-1-i)) + 1
+			}
+			remaining -= weight
+			intents[i] = sdk.NewDecWithPrec(int64(weight), 2).String() + validator.ValoperAddress
+		}
+
+		acc := simtypes.RandomSimAccount(r, accs)
+		msg := &types.MsgSignalIntent{
+			ChainId:     zone.ChainId,
+			Intents:     strings.Join(intents, ","),
+			FromAddress: acc.Address.String(),
+		}
+
+		return deliverTx(r, bApp, ctx, k, acc, msg, TypeMsgSignalIntent, nil)
+	}
+}
+
+// SimulateValidatorQuery mocks the validator set query callback, adding a new bonded validator to the zone.
+func SimulateValidatorQuery(k *keeper.Keeper) sdksimtypes.Operation {
+	return func(
+		r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []sdksimtypes.Account, _ string,
+	) (sdksimtypes.OperationMsg, []sdksimtypes.FutureOperation, error) {
+		zone, found := randomZone(ctx, r, k)
+		if !found {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeValidatorQuery, "no zone found"), nil, nil
+		}
+
+		if len(k.GetValidators(ctx, zone.ChainId)) >= maxSimValidators {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeValidatorQuery, "validator set is full"), nil, nil
+		}
+
+		valAddr := make([]byte, 20)
+		r.Read(valAddr)
+		power := sdkmath.NewInt(r.Int63n(1_000_000_000_000) + 1)
+		validator := types.Validator{
+			ValoperAddress:      addressutils.MustEncodeAddressToBech32(zone.GetValoperPrefix(), sdk.ValAddress(valAddr)),
+			CommissionRate:      sdk.NewDecWithPrec(int64(r.Intn(20)), 2),
+			DelegatorShares:     sdk.NewDecFromInt(power),
+			VotingPower:         power,
+			Score:               sdk.ZeroDec(),
+			Status:              stakingtypes.BondStatusBonded,
+			ValidatorBondShares: sdk.ZeroDec(),
+			LiquidShares:        sdk.ZeroDec(),
+		}
+		if err := k.SetValidator(ctx, zone.ChainId, validator); err != nil {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeValidatorQuery, "unable to set validator"), nil, err
+		}
+
+		return sdksimtypes.NewOperationMsgBasic(types.ModuleName, TypeValidatorQuery, "", true, nil), nil, nil
+	}
+}
+
+// SimulateDepositQuery mocks the deposit account transaction query callback, recording a receipt for a deposit by a
+// random simulation account and minting qAssets to it at the current redemption rate.
+func SimulateDepositQuery(k *keeper.Keeper) sdksimtypes.Operation {
+	return func(
+		r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []sdksimtypes.Account, _ string,
+	) (sdksimtypes.OperationMsg, []sdksimtypes.FutureOperation, error) {
+		zone, found := randomZone(ctx, r, k)
+		if !found {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeDepositQuery, "no zone found"), nil, nil
+		}
+
+		if !zone.DepositsEnabled || k.IsCircuitBreakerTripped(ctx, zone.ChainId, types.CircuitBreakerActionDeposits) {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeDepositQuery, "deposits disabled"), nil, nil
+		}
+
+		acc := simtypes.RandomSimAccount(r, accs)
+		assets := sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdkmath.NewInt(r.Int63n(1_000_000_000)+1_000)))
+		sender := addressutils.MustEncodeAddressToBech32(zone.AccountPrefix, acc.Address)
+		hash := randomHash(r)
+
+		if err := k.MintAndSendQAsset(ctx, acc.Address, sender, zone, assets, false, nil); err != nil {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeDepositQuery, "unable to mint qAssets"), nil, err
+		}
+		k.SetReceipt(ctx, *k.NewReceipt(ctx, zone, sender, hash, assets))
+
+		return sdksimtypes.NewOperationMsgBasic(types.ModuleName, TypeDepositQuery, "", true, nil), nil, nil
+	}
+}
+
+// SimulateDelegateAck mocks the acknowledgement of the delegation of a random outstanding receipt, completing the
+// receipt and crediting its assets to a random validator.
+func SimulateDelegateAck(k *keeper.Keeper) sdksimtypes.Operation {
+	return func(
+		r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []sdksimtypes.Account, _ string,
+	) (sdksimtypes.OperationMsg, []sdksimtypes.FutureOperation, error) {
+		zone, found := randomZone(ctx, r, k)
+		if !found {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeDelegateAck, "no zone found"), nil, nil
+		}
+
+		validators := k.GetValidators(ctx, zone.ChainId)
+		if len(validators) == 0 {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeDelegateAck, "no validators found"), nil, nil
+		}
+
+		receipts := make([]types.Receipt, 0)
+		k.IterateZoneReceipts(ctx, zone.ChainId, func(_ int64, receipt types.Receipt) (stop bool) {
+			if receipt.Completed == nil {
+				receipts = append(receipts, receipt)
+			}
+			return false
+		})
+		if len(receipts) == 0 {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeDelegateAck, "no outstanding receipts found"), nil, nil
+		}
+
+		receipt := receipts[r.Intn(len(receipts))]
+		valoper := validators[r.Intn(len(validators))].ValoperAddress
+		delegation, found := k.GetDelegation(ctx, zone.ChainId, zone.DelegationAddress.Address, valoper)
+		if !found {
+			delegation = types.NewDelegation(zone.DelegationAddress.Address, valoper, sdk.NewCoin(zone.BaseDenom, sdk.ZeroInt()))
+		}
+		delegation.Amount = delegation.Amount.Add(sdk.NewCoin(zone.BaseDenom, receipt.Amount.AmountOf(zone.BaseDenom)))
+		k.SetDelegation(ctx, zone.ChainId, delegation)
+
+		completed := ctx.BlockTime()
+		receipt.Completed = &completed
+		k.SetReceipt(ctx, receipt)
+
+		return sdksimtypes.NewOperationMsgBasic(types.ModuleName, TypeDelegateAck, "", true, nil), nil, nil
+	}
+}
+
+// SimulateUndelegateAck mocks the acknowledgement of the undelegation of a random queued withdrawal record, moving
+// the record to UNBOND and deducting its native assets from the zone's delegations.
+func SimulateUndelegateAck(k *keeper.Keeper) sdksimtypes.Operation {
+	return func(
+		r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []sdksimtypes.Account, _ string,
+	) (sdksimtypes.OperationMsg, []sdksimtypes.FutureOperation, error) {
+		zone, found := randomZone(ctx, r, k)
+		if !found {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeUndelegateAck, "no zone found"), nil, nil
+		}
+
+		records := make([]types.WithdrawalRecord, 0)
+		k.IterateZoneStatusWithdrawalRecords(ctx, zone.ChainId, types.WithdrawStatusQueued, func(_ int64, record types.WithdrawalRecord) (stop bool) {
+			records = append(records, record)
+			return false
+		})
+		if len(records) == 0 {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeUndelegateAck, "no queued withdrawal records found"), nil, nil
+		}
+
+		record := records[r.Intn(len(records))]
+		amount := sdk.NewDecFromInt(record.BurnAmount.Amount).Mul(zone.RedemptionRate).TruncateInt()
+		if !amount.IsPositive() || k.GetDelegatedAmount(ctx, zone).Amount.LT(amount) {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeUndelegateAck, "insufficient delegations"), nil, nil
+		}
+
+		remaining := amount
+		distribution := make([]*types.Distribution, 0)
+		for _, delegation := range k.GetAllDelegations(ctx, zone.ChainId) {
+			if remaining.IsZero() {
+				break
+			}
+			unbonded := sdkmath.MinInt(remaining, delegation.Amount.Amount)
+			if !unbonded.IsPositive() {
+				continue
+			}
+			delegation.Amount = delegation.Amount.SubAmount(unbonded)
+			k.SetDelegation(ctx, zone.ChainId, delegation)
+			distribution = append(distribution, &types.Distribution{Valoper: delegation.ValidatorAddress, Amount: unbonded.Uint64()})
+			remaining = remaining.Sub(unbonded)
+		}
+
+		record.Distribution = distribution
+		record.Amount = sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, amount))
+		record.CompletionTime = ctx.BlockTime().Add(time.Duration(zone.UnbondingPeriod))
+		k.UpdateWithdrawalRecordStatus(ctx, &record, types.WithdrawStatusUnbond)
+
+		return sdksimtypes.NewOperationMsgBasic(types.ModuleName, TypeUndelegateAck, "", true, nil), nil, nil
+	}
+}
+
+// SimulateWithdrawalAck mocks the transfer of the native assets of a random unbonding withdrawal record to its
+// recipient, and the acknowledgement thereof, completing the record and burning its escrowed qAssets.
+func SimulateWithdrawalAck(k *keeper.Keeper) sdksimtypes.Operation {
+	return func(
+		r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []sdksimtypes.Account, _ string,
+	) (sdksimtypes.OperationMsg, []sdksimtypes.FutureOperation, error) {
+		zone, found := randomZone(ctx, r, k)
+		if !found {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeWithdrawalAck, "no zone found"), nil, nil
+		}
+
+		records := make([]types.WithdrawalRecord, 0)
+		k.IterateZoneStatusWithdrawalRecords(ctx, zone.ChainId, types.WithdrawStatusUnbond, func(_ int64, record types.WithdrawalRecord) (stop bool) {
+			records = append(records, record)
+			return false
+		})
+		if len(records) == 0 {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeWithdrawalAck, "no unbonding withdrawal records found"), nil, nil
+		}
+
+		record := records[r.Intn(len(records))]
+		k.UpdateWithdrawalRecordStatus(ctx, &record, types.WithdrawStatusSend)
+
+		msg := &banktypes.MsgSend{FromAddress: zone.DelegationAddress.Address, ToAddress: record.Recipient, Amount: record.Amount}
+		if err := k.HandleWithdrawForUser(ctx, zone, msg, types.TxUnbondSendMemo(record.Txhash)); err != nil {
+			return sdksimtypes.NoOpMsg(types.ModuleName, TypeWithdrawalAck, "unable to handle withdrawal"), nil, err
+		}
+
+		return sdksimtypes.NewOperationMsgBasic(types.ModuleName, TypeWithdrawalAck, "", true, nil), nil, nil
+	}
+}
+
+// randomZone returns a random registered zone.
+func randomZone(ctx sdk.Context, r *rand.Rand, k *keeper.Keeper) (*types.Zone, bool) {
+	zones := k.AllZones(ctx)
+	if len(zones) == 0 {
+		return nil, false
+	}
+	return &zones[r.Intn(len(zones))], true
+}
+
+// randomHash returns a random hex encoded sha256 sized hash.
+func randomHash(r *rand.Rand) string {
+	bz := make([]byte, 32)
+	r.Read(bz)
+	return hex.EncodeToString(bz)
+}
+
+// deliverTx delivers the given message in a transaction signed by acc, paying random fees from the coins not spent
+// by the message.
+func deliverTx(
+	r *rand.Rand, bApp *baseapp.BaseApp, ctx sdk.Context, k *keeper.Keeper, acc sdksimtypes.Account, msg sdk.Msg, msgType string, spent sdk.Coins,
+) (sdksimtypes.OperationMsg, []sdksimtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             bApp,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msgType,
+		CoinsSpentInMsg: spent,
+		Context:         ctx,
+		SimAccount:      acc,
+		AccountKeeper:   k.AccountKeeper,
+		Bankkeeper:      k.BankKeeper,
+		ModuleName:      types.ModuleName,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// CircuitBreakerAutoTrip is recorded as the tripping party when the module
+// trips a breaker itself in response to an anomaly.
+const CircuitBreakerAutoTrip = "auto"
+
+// AllCircuitBreakerActions returns every action that may be paused by the
+// circuit breaker.
+func AllCircuitBreakerActions() []CircuitBreakerAction {
+	return []CircuitBreakerAction{
+		CircuitBreakerActionDeposits,
+		CircuitBreakerActionRedemptions,
+		CircuitBreakerActionRebalance,
+		CircuitBreakerActionRewards,
+		CircuitBreakerActionICA,
+	}
+}
+
+// ParseCircuitBreakerAction parses an action from either its full enum name
+// (e.g. CircuitBreakerActionDeposits) or its short, case-insensitive form
+// (e.g. deposits).
+func ParseCircuitBreakerAction(s string) (CircuitBreakerAction, error) {
+	if v, ok := CircuitBreakerAction_value[s]; ok && v != int32(CircuitBreakerActionUndefined) {
+		return CircuitBreakerAction(v), nil
+	}
+	for _, action := range AllCircuitBreakerActions() {
+		if strings.EqualFold(s, action.ShortName()) {
+			return action, nil
+		}
+	}
+	return CircuitBreakerActionUndefined, fmt.Errorf("unknown circuit breaker action %q", s)
+}
+
+// ParseCircuitBreakerActions parses a comma separated list of actions. The
+// value "all" yields an empty slice, which refers to every action.
+func ParseCircuitBreakerActions(s string) ([]CircuitBreakerAction, error) {
+	if strings.EqualFold(strings.TrimSpace(s), "all") {
+		return []CircuitBreakerAction{}, nil
+	}
+	actions := make([]CircuitBreakerAction, 0)
+	for _, part := range strings.Split(s, ",") {
+		action, err := ParseCircuitBreakerAction(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, action)
+	}
+	return actions, ValidateCircuitBreakerActions(actions)
+}
+
+// ShortName returns the action name without the enum prefix, in lower case.
+func (a CircuitBreakerAction) ShortName() string {
+	return strings.ToLower(strings.TrimPrefix(a.String(), "CircuitBreakerAction"))
+}
+
+// ValidateCircuitBreakerActions ensures each action is defined and appears at
+// most once. An empty slice is valid and refers to every action.
+func ValidateCircuitBreakerActions(actions []CircuitBreakerAction) error {
+	seen := make(map[CircuitBreakerAction]bool, len(actions))
+	for _, action := range actions {
+		if _, ok := CircuitBreakerAction_name[int32(action)]; !ok || action == CircuitBreakerActionUndefined {
+			return fmt.Errorf("invalid circuit breaker action %d", action)
+		}
+		if seen[action] {
+			return fmt.Errorf("duplicate circuit breaker action %s", action.ShortName())
+		}
+		seen[action] = true
+	}
+	return nil
+}
+
+// GetTrip returns the trip record for the given action, if the action is
+// currently paused.
+func (cb CircuitBreaker) GetTrip(action CircuitBreakerAction) (CircuitBreakerTrip, bool) {
+	for _, trip := range cb.Trips {
+		if trip.Action == action {
+			return trip, true
+		}
+	}
+	return CircuitBreakerTrip{}, false
+}
+
+// IsTripped returns true if the given action is currently paused.
+func (cb CircuitBreaker) IsTripped(action CircuitBreakerAction) bool {
+	_, found := cb.GetTrip(action)
+	return found
+}
+
+// Validate validates the circuit breaker record.
+func (cb CircuitBreaker) Validate() error {
+	if cb.ChainId == "" {
+		return errors.New("chain id must not be empty")
+	}
+	actions := make([]CircuitBreakerAction, 0, len(cb.Trips))
+	for _, trip := range cb.Trips {
+		actions = append(actions, trip.Action)
+	}
+	return ValidateCircuitBreakerActions(actions)
+}
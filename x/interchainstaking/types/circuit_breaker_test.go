@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+func TestParseCircuitBreakerActions(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    string
+		Expected []types.CircuitBreakerAction
+		Err      string
+	}{
+		{
+			Name:     "all",
+			Input:    "all",
+			Expected: []types.CircuitBreakerAction{},
+		},
+		{
+			Name:     "short names",
+			Input:    "deposits, Redemptions,ica",
+			Expected: []types.CircuitBreakerAction{types.CircuitBreakerActionDeposits, types.CircuitBreakerActionRedemptions, types.CircuitBreakerActionICA},
+		},
+		{
+			Name:     "full names",
+			Input:    "CircuitBreakerActionRebalance,CircuitBreakerActionRewards",
+			Expected: []types.CircuitBreakerAction{types.CircuitBreakerActionRebalance, types.CircuitBreakerActionRewards},
+		},
+		{
+			Name:  "undefined",
+			Input: "CircuitBreakerActionUndefined",
+			Err:   "unknown circuit breaker action",
+		},
+		{
+			Name:  "unknown",
+			Input: "deposits,withdrawals",
+			Err:   "unknown circuit breaker action \"withdrawals\"",
+		},
+		{
+			Name:  "duplicate",
+			Input: "deposits,deposits",
+			Err:   "duplicate circuit breaker action deposits",
+		},
+	}
+
+	for _, c := range cases {
+		actions, err := types.ParseCircuitBreakerActions(c.Input)
+		if c.Err == "" {
+			require.NoError(t, err, c.Name)
+			require.Equal(t, c.Expected, actions, c.Name)
+		} else {
+			require.ErrorContains(t, err, c.Err, c.Name)
+		}
+	}
+}
+
+func TestCircuitBreakerIsTripped(t *testing.T) {
+	cb := types.CircuitBreaker{
+		ChainId: "chain-1",
+		Trips: []types.CircuitBreakerTrip{
+			{Action: types.CircuitBreakerActionDeposits, Reason: "test", TrippedBy: types.CircuitBreakerAutoTrip},
+		},
+	}
+
+	require.NoError(t, cb.Validate())
+	require.True(t, cb.IsTripped(types.CircuitBreakerActionDeposits))
+	require.False(t, cb.IsTripped(types.CircuitBreakerActionRedemptions))
+
+	trip, found := cb.GetTrip(types.CircuitBreakerActionDeposits)
+	require.True(t, found)
+	require.Equal(t, "test", trip.Reason)
+
+	cb.Trips = append(cb.Trips, cb.Trips[0])
+	require.ErrorContains(t, cb.Validate(), "duplicate circuit breaker action")
+
+	require.ErrorContains(t, types.CircuitBreaker{}.Validate(), "chain id must not be empty")
+}
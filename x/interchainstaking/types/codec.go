@@ -20,6 +20,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSignalIntent{}, "quicksilver/MsgSignalIntent", nil)
 	cdc.RegisterConcrete(&MsgRequestRedemption{}, "quicksilver/MsgRequestRedemption", nil)
 	cdc.RegisterConcrete(&MsgCancelQueuedRedemption{}, "quicksilver/MsgCancelQueuedRedemption", nil)
+	cdc.RegisterConcrete(&MsgTripCircuitBreaker{}, "quicksilver/MsgTripCircuitBreaker", nil)
 	cdc.RegisterConcrete(&RegisterZoneProposal{}, "quicksilver/RegisterZoneProposal", nil)
 	cdc.RegisterConcrete(&UpdateZoneProposal{}, "quicksilver/UpdateZoneProposal", nil)
 	lsmstakingtypes.RegisterLegacyAminoCodec(cdc)
@@ -35,6 +36,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgGovCloseChannel{},
 		&MsgGovReopenChannel{},
 		&MsgGovSetLsmCaps{},
+		&MsgGovSetEpochCaps{},
+		&MsgTripCircuitBreaker{},
+		&MsgGovResetCircuitBreaker{},
+		&MsgGovSetEmergencyAuthority{},
+		&MsgGovSetRewardSwapRoutes{},
+		&MsgGovDeregisterZone{},
 	)
 
 	registry.RegisterImplementations(
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// Validate checks that no cap is negative. Zero caps are not enforced.
+func (caps EpochCaps) Validate() error {
+	if caps.DepositCap.IsNil() || caps.RedemptionCap.IsNil() || caps.AddressCap.IsNil() {
+		return errors.New("epoch caps must not be nil")
+	}
+
+	if caps.DepositCap.IsNegative() {
+		return errors.New("deposit cap must not be negative")
+	}
+
+	if caps.RedemptionCap.IsNegative() {
+		return errors.New("redemption cap must not be negative")
+	}
+
+	if caps.AddressCap.IsNegative() {
+		return errors.New("address cap must not be negative")
+	}
+
+	return nil
+}
+
+// IsZero returns true if none of the caps is enforced.
+func (caps EpochCaps) IsZero() bool {
+	return caps.DepositCap.IsZero() && caps.RedemptionCap.IsZero() && caps.AddressCap.IsZero()
+}
+
+// NewEpochCapUsage returns empty usage of the caps of the given zone in the given epoch, by the given address; the
+// address is empty for the usage of the zone as a whole.
+func NewEpochCapUsage(chainID, address string, epochNumber int64) EpochCapUsage {
+	return EpochCapUsage{
+		ChainId:     chainID,
+		Address:     address,
+		EpochNumber: epochNumber,
+		Deposited:   sdkmath.ZeroInt(),
+		Redeemed:    sdkmath.ZeroInt(),
+	}
+}
+
+// remainingUnder returns the amount that may be added to used without exceeding limit, and whether limit is enforced.
+func remainingUnder(limit, used sdkmath.Int) (sdkmath.Int, bool) {
+	if limit.IsZero() {
+		return sdkmath.ZeroInt(), false
+	}
+	if used.GTE(limit) {
+		return sdkmath.ZeroInt(), true
+	}
+	return limit.Sub(used), true
+}
+
+// RemainingDeposit returns the amount of qAssets that may yet be minted against the given cap with this usage, and
+// whether the cap is enforced.
+func (u EpochCapUsage) RemainingDeposit(limit sdkmath.Int) (sdkmath.Int, bool) {
+	return remainingUnder(limit, u.Deposited)
+}
+
+// RemainingRedemption returns the amount of qAssets that may yet be redeemed against the given cap with this usage,
+// and whether the cap is enforced.
+func (u EpochCapUsage) RemainingRedemption(limit sdkmath.Int) (sdkmath.Int, bool) {
+	return remainingUnder(limit, u.Redeemed)
+}
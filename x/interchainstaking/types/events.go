@@ -4,10 +4,23 @@ const (
 	EventTypeRegisterZone           = "register_zone"
 	EventTypeRedemptionRequest      = "request_redemption"
 	EventTypeRedemptionCancellation = "cancel_redemption"
+	EventTypeUnbondingCancellation  = "cancel_unbonding"
 	EventTypeSetIntent              = "set_intent"
 	EventTypeCloseICA               = "close_ica_channel"
 	EventTypeReopenICA              = "reopen_ica_channel"
 	EventTypeSetLsmCaps             = "lsm_set_caps"
+	EventTypeCircuitBreakerTrip     = "circuit_breaker_trip"
+	EventTypeCircuitBreakerReset    = "circuit_breaker_reset"
+	EventTypeSetEmergencyAuthority  = "set_emergency_authority"
+	EventTypeValidatorSlash         = "validator_slash"
+	EventTypeSlashReconciled        = "slash_reconciled"
+	EventTypeSetRewardSwapRoutes    = "set_reward_swap_routes"
+	EventTypeRewardSwap             = "reward_swap"
+	EventTypeRewardSwapComplete     = "reward_swap_complete"
+	EventTypeZoneWindDownStarted    = "zone_wind_down_started"
+	EventTypeZoneWindDownSettlement = "zone_wind_down_settlement"
+	EventTypeZoneWindDownComplete   = "zone_wind_down_complete"
+	EventTypeSetEpochCaps           = "set_epoch_caps"
 
 	AttributeKeyConnectionID     = "connection_id"
 	AttributeKeyChainID          = "chain_id"
@@ -19,10 +32,32 @@ const (
 	AttributeKeyChannelID        = "channel_id"
 	AttributeKeyPortID           = "port_name"
 	AttributeKeyUser             = "user_address"
+	AttributeKeyHash             = "hash"
+	AttributeKeyValidator        = "validator"
+	AttributeKeyRemainingAmount  = "remaining_amount"
+	AttributeKeyAction           = "action"
+	AttributeKeyReason           = "reason"
+	AttributeKeyTrippedBy        = "tripped_by"
+	AttributeKeyAuthority        = "authority"
+	AttributeKeySlashFraction    = "slash_fraction"
+	AttributeKeyExpectedAmount   = "expected_amount"
+	AttributeKeyReportedAmount   = "reported_amount"
+	AttributeKeyLossAmount       = "loss_amount"
+	AttributeKeyDenom            = "denom"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyMinOutAmount     = "min_out_amount"
+	AttributeKeyOutAmount        = "out_amount"
+	AttributeKeyRedemptionRate   = "redemption_rate"
+	AttributeKeyPriorityFee      = "priority_fee"
+	AttributeKeyStatus           = "status"
 
 	AttributeLsmValidatorCap     = "lsm_validator_cap"
 	AttributeLsmValidatorBondCap = "lsm_validator_bond_cap"
 	AttributeLsmGlobalCap        = "lsm_global_cap"
 
+	AttributeKeyDepositCap    = "deposit_cap"
+	AttributeKeyRedemptionCap = "redemption_cap"
+	AttributeKeyAddressCap    = "address_cap"
+
 	AttributeValueCategory = ModuleName
 )
@@ -1,5 +1,14 @@
 package types
 
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+)
+
 func NewGenesisState(params Params, zones []Zone) *GenesisState {
 	return &GenesisState{Params: params, Zones: zones}
 }
@@ -11,8 +20,246 @@ func DefaultGenesis() *GenesisState {
 }
 
 // Validate performs basic genesis state validation returning an error upon any
-// failure.
+// failure. Every record must belong to a zone in the genesis state, with the
+// exception of the wind-down records of zones already removed or migrated.
 func (gs GenesisState) Validate() error {
-	// TODO: validate genesis state.
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	zones := make(map[string]bool, len(gs.Zones))
+	for _, zone := range gs.Zones {
+		if zone.ChainId == "" {
+			return errors.New("zone chain id cannot be empty")
+		}
+		if zones[zone.ChainId] {
+			return fmt.Errorf("duplicate zone %s", zone.ChainId)
+		}
+		zones[zone.ChainId] = true
+	}
+	checkZone := func(record, chainID string) error {
+		if !zones[chainID] {
+			return fmt.Errorf("%s for unknown zone %q", record, chainID)
+		}
+		return nil
+	}
+
+	for _, pc := range gs.PortConnections {
+		if pc.PortId == "" || pc.ConnectionId == "" {
+			return fmt.Errorf("invalid port connection %s/%s", pc.PortId, pc.ConnectionId)
+		}
+	}
+
+	receipts := make(map[string]bool, len(gs.Receipts))
+	for _, receipt := range gs.Receipts {
+		if err := checkZone("receipt", receipt.ChainId); err != nil {
+			return err
+		}
+		if receipt.Txhash == "" {
+			return fmt.Errorf("receipt for zone %s has empty txhash", receipt.ChainId)
+		}
+		key := GetReceiptKey(receipt.ChainId, receipt.Txhash)
+		if receipts[key] {
+			return fmt.Errorf("duplicate receipt %s", key)
+		}
+		receipts[key] = true
+	}
+
+	for _, delegations := range append(append([]DelegationsForZone{}, gs.Delegations...), gs.PerformanceDelegations...) {
+		if err := checkZone("delegations", delegations.ChainId); err != nil {
+			return err
+		}
+		for _, delegation := range delegations.Delegations {
+			if delegation == nil {
+				return fmt.Errorf("nil delegation for zone %s", delegations.ChainId)
+			}
+			if err := delegation.Amount.Validate(); err != nil {
+				return fmt.Errorf("invalid delegation of %s to %s: %w", delegation.DelegationAddress, delegation.ValidatorAddress, err)
+			}
+		}
+	}
+
+	for _, intents := range gs.DelegatorIntents {
+		if err := checkZone("delegator intents", intents.ChainId); err != nil {
+			return err
+		}
+	}
+
+	withdrawals := make(map[string]bool, len(gs.WithdrawalRecords))
+	for _, record := range gs.WithdrawalRecords {
+		if err := checkZone("withdrawal record", record.ChainId); err != nil {
+			return err
+		}
+		if _, err := hex.DecodeString(record.Txhash); err != nil {
+			return fmt.Errorf("invalid withdrawal record txhash %q: %w", record.Txhash, err)
+		}
+		if record.Status < WithdrawStatusTokenize || record.Status > WithdrawStatusCancel {
+			return fmt.Errorf("invalid status %d for withdrawal record %s", record.Status, record.Txhash)
+		}
+		key := fmt.Sprintf("%s/%d/%s", record.ChainId, record.Status, strings.ToLower(record.Txhash))
+		if withdrawals[key] {
+			return fmt.Errorf("duplicate withdrawal record %s", key)
+		}
+		withdrawals[key] = true
+	}
+
+	unbondings := make(map[string]bool, len(gs.UnbondingRecords))
+	for _, record := range gs.UnbondingRecords {
+		if err := checkZone("unbonding record", record.ChainId); err != nil {
+			return err
+		}
+		key := string(GetUnbondingKey(record.ChainId, record.Validator, record.EpochNumber))
+		if unbondings[key] {
+			return fmt.Errorf("duplicate unbonding record for %s/%s at epoch %d", record.ChainId, record.Validator, record.EpochNumber)
+		}
+		unbondings[key] = true
+	}
+
+	redelegations := make(map[string]bool, len(gs.RedelegationRecords))
+	for _, record := range gs.RedelegationRecords {
+		if err := checkZone("redelegation record", record.ChainId); err != nil {
+			return err
+		}
+		key := string(GetRedelegationKey(record.ChainId, record.Source, record.Destination, record.EpochNumber))
+		if redelegations[key] {
+			return fmt.Errorf("duplicate redelegation record for %s/%s/%s at epoch %d", record.ChainId, record.Source, record.Destination, record.EpochNumber)
+		}
+		redelegations[key] = true
+	}
+
+	for _, validators := range gs.Validators {
+		if err := checkZone("validators", validators.ChainId); err != nil {
+			return err
+		}
+		seen := make(map[string]bool, len(validators.Validators))
+		for _, validator := range validators.Validators {
+			if _, err := validator.GetAddressBytes(); err != nil {
+				return fmt.Errorf("invalid validator address %q for zone %s: %w", validator.ValoperAddress, validators.ChainId, err)
+			}
+			if seen[validator.ValoperAddress] {
+				return fmt.Errorf("duplicate validator %s for zone %s", validator.ValoperAddress, validators.ChainId)
+			}
+			seen[validator.ValoperAddress] = true
+		}
+	}
+
+	for _, consAddr := range gs.ValidatorConsAddrs {
+		if err := checkZone("validator consensus address", consAddr.ChainId); err != nil {
+			return err
+		}
+		if len(consAddr.ConsAddress) == 0 || consAddr.ValoperAddress == "" {
+			return fmt.Errorf("invalid validator consensus address mapping for zone %s", consAddr.ChainId)
+		}
+	}
+
+	for _, account := range gs.MappedAccounts {
+		if err := checkZone("mapped account", account.ChainId); err != nil {
+			return err
+		}
+		if len(account.LocalAddress) == 0 || len(account.RemoteAddress) == 0 {
+			return fmt.Errorf("invalid mapped account for zone %s", account.ChainId)
+		}
+	}
+
+	for _, mapping := range gs.AddressZoneMappings {
+		if err := checkZone("address mapping", mapping.ChainId); err != nil {
+			return err
+		}
+		if mapping.Address == "" {
+			return fmt.Errorf("empty address mapped to zone %s", mapping.ChainId)
+		}
+	}
+
+	for _, caps := range gs.LsmCaps {
+		if err := checkZone("lsm caps", caps.ChainId); err != nil {
+			return err
+		}
+		if err := caps.Caps.Validate(); err != nil {
+			return fmt.Errorf("invalid lsm caps for zone %s: %w", caps.ChainId, err)
+		}
+	}
+
+	for _, caps := range gs.EpochCaps {
+		if err := checkZone("epoch caps", caps.ChainId); err != nil {
+			return err
+		}
+		if err := caps.Caps.Validate(); err != nil {
+			return fmt.Errorf("invalid epoch caps for zone %s: %w", caps.ChainId, err)
+		}
+	}
+
+	for _, usage := range gs.EpochCapUsages {
+		if err := checkZone("epoch cap usage", usage.ChainId); err != nil {
+			return err
+		}
+		if usage.Deposited.IsNegative() || usage.Redeemed.IsNegative() {
+			return fmt.Errorf("negative epoch cap usage for zone %s", usage.ChainId)
+		}
+	}
+
+	for _, cb := range gs.CircuitBreakers {
+		if err := cb.Validate(); err != nil {
+			return fmt.Errorf("invalid circuit breaker: %w", err)
+		}
+		if err := checkZone("circuit breaker", cb.ChainId); err != nil {
+			return err
+		}
+	}
+
+	if gs.EmergencyAuthority != "" {
+		if _, err := addressutils.AccAddressFromBech32(gs.EmergencyAuthority, ""); err != nil {
+			return fmt.Errorf("invalid emergency authority: %w", err)
+		}
+	}
+
+	for _, record := range gs.SlashRecords {
+		if err := checkZone("slash record", record.ChainId); err != nil {
+			return err
+		}
+	}
+
+	for _, config := range gs.RewardSwapConfigs {
+		if err := config.Validate(); err != nil {
+			return fmt.Errorf("invalid reward swap config: %w", err)
+		}
+		if err := checkZone("reward swap config", config.ChainId); err != nil {
+			return err
+		}
+	}
+
+	for _, pool := range gs.SwapPools {
+		if err := checkZone("swap pool", pool.ChainId); err != nil {
+			return err
+		}
+	}
+
+	windDowns := make(map[string]bool, len(gs.ZoneWindDowns))
+	for _, windDown := range gs.ZoneWindDowns {
+		if windDown.ChainId == "" {
+			return errors.New("wind-down chain id cannot be empty")
+		}
+		if windDowns[windDown.ChainId] {
+			return fmt.Errorf("duplicate wind-down for zone %s", windDown.ChainId)
+		}
+		windDowns[windDown.ChainId] = true
+		if windDown.Status == WindDownStatusDraining {
+			if err := checkZone("draining wind-down", windDown.ChainId); err != nil {
+				return err
+			}
+		}
+	}
+
+	snapshots := make(map[string]bool, len(gs.ZoneSnapshots))
+	for _, snapshot := range gs.ZoneSnapshots {
+		if err := checkZone("zone snapshot", snapshot.ChainId); err != nil {
+			return err
+		}
+		key := string(GetZoneSnapshotKey(snapshot.ChainId, snapshot.EpochNumber))
+		if snapshots[key] {
+			return fmt.Errorf("duplicate snapshot for zone %s at epoch %d", snapshot.ChainId, snapshot.EpochNumber)
+		}
+		snapshots[key] = true
+	}
+
+	return nil
 }
@@ -82,6 +82,9 @@ type Params struct {
 	ValidatorsetInterval uint64                                 `protobuf:"varint,2,opt,name=validatorset_interval,json=validatorsetInterval,proto3" json:"validatorset_interval,omitempty"`
 	CommissionRate       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
 	UnbondingEnabled     bool                                   `protobuf:"varint,4,opt,name=unbonding_enabled,json=unbondingEnabled,proto3" json:"unbonding_enabled,omitempty"`
+	// zone_history_retention_epochs is the number of epochs for which zone
+	// snapshots are retained.
+	ZoneHistoryRetentionEpochs uint64 `protobuf:"varint,5,opt,name=zone_history_retention_epochs,json=zoneHistoryRetentionEpochs,proto3" json:"zone_history_retention_epochs,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -137,6 +140,13 @@ func (m *Params) GetUnbondingEnabled() bool {
 	return false
 }
 
+func (m *Params) GetZoneHistoryRetentionEpochs() uint64 {
+	if m != nil {
+		return m.ZoneHistoryRetentionEpochs
+	}
+	return 0
+}
+
 type DelegationsForZone struct {
 	ChainId     string        `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	Delegations []*Delegation `protobuf:"bytes,2,rep,name=delegations,proto3" json:"delegations,omitempty"`
@@ -249,6 +259,339 @@ func (m *DelegatorIntentsForZone) GetSnapshot() bool {
 	return false
 }
 
+type ValidatorsForZone struct {
+	ChainId    string      `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Validators []Validator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *ValidatorsForZone) Reset()         { *m = ValidatorsForZone{} }
+func (m *ValidatorsForZone) String() string { return proto.CompactTextString(m) }
+func (*ValidatorsForZone) ProtoMessage()    {}
+func (*ValidatorsForZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{4}
+}
+func (m *ValidatorsForZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorsForZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorsForZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorsForZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorsForZone.Merge(m, src)
+}
+func (m *ValidatorsForZone) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorsForZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorsForZone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorsForZone proto.InternalMessageInfo
+
+func (m *ValidatorsForZone) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ValidatorsForZone) GetValidators() []Validator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// ValidatorConsAddr maps a host chain consensus address to the validator's
+// operator address.
+type ValidatorConsAddr struct {
+	ChainId        string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConsAddress    []byte `protobuf:"bytes,2,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	ValoperAddress string `protobuf:"bytes,3,opt,name=valoper_address,json=valoperAddress,proto3" json:"valoper_address,omitempty"`
+}
+
+func (m *ValidatorConsAddr) Reset()         { *m = ValidatorConsAddr{} }
+func (m *ValidatorConsAddr) String() string { return proto.CompactTextString(m) }
+func (*ValidatorConsAddr) ProtoMessage()    {}
+func (*ValidatorConsAddr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{5}
+}
+func (m *ValidatorConsAddr) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorConsAddr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorConsAddr.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorConsAddr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorConsAddr.Merge(m, src)
+}
+func (m *ValidatorConsAddr) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorConsAddr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorConsAddr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorConsAddr proto.InternalMessageInfo
+
+func (m *ValidatorConsAddr) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ValidatorConsAddr) GetConsAddress() []byte {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return nil
+}
+
+func (m *ValidatorConsAddr) GetValoperAddress() string {
+	if m != nil {
+		return m.ValoperAddress
+	}
+	return ""
+}
+
+// MappedAccount maps a local account to the remote account of a zone it
+// receives its assets from.
+type MappedAccount struct {
+	ChainId       string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	LocalAddress  []byte `protobuf:"bytes,2,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	RemoteAddress []byte `protobuf:"bytes,3,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+}
+
+func (m *MappedAccount) Reset()         { *m = MappedAccount{} }
+func (m *MappedAccount) String() string { return proto.CompactTextString(m) }
+func (*MappedAccount) ProtoMessage()    {}
+func (*MappedAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{6}
+}
+func (m *MappedAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MappedAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MappedAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MappedAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MappedAccount.Merge(m, src)
+}
+func (m *MappedAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MappedAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MappedAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MappedAccount proto.InternalMessageInfo
+
+func (m *MappedAccount) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *MappedAccount) GetLocalAddress() []byte {
+	if m != nil {
+		return m.LocalAddress
+	}
+	return nil
+}
+
+func (m *MappedAccount) GetRemoteAddress() []byte {
+	if m != nil {
+		return m.RemoteAddress
+	}
+	return nil
+}
+
+// AddressZoneMapping maps a zone's interchain account address to the zone.
+type AddressZoneMapping struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *AddressZoneMapping) Reset()         { *m = AddressZoneMapping{} }
+func (m *AddressZoneMapping) String() string { return proto.CompactTextString(m) }
+func (*AddressZoneMapping) ProtoMessage()    {}
+func (*AddressZoneMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{7}
+}
+func (m *AddressZoneMapping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressZoneMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressZoneMapping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressZoneMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressZoneMapping.Merge(m, src)
+}
+func (m *AddressZoneMapping) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressZoneMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressZoneMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressZoneMapping proto.InternalMessageInfo
+
+func (m *AddressZoneMapping) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressZoneMapping) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type LsmCapsForZone struct {
+	ChainId string  `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Caps    LsmCaps `protobuf:"bytes,2,opt,name=caps,proto3" json:"caps"`
+}
+
+func (m *LsmCapsForZone) Reset()         { *m = LsmCapsForZone{} }
+func (m *LsmCapsForZone) String() string { return proto.CompactTextString(m) }
+func (*LsmCapsForZone) ProtoMessage()    {}
+func (*LsmCapsForZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{8}
+}
+func (m *LsmCapsForZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LsmCapsForZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LsmCapsForZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LsmCapsForZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LsmCapsForZone.Merge(m, src)
+}
+func (m *LsmCapsForZone) XXX_Size() int {
+	return m.Size()
+}
+func (m *LsmCapsForZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_LsmCapsForZone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LsmCapsForZone proto.InternalMessageInfo
+
+func (m *LsmCapsForZone) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *LsmCapsForZone) GetCaps() LsmCaps {
+	if m != nil {
+		return m.Caps
+	}
+	return LsmCaps{}
+}
+
+type EpochCapsForZone struct {
+	ChainId string    `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Caps    EpochCaps `protobuf:"bytes,2,opt,name=caps,proto3" json:"caps"`
+}
+
+func (m *EpochCapsForZone) Reset()         { *m = EpochCapsForZone{} }
+func (m *EpochCapsForZone) String() string { return proto.CompactTextString(m) }
+func (*EpochCapsForZone) ProtoMessage()    {}
+func (*EpochCapsForZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{9}
+}
+func (m *EpochCapsForZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochCapsForZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochCapsForZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochCapsForZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochCapsForZone.Merge(m, src)
+}
+func (m *EpochCapsForZone) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochCapsForZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochCapsForZone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochCapsForZone proto.InternalMessageInfo
+
+func (m *EpochCapsForZone) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *EpochCapsForZone) GetCaps() EpochCaps {
+	if m != nil {
+		return m.Caps
+	}
+	return EpochCaps{}
+}
+
 // GenesisState defines the interchainstaking module's genesis state.
 type GenesisState struct {
 	Params                 Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
@@ -259,13 +602,32 @@ type GenesisState struct {
 	DelegatorIntents       []DelegatorIntentsForZone `protobuf:"bytes,6,rep,name=delegator_intents,json=delegatorIntents,proto3" json:"delegator_intents"`
 	PortConnections        []PortConnectionTuple     `protobuf:"bytes,7,rep,name=port_connections,json=portConnections,proto3" json:"port_connections"`
 	WithdrawalRecords      []WithdrawalRecord        `protobuf:"bytes,8,rep,name=withdrawal_records,json=withdrawalRecords,proto3" json:"withdrawal_records"`
+	UnbondingRecords       []UnbondingRecord         `protobuf:"bytes,9,rep,name=unbonding_records,json=unbondingRecords,proto3" json:"unbonding_records"`
+	RedelegationRecords    []RedelegationRecord      `protobuf:"bytes,10,rep,name=redelegation_records,json=redelegationRecords,proto3" json:"redelegation_records"`
+	Validators             []ValidatorsForZone       `protobuf:"bytes,11,rep,name=validators,proto3" json:"validators"`
+	ValidatorConsAddrs     []ValidatorConsAddr       `protobuf:"bytes,12,rep,name=validator_cons_addrs,json=validatorConsAddrs,proto3" json:"validator_cons_addrs"`
+	MappedAccounts         []MappedAccount           `protobuf:"bytes,13,rep,name=mapped_accounts,json=mappedAccounts,proto3" json:"mapped_accounts"`
+	AddressZoneMappings    []AddressZoneMapping      `protobuf:"bytes,14,rep,name=address_zone_mappings,json=addressZoneMappings,proto3" json:"address_zone_mappings"`
+	// withdrawal_record_sequence is the next sequence used to key requeued
+	// withdrawal records.
+	WithdrawalRecordSequence uint64             `protobuf:"varint,15,opt,name=withdrawal_record_sequence,json=withdrawalRecordSequence,proto3" json:"withdrawal_record_sequence,omitempty"`
+	LsmCaps                  []LsmCapsForZone   `protobuf:"bytes,16,rep,name=lsm_caps,json=lsmCaps,proto3" json:"lsm_caps"`
+	CircuitBreakers          []CircuitBreaker   `protobuf:"bytes,17,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
+	EmergencyAuthority       string             `protobuf:"bytes,18,opt,name=emergency_authority,json=emergencyAuthority,proto3" json:"emergency_authority,omitempty"`
+	SlashRecords             []SlashRecord      `protobuf:"bytes,19,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records"`
+	RewardSwapConfigs        []RewardSwapConfig `protobuf:"bytes,20,rep,name=reward_swap_configs,json=rewardSwapConfigs,proto3" json:"reward_swap_configs"`
+	SwapPools                []SwapPool         `protobuf:"bytes,21,rep,name=swap_pools,json=swapPools,proto3" json:"swap_pools"`
+	ZoneWindDowns            []ZoneWindDown     `protobuf:"bytes,22,rep,name=zone_wind_downs,json=zoneWindDowns,proto3" json:"zone_wind_downs"`
+	ZoneSnapshots            []ZoneSnapshot     `protobuf:"bytes,23,rep,name=zone_snapshots,json=zoneSnapshots,proto3" json:"zone_snapshots"`
+	EpochCaps                []EpochCapsForZone `protobuf:"bytes,24,rep,name=epoch_caps,json=epochCaps,proto3" json:"epoch_caps"`
+	EpochCapUsages           []EpochCapUsage    `protobuf:"bytes,25,rep,name=epoch_cap_usages,json=epochCapUsages,proto3" json:"epoch_cap_usages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{4}
+	return fileDescriptor_196cdf77e041fc72, []int{10}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,90 +712,255 @@ func (m *GenesisState) GetWithdrawalRecords() []WithdrawalRecord {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ParamsV1)(nil), "quicksilver.interchainstaking.v1.Params_v1")
-	proto.RegisterType((*Params)(nil), "quicksilver.interchainstaking.v1.Params")
-	proto.RegisterType((*DelegationsForZone)(nil), "quicksilver.interchainstaking.v1.DelegationsForZone")
-	proto.RegisterType((*DelegatorIntentsForZone)(nil), "quicksilver.interchainstaking.v1.DelegatorIntentsForZone")
-	proto.RegisterType((*GenesisState)(nil), "quicksilver.interchainstaking.v1.GenesisState")
+func (m *GenesisState) GetUnbondingRecords() []UnbondingRecord {
+	if m != nil {
+		return m.UnbondingRecords
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("quicksilver/interchainstaking/v1/genesis.proto", fileDescriptor_196cdf77e041fc72)
+func (m *GenesisState) GetRedelegationRecords() []RedelegationRecord {
+	if m != nil {
+		return m.RedelegationRecords
+	}
+	return nil
 }
 
-var fileDescriptor_196cdf77e041fc72 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0x41, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x42, 0x29, 0xcb, 0x60, 0xa4, 0x4c, 0x50, 0x16, 0x0e, 0x6d, 0xd3, 0x03, 0x29, 0x51,
-	0xb6, 0x29, 0x68, 0xa2, 0xc6, 0x8b, 0x15, 0x31, 0xc4, 0xc4, 0x98, 0xd5, 0xc4, 0x04, 0x8d, 0x9b,
-	0xe9, 0xee, 0xb0, 0x9d, 0xb0, 0x9d, 0x59, 0x67, 0xa6, 0x45, 0xbd, 0x78, 0xf3, 0xec, 0xd1, 0x23,
-	0x3f, 0xc1, 0x83, 0x3f, 0x82, 0x23, 0xf1, 0x64, 0x8c, 0x21, 0x06, 0x2e, 0xfe, 0x05, 0x6f, 0xa6,
-	0xb3, 0xd3, 0xed, 0x00, 0x26, 0x85, 0x78, 0xf3, 0xd4, 0xce, 0x7b, 0xef, 0xfb, 0xbe, 0x37, 0xef,
-	0x7d, 0xbb, 0x0b, 0xdc, 0xd7, 0x5d, 0x12, 0xec, 0x08, 0x12, 0xf7, 0x30, 0xaf, 0x13, 0x2a, 0x31,
-	0x0f, 0xda, 0x88, 0x50, 0x21, 0xd1, 0x0e, 0xa1, 0x51, 0xbd, 0xd7, 0xa8, 0x47, 0x98, 0x62, 0x41,
-	0x84, 0x9b, 0x70, 0x26, 0x19, 0xac, 0x18, 0xf5, 0xee, 0x99, 0x7a, 0xb7, 0xd7, 0x58, 0x5c, 0x08,
-	0x98, 0xe8, 0x30, 0xe1, 0xab, 0xfa, 0x7a, 0x7a, 0x48, 0xc1, 0x8b, 0x73, 0x11, 0x8b, 0x58, 0x1a,
-	0xef, 0xff, 0xd3, 0xd1, 0x5b, 0x23, 0x5b, 0x38, 0xab, 0xa3, 0x90, 0xd5, 0x1f, 0x16, 0x98, 0x7a,
-	0x82, 0x38, 0xea, 0x08, 0xbf, 0xd7, 0x80, 0xcb, 0xa0, 0x18, 0xe2, 0x84, 0x09, 0x22, 0x7d, 0x05,
-	0xe8, 0xa1, 0xd8, 0xb1, 0x2a, 0x56, 0x2d, 0xef, 0xcd, 0xe8, 0xf8, 0xa6, 0x0e, 0xc3, 0x35, 0x70,
-	0xa5, 0x87, 0x62, 0x12, 0x22, 0xc9, 0xb8, 0xc0, 0x46, 0xfd, 0x98, 0xaa, 0x9f, 0x33, 0x93, 0x19,
-	0x08, 0x83, 0x99, 0x80, 0x75, 0x3a, 0x44, 0x08, 0xc2, 0xa8, 0xcf, 0x91, 0xc4, 0xce, 0x78, 0xc5,
-	0xaa, 0x4d, 0x35, 0xef, 0xee, 0x1f, 0x96, 0x73, 0xdf, 0x0f, 0xcb, 0x4b, 0x11, 0x91, 0xed, 0x6e,
-	0xcb, 0x0d, 0x58, 0x47, 0xdf, 0x5b, 0xff, 0xac, 0x88, 0x70, 0xa7, 0x2e, 0xdf, 0x26, 0x58, 0xb8,
-	0xeb, 0x38, 0xf8, 0xfa, 0x65, 0x05, 0xe8, 0xb1, 0xac, 0xe3, 0xc0, 0xbb, 0x3c, 0x24, 0xf5, 0x90,
-	0xc4, 0x77, 0xec, 0x4f, 0x7b, 0xe5, 0xdc, 0xaf, 0xbd, 0xb2, 0x55, 0xfd, 0x30, 0x06, 0x0a, 0xe9,
-	0xf5, 0xfe, 0x93, 0xbb, 0xc1, 0x6b, 0x60, 0xb6, 0x4b, 0x5b, 0x8c, 0x86, 0x84, 0x46, 0x3e, 0xa6,
-	0xa8, 0x15, 0xe3, 0xd0, 0xc9, 0x57, 0xac, 0x9a, 0xed, 0x15, 0xb3, 0xc4, 0x83, 0x34, 0x6e, 0x0c,
-	0xe2, 0x3d, 0x80, 0xeb, 0x38, 0xc6, 0x11, 0x92, 0x84, 0x51, 0xb1, 0xc1, 0xf8, 0x16, 0xa3, 0x18,
-	0x2e, 0x00, 0x5b, 0x79, 0xc2, 0x27, 0xa1, 0x9a, 0xc5, 0x94, 0x37, 0xa9, 0xce, 0x9b, 0x21, 0x7c,
-	0x0c, 0xa6, 0xc3, 0x21, 0xc0, 0x19, 0xab, 0x8c, 0xd7, 0xa6, 0x57, 0xaf, 0xbb, 0xa3, 0xbc, 0xeb,
-	0x0e, 0x55, 0x3c, 0x93, 0xa0, 0xfa, 0xd9, 0x02, 0xf3, 0x3a, 0xc7, 0x78, 0x7f, 0x68, 0x54, 0x9e,
-	0xa7, 0x8d, 0x57, 0x60, 0x76, 0xc8, 0xa2, 0x16, 0x41, 0xa5, 0x6e, 0xa6, 0x71, 0xee, 0x66, 0x06,
-	0x82, 0x5e, 0x71, 0xc8, 0x95, 0x46, 0xe0, 0x22, 0xb0, 0x05, 0x45, 0x89, 0x68, 0x33, 0xa9, 0xd6,
-	0x65, 0x7b, 0xd9, 0xb9, 0xfa, 0x7b, 0x02, 0x5c, 0x7a, 0x98, 0x3e, 0xba, 0x4f, 0x65, 0x7f, 0xf6,
-	0x1b, 0xa0, 0x90, 0x28, 0x33, 0xa9, 0x2e, 0xa7, 0x57, 0x6b, 0xa3, 0x3b, 0x48, 0xcd, 0xd7, 0xcc,
-	0xf7, 0x3d, 0xe0, 0x69, 0x34, 0x6c, 0x82, 0x89, 0x77, 0x8c, 0xe2, 0xc1, 0x54, 0x97, 0x46, 0xd3,
-	0xf4, 0xc7, 0xa4, 0x49, 0x52, 0x28, 0x7c, 0x04, 0x6c, 0x8e, 0x03, 0x4c, 0x12, 0x29, 0x9c, 0x71,
-	0x45, 0xb3, 0x3c, 0x9a, 0xc6, 0x4b, 0x11, 0x9a, 0x29, 0x23, 0x80, 0x2f, 0x4f, 0x2e, 0x3b, 0xaf,
-	0xf8, 0x6e, 0x5c, 0x64, 0xd9, 0x83, 0x5d, 0x6a, 0x6a, 0x93, 0x0e, 0x0a, 0x30, 0x9f, 0x60, 0xbe,
-	0xcd, 0x78, 0x07, 0xd1, 0x00, 0xfb, 0xa6, 0xd2, 0xc4, 0x3f, 0x2b, 0x5d, 0x35, 0xa8, 0x8d, 0x22,
-	0x18, 0x67, 0xc6, 0x61, 0x5c, 0xfb, 0x46, 0x38, 0x05, 0x25, 0x77, 0xfb, 0xc2, 0xc6, 0x39, 0xa5,
-	0x59, 0x0c, 0x4f, 0xa5, 0xe1, 0x36, 0x28, 0x26, 0x8c, 0x4b, 0x3f, 0x60, 0x94, 0xe2, 0x20, 0xbd,
-	0xdb, 0xa4, 0x12, 0xbb, 0x79, 0x0e, 0x8f, 0x30, 0x2e, 0xef, 0x67, 0xc0, 0x67, 0xdd, 0x24, 0x1e,
-	0x08, 0xcd, 0x24, 0x27, 0x52, 0x02, 0x46, 0x00, 0xee, 0x12, 0xd9, 0x0e, 0x39, 0xda, 0x45, 0xb1,
-	0xcf, 0x71, 0xc0, 0x78, 0x28, 0x1c, 0x5b, 0x29, 0xad, 0x8e, 0x56, 0x7a, 0x9e, 0x61, 0x3d, 0x05,
-	0xd5, 0x32, 0xb3, 0xbb, 0xa7, 0xe2, 0xa2, 0xf9, 0x62, 0xff, 0xa8, 0x64, 0x1d, 0x1c, 0x95, 0xac,
-	0x9f, 0x47, 0x25, 0xeb, 0xe3, 0x71, 0x29, 0x77, 0x70, 0x5c, 0xca, 0x7d, 0x3b, 0x2e, 0xe5, 0xb6,
-	0xee, 0x19, 0xaf, 0x31, 0x43, 0x70, 0xa5, 0x6f, 0x4b, 0x33, 0x50, 0x7f, 0xf3, 0x97, 0x2f, 0x91,
-	0x7a, 0xcb, 0xb5, 0x0a, 0xea, 0xdb, 0xb3, 0xf6, 0x27, 0x00, 0x00, 0xff, 0xff, 0x11, 0x78, 0xa7,
-	0x14, 0x3a, 0x07, 0x00, 0x00,
+func (m *GenesisState) GetValidators() []ValidatorsForZone {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
 }
 
-func (this *ParamsV1) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *GenesisState) GetValidatorConsAddrs() []ValidatorConsAddr {
+	if m != nil {
+		return m.ValidatorConsAddrs
 	}
+	return nil
+}
 
-	that1, ok := that.(*ParamsV1)
-	if !ok {
-		that2, ok := that.(ParamsV1)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *GenesisState) GetMappedAccounts() []MappedAccount {
+	if m != nil {
+		return m.MappedAccounts
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *GenesisState) GetAddressZoneMappings() []AddressZoneMapping {
+	if m != nil {
+		return m.AddressZoneMappings
 	}
-	if this.DepositInterval != that1.DepositInterval {
-		return false
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawalRecordSequence() uint64 {
+	if m != nil {
+		return m.WithdrawalRecordSequence
 	}
-	if this.ValidatorsetInterval != that1.ValidatorsetInterval {
-		return false
+	return 0
+}
+
+func (m *GenesisState) GetLsmCaps() []LsmCapsForZone {
+	if m != nil {
+		return m.LsmCaps
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakers() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEmergencyAuthority() string {
+	if m != nil {
+		return m.EmergencyAuthority
+	}
+	return ""
+}
+
+func (m *GenesisState) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardSwapConfigs() []RewardSwapConfig {
+	if m != nil {
+		return m.RewardSwapConfigs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSwapPools() []SwapPool {
+	if m != nil {
+		return m.SwapPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetZoneWindDowns() []ZoneWindDown {
+	if m != nil {
+		return m.ZoneWindDowns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetZoneSnapshots() []ZoneSnapshot {
+	if m != nil {
+		return m.ZoneSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochCaps() []EpochCapsForZone {
+	if m != nil {
+		return m.EpochCaps
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochCapUsages() []EpochCapUsage {
+	if m != nil {
+		return m.EpochCapUsages
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ParamsV1)(nil), "quicksilver.interchainstaking.v1.Params_v1")
+	proto.RegisterType((*Params)(nil), "quicksilver.interchainstaking.v1.Params")
+	proto.RegisterType((*DelegationsForZone)(nil), "quicksilver.interchainstaking.v1.DelegationsForZone")
+	proto.RegisterType((*DelegatorIntentsForZone)(nil), "quicksilver.interchainstaking.v1.DelegatorIntentsForZone")
+	proto.RegisterType((*ValidatorsForZone)(nil), "quicksilver.interchainstaking.v1.ValidatorsForZone")
+	proto.RegisterType((*ValidatorConsAddr)(nil), "quicksilver.interchainstaking.v1.ValidatorConsAddr")
+	proto.RegisterType((*MappedAccount)(nil), "quicksilver.interchainstaking.v1.MappedAccount")
+	proto.RegisterType((*AddressZoneMapping)(nil), "quicksilver.interchainstaking.v1.AddressZoneMapping")
+	proto.RegisterType((*LsmCapsForZone)(nil), "quicksilver.interchainstaking.v1.LsmCapsForZone")
+	proto.RegisterType((*EpochCapsForZone)(nil), "quicksilver.interchainstaking.v1.EpochCapsForZone")
+	proto.RegisterType((*GenesisState)(nil), "quicksilver.interchainstaking.v1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("quicksilver/interchainstaking/v1/genesis.proto", fileDescriptor_196cdf77e041fc72)
+}
+
+var fileDescriptor_196cdf77e041fc72 = []byte{
+	// 1329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0xb6, 0x1c, 0xc7, 0x96, 0xc7, 0xb6, 0x24, 0xaf, 0x9d, 0x84, 0x31, 0xf0, 0xda, 0x7e, 0xf5,
+	0xe2, 0x4d, 0x9d, 0xa6, 0x96, 0x6a, 0xa7, 0x05, 0xda, 0x22, 0x17, 0x7f, 0x24, 0x6d, 0xd0, 0xaf,
+	0x84, 0x6e, 0x9a, 0x36, 0x29, 0x42, 0xac, 0xc9, 0x8d, 0x44, 0x98, 0xdc, 0x65, 0x76, 0x56, 0x52,
+	0x9d, 0x4b, 0xd1, 0x7f, 0xd0, 0x4b, 0x81, 0x1e, 0xf3, 0x13, 0x7a, 0xe8, 0x8f, 0x48, 0x6f, 0x41,
+	0x4f, 0x45, 0x51, 0x04, 0x45, 0x72, 0xe9, 0xcf, 0x28, 0xb8, 0x5c, 0x52, 0xa4, 0x5c, 0x44, 0x34,
+	0x7a, 0xeb, 0x49, 0xe2, 0xcc, 0x3c, 0xcf, 0x33, 0xbb, 0x3b, 0x9c, 0xe1, 0x42, 0xeb, 0x51, 0xcf,
+	0x77, 0x8f, 0xd0, 0x0f, 0xfa, 0x4c, 0xb6, 0x7d, 0xae, 0x98, 0x74, 0xbb, 0xd4, 0xe7, 0xa8, 0xe8,
+	0x91, 0xcf, 0x3b, 0xed, 0xfe, 0x56, 0xbb, 0xc3, 0x38, 0x43, 0x1f, 0x5b, 0x91, 0x14, 0x4a, 0x90,
+	0xf5, 0x5c, 0x7c, 0xeb, 0x44, 0x7c, 0xab, 0xbf, 0xb5, 0x72, 0xd1, 0x15, 0x18, 0x0a, 0x74, 0x74,
+	0x7c, 0x3b, 0x79, 0x48, 0xc0, 0x2b, 0xcb, 0x1d, 0xd1, 0x11, 0x89, 0x3d, 0xfe, 0x67, 0xac, 0xef,
+	0x8c, 0x4d, 0xe1, 0xa4, 0x8e, 0x46, 0x36, 0x7f, 0xaf, 0xc0, 0xec, 0x2d, 0x2a, 0x69, 0x88, 0x4e,
+	0x7f, 0x8b, 0x5c, 0x86, 0x86, 0xc7, 0x22, 0x81, 0xbe, 0x72, 0x34, 0xa0, 0x4f, 0x03, 0xab, 0xb2,
+	0x5e, 0xd9, 0x98, 0xb2, 0xeb, 0xc6, 0x7e, 0xd3, 0x98, 0xc9, 0x55, 0x38, 0xd7, 0xa7, 0x81, 0xef,
+	0x51, 0x25, 0x24, 0xb2, 0x5c, 0xfc, 0xa4, 0x8e, 0x5f, 0xce, 0x3b, 0x33, 0x10, 0x83, 0xba, 0x2b,
+	0xc2, 0xd0, 0x47, 0xf4, 0x05, 0x77, 0x24, 0x55, 0xcc, 0x3a, 0xb3, 0x5e, 0xd9, 0x98, 0xdd, 0xbd,
+	0xf6, 0xf4, 0xf9, 0xda, 0xc4, 0x6f, 0xcf, 0xd7, 0x2e, 0x75, 0x7c, 0xd5, 0xed, 0x1d, 0xb6, 0x5c,
+	0x11, 0x9a, 0x75, 0x9b, 0x9f, 0x4d, 0xf4, 0x8e, 0xda, 0xea, 0x38, 0x62, 0xd8, 0xda, 0x67, 0xee,
+	0x2f, 0x3f, 0x6d, 0x82, 0xd9, 0x96, 0x7d, 0xe6, 0xda, 0xb5, 0x21, 0xa9, 0x4d, 0x15, 0x7b, 0xaf,
+	0xfa, 0xc3, 0x93, 0xb5, 0x89, 0x3f, 0x9f, 0xac, 0x55, 0x9a, 0x3f, 0x4f, 0xc2, 0x74, 0xb2, 0xbc,
+	0x7f, 0xc9, 0xda, 0xc8, 0x15, 0x58, 0xec, 0xf1, 0x43, 0xc1, 0x3d, 0x9f, 0x77, 0x1c, 0xc6, 0xe9,
+	0x61, 0xc0, 0x3c, 0x6b, 0x6a, 0xbd, 0xb2, 0x51, 0xb5, 0x1b, 0x99, 0xe3, 0x7a, 0x62, 0x27, 0x3b,
+	0xf0, 0x9f, 0xc7, 0x82, 0x33, 0xa7, 0xeb, 0xa3, 0x12, 0xf2, 0xd8, 0x91, 0x4c, 0x31, 0xae, 0xe2,
+	0xfc, 0x58, 0x24, 0xdc, 0x2e, 0x5a, 0x67, 0xf5, 0x82, 0x56, 0xe2, 0xa0, 0x0f, 0x92, 0x18, 0x3b,
+	0x0d, 0xb9, 0xae, 0x23, 0x72, 0x7b, 0xf9, 0x0d, 0x90, 0x7d, 0x16, 0xb0, 0x0e, 0x8d, 0xbd, 0x78,
+	0x43, 0xc8, 0x7b, 0x82, 0x33, 0x72, 0x11, 0xaa, 0xba, 0xac, 0x1c, 0xdf, 0xd3, 0xdb, 0x39, 0x6b,
+	0xcf, 0xe8, 0xe7, 0x9b, 0x1e, 0xf9, 0x04, 0xe6, 0xbc, 0x21, 0xc0, 0x9a, 0x5c, 0x3f, 0xb3, 0x31,
+	0xb7, 0xfd, 0x46, 0x6b, 0x5c, 0xf9, 0xb7, 0x86, 0x2a, 0x76, 0x9e, 0xa0, 0xf9, 0x63, 0x05, 0x2e,
+	0x18, 0x9f, 0x90, 0xf1, 0xbe, 0x73, 0x55, 0x26, 0x8d, 0x07, 0xb0, 0x38, 0x64, 0xd1, 0x67, 0xc9,
+	0x95, 0x49, 0x66, 0xab, 0x74, 0x32, 0xa9, 0xa0, 0xdd, 0x18, 0x72, 0x25, 0x16, 0xb2, 0x02, 0x55,
+	0xe4, 0x34, 0xc2, 0xae, 0x50, 0xfa, 0xc4, 0xab, 0x76, 0xf6, 0xdc, 0xfc, 0xb6, 0x02, 0x8b, 0x9f,
+	0x67, 0xd5, 0x52, 0x22, 0xd9, 0xdb, 0x00, 0xc3, 0xea, 0x32, 0x59, 0x5e, 0x19, 0x9f, 0x65, 0xa6,
+	0xb1, 0x3b, 0x15, 0x57, 0x9b, 0x9d, 0x23, 0x69, 0x3e, 0xce, 0xa5, 0xb0, 0x27, 0x38, 0xee, 0x78,
+	0x9e, 0x7c, 0x55, 0x0a, 0xff, 0x85, 0x79, 0x57, 0x70, 0x74, 0xa8, 0xe7, 0x49, 0x86, 0xa8, 0x8b,
+	0x7e, 0xde, 0x9e, 0x73, 0x0d, 0x94, 0x21, 0x92, 0xd7, 0xa0, 0xde, 0xa7, 0x81, 0x88, 0x98, 0xcc,
+	0xa2, 0x74, 0xad, 0xdb, 0x35, 0x63, 0x36, 0x81, 0xcd, 0x3e, 0x2c, 0x7c, 0x4c, 0xa3, 0x88, 0x79,
+	0x3b, 0xae, 0x2b, 0x7a, 0x5c, 0xbd, 0x4a, 0xf7, 0x7f, 0xb0, 0x10, 0x08, 0x97, 0x06, 0x23, 0xc2,
+	0xf3, 0xda, 0x98, 0x2a, 0xff, 0x1f, 0x6a, 0x92, 0x85, 0x42, 0xb1, 0x82, 0xf0, 0xbc, 0xbd, 0x90,
+	0x58, 0x53, 0xdd, 0x9b, 0x40, 0xcc, 0xdf, 0x78, 0xc3, 0xe3, 0x14, 0x7c, 0xde, 0x21, 0x16, 0xcc,
+	0xa4, 0x28, 0xa3, 0x6d, 0x1e, 0x0b, 0x69, 0x4d, 0x16, 0xd2, 0x6a, 0x46, 0x50, 0xfb, 0x08, 0xc3,
+	0x3d, 0x1a, 0x95, 0x39, 0xbe, 0x3d, 0x98, 0x72, 0x69, 0x94, 0xa4, 0x3e, 0xb7, 0x7d, 0x79, 0xfc,
+	0xc1, 0x19, 0x6a, 0x73, 0x6c, 0x1a, 0xdc, 0x54, 0xd0, 0xd0, 0x2f, 0x5f, 0x49, 0xcd, 0xeb, 0x05,
+	0xcd, 0x12, 0xc5, 0x92, 0x91, 0x17, 0x54, 0xbf, 0x27, 0x30, 0xff, 0x7e, 0x32, 0xa8, 0x0e, 0x54,
+	0xdc, 0x69, 0x6e, 0xc0, 0x74, 0xa4, 0x5b, 0xa7, 0x16, 0x9c, 0xdb, 0xde, 0x18, 0xcf, 0x9c, 0xb4,
+	0x5a, 0x43, 0x6b, 0xd0, 0x64, 0x17, 0xce, 0xc6, 0xfd, 0x25, 0xad, 0xe6, 0x4b, 0xe3, 0x69, 0xe2,
+	0x15, 0x1b, 0x92, 0x04, 0x4a, 0x3e, 0x84, 0xaa, 0x64, 0x2e, 0xf3, 0x23, 0x15, 0x1f, 0xf8, 0x99,
+	0x72, 0x7b, 0x6b, 0x27, 0x08, 0xc3, 0x94, 0x11, 0x90, 0xaf, 0x8a, 0x7d, 0x69, 0x4a, 0xf3, 0xbd,
+	0x75, 0x9a, 0xbe, 0x94, 0x1e, 0x8b, 0xa1, 0xce, 0xd3, 0x11, 0x84, 0x0b, 0x11, 0x93, 0x0f, 0x85,
+	0x0c, 0x29, 0x77, 0x99, 0x93, 0x57, 0x3a, 0xfb, 0x8f, 0x95, 0xce, 0xe7, 0xa8, 0x73, 0x41, 0x24,
+	0xc8, 0x7a, 0x9c, 0x90, 0xa6, 0xc5, 0xa1, 0x35, 0xad, 0xe5, 0xde, 0x3d, 0x75, 0x8f, 0x1b, 0xd1,
+	0x6c, 0x78, 0x23, 0x6e, 0xf2, 0x10, 0x1a, 0x91, 0x90, 0xca, 0x71, 0x05, 0xe7, 0xcc, 0x4d, 0xd6,
+	0x36, 0xa3, 0xc5, 0xde, 0x2e, 0x51, 0x23, 0x42, 0xaa, 0xbd, 0x0c, 0xf8, 0x59, 0x2f, 0x0a, 0x52,
+	0xa1, 0x7a, 0x54, 0x70, 0x21, 0xe9, 0x00, 0x19, 0xf8, 0xaa, 0xeb, 0x49, 0x3a, 0xa0, 0x81, 0x23,
+	0x99, 0x2b, 0xa4, 0x87, 0x56, 0x55, 0x2b, 0x6d, 0x8f, 0x57, 0xba, 0x9b, 0x61, 0x6d, 0x0d, 0x35,
+	0x32, 0x8b, 0x83, 0x11, 0x3b, 0x12, 0x2f, 0x3f, 0x54, 0x53, 0x9d, 0xd9, 0xb2, 0x23, 0xe2, 0x4e,
+	0x0a, 0x2d, 0xc8, 0x34, 0x7a, 0x45, 0x33, 0x92, 0x10, 0x96, 0x25, 0xcb, 0x8d, 0xa2, 0x54, 0x08,
+	0xca, 0x96, 0x85, 0x9d, 0x43, 0x17, 0xb4, 0x96, 0xe4, 0x09, 0x0f, 0x92, 0x2f, 0x0b, 0xa3, 0x64,
+	0x4e, 0x8b, 0x5c, 0x3d, 0xc5, 0x28, 0x19, 0x29, 0x83, 0x1c, 0x19, 0x39, 0x82, 0xe1, 0x37, 0x90,
+	0x93, 0x0d, 0x0b, 0xb4, 0xe6, 0x4f, 0x2d, 0x92, 0x0e, 0x24, 0x23, 0x42, 0xfa, 0xa3, 0x0e, 0x24,
+	0x0f, 0xa0, 0x1e, 0xea, 0x19, 0xe2, 0xd0, 0x64, 0x88, 0xa0, 0xb5, 0xa0, 0x75, 0xda, 0xe3, 0x75,
+	0x0a, 0xc3, 0xc7, 0x68, 0xd4, 0xc2, 0xbc, 0x11, 0x09, 0x87, 0x73, 0x66, 0x0c, 0x38, 0xfa, 0x63,
+	0x29, 0x4c, 0xa6, 0x05, 0x5a, 0xb5, 0xb2, 0xe7, 0x72, 0x72, 0xd4, 0xa4, 0xe7, 0x42, 0x4f, 0x78,
+	0x90, 0x5c, 0x83, 0x95, 0x13, 0x55, 0xed, 0x20, 0x7b, 0xd4, 0x63, 0xdc, 0x65, 0x56, 0x5d, 0x7f,
+	0x91, 0x59, 0xa3, 0x35, 0x7a, 0x60, 0xfc, 0xe4, 0x36, 0x54, 0x03, 0x0c, 0x1d, 0xdd, 0xf1, 0x1b,
+	0x3a, 0xc1, 0x37, 0x4b, 0x4f, 0x99, 0xe2, 0x81, 0xce, 0x04, 0x89, 0x95, 0x50, 0x68, 0xb8, 0xbe,
+	0x74, 0x7b, 0xbe, 0x72, 0x0e, 0x25, 0xa3, 0x47, 0x4c, 0xa2, 0xb5, 0x58, 0x96, 0x7a, 0x2f, 0x41,
+	0xee, 0x26, 0xc0, 0xf4, 0x4d, 0x76, 0x0b, 0x56, 0x24, 0x6d, 0x58, 0x62, 0x21, 0x93, 0x1d, 0xc6,
+	0xdd, 0x63, 0x87, 0xf6, 0x54, 0x57, 0x48, 0x5f, 0x1d, 0x5b, 0x44, 0x4f, 0x32, 0x92, 0xb9, 0x76,
+	0x52, 0x0f, 0xf9, 0x02, 0x16, 0x30, 0xa0, 0xd8, 0xcd, 0x5e, 0x92, 0x25, 0x9d, 0xd0, 0xe6, 0xf8,
+	0x84, 0x0e, 0x62, 0x58, 0xe1, 0xed, 0x98, 0xc7, 0xa1, 0x09, 0x49, 0x17, 0x96, 0x24, 0x1b, 0xd0,
+	0x78, 0xcf, 0x07, 0x34, 0x8a, 0xab, 0xf7, 0xa1, 0xdf, 0x41, 0x6b, 0xb9, 0x6c, 0x57, 0xb1, 0x35,
+	0xf8, 0x60, 0x40, 0xa3, 0x3d, 0x0d, 0x4d, 0xbb, 0x8a, 0x1c, 0xb1, 0x23, 0xf9, 0x14, 0x40, 0x4b,
+	0x44, 0x42, 0x04, 0x68, 0x9d, 0xd3, 0x02, 0xaf, 0x97, 0x58, 0xc0, 0x80, 0x46, 0xb7, 0x84, 0x08,
+	0x0c, 0xf1, 0x2c, 0x9a, 0xe7, 0x78, 0x70, 0xd5, 0x75, 0x85, 0x0e, 0x7c, 0xee, 0x39, 0x9e, 0x18,
+	0x70, 0xb4, 0xce, 0x6b, 0xd6, 0x56, 0xb9, 0x99, 0x7a, 0xd7, 0xe7, 0xde, 0xbe, 0x18, 0x70, 0xc3,
+	0xbc, 0xf0, 0x38, 0x67, 0x43, 0x72, 0x1f, 0x6a, 0x9a, 0x3d, 0xfd, 0x78, 0x45, 0xeb, 0xc2, 0x69,
+	0xc8, 0x0f, 0x0c, 0x2c, 0x4f, 0x9e, 0xda, 0x90, 0xdc, 0x05, 0xd0, 0x57, 0x8e, 0xa4, 0x70, 0xad,
+	0xb2, 0x9b, 0x3d, 0xfa, 0x1d, 0x94, 0xee, 0x09, 0x4b, 0xed, 0xc4, 0x81, 0x46, 0x46, 0xec, 0xf4,
+	0x90, 0x76, 0x18, 0x5a, 0x17, 0xcb, 0xb6, 0x87, 0x94, 0xfe, 0x4e, 0x8c, 0x4b, 0xdb, 0x03, 0xcb,
+	0x1b, 0x71, 0xf7, 0xfe, 0xd3, 0x17, 0xab, 0x95, 0x67, 0x2f, 0x56, 0x2b, 0x7f, 0xbc, 0x58, 0xad,
+	0x7c, 0xf7, 0x72, 0x75, 0xe2, 0xd9, 0xcb, 0xd5, 0x89, 0x5f, 0x5f, 0xae, 0x4e, 0xdc, 0xdb, 0xc9,
+	0x5d, 0xe8, 0x72, 0x52, 0x9b, 0xf1, 0xca, 0xf3, 0x86, 0xf6, 0xd7, 0x7f, 0x73, 0x27, 0xd7, 0xf7,
+	0xbd, 0xc3, 0x69, 0x7d, 0x0b, 0xbf, 0xfa, 0xd7, 0x00, 0x14, 0x0f, 0x3f, 0x8a, 0x44, 0x10, 0x00,
+	0x00,
+}
+
+func (this *ParamsV1) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ParamsV1)
+	if !ok {
+		that2, ok := that.(ParamsV1)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DepositInterval != that1.DepositInterval {
+		return false
+	}
+	if this.ValidatorsetInterval != that1.ValidatorsetInterval {
+		return false
 	}
 	if !this.CommissionRate.Equal(that1.CommissionRate) {
 		return false
@@ -471,6 +998,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.UnbondingEnabled != that1.UnbondingEnabled {
 		return false
 	}
+	if this.ZoneHistoryRetentionEpochs != that1.ZoneHistoryRetentionEpochs {
+		return false
+	}
 	return true
 }
 func (m *ParamsV1) Marshal() (dAtA []byte, err error) {
@@ -536,6 +1066,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ZoneHistoryRetentionEpochs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ZoneHistoryRetentionEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.UnbondingEnabled {
 		i--
 		if m.UnbondingEnabled {
@@ -667,7 +1202,7 @@ func (m *DelegatorIntentsForZone) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorsForZone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -677,104 +1212,20 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorsForZone) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorsForZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.WithdrawalRecords) > 0 {
-		for iNdEx := len(m.WithdrawalRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WithdrawalRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.PortConnections) > 0 {
-		for iNdEx := len(m.PortConnections) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PortConnections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.DelegatorIntents) > 0 {
-		for iNdEx := len(m.DelegatorIntents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegatorIntents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.PerformanceDelegations) > 0 {
-		for iNdEx := len(m.PerformanceDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PerformanceDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Receipts) > 0 {
-		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Zones) > 0 {
-		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Zones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -785,201 +1236,2378 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ValidatorConsAddr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ParamsV1) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DepositInterval != 0 {
-		n += 1 + sovGenesis(uint64(m.DepositInterval))
-	}
-	if m.ValidatorsetInterval != 0 {
-		n += 1 + sovGenesis(uint64(m.ValidatorsetInterval))
-	}
-	l = m.CommissionRate.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+
+func (m *ValidatorConsAddr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorConsAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DepositInterval != 0 {
-		n += 1 + sovGenesis(uint64(m.DepositInterval))
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValoperAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ValidatorsetInterval != 0 {
-		n += 1 + sovGenesis(uint64(m.ValidatorsetInterval))
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.CommissionRate.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.UnbondingEnabled {
-		n += 2
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegationsForZone) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MappedAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MappedAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MappedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RemoteAddress) > 0 {
+		i -= len(m.RemoteAddress)
+		copy(dAtA[i:], m.RemoteAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RemoteAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.LocalAddress) > 0 {
+		i -= len(m.LocalAddress)
+		copy(dAtA[i:], m.LocalAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LocalAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorIntentsForZone) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddressZoneMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressZoneMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressZoneMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.DelegationIntent) > 0 {
-		for _, e := range m.DelegationIntent {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LsmCapsForZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LsmCapsForZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LsmCapsForZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Caps.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.Snapshot {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EpochCapsForZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochCapsForZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochCapsForZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Zones) > 0 {
-		for _, e := range m.Zones {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.Caps.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Receipts) > 0 {
-		for _, e := range m.Receipts {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochCapUsages) > 0 {
+		for iNdEx := len(m.EpochCapUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochCapUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EpochCaps) > 0 {
+		for iNdEx := len(m.EpochCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.PerformanceDelegations) > 0 {
-		for _, e := range m.PerformanceDelegations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ZoneSnapshots) > 0 {
+		for iNdEx := len(m.ZoneSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ZoneSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
 		}
 	}
-	if len(m.DelegatorIntents) > 0 {
-		for _, e := range m.DelegatorIntents {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ZoneWindDowns) > 0 {
+		for iNdEx := len(m.ZoneWindDowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ZoneWindDowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.PortConnections) > 0 {
-		for _, e := range m.PortConnections {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SwapPools) > 0 {
+		for iNdEx := len(m.SwapPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.WithdrawalRecords) > 0 {
-		for _, e := range m.WithdrawalRecords {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RewardSwapConfigs) > 0 {
+		for iNdEx := len(m.RewardSwapConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardSwapConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
 		}
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *ParamsV1) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.EmergencyAuthority) > 0 {
+		i -= len(m.EmergencyAuthority)
+		copy(dAtA[i:], m.EmergencyAuthority)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EmergencyAuthority)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.LsmCaps) > 0 {
+		for iNdEx := len(m.LsmCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LsmCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.WithdrawalRecordSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WithdrawalRecordSequence))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.AddressZoneMappings) > 0 {
+		for iNdEx := len(m.AddressZoneMappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressZoneMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.MappedAccounts) > 0 {
+		for iNdEx := len(m.MappedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MappedAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ValidatorConsAddrs) > 0 {
+		for iNdEx := len(m.ValidatorConsAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorConsAddrs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RedelegationRecords) > 0 {
+		for iNdEx := len(m.RedelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.UnbondingRecords) > 0 {
+		for iNdEx := len(m.UnbondingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.WithdrawalRecords) > 0 {
+		for iNdEx := len(m.WithdrawalRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawalRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PortConnections) > 0 {
+		for iNdEx := len(m.PortConnections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PortConnections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DelegatorIntents) > 0 {
+		for iNdEx := len(m.DelegatorIntents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorIntents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PerformanceDelegations) > 0 {
+		for iNdEx := len(m.PerformanceDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerformanceDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Zones) > 0 {
+		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Zones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.DepositInterval))
+	}
+	if m.ValidatorsetInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.ValidatorsetInterval))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.DepositInterval))
+	}
+	if m.ValidatorsetInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.ValidatorsetInterval))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.UnbondingEnabled {
+		n += 2
+	}
+	if m.ZoneHistoryRetentionEpochs != 0 {
+		n += 1 + sovGenesis(uint64(m.ZoneHistoryRetentionEpochs))
+	}
+	return n
+}
+
+func (m *DelegationsForZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorIntentsForZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.DelegationIntent) > 0 {
+		for _, e := range m.DelegationIntent {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Snapshot {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorsForZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorConsAddr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *MappedAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.LocalAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RemoteAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressZoneMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *LsmCapsForZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Caps.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *EpochCapsForZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Caps.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Zones) > 0 {
+		for _, e := range m.Zones {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PerformanceDelegations) > 0 {
+		for _, e := range m.PerformanceDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorIntents) > 0 {
+		for _, e := range m.DelegatorIntents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PortConnections) > 0 {
+		for _, e := range m.PortConnections {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawalRecords) > 0 {
+		for _, e := range m.WithdrawalRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingRecords) > 0 {
+		for _, e := range m.UnbondingRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedelegationRecords) > 0 {
+		for _, e := range m.RedelegationRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorConsAddrs) > 0 {
+		for _, e := range m.ValidatorConsAddrs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MappedAccounts) > 0 {
+		for _, e := range m.MappedAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressZoneMappings) > 0 {
+		for _, e := range m.AddressZoneMappings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.WithdrawalRecordSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.WithdrawalRecordSequence))
+	}
+	if len(m.LsmCaps) > 0 {
+		for _, e := range m.LsmCaps {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.EmergencyAuthority)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardSwapConfigs) > 0 {
+		for _, e := range m.RewardSwapConfigs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SwapPools) > 0 {
+		for _, e := range m.SwapPools {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ZoneWindDowns) > 0 {
+		for _, e := range m.ZoneWindDowns {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ZoneSnapshots) > 0 {
+		for _, e := range m.ZoneSnapshots {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochCaps) > 0 {
+		for _, e := range m.EpochCaps {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochCapUsages) > 0 {
+		for _, e := range m.EpochCapUsages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params_v1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params_v1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositInterval", wireType)
+			}
+			m.DepositInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsetInterval", wireType)
+			}
+			m.ValidatorsetInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsetInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositInterval", wireType)
+			}
+			m.DepositInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsetInterval", wireType)
+			}
+			m.ValidatorsetInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsetInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnbondingEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneHistoryRetentionEpochs", wireType)
+			}
+			m.ZoneHistoryRetentionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZoneHistoryRetentionEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationsForZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationsForZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationsForZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, &Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorIntentsForZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorIntentsForZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorIntentsForZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationIntent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationIntent = append(m.DelegationIntent, &DelegatorIntent{})
+			if err := m.DelegationIntent[len(m.DelegationIntent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Snapshot = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorsForZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorsForZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorsForZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorConsAddr) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorConsAddr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorConsAddr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = append(m.ConsAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsAddress == nil {
+				m.ConsAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MappedAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MappedAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MappedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalAddress = append(m.LocalAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.LocalAddress == nil {
+				m.LocalAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteAddress = append(m.RemoteAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.RemoteAddress == nil {
+				m.RemoteAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressZoneMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressZoneMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressZoneMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LsmCapsForZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LsmCapsForZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LsmCapsForZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Caps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochCapsForZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochCapsForZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochCapsForZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Caps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zones = append(m.Zones, Zone{})
+			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Receipts = append(m.Receipts, Receipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params_v1: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params_v1: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositInterval", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
-			m.DepositInterval = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -989,16 +3617,31 @@ func (m *ParamsV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositInterval |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsetInterval", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.ValidatorsetInterval = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, DelegationsForZone{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceDelegations", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1008,16 +3651,31 @@ func (m *ParamsV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorsetInterval |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerformanceDelegations = append(m.PerformanceDelegations, DelegationsForZone{})
+			if err := m.PerformanceDelegations[len(m.PerformanceDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorIntents", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1027,81 +3685,99 @@ func (m *ParamsV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegatorIntents = append(m.DelegatorIntents, DelegatorIntentsForZone{})
+			if err := m.DelegatorIntents[len(m.DelegatorIntents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortConnections", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.PortConnections = append(m.PortConnections, PortConnectionTuple{})
+			if err := m.PortConnections[len(m.PortConnections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WithdrawalRecords = append(m.WithdrawalRecords, WithdrawalRecord{})
+			if err := m.WithdrawalRecords[len(m.WithdrawalRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositInterval", wireType)
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingRecords", wireType)
 			}
-			m.DepositInterval = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1111,16 +3787,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositInterval |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsetInterval", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.ValidatorsetInterval = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingRecords = append(m.UnbondingRecords, UnbondingRecord{})
+			if err := m.UnbondingRecords[len(m.UnbondingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationRecords", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1130,16 +3821,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorsetInterval |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegationRecords = append(m.RedelegationRecords, RedelegationRecord{})
+			if err := m.RedelegationRecords[len(m.RedelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1149,31 +3855,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, ValidatorsForZone{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEnabled", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorConsAddrs", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1183,67 +3889,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UnbondingEnabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationsForZone) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ValidatorConsAddrs = append(m.ValidatorConsAddrs, ValidatorConsAddr{})
+			if err := m.ValidatorConsAddrs[len(m.ValidatorConsAddrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationsForZone: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationsForZone: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MappedAccounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1253,27 +3923,29 @@ func (m *DelegationsForZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.MappedAccounts = append(m.MappedAccounts, MappedAccount{})
+			if err := m.MappedAccounts[len(m.MappedAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressZoneMappings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1300,66 +3972,16 @@ func (m *DelegationsForZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, &Delegation{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AddressZoneMappings = append(m.AddressZoneMappings, AddressZoneMapping{})
+			if err := m.AddressZoneMappings[len(m.AddressZoneMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegatorIntentsForZone) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorIntentsForZone: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorIntentsForZone: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalRecordSequence", wireType)
 			}
-			var stringLen uint64
+			m.WithdrawalRecordSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1369,27 +3991,14 @@ func (m *DelegatorIntentsForZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WithdrawalRecordSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationIntent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmCaps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1416,16 +4025,16 @@ func (m *DelegatorIntentsForZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegationIntent = append(m.DelegationIntent, &DelegatorIntent{})
-			if err := m.DelegationIntent[len(m.DelegationIntent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LsmCaps = append(m.LsmCaps, LsmCapsForZone{})
+			if err := m.LsmCaps[len(m.LsmCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1435,67 +4044,31 @@ func (m *DelegatorIntentsForZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Snapshot = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAuthority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1505,28 +4078,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EmergencyAuthority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1553,14 +4125,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Zones = append(m.Zones, Zone{})
-			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSwapConfigs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1587,14 +4159,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receipts = append(m.Receipts, Receipt{})
-			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardSwapConfigs = append(m.RewardSwapConfigs, RewardSwapConfig{})
+			if err := m.RewardSwapConfigs[len(m.RewardSwapConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1621,14 +4193,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, DelegationsForZone{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SwapPools = append(m.SwapPools, SwapPool{})
+			if err := m.SwapPools[len(m.SwapPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneWindDowns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1655,14 +4227,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PerformanceDelegations = append(m.PerformanceDelegations, DelegationsForZone{})
-			if err := m.PerformanceDelegations[len(m.PerformanceDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ZoneWindDowns = append(m.ZoneWindDowns, ZoneWindDown{})
+			if err := m.ZoneWindDowns[len(m.ZoneWindDowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorIntents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneSnapshots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1689,14 +4261,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorIntents = append(m.DelegatorIntents, DelegatorIntentsForZone{})
-			if err := m.DelegatorIntents[len(m.DelegatorIntents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ZoneSnapshots = append(m.ZoneSnapshots, ZoneSnapshot{})
+			if err := m.ZoneSnapshots[len(m.ZoneSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortConnections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCaps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1723,14 +4295,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortConnections = append(m.PortConnections, PortConnectionTuple{})
-			if err := m.PortConnections[len(m.PortConnections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochCaps = append(m.EpochCaps, EpochCapsForZone{})
+			if err := m.EpochCaps[len(m.EpochCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCapUsages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1757,8 +4329,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawalRecords = append(m.WithdrawalRecords, WithdrawalRecord{})
-			if err := m.WithdrawalRecords[len(m.WithdrawalRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochCapUsages = append(m.EpochCapUsages, EpochCapUsage{})
+			if err := m.EpochCapUsages[len(m.EpochCapUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
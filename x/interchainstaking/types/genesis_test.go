@@ -4,36 +4,81 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
-	type fields struct {
-		Params           Params
-		Zones            []Zone
-		Receipts         []Receipt
-		Delegations      []DelegationsForZone
-		DelegatorIntents []DelegatorIntentsForZone
-		PortConnections  []PortConnectionTuple
+	zone := Zone{ChainId: "cosmoshub-4", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	withZone := func(fn func(gs *GenesisState)) GenesisState {
+		gs := NewGenesisState(DefaultParams(), []Zone{zone})
+		fn(gs)
+		return *gs
 	}
 	tests := []struct {
 		name    string
-		fields  fields
+		gs      GenesisState
 		wantErr bool
 	}{
-		// TODO: Add test cases.
+		{
+			name: "default",
+			gs:   *DefaultGenesis(),
+		},
+		{
+			name: "valid records",
+			gs: withZone(func(gs *GenesisState) {
+				gs.Receipts = []Receipt{{ChainId: zone.ChainId, Txhash: "abcd"}}
+				gs.WithdrawalRecords = []WithdrawalRecord{{ChainId: zone.ChainId, Txhash: "abcd", Status: WithdrawStatusQueued}}
+				gs.UnbondingRecords = []UnbondingRecord{{ChainId: zone.ChainId, Validator: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0", EpochNumber: 1}}
+				gs.Delegations = []DelegationsForZone{{ChainId: zone.ChainId, Delegations: []*Delegation{{Amount: sdk.NewCoin("uatom", sdk.NewInt(1))}}}}
+				gs.ZoneWindDowns = []ZoneWindDown{{ChainId: "osmosis-1", Status: WindDownStatusRemoved}}
+			}),
+		},
+		{
+			name:    "duplicate zone",
+			gs:      *NewGenesisState(DefaultParams(), []Zone{zone, zone}),
+			wantErr: true,
+		},
+		{
+			name: "receipt for unknown zone",
+			gs: withZone(func(gs *GenesisState) {
+				gs.Receipts = []Receipt{{ChainId: "osmosis-1", Txhash: "abcd"}}
+			}),
+			wantErr: true,
+		},
+		{
+			name: "withdrawal record with invalid txhash",
+			gs: withZone(func(gs *GenesisState) {
+				gs.WithdrawalRecords = []WithdrawalRecord{{ChainId: zone.ChainId, Txhash: "xyz", Status: WithdrawStatusQueued}}
+			}),
+			wantErr: true,
+		},
+		{
+			name: "duplicate unbonding record",
+			gs: withZone(func(gs *GenesisState) {
+				record := UnbondingRecord{ChainId: zone.ChainId, Validator: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0", EpochNumber: 1}
+				gs.UnbondingRecords = []UnbondingRecord{record, record}
+			}),
+			wantErr: true,
+		},
+		{
+			name: "draining wind-down for unknown zone",
+			gs: withZone(func(gs *GenesisState) {
+				gs.ZoneWindDowns = []ZoneWindDown{{ChainId: "osmosis-1", Status: WindDownStatusDraining}}
+			}),
+			wantErr: true,
+		},
+		{
+			name: "invalid emergency authority",
+			gs: withZone(func(gs *GenesisState) {
+				gs.EmergencyAuthority = "notanaddress"
+			}),
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gs := GenesisState{
-				Params:           tt.fields.Params,
-				Zones:            tt.fields.Zones,
-				Receipts:         tt.fields.Receipts,
-				Delegations:      tt.fields.Delegations,
-				DelegatorIntents: tt.fields.DelegatorIntents,
-				PortConnections:  tt.fields.PortConnections,
-			}
-
-			err := gs.Validate()
+			err := tt.gs.Validate()
 			if tt.wantErr {
 				t.Logf("Error:\n%v\n", err)
 				require.Error(t, err)
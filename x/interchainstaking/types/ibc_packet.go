@@ -8,11 +8,12 @@ import (
 )
 
 const (
-	MsgTypeWithdrawal  = "withdrawal"
-	MsgTypeRebalance   = "rebalance"
-	MsgTypeUnbondSend  = "unbondSend"
-	MsgTypePerformance = "perf"
-	MsgTypeBatch       = "batch"
+	MsgTypeWithdrawal   = "withdrawal"
+	MsgTypeRebalance    = "rebalance"
+	MsgTypeUnbondSend   = "unbondSend"
+	MsgTypePerformance  = "perf"
+	MsgTypeBatch        = "batch"
+	MsgTypeCancelUnbond = "cancelUnbond"
 	// TransferPort is the portID for ibc transfer module.
 	TransferPort = "transfer"
 )
@@ -60,3 +61,7 @@ func EpochWithdrawalMemo(epoch int64) string {
 func TxUnbondSendMemo(hash string) string {
 	return fmt.Sprintf("%s/%s", MsgTypeUnbondSend, hash)
 }
+
+func TxCancelUnbondMemo(hash string) string {
+	return fmt.Sprintf("%s/%s", MsgTypeCancelUnbond, hash)
+}
@@ -30,6 +30,83 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CircuitBreakerAction enumerates the zone actions that may be paused
+// independently by the circuit breaker.
+type CircuitBreakerAction int32
+
+const (
+	CircuitBreakerActionUndefined   CircuitBreakerAction = 0
+	CircuitBreakerActionDeposits    CircuitBreakerAction = 1
+	CircuitBreakerActionRedemptions CircuitBreakerAction = 2
+	CircuitBreakerActionRebalance   CircuitBreakerAction = 3
+	CircuitBreakerActionRewards     CircuitBreakerAction = 4
+	CircuitBreakerActionICA         CircuitBreakerAction = 5
+)
+
+var CircuitBreakerAction_name = map[int32]string{
+	0: "CircuitBreakerActionUndefined",
+	1: "CircuitBreakerActionDeposits",
+	2: "CircuitBreakerActionRedemptions",
+	3: "CircuitBreakerActionRebalance",
+	4: "CircuitBreakerActionRewards",
+	5: "CircuitBreakerActionICA",
+}
+
+var CircuitBreakerAction_value = map[string]int32{
+	"CircuitBreakerActionUndefined":   0,
+	"CircuitBreakerActionDeposits":    1,
+	"CircuitBreakerActionRedemptions": 2,
+	"CircuitBreakerActionRebalance":   3,
+	"CircuitBreakerActionRewards":     4,
+	"CircuitBreakerActionICA":         5,
+}
+
+func (x CircuitBreakerAction) String() string {
+	return proto.EnumName(CircuitBreakerAction_name, int32(x))
+}
+
+func (CircuitBreakerAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{0}
+}
+
+type WindDownStatus int32
+
+const (
+	// WindDownStatusActive indicates the zone is not being wound down.
+	WindDownStatusActive WindDownStatus = 0
+	// WindDownStatusDraining indicates deposits are blocked and delegations are
+	// being unbonded to settle qAsset holders.
+	WindDownStatusDraining WindDownStatus = 1
+	// WindDownStatusRemoved indicates all holders were settled and the zone's
+	// state was removed.
+	WindDownStatusRemoved WindDownStatus = 2
+	// WindDownStatusMigrated indicates all holders were settled and the zone was
+	// registered afresh on a new connection.
+	WindDownStatusMigrated WindDownStatus = 3
+)
+
+var WindDownStatus_name = map[int32]string{
+	0: "WindDownStatusActive",
+	1: "WindDownStatusDraining",
+	2: "WindDownStatusRemoved",
+	3: "WindDownStatusMigrated",
+}
+
+var WindDownStatus_value = map[string]int32{
+	"WindDownStatusActive":   0,
+	"WindDownStatusDraining": 1,
+	"WindDownStatusRemoved":  2,
+	"WindDownStatusMigrated": 3,
+}
+
+func (x WindDownStatus) String() string {
+	return proto.EnumName(WindDownStatus_name, int32(x))
+}
+
+func (WindDownStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{1}
+}
+
 type Zone struct {
 	ConnectionId                 string                                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
 	ChainId                      string                                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -60,6 +137,9 @@ type Zone struct {
 	ReturnToSender               bool                                   `protobuf:"varint,27,opt,name=return_to_sender,json=returnToSender,proto3" json:"return_to_sender,omitempty"`
 	Is_118                       bool                                   `protobuf:"varint,28,opt,name=is_118,json=is118,proto3" json:"is_118,omitempty"`
 	SubzoneInfo                  *SubzoneInfo                           `protobuf:"bytes,29,opt,name=subzoneInfo,proto3" json:"subzoneInfo,omitempty"`
+	// redemption_rate_uncapped is set while the current redemption rate is one
+	// set without the per-epoch caps, by OverrideRedemptionRateNoCap.
+	RedemptionRateUncapped bool `protobuf:"varint,30,opt,name=redemption_rate_uncapped,json=redemptionRateUncapped,proto3" json:"redemption_rate_uncapped,omitempty"`
 }
 
 func (m *Zone) Reset()         { *m = Zone{} }
@@ -277,6 +357,13 @@ func (m *Zone) GetSubzoneInfo() *SubzoneInfo {
 	return nil
 }
 
+func (m *Zone) GetRedemptionRateUncapped() bool {
+	if m != nil {
+		return m.RedemptionRateUncapped
+	}
+	return false
+}
+
 type SubzoneInfo struct {
 	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	BaseChainID string `protobuf:"bytes,2,opt,name=base_chainID,json=baseChainID,proto3" json:"base_chainID,omitempty"`
@@ -510,6 +597,9 @@ type WithdrawalRecord struct {
 	Requeued       bool                                     `protobuf:"varint,10,opt,name=requeued,proto3" json:"requeued,omitempty"`
 	Acknowledged   bool                                     `protobuf:"varint,11,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
 	EpochNumber    int64                                    `protobuf:"varint,12,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// priority_fee is the qAsset fee paid to move the record up the redemption
+	// queue, if any.
+	PriorityFee *types.Coin `protobuf:"bytes,13,opt,name=priority_fee,json=priorityFee,proto3" json:"priority_fee,omitempty"`
 }
 
 func (m *WithdrawalRecord) Reset()         { *m = WithdrawalRecord{} }
@@ -622,12 +712,21 @@ func (m *WithdrawalRecord) GetEpochNumber() int64 {
 	return 0
 }
 
+func (m *WithdrawalRecord) GetPriorityFee() *types.Coin {
+	if m != nil {
+		return m.PriorityFee
+	}
+	return nil
+}
+
 type UnbondingRecord struct {
-	ChainId       string                                  `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	EpochNumber   int64                                   `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
-	Validator     string                                  `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
-	RelatedTxhash []string                                `protobuf:"bytes,4,rep,name=related_txhash,json=relatedTxhash,proto3" json:"related_txhash,omitempty"`
-	Amount        github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	ChainId        string                                  `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	EpochNumber    int64                                   `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Validator      string                                  `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	RelatedTxhash  []string                                `protobuf:"bytes,4,rep,name=related_txhash,json=relatedTxhash,proto3" json:"related_txhash,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	CompletionTime time.Time                               `protobuf:"bytes,6,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	CreationHeight int64                                   `protobuf:"varint,7,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
 }
 
 func (m *UnbondingRecord) Reset()         { *m = UnbondingRecord{} }
@@ -691,6 +790,20 @@ func (m *UnbondingRecord) GetRelatedTxhash() []string {
 	return nil
 }
 
+func (m *UnbondingRecord) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func (m *UnbondingRecord) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
 type RedelegationRecord struct {
 	ChainId        string    `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	EpochNumber    int64     `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
@@ -1214,505 +1327,989 @@ func (m *Receipt) GetCompleted() *time.Time {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Zone)(nil), "quicksilver.interchainstaking.v1.Zone")
-	proto.RegisterType((*SubzoneInfo)(nil), "quicksilver.interchainstaking.v1.SubzoneInfo")
-	proto.RegisterType((*LsmCaps)(nil), "quicksilver.interchainstaking.v1.LsmCaps")
-	proto.RegisterType((*ICAAccount)(nil), "quicksilver.interchainstaking.v1.ICAAccount")
-	proto.RegisterType((*Distribution)(nil), "quicksilver.interchainstaking.v1.Distribution")
-	proto.RegisterType((*WithdrawalRecord)(nil), "quicksilver.interchainstaking.v1.WithdrawalRecord")
-	proto.RegisterType((*UnbondingRecord)(nil), "quicksilver.interchainstaking.v1.UnbondingRecord")
-	proto.RegisterType((*RedelegationRecord)(nil), "quicksilver.interchainstaking.v1.RedelegationRecord")
-	proto.RegisterType((*TransferRecord)(nil), "quicksilver.interchainstaking.v1.TransferRecord")
-	proto.RegisterType((*Validator)(nil), "quicksilver.interchainstaking.v1.Validator")
-	proto.RegisterType((*DelegatorIntent)(nil), "quicksilver.interchainstaking.v1.DelegatorIntent")
-	proto.RegisterType((*ValidatorIntent)(nil), "quicksilver.interchainstaking.v1.ValidatorIntent")
-	proto.RegisterType((*Delegation)(nil), "quicksilver.interchainstaking.v1.Delegation")
-	proto.RegisterType((*PortConnectionTuple)(nil), "quicksilver.interchainstaking.v1.PortConnectionTuple")
-	proto.RegisterType((*Receipt)(nil), "quicksilver.interchainstaking.v1.Receipt")
+// CircuitBreakerTrip records why, when and by whom a single zone action was
+// paused.
+type CircuitBreakerTrip struct {
+	Action CircuitBreakerAction `protobuf:"varint,1,opt,name=action,proto3,enum=quicksilver.interchainstaking.v1.CircuitBreakerAction" json:"action,omitempty"`
+	Reason string               `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// tripped_by is the address that tripped the breaker, or "auto" when it was
+	// tripped by the module itself.
+	TrippedBy string    `protobuf:"bytes,3,opt,name=tripped_by,json=trippedBy,proto3" json:"tripped_by,omitempty"`
+	Height    int64     `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Time      time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
 }
 
-func init() {
-	proto.RegisterFile("quicksilver/interchainstaking/v1/interchainstaking.proto", fileDescriptor_0d755cfd37ef9fee)
+func (m *CircuitBreakerTrip) Reset()         { *m = CircuitBreakerTrip{} }
+func (m *CircuitBreakerTrip) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerTrip) ProtoMessage()    {}
+func (*CircuitBreakerTrip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{15}
+}
+func (m *CircuitBreakerTrip) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerTrip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerTrip.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerTrip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerTrip.Merge(m, src)
+}
+func (m *CircuitBreakerTrip) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerTrip) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerTrip.DiscardUnknown(m)
 }
 
-var fileDescriptor_0d755cfd37ef9fee = []byte{
-	// 2062 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
-	0x15, 0x36, 0x29, 0x89, 0x12, 0x0f, 0x29, 0x51, 0x1a, 0xc9, 0xce, 0xda, 0xb1, 0x45, 0x86, 0x4d,
-	0x52, 0x16, 0xb6, 0xc8, 0xc8, 0x01, 0x5a, 0x37, 0x28, 0x8a, 0xea, 0xe2, 0x26, 0x42, 0x63, 0x55,
-	0x58, 0x29, 0x0d, 0x1a, 0xa3, 0x58, 0x0c, 0x77, 0x47, 0xe4, 0xc4, 0xbb, 0x3b, 0xeb, 0x9d, 0xa1,
-	0x2e, 0x7e, 0xec, 0x2f, 0xc8, 0x4f, 0x68, 0x9f, 0x0a, 0x18, 0x7d, 0x74, 0xdf, 0xfa, 0x03, 0xf2,
-	0x18, 0xf8, 0xa9, 0x28, 0x0a, 0xb9, 0xb0, 0xdf, 0x04, 0xf4, 0xa5, 0xbf, 0x20, 0x98, 0xcb, 0x5e,
-	0x28, 0x29, 0xa6, 0xe4, 0x28, 0x79, 0x12, 0xe7, 0x5c, 0xbe, 0x33, 0x3b, 0x73, 0xe6, 0x9c, 0x6f,
-	0x46, 0x70, 0xef, 0xf1, 0x80, 0xba, 0x8f, 0x38, 0xf5, 0xf7, 0x48, 0xdc, 0xa1, 0xa1, 0x20, 0xb1,
-	0xdb, 0xc7, 0x34, 0xe4, 0x02, 0x3f, 0xa2, 0x61, 0xaf, 0xb3, 0xb7, 0x7c, 0x5a, 0xd8, 0x8e, 0x62,
-	0x26, 0x18, 0x6a, 0xe4, 0x3c, 0xdb, 0xa7, 0x8d, 0xf6, 0x96, 0x6f, 0x2c, 0xba, 0x8c, 0x07, 0x8c,
-	0x77, 0xba, 0x98, 0x93, 0xce, 0xde, 0x72, 0x97, 0x08, 0xbc, 0xdc, 0x71, 0x19, 0x0d, 0x35, 0xc2,
-	0x8d, 0xeb, 0x5a, 0xef, 0xa8, 0x51, 0x47, 0x0f, 0x8c, 0x6a, 0xa1, 0xc7, 0x7a, 0x4c, 0xcb, 0xe5,
-	0x2f, 0x23, 0xad, 0xf7, 0x18, 0xeb, 0xf9, 0xa4, 0xa3, 0x46, 0xdd, 0xc1, 0x6e, 0x47, 0xd0, 0x80,
-	0x70, 0x81, 0x83, 0x48, 0x1b, 0x34, 0x9f, 0xd6, 0x60, 0xfc, 0x0b, 0x16, 0x12, 0xf4, 0x13, 0x98,
-	0x76, 0x59, 0x18, 0x12, 0x57, 0x50, 0x16, 0x3a, 0xd4, 0xb3, 0x0a, 0x8d, 0x42, 0xab, 0x6c, 0x57,
-	0x33, 0xe1, 0x86, 0x87, 0xae, 0xc3, 0x94, 0x9a, 0xb2, 0xd4, 0x17, 0x95, 0x7e, 0x52, 0x8d, 0x37,
-	0x3c, 0xf4, 0x19, 0xd4, 0x3c, 0x12, 0x31, 0x4e, 0x85, 0x83, 0x3d, 0x2f, 0x26, 0x9c, 0x5b, 0x63,
-	0x8d, 0x42, 0xab, 0x72, 0xf7, 0x4e, 0x7b, 0xd4, 0x67, 0xb7, 0x37, 0xd6, 0x56, 0x56, 0x5c, 0x97,
-	0x0d, 0x42, 0x61, 0xcf, 0x18, 0x90, 0x15, 0x8d, 0x81, 0x1e, 0x02, 0xda, 0xa7, 0xa2, 0xef, 0xc5,
-	0x78, 0x1f, 0xfb, 0x29, 0xf2, 0xf8, 0x1b, 0x20, 0xcf, 0x65, 0x38, 0x09, 0xf8, 0x9f, 0x60, 0x3e,
-	0x22, 0xf1, 0x2e, 0x8b, 0x03, 0x1c, 0xba, 0x24, 0x45, 0x9f, 0x78, 0x03, 0x74, 0x94, 0x03, 0xca,
-	0xcd, 0xdd, 0x23, 0x3e, 0xe9, 0x61, 0xb5, 0xa4, 0x09, 0x7a, 0xe9, 0x4d, 0xe6, 0x9e, 0xe1, 0x24,
-	0xe0, 0xef, 0xc1, 0x0c, 0xd6, 0x5a, 0x27, 0x8a, 0xc9, 0x2e, 0x3d, 0xb0, 0x26, 0xd5, 0x86, 0x4c,
-	0x1b, 0xe9, 0x96, 0x12, 0xa2, 0x3a, 0x54, 0x7c, 0xe6, 0x62, 0xdf, 0xf1, 0x48, 0xc8, 0x02, 0x6b,
-	0x4a, 0xd9, 0x80, 0x12, 0xad, 0x4b, 0x09, 0xba, 0x05, 0x20, 0xb3, 0xcd, 0xe8, 0xcb, 0x4a, 0x5f,
-	0x96, 0x12, 0xad, 0x26, 0x50, 0x8b, 0x89, 0x47, 0x82, 0x48, 0x7d, 0x43, 0x8c, 0x05, 0xb1, 0x40,
-	0xda, 0xac, 0xfe, 0xea, 0xeb, 0xa3, 0xfa, 0x95, 0x7f, 0x1f, 0xd5, 0xdf, 0xef, 0x51, 0xd1, 0x1f,
-	0x74, 0xdb, 0x2e, 0x0b, 0x4c, 0x42, 0x9a, 0x3f, 0x4b, 0xdc, 0x7b, 0xd4, 0x11, 0x87, 0x11, 0xe1,
-	0xed, 0x75, 0xe2, 0x3e, 0x7f, 0xb6, 0x04, 0x26, 0x5f, 0xd7, 0x89, 0x6b, 0xcf, 0x64, 0xa0, 0x36,
-	0x16, 0x04, 0x85, 0xb0, 0xe0, 0x63, 0x2e, 0x9c, 0x93, 0xb1, 0x2a, 0x97, 0x10, 0x0b, 0x49, 0x64,
-	0x7b, 0x38, 0xde, 0xef, 0x00, 0xf6, 0xb0, 0x4f, 0x3d, 0x2c, 0x58, 0xcc, 0xad, 0x6a, 0x63, 0xac,
-	0x55, 0xb9, 0x7b, 0x7b, 0xf4, 0x96, 0xfc, 0x21, 0xf1, 0xb1, 0x73, 0xee, 0x28, 0x86, 0x59, 0xdc,
-	0xeb, 0xc5, 0x72, 0x83, 0x88, 0x23, 0xfd, 0x42, 0x61, 0x4d, 0x2b, 0xc8, 0xe5, 0x0b, 0x40, 0x6e,
-	0x28, 0xc7, 0xd5, 0x85, 0xa7, 0x2f, 0xea, 0xb3, 0x27, 0x84, 0xdc, 0xae, 0xa5, 0x01, 0xb4, 0x44,
-	0x6e, 0x5b, 0x30, 0xf0, 0x05, 0x75, 0x38, 0x09, 0x3d, 0x6b, 0xa6, 0x51, 0x68, 0x4d, 0xd9, 0x65,
-	0x25, 0xd9, 0x26, 0xa1, 0x87, 0x7e, 0x06, 0xb3, 0x3e, 0x7d, 0x3c, 0xa0, 0x1e, 0x15, 0x87, 0x4e,
-	0xc0, 0xbc, 0x81, 0x4f, 0xac, 0x9a, 0x32, 0xaa, 0xa5, 0xf2, 0x07, 0x4a, 0x8c, 0x96, 0x61, 0x21,
-	0x77, 0xc2, 0xf6, 0x31, 0x15, 0xbd, 0x98, 0x0d, 0x22, 0x6b, 0xb6, 0x51, 0x68, 0x4d, 0xdb, 0xf3,
-	0x99, 0xee, 0xf3, 0x44, 0x85, 0x7e, 0x01, 0x16, 0xed, 0xba, 0x4e, 0x48, 0x0e, 0x84, 0x93, 0xad,
-	0x83, 0xd3, 0xc7, 0xbc, 0x6f, 0xcd, 0x35, 0x0a, 0xad, 0xaa, 0x7d, 0x95, 0x76, 0xdd, 0x4d, 0x72,
-	0x20, 0xd2, 0x0f, 0xe1, 0x9f, 0x60, 0xde, 0x47, 0x87, 0xb0, 0x98, 0xda, 0x3b, 0x9c, 0xf8, 0xa6,
-	0xda, 0x60, 0x5f, 0x26, 0xa4, 0xfc, 0x69, 0xa1, 0x46, 0xa1, 0x35, 0xbe, 0xfa, 0xe1, 0xf1, 0x51,
-	0xbd, 0xf3, 0x7a, 0xcb, 0x3b, 0x5c, 0xc4, 0x34, 0xec, 0xdd, 0x61, 0x01, 0x15, 0x72, 0x67, 0x0f,
-	0xed, 0x9b, 0xa9, 0xc3, 0x76, 0x62, 0xbf, 0x92, 0x9a, 0xa3, 0x3f, 0xc2, 0x7c, 0x9f, 0xf9, 0x1e,
-	0x0d, 0x7b, 0x3c, 0x1f, 0x6f, 0x5e, 0xc5, 0x6b, 0x1d, 0x1f, 0xd5, 0xdf, 0x3d, 0x43, 0x7d, 0x3a,
-	0x08, 0x4a, 0xac, 0x72, 0xd0, 0x36, 0xcc, 0xa9, 0xe4, 0x25, 0x11, 0x73, 0xfb, 0x4e, 0x9f, 0xd0,
-	0x5e, 0x5f, 0x58, 0x0b, 0x8d, 0x42, 0x6b, 0x6c, 0xf5, 0xfd, 0xe3, 0xa3, 0x7a, 0xf3, 0x94, 0xf2,
-	0x34, 0x6c, 0x4d, 0xda, 0xdc, 0x97, 0x26, 0x9f, 0x28, 0x0b, 0xb4, 0x09, 0x63, 0x62, 0xcf, 0xb7,
-	0xae, 0x5e, 0x42, 0xfe, 0x4b, 0x20, 0xb4, 0x05, 0xb3, 0x83, 0xb0, 0xcb, 0x42, 0x39, 0x77, 0x27,
-	0x22, 0x31, 0x65, 0x9e, 0x75, 0x4d, 0x4d, 0xf1, 0xbd, 0xe3, 0xa3, 0xfa, 0x3b, 0x27, 0x75, 0x67,
-	0xcc, 0x30, 0x35, 0xd9, 0x52, 0x16, 0xe8, 0x53, 0xa8, 0x05, 0x84, 0x73, 0xdc, 0x23, 0x5c, 0x3a,
-	0x39, 0xe2, 0xc0, 0x7a, 0x4b, 0x01, 0xbe, 0x7b, 0x7c, 0x54, 0x6f, 0x9c, 0x50, 0x9d, 0xc6, 0x9b,
-	0x4e, 0x2c, 0xb6, 0x48, 0xbc, 0x73, 0x80, 0x7e, 0x09, 0x53, 0x1e, 0x71, 0x69, 0x80, 0x7d, 0x6e,
-	0x59, 0x0a, 0xe6, 0xd6, 0xf1, 0x51, 0xfd, 0x7a, 0x22, 0x3b, 0xed, 0x9f, 0x9a, 0xa3, 0xdb, 0x30,
-	0x97, 0x4d, 0x9f, 0x84, 0xb8, 0xeb, 0x13, 0xcf, 0xba, 0xae, 0x92, 0x3d, 0xfb, 0xe6, 0xfb, 0x5a,
-	0x2e, 0x0f, 0x86, 0xe9, 0x30, 0x3c, 0xb5, 0xbd, 0xa1, 0x0f, 0x46, 0x22, 0x4f, 0x4c, 0x5b, 0x30,
-	0x1b, 0x13, 0x31, 0x88, 0x43, 0x47, 0x30, 0x75, 0xcc, 0x48, 0x6c, 0xbd, 0xad, 0x4c, 0x67, 0xb4,
-	0x7c, 0x87, 0x6d, 0x2b, 0x29, 0xba, 0x0a, 0x25, 0xca, 0x9d, 0xe5, 0xe5, 0x7b, 0xd6, 0x4d, 0xa5,
-	0x9f, 0xa0, 0x7c, 0x79, 0xf9, 0x1e, 0xfa, 0x3d, 0x54, 0xf8, 0xa0, 0xfb, 0x84, 0x85, 0x64, 0x23,
-	0xdc, 0x65, 0xd6, 0x2d, 0x55, 0xf8, 0x97, 0x46, 0x97, 0x84, 0xed, 0xcc, 0xc9, 0xce, 0x23, 0x34,
-	0x37, 0xa1, 0x92, 0xd3, 0xa1, 0x9b, 0x50, 0xc6, 0x03, 0xd1, 0x67, 0x31, 0x15, 0x87, 0xa6, 0x5d,
-	0x67, 0x02, 0xf4, 0x0e, 0x54, 0x55, 0x61, 0xd7, 0x0d, 0x7a, 0xdd, 0xf4, 0xeb, 0x8a, 0x94, 0xad,
-	0x69, 0x51, 0xf3, 0x1f, 0x45, 0x98, 0xfc, 0x94, 0x07, 0x6b, 0x38, 0xe2, 0x08, 0xc3, 0x74, 0x76,
-	0xe0, 0x5c, 0x1c, 0x69, 0xc0, 0xef, 0x99, 0x7a, 0xd5, 0x14, 0x72, 0x0d, 0x47, 0xe8, 0x4b, 0x40,
-	0x59, 0x08, 0xb9, 0x2f, 0x2a, 0x4e, 0xf1, 0x12, 0xe2, 0xcc, 0xa6, 0xb8, 0xab, 0x2c, 0xf4, 0x64,
-	0xac, 0x87, 0x00, 0x3d, 0x9f, 0x75, 0xb1, 0xaf, 0x62, 0x8c, 0x5d, 0x42, 0x8c, 0xb2, 0xc6, 0x5b,
-	0xc3, 0x51, 0xf3, 0x2f, 0x45, 0x80, 0xac, 0x3b, 0xa3, 0xbb, 0x30, 0x99, 0x34, 0x77, 0xbd, 0x68,
-	0xd6, 0xf3, 0x67, 0x4b, 0x0b, 0xc6, 0xd5, 0xf4, 0xeb, 0x6d, 0x95, 0xbf, 0x76, 0x62, 0x88, 0x08,
-	0x4c, 0x76, 0xb1, 0x2f, 0xd9, 0x82, 0x55, 0x54, 0xad, 0xe2, 0x7a, 0xdb, 0x38, 0xc8, 0x0d, 0x6a,
-	0x1b, 0xee, 0xd7, 0x5e, 0x63, 0x34, 0x5c, 0xfd, 0x40, 0xce, 0xfb, 0xe9, 0x8b, 0x7a, 0xeb, 0x1c,
-	0xf3, 0x96, 0x0e, 0xdc, 0x4e, 0xb0, 0xd1, 0xdb, 0x50, 0x8e, 0x58, 0x2c, 0x9c, 0x10, 0x07, 0x44,
-	0xaf, 0x82, 0x3d, 0x25, 0x05, 0x9b, 0x38, 0x20, 0x68, 0xe9, 0x3b, 0xb9, 0x55, 0xf9, 0x2c, 0xb6,
-	0x74, 0x1b, 0xe6, 0x0c, 0x6c, 0xae, 0x4b, 0x4c, 0xa8, 0x2e, 0x31, 0x6b, 0x14, 0x69, 0x8b, 0x68,
-	0xfe, 0x06, 0xaa, 0xeb, 0x54, 0x1e, 0xda, 0xee, 0x40, 0xd5, 0x48, 0x0b, 0x26, 0xf7, 0xb0, 0xcf,
-	0x22, 0x12, 0x9b, 0x4c, 0x4d, 0x86, 0xe8, 0x1a, 0x94, 0x70, 0x20, 0xd7, 0x51, 0x65, 0xc2, 0xb8,
-	0x6d, 0x46, 0xcd, 0x67, 0x13, 0x30, 0xfb, 0x79, 0x3a, 0x09, 0x9b, 0xb8, 0x2c, 0x1e, 0x26, 0xa0,
-	0x85, 0x61, 0x02, 0xfa, 0x73, 0x28, 0x1b, 0x96, 0xc4, 0x62, 0x93, 0x54, 0xdf, 0xbd, 0x0f, 0x99,
-	0x29, 0xb2, 0xa1, 0xea, 0xe5, 0x66, 0x6a, 0x8d, 0xa9, 0xed, 0x68, 0x8f, 0x3e, 0xa6, 0xf9, 0xef,
-	0xb3, 0x87, 0x30, 0xe4, 0x5c, 0x62, 0xe2, 0xd2, 0x88, 0x4a, 0x2a, 0x30, 0x3e, 0x6a, 0x2e, 0xa9,
-	0x29, 0x72, 0xd3, 0xb5, 0x98, 0xb8, 0xfc, 0xa4, 0x30, 0xd0, 0xe8, 0x09, 0x54, 0xba, 0xb2, 0xaa,
-	0x99, 0x48, 0x9a, 0x8f, 0xbe, 0x26, 0xd2, 0xaf, 0xcd, 0xb1, 0xf9, 0xe9, 0x39, 0x23, 0x3d, 0x7f,
-	0xb6, 0x54, 0x31, 0x60, 0x72, 0x68, 0x83, 0x8c, 0xb6, 0xa2, 0x63, 0x5f, 0x83, 0x92, 0x38, 0x50,
-	0x3c, 0x41, 0xb3, 0x55, 0x33, 0x92, 0x72, 0x2e, 0xb0, 0x18, 0x70, 0xc5, 0x50, 0x27, 0x6c, 0x33,
-	0x42, 0x0f, 0xa0, 0xe6, 0xb2, 0x20, 0xf2, 0x89, 0xea, 0xfe, 0xf2, 0xf2, 0xa2, 0x28, 0x6a, 0xe5,
-	0xee, 0x8d, 0xb6, 0xbe, 0xd9, 0xb4, 0x93, 0x9b, 0x4d, 0x7b, 0x27, 0xb9, 0xd9, 0xac, 0x4e, 0xc9,
-	0x09, 0x7f, 0xf5, 0xa2, 0x5e, 0xb0, 0x67, 0x32, 0x67, 0xa9, 0x46, 0x37, 0x60, 0x2a, 0x26, 0x8f,
-	0x07, 0x64, 0x40, 0x3c, 0x45, 0x63, 0xa7, 0xec, 0x74, 0x8c, 0x9a, 0x50, 0xc5, 0xee, 0xa3, 0x90,
-	0xed, 0xfb, 0xc4, 0xeb, 0x11, 0x4f, 0x51, 0xcf, 0x29, 0x7b, 0x48, 0x26, 0x6b, 0xaa, 0xee, 0xe3,
-	0xe1, 0x20, 0xe8, 0x92, 0xd8, 0xaa, 0xca, 0x4e, 0x65, 0x57, 0x94, 0x6c, 0x53, 0x89, 0x9a, 0x7f,
-	0x2d, 0x42, 0xed, 0xb3, 0xa4, 0xeb, 0x8c, 0xce, 0xda, 0x93, 0x88, 0xc5, 0x53, 0x88, 0x32, 0x99,
-	0xd2, 0xf2, 0x66, 0x2a, 0xd9, 0x6b, 0x92, 0x29, 0x35, 0x95, 0x37, 0x84, 0x98, 0xf8, 0x58, 0x10,
-	0xcf, 0x31, 0x6b, 0x3e, 0xde, 0x18, 0x93, 0x37, 0x04, 0x23, 0xdd, 0xd1, 0x4b, 0xff, 0x38, 0x97,
-	0x73, 0x3f, 0x70, 0x26, 0x24, 0x47, 0xfb, 0x6f, 0x45, 0x40, 0x92, 0x90, 0x27, 0x77, 0x9a, 0x4b,
-	0x59, 0xa6, 0x0f, 0xa0, 0xc4, 0xd9, 0x20, 0x76, 0xc9, 0xc8, 0x35, 0x32, 0x76, 0xe8, 0x23, 0xa8,
-	0x78, 0x84, 0x0b, 0x1a, 0x6a, 0x2a, 0x38, 0xea, 0x9c, 0xe6, 0x8d, 0x73, 0x55, 0x6b, 0x42, 0x4d,
-	0x25, 0x39, 0x5c, 0x67, 0x24, 0x6c, 0xe9, 0xcd, 0x13, 0xb6, 0xf9, 0xbf, 0x02, 0xcc, 0xec, 0xc4,
-	0x38, 0xe4, 0xbb, 0x24, 0x36, 0xab, 0x24, 0xbf, 0x53, 0x93, 0x91, 0xc2, 0xc8, 0xef, 0xd4, 0xf4,
-	0x64, 0xa8, 0x1a, 0x15, 0xcf, 0x5f, 0x8d, 0xb2, 0xcc, 0x18, 0xfb, 0xb1, 0x32, 0xe3, 0xa8, 0x04,
-	0xe5, 0xf4, 0xce, 0x80, 0x56, 0xa0, 0x66, 0xba, 0x84, 0x73, 0xde, 0x06, 0x3b, 0x63, 0x1c, 0x56,
-	0xd2, 0x3e, 0x2b, 0xf7, 0x23, 0xa0, 0x9c, 0xa7, 0x77, 0xca, 0xcb, 0x20, 0x1c, 0x33, 0x19, 0xa8,
-	0xba, 0x4f, 0xf6, 0x24, 0xad, 0x34, 0x1d, 0xc5, 0xe1, 0x7d, 0x1c, 0x13, 0x7e, 0x29, 0xa4, 0xa3,
-	0x96, 0xa2, 0x6e, 0x2b, 0x50, 0xe4, 0x40, 0x75, 0x8f, 0x09, 0x45, 0xd4, 0xd9, 0x3e, 0x89, 0x4d,
-	0xd2, 0x5e, 0x24, 0xc8, 0x46, 0x28, 0x72, 0x41, 0x36, 0x42, 0x61, 0x57, 0x34, 0xe2, 0x96, 0x04,
-	0x44, 0x36, 0x4c, 0x70, 0x97, 0xc5, 0x44, 0xe5, 0xf5, 0xf7, 0x9d, 0xbe, 0x86, 0xca, 0x55, 0xf7,
-	0x92, 0xae, 0xfa, 0xa6, 0xba, 0x5f, 0x83, 0xd2, 0x97, 0x98, 0x4a, 0x0a, 0x3e, 0xa9, 0x8a, 0xad,
-	0x19, 0xa1, 0x45, 0x00, 0xc1, 0x82, 0x2e, 0x17, 0x2c, 0x24, 0x9e, 0xea, 0x08, 0x53, 0x76, 0x4e,
-	0x82, 0x3e, 0x86, 0xaa, 0xb6, 0x74, 0x38, 0x95, 0x0c, 0xea, 0x22, 0x2d, 0xa1, 0xa2, 0x3d, 0xb7,
-	0xa5, 0x23, 0xfa, 0x73, 0x01, 0xae, 0x9e, 0xa0, 0xa4, 0x66, 0xf3, 0xf4, 0x23, 0xc7, 0xe6, 0xc5,
-	0xbe, 0xfe, 0xff, 0x47, 0xf5, 0x9b, 0x87, 0x38, 0xf0, 0x3f, 0x6a, 0x9e, 0x09, 0xda, 0xb4, 0xe7,
-	0x87, 0x78, 0xaa, 0xd9, 0xd2, 0x47, 0x30, 0xad, 0xef, 0xe4, 0x49, 0x6c, 0xfd, 0xe8, 0xf1, 0xdb,
-	0x0b, 0xc7, 0x5e, 0xd0, 0xb1, 0x87, 0xc0, 0x9a, 0x76, 0x55, 0x8f, 0x75, 0xb0, 0xe6, 0xdf, 0x0b,
-	0x50, 0x5b, 0x4f, 0x72, 0xca, 0xbc, 0x25, 0x0c, 0x31, 0xa7, 0xc2, 0xf9, 0x99, 0x13, 0x86, 0x49,
-	0xfd, 0xda, 0xc1, 0x0d, 0x87, 0xbd, 0xb4, 0xe7, 0x8e, 0x04, 0xb7, 0xf9, 0xcf, 0x02, 0xd4, 0x4e,
-	0x68, 0xd1, 0xea, 0xc5, 0xab, 0xc2, 0x49, 0x07, 0x44, 0xa0, 0xb4, 0xaf, 0xef, 0xe9, 0xba, 0x1a,
-	0x3c, 0xb8, 0xf0, 0x62, 0x4f, 0xeb, 0xc5, 0xd6, 0x28, 0xcd, 0x13, 0x79, 0x5f, 0x4a, 0xc4, 0x45,
-	0x80, 0xf5, 0xb4, 0xcd, 0xa1, 0x8f, 0xcf, 0x7c, 0x10, 0x1c, 0x35, 0xf9, 0x33, 0x1e, 0xff, 0xee,
-	0xc3, 0x5c, 0x96, 0x61, 0x09, 0xce, 0xa8, 0xca, 0x9e, 0x5d, 0x92, 0x12, 0x98, 0x1f, 0xbf, 0xc0,
-	0xcb, 0x23, 0x6f, 0x1e, 0x48, 0xc6, 0x75, 0xdf, 0xd4, 0x23, 0x79, 0x2f, 0x8f, 0x73, 0x8c, 0xc0,
-	0x21, 0xa1, 0x67, 0x3a, 0x6b, 0x2d, 0x2f, 0xbf, 0x1f, 0x7a, 0xcd, 0x6d, 0x98, 0xdf, 0x62, 0xb1,
-	0x58, 0x4b, 0x1f, 0xa6, 0x77, 0x06, 0x91, 0x7f, 0xce, 0x07, 0xec, 0xb7, 0x60, 0x52, 0xdd, 0x87,
-	0xd2, 0xf7, 0xeb, 0x92, 0x1c, 0x6e, 0x78, 0xcd, 0xff, 0x14, 0x61, 0xd2, 0x26, 0x2e, 0xa1, 0x91,
-	0x78, 0x1d, 0x0f, 0xc9, 0x9a, 0x6f, 0xf1, 0x9c, 0xcd, 0x37, 0x63, 0xbc, 0x63, 0x43, 0x8c, 0x37,
-	0xa3, 0xfa, 0xe3, 0x3f, 0x1c, 0xd5, 0x5f, 0x03, 0xd8, 0xa5, 0x31, 0x17, 0x0e, 0x27, 0x24, 0x34,
-	0xfc, 0x6e, 0x54, 0x99, 0x2c, 0xa8, 0x32, 0x59, 0x56, 0x7e, 0xdb, 0x84, 0x84, 0x68, 0x15, 0xca,
-	0x86, 0x95, 0x10, 0xef, 0x9c, 0x64, 0xc6, 0x60, 0xa4, 0x6e, 0xab, 0x0f, 0xbf, 0x7e, 0xb9, 0x58,
-	0xf8, 0xe6, 0xe5, 0x62, 0xe1, 0xbf, 0x2f, 0x17, 0x0b, 0x5f, 0xbd, 0x5a, 0xbc, 0xf2, 0xcd, 0xab,
-	0xc5, 0x2b, 0xff, 0x7a, 0xb5, 0x78, 0xe5, 0x8b, 0x95, 0xdc, 0x47, 0xe5, 0xaa, 0xc7, 0xd2, 0x13,
-	0x16, 0x92, 0xbc, 0xa0, 0x73, 0x70, 0xc6, 0x3f, 0x5b, 0xd4, 0x37, 0x77, 0x4b, 0x6a, 0x16, 0x1f,
-	0x7e, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x36, 0x6d, 0xcd, 0xc0, 0x9a, 0x19, 0x00, 0x00,
+var xxx_messageInfo_CircuitBreakerTrip proto.InternalMessageInfo
+
+func (m *CircuitBreakerTrip) GetAction() CircuitBreakerAction {
+	if m != nil {
+		return m.Action
+	}
+	return CircuitBreakerActionUndefined
 }
 
-func (m *Zone) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CircuitBreakerTrip) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Zone) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CircuitBreakerTrip) GetTrippedBy() string {
+	if m != nil {
+		return m.TrippedBy
+	}
+	return ""
 }
 
-func (m *Zone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SubzoneInfo != nil {
-		{
-			size, err := m.SubzoneInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xea
+func (m *CircuitBreakerTrip) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.Is_118 {
-		i--
-		if m.Is_118 {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xe0
+	return 0
+}
+
+func (m *CircuitBreakerTrip) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if m.ReturnToSender {
-		i--
-		if m.ReturnToSender {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return time.Time{}
+}
+
+// CircuitBreaker holds the set of currently paused actions for a zone.
+type CircuitBreaker struct {
+	ChainId string               `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Trips   []CircuitBreakerTrip `protobuf:"bytes,2,rep,name=trips,proto3" json:"trips"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{16}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd8
+		return b[:n], nil
 	}
-	if m.DepositsEnabled {
-		i--
-		if m.DepositsEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd0
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.UnbondingEnabled {
-		i--
-		if m.UnbondingEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+func (m *CircuitBreaker) GetTrips() []CircuitBreakerTrip {
+	if m != nil {
+		return m.Trips
+	}
+	return nil
+}
+
+// SlashRecord records a slash of a host chain validator, and the loss it
+// caused to the zone's delegations.
+type SlashRecord struct {
+	ChainId   string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// height is the local block height at which the slash was detected.
+	Height int64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// fraction is the share of the validator's tokens removed by the slash.
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+	// expected_amount is the zone's delegation to the validator prior to the slash.
+	ExpectedAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=expected_amount,json=expectedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"expected_amount"`
+	// reported_amount is the zone's delegation to the validator after the slash.
+	// Until the record is reconciled this is estimated from fraction.
+	ReportedAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,7,opt,name=reported_amount,json=reportedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"reported_amount"`
+	// reconciled is set once reported_amount has been proven by a delegation
+	// query against the host chain.
+	Reconciled bool `protobuf:"varint,8,opt,name=reconciled,proto3" json:"reconciled,omitempty"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{17}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc8
+		return b[:n], nil
 	}
-	if m.Decimals != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Decimals))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc0
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.MessagesPerTx != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.MessagesPerTx))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb8
+	return ""
+}
+
+func (m *SlashRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	if m.UnbondingPeriod != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.UnbondingPeriod))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
+	return ""
+}
+
+func (m *SlashRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	{
-		size := m.Tvl.Size()
-		i -= size
-		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *SlashRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xaa
-	if m.LastEpochHeight != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.LastEpochHeight))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
-	}
-	if m.HoldingsAllocation != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.HoldingsAllocation))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
+	return time.Time{}
+}
+
+func (m *SlashRecord) GetReconciled() bool {
+	if m != nil {
+		return m.Reconciled
 	}
-	if m.ValidatorSelectionAllocation != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.ValidatorSelectionAllocation))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
+	return false
+}
+
+// SwapHop is a single pool traversed by a reward swap route.
+type SwapHop struct {
+	PoolId        uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+}
+
+func (m *SwapHop) Reset()         { *m = SwapHop{} }
+func (m *SwapHop) String() string { return proto.CompactTextString(m) }
+func (*SwapHop) ProtoMessage()    {}
+func (*SwapHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{18}
+}
+func (m *SwapHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.IbcNextValidatorsHash) > 0 {
-		i -= len(m.IbcNextValidatorsHash)
-		copy(dAtA[i:], m.IbcNextValidatorsHash)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.IbcNextValidatorsHash)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+}
+func (m *SwapHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapHop.Merge(m, src)
+}
+func (m *SwapHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapHop proto.InternalMessageInfo
+
+func (m *SwapHop) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if m.WithdrawalWaitgroup != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.WithdrawalWaitgroup))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+	return 0
+}
+
+func (m *SwapHop) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
 	}
-	if m.LiquidityModule {
-		i--
-		if m.LiquidityModule {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// RewardSwapRoute describes how a non-base reward denom is swapped into the
+// zone's base denom by the withdrawal account, using the host chain's gamm
+// module.
+type RewardSwapRoute struct {
+	Denom string    `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Hops  []SwapHop `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops"`
+	// max_slippage is the maximum fraction by which the swap output may fall
+	// short of the output calculated from the most recently queried pool state.
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage"`
+}
+
+func (m *RewardSwapRoute) Reset()         { *m = RewardSwapRoute{} }
+func (m *RewardSwapRoute) String() string { return proto.CompactTextString(m) }
+func (*RewardSwapRoute) ProtoMessage()    {}
+func (*RewardSwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{19}
+}
+func (m *RewardSwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardSwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardSwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x78
+		return b[:n], nil
 	}
-	if m.MultiSend {
-		i--
-		if m.MultiSend {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
+}
+func (m *RewardSwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardSwapRoute.Merge(m, src)
+}
+func (m *RewardSwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardSwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardSwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardSwapRoute proto.InternalMessageInfo
+
+func (m *RewardSwapRoute) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.AggregateIntent) > 0 {
-		for iNdEx := len(m.AggregateIntent) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AggregateIntent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6a
-		}
+	return ""
+}
+
+func (m *RewardSwapRoute) GetHops() []SwapHop {
+	if m != nil {
+		return m.Hops
 	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
+	return nil
+}
+
+// RewardSwapConfig holds the governance-approved reward swap routes for a
+// zone. Reward denoms without a route are not swapped.
+type RewardSwapConfig struct {
+	ChainId string            `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Routes  []RewardSwapRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+}
+
+func (m *RewardSwapConfig) Reset()         { *m = RewardSwapConfig{} }
+func (m *RewardSwapConfig) String() string { return proto.CompactTextString(m) }
+func (*RewardSwapConfig) ProtoMessage()    {}
+func (*RewardSwapConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{20}
+}
+func (m *RewardSwapConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardSwapConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardSwapConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	{
-		size := m.LastRedemptionRate.Size()
-		i -= size
-		if _, err := m.LastRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+}
+func (m *RewardSwapConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardSwapConfig.Merge(m, src)
+}
+func (m *RewardSwapConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardSwapConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardSwapConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardSwapConfig proto.InternalMessageInfo
+
+func (m *RewardSwapConfig) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.RedemptionRate.Size()
-		i -= size
-		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+func (m *RewardSwapConfig) GetRoutes() []RewardSwapRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+// SwapPool holds the most recently queried state of a host chain pool used by
+// a reward swap route.
+type SwapPool struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	PoolId  uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// pool is the interface-encoded pool, as returned by the host chain.
+	Pool        []byte    `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
+	LastUpdated time.Time `protobuf:"bytes,4,opt,name=last_updated,json=lastUpdated,proto3,stdtime" json:"last_updated"`
+}
+
+func (m *SwapPool) Reset()         { *m = SwapPool{} }
+func (m *SwapPool) String() string { return proto.CompactTextString(m) }
+func (*SwapPool) ProtoMessage()    {}
+func (*SwapPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{21}
+}
+func (m *SwapPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0x4a
+}
+func (m *SwapPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapPool.Merge(m, src)
+}
+func (m *SwapPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapPool proto.InternalMessageInfo
+
+func (m *SwapPool) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.LocalDenom) > 0 {
-		i -= len(m.LocalDenom)
-		copy(dAtA[i:], m.LocalDenom)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.LocalDenom)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *SwapPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if len(m.AccountPrefix) > 0 {
-		i -= len(m.AccountPrefix)
-		copy(dAtA[i:], m.AccountPrefix)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.AccountPrefix)))
-		i--
-		dAtA[i] = 0x3a
+	return 0
+}
+
+func (m *SwapPool) GetPool() []byte {
+	if m != nil {
+		return m.Pool
 	}
-	if m.DelegationAddress != nil {
-		{
-			size, err := m.DelegationAddress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *SwapPool) GetLastUpdated() time.Time {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return time.Time{}
+}
+
+// ZoneWindDown records the progress of a governance initiated zone wind-down.
+type ZoneWindDown struct {
+	ChainId string         `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Status  WindDownStatus `protobuf:"varint,2,opt,name=status,proto3,enum=quicksilver.interchainstaking.v1.WindDownStatus" json:"status,omitempty"`
+	// migration_connection_id, if set, is the connection on which the zone is
+	// registered once wound down.
+	MigrationConnectionId string    `protobuf:"bytes,3,opt,name=migration_connection_id,json=migrationConnectionId,proto3" json:"migration_connection_id,omitempty"`
+	StartedHeight         int64     `protobuf:"varint,4,opt,name=started_height,json=startedHeight,proto3" json:"started_height,omitempty"`
+	StartedAt             time.Time `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3,stdtime" json:"started_at"`
+	// final_redemption_rate is the rate at which all redemptions are paid once
+	// wind-down has started.
+	FinalRedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=final_redemption_rate,json=finalRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"final_redemption_rate"`
+	// deposits_enabled records whether the zone accepted deposits before
+	// wind-down, and is restored on migration.
+	DepositsEnabled bool `protobuf:"varint,7,opt,name=deposits_enabled,json=depositsEnabled,proto3" json:"deposits_enabled,omitempty"`
+	// unsettled_holders are holders of the zone's qAsset for whom no host
+	// chain address could be determined; they must redeem manually.
+	UnsettledHolders []string `protobuf:"bytes,8,rep,name=unsettled_holders,json=unsettledHolders,proto3" json:"unsettled_holders,omitempty"`
+	CompletedHeight  int64    `protobuf:"varint,9,opt,name=completed_height,json=completedHeight,proto3" json:"completed_height,omitempty"`
+}
+
+func (m *ZoneWindDown) Reset()         { *m = ZoneWindDown{} }
+func (m *ZoneWindDown) String() string { return proto.CompactTextString(m) }
+func (*ZoneWindDown) ProtoMessage()    {}
+func (*ZoneWindDown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{22}
+}
+func (m *ZoneWindDown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZoneWindDown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZoneWindDown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if m.PerformanceAddress != nil {
-		{
-			size, err := m.PerformanceAddress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *ZoneWindDown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneWindDown.Merge(m, src)
+}
+func (m *ZoneWindDown) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZoneWindDown) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneWindDown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneWindDown proto.InternalMessageInfo
+
+func (m *ZoneWindDown) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.WithdrawalAddress != nil {
-		{
-			size, err := m.WithdrawalAddress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *ZoneWindDown) GetStatus() WindDownStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.DepositAddress != nil {
-		{
-			size, err := m.DepositAddress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	return WindDownStatusActive
+}
+
+func (m *ZoneWindDown) GetMigrationConnectionId() string {
+	if m != nil {
+		return m.MigrationConnectionId
+	}
+	return ""
+}
+
+func (m *ZoneWindDown) GetStartedHeight() int64 {
+	if m != nil {
+		return m.StartedHeight
+	}
+	return 0
+}
+
+func (m *ZoneWindDown) GetStartedAt() time.Time {
+	if m != nil {
+		return m.StartedAt
+	}
+	return time.Time{}
+}
+
+func (m *ZoneWindDown) GetDepositsEnabled() bool {
+	if m != nil {
+		return m.DepositsEnabled
+	}
+	return false
+}
+
+func (m *ZoneWindDown) GetUnsettledHolders() []string {
+	if m != nil {
+		return m.UnsettledHolders
+	}
+	return nil
+}
+
+func (m *ZoneWindDown) GetCompletedHeight() int64 {
+	if m != nil {
+		return m.CompletedHeight
+	}
+	return 0
+}
+
+// ZoneSnapshot records the redemption rate and value of a zone at the end of
+// an epoch.
+type ZoneSnapshot struct {
+	ChainId        string                                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	EpochNumber    int64                                  `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Height         int64                                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp      time.Time                              `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	RedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=redemption_rate,json=redemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_rate"`
+	Supply         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply"`
+	// tvl is the value of the zone's qAsset supply, in the base denom.
+	Tvl github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=tvl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tvl"`
+	// apr is the annualised rate implied by the change in redemption rate since
+	// the previous snapshot; it is zero for the first snapshot of a zone.
+	Apr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=apr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"apr"`
+}
+
+func (m *ZoneSnapshot) Reset()         { *m = ZoneSnapshot{} }
+func (m *ZoneSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ZoneSnapshot) ProtoMessage()    {}
+func (*ZoneSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{23}
+}
+func (m *ZoneSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZoneSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZoneSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ZoneSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneSnapshot.Merge(m, src)
+}
+func (m *ZoneSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZoneSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneSnapshot proto.InternalMessageInfo
+
+func (m *ZoneSnapshot) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ZoneSnapshot) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SubzoneInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ZoneSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SubzoneInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ZoneSnapshot) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
 }
 
-func (m *SubzoneInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BaseChainID) > 0 {
-		i -= len(m.BaseChainID)
-		copy(dAtA[i:], m.BaseChainID)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.BaseChainID)))
-		i--
-		dAtA[i] = 0x12
+// EpochCaps are the optional limits on the qAssets minted for deposits to, and
+// burned by redemptions from, a zone in a single epoch. A zero cap is not
+// enforced.
+type EpochCaps struct {
+	// deposit_cap is the amount of qAssets that may be minted for deposits to
+	// the zone each epoch. Deposits that would exceed it are refunded.
+	DepositCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=deposit_cap,json=depositCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"deposit_cap"`
+	// redemption_cap is the amount of qAssets that may be unbonded for
+	// redemptions from the zone each epoch. Redemptions that would exceed it
+	// remain queued for a subsequent epoch.
+	RedemptionCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=redemption_cap,json=redemptionCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"redemption_cap"`
+	// address_cap is the amount of qAssets that a single address may mint, and
+	// separately redeem, each epoch.
+	AddressCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=address_cap,json=addressCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"address_cap"`
+}
+
+func (m *EpochCaps) Reset()         { *m = EpochCaps{} }
+func (m *EpochCaps) String() string { return proto.CompactTextString(m) }
+func (*EpochCaps) ProtoMessage()    {}
+func (*EpochCaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{24}
+}
+func (m *EpochCaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochCaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochCaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EpochCaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochCaps.Merge(m, src)
+}
+func (m *EpochCaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochCaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochCaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochCaps proto.InternalMessageInfo
+
+// EpochCapUsage is the amount of qAssets minted and redeemed against a zone's
+// epoch caps in an epoch, by the zone as a whole or by a single address.
+type EpochCapUsage struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// address is empty for the usage of the zone as a whole.
+	Address     string                                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	EpochNumber int64                                  `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Deposited   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=deposited,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"deposited"`
+	Redeemed    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=redeemed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"redeemed"`
+}
+
+func (m *EpochCapUsage) Reset()         { *m = EpochCapUsage{} }
+func (m *EpochCapUsage) String() string { return proto.CompactTextString(m) }
+func (*EpochCapUsage) ProtoMessage()    {}
+func (*EpochCapUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{25}
+}
+func (m *EpochCapUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochCapUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochCapUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EpochCapUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochCapUsage.Merge(m, src)
+}
+func (m *EpochCapUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochCapUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochCapUsage.DiscardUnknown(m)
 }
 
-func (m *LsmCaps) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_EpochCapUsage proto.InternalMessageInfo
+
+func (m *EpochCapUsage) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *EpochCapUsage) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EpochCapUsage) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("quicksilver.interchainstaking.v1.CircuitBreakerAction", CircuitBreakerAction_name, CircuitBreakerAction_value)
+	proto.RegisterEnum("quicksilver.interchainstaking.v1.WindDownStatus", WindDownStatus_name, WindDownStatus_value)
+	proto.RegisterType((*Zone)(nil), "quicksilver.interchainstaking.v1.Zone")
+	proto.RegisterType((*SubzoneInfo)(nil), "quicksilver.interchainstaking.v1.SubzoneInfo")
+	proto.RegisterType((*LsmCaps)(nil), "quicksilver.interchainstaking.v1.LsmCaps")
+	proto.RegisterType((*ICAAccount)(nil), "quicksilver.interchainstaking.v1.ICAAccount")
+	proto.RegisterType((*Distribution)(nil), "quicksilver.interchainstaking.v1.Distribution")
+	proto.RegisterType((*WithdrawalRecord)(nil), "quicksilver.interchainstaking.v1.WithdrawalRecord")
+	proto.RegisterType((*UnbondingRecord)(nil), "quicksilver.interchainstaking.v1.UnbondingRecord")
+	proto.RegisterType((*RedelegationRecord)(nil), "quicksilver.interchainstaking.v1.RedelegationRecord")
+	proto.RegisterType((*TransferRecord)(nil), "quicksilver.interchainstaking.v1.TransferRecord")
+	proto.RegisterType((*Validator)(nil), "quicksilver.interchainstaking.v1.Validator")
+	proto.RegisterType((*DelegatorIntent)(nil), "quicksilver.interchainstaking.v1.DelegatorIntent")
+	proto.RegisterType((*ValidatorIntent)(nil), "quicksilver.interchainstaking.v1.ValidatorIntent")
+	proto.RegisterType((*Delegation)(nil), "quicksilver.interchainstaking.v1.Delegation")
+	proto.RegisterType((*PortConnectionTuple)(nil), "quicksilver.interchainstaking.v1.PortConnectionTuple")
+	proto.RegisterType((*Receipt)(nil), "quicksilver.interchainstaking.v1.Receipt")
+	proto.RegisterType((*CircuitBreakerTrip)(nil), "quicksilver.interchainstaking.v1.CircuitBreakerTrip")
+	proto.RegisterType((*CircuitBreaker)(nil), "quicksilver.interchainstaking.v1.CircuitBreaker")
+	proto.RegisterType((*SlashRecord)(nil), "quicksilver.interchainstaking.v1.SlashRecord")
+	proto.RegisterType((*SwapHop)(nil), "quicksilver.interchainstaking.v1.SwapHop")
+	proto.RegisterType((*RewardSwapRoute)(nil), "quicksilver.interchainstaking.v1.RewardSwapRoute")
+	proto.RegisterType((*RewardSwapConfig)(nil), "quicksilver.interchainstaking.v1.RewardSwapConfig")
+	proto.RegisterType((*SwapPool)(nil), "quicksilver.interchainstaking.v1.SwapPool")
+	proto.RegisterType((*ZoneWindDown)(nil), "quicksilver.interchainstaking.v1.ZoneWindDown")
+	proto.RegisterType((*ZoneSnapshot)(nil), "quicksilver.interchainstaking.v1.ZoneSnapshot")
+	proto.RegisterType((*EpochCaps)(nil), "quicksilver.interchainstaking.v1.EpochCaps")
+	proto.RegisterType((*EpochCapUsage)(nil), "quicksilver.interchainstaking.v1.EpochCapUsage")
+}
+
+func init() {
+	proto.RegisterFile("quicksilver/interchainstaking/v1/interchainstaking.proto", fileDescriptor_0d755cfd37ef9fee)
+}
+
+var fileDescriptor_0d755cfd37ef9fee = []byte{
+	// 2954 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x5b, 0x6f, 0x1b, 0xc7,
+	0xf5, 0x37, 0xef, 0xe2, 0x21, 0x45, 0xd2, 0x63, 0xd9, 0x5e, 0xdf, 0x44, 0x85, 0xb9, 0x29, 0x17,
+	0x4b, 0x91, 0xf3, 0x87, 0xff, 0x6e, 0x10, 0x14, 0xd5, 0xc5, 0x89, 0xd5, 0xc6, 0x8e, 0xb0, 0x94,
+	0x9b, 0x36, 0x41, 0xb0, 0x18, 0xee, 0x8e, 0xc8, 0x8d, 0x96, 0x3b, 0xeb, 0xd9, 0xa1, 0x2e, 0x01,
+	0xda, 0x87, 0x16, 0x05, 0xfa, 0xd0, 0x87, 0xbc, 0x16, 0x45, 0x81, 0x02, 0x7d, 0x28, 0x10, 0x14,
+	0x7d, 0x4a, 0xfb, 0xd4, 0x0f, 0x90, 0x97, 0x02, 0x41, 0x9e, 0x8a, 0xa2, 0x50, 0x8a, 0xa4, 0x40,
+	0x01, 0x03, 0x7d, 0xc9, 0x27, 0x28, 0xe6, 0xb2, 0xbb, 0xa4, 0x48, 0x8b, 0xa2, 0x43, 0xe7, 0x49,
+	0x9c, 0x33, 0xe7, 0xfc, 0xce, 0xd9, 0x99, 0x33, 0xe7, 0x32, 0x23, 0xb8, 0xf5, 0xa0, 0xe7, 0xda,
+	0xbb, 0xa1, 0xeb, 0xed, 0x11, 0xb6, 0xec, 0xfa, 0x9c, 0x30, 0xbb, 0x83, 0x5d, 0x3f, 0xe4, 0x78,
+	0xd7, 0xf5, 0xdb, 0xcb, 0x7b, 0x2b, 0xc3, 0xc4, 0xa5, 0x80, 0x51, 0x4e, 0xd1, 0x42, 0x9f, 0xe4,
+	0xd2, 0x30, 0xd3, 0xde, 0xca, 0xe5, 0x79, 0x9b, 0x86, 0x5d, 0x1a, 0x2e, 0xb7, 0x70, 0x48, 0x96,
+	0xf7, 0x56, 0x5a, 0x84, 0xe3, 0x95, 0x65, 0x9b, 0xba, 0xbe, 0x42, 0xb8, 0x7c, 0x49, 0xcd, 0x5b,
+	0x72, 0xb4, 0xac, 0x06, 0x7a, 0x6a, 0xae, 0x4d, 0xdb, 0x54, 0xd1, 0xc5, 0x2f, 0x4d, 0xad, 0xb7,
+	0x29, 0x6d, 0x7b, 0x64, 0x59, 0x8e, 0x5a, 0xbd, 0x9d, 0x65, 0xee, 0x76, 0x49, 0xc8, 0x71, 0x37,
+	0x50, 0x0c, 0x8d, 0x7f, 0x57, 0x21, 0xfb, 0x2e, 0xf5, 0x09, 0x7a, 0x1a, 0x66, 0x6d, 0xea, 0xfb,
+	0xc4, 0xe6, 0x2e, 0xf5, 0x2d, 0xd7, 0x31, 0x52, 0x0b, 0xa9, 0xc5, 0xa2, 0x59, 0x4e, 0x88, 0x9b,
+	0x0e, 0xba, 0x04, 0x33, 0xd2, 0x64, 0x31, 0x9f, 0x96, 0xf3, 0x05, 0x39, 0xde, 0x74, 0xd0, 0x7d,
+	0xa8, 0x3a, 0x24, 0xa0, 0xa1, 0xcb, 0x2d, 0xec, 0x38, 0x8c, 0x84, 0xa1, 0x91, 0x59, 0x48, 0x2d,
+	0x96, 0x6e, 0xbc, 0xbc, 0x34, 0xee, 0xb3, 0x97, 0x36, 0xd7, 0x57, 0x57, 0x6d, 0x9b, 0xf6, 0x7c,
+	0x6e, 0x56, 0x34, 0xc8, 0xaa, 0xc2, 0x40, 0xef, 0x01, 0xda, 0x77, 0x79, 0xc7, 0x61, 0x78, 0x1f,
+	0x7b, 0x31, 0x72, 0xf6, 0x31, 0x90, 0xcf, 0x26, 0x38, 0x11, 0xf8, 0xfb, 0x70, 0x2e, 0x20, 0x6c,
+	0x87, 0xb2, 0x2e, 0xf6, 0x6d, 0x12, 0xa3, 0xe7, 0x1e, 0x03, 0x1d, 0xf5, 0x01, 0xf5, 0xd9, 0xee,
+	0x10, 0x8f, 0xb4, 0xb1, 0x5c, 0xd2, 0x08, 0x3d, 0xff, 0x38, 0xb6, 0x27, 0x38, 0x11, 0xf8, 0xb3,
+	0x50, 0xc1, 0x6a, 0xd6, 0x0a, 0x18, 0xd9, 0x71, 0x0f, 0x8c, 0x82, 0xdc, 0x90, 0x59, 0x4d, 0xdd,
+	0x92, 0x44, 0x54, 0x87, 0x92, 0x47, 0x6d, 0xec, 0x59, 0x0e, 0xf1, 0x69, 0xd7, 0x98, 0x91, 0x3c,
+	0x20, 0x49, 0x1b, 0x82, 0x82, 0xae, 0x01, 0x08, 0x6f, 0xd3, 0xf3, 0x45, 0x39, 0x5f, 0x14, 0x14,
+	0x35, 0x4d, 0xa0, 0xca, 0x88, 0x43, 0xba, 0x81, 0xfc, 0x06, 0x86, 0x39, 0x31, 0x40, 0xf0, 0xac,
+	0xbd, 0xfe, 0xe9, 0x51, 0xfd, 0xcc, 0x3f, 0x8e, 0xea, 0xcf, 0xb5, 0x5d, 0xde, 0xe9, 0xb5, 0x96,
+	0x6c, 0xda, 0xd5, 0x0e, 0xa9, 0xff, 0x5c, 0x0f, 0x9d, 0xdd, 0x65, 0x7e, 0x18, 0x90, 0x70, 0x69,
+	0x83, 0xd8, 0x9f, 0x7f, 0x72, 0x1d, 0x14, 0x5d, 0x8c, 0xcc, 0x4a, 0x02, 0x6a, 0x62, 0x4e, 0x90,
+	0x0f, 0x73, 0x1e, 0x0e, 0xb9, 0x75, 0x5c, 0x57, 0x69, 0x0a, 0xba, 0x90, 0x40, 0x36, 0x07, 0xf5,
+	0xfd, 0x00, 0x60, 0x0f, 0x7b, 0xae, 0x83, 0x39, 0x65, 0xa1, 0x51, 0x5e, 0xc8, 0x2c, 0x96, 0x6e,
+	0xbc, 0x34, 0x7e, 0x4b, 0x7e, 0x18, 0xc9, 0x98, 0x7d, 0xe2, 0x88, 0x41, 0x0d, 0xb7, 0xdb, 0x4c,
+	0x6c, 0x10, 0xb1, 0x84, 0x9c, 0xcf, 0x8d, 0x59, 0x09, 0xb9, 0x32, 0x01, 0xe4, 0xa6, 0x14, 0x5c,
+	0x9b, 0xfb, 0xf8, 0x8b, 0x7a, 0xed, 0x18, 0x31, 0x34, 0xab, 0xb1, 0x02, 0x45, 0x11, 0xdb, 0xd6,
+	0xed, 0x79, 0xdc, 0xb5, 0x42, 0xe2, 0x3b, 0x46, 0x65, 0x21, 0xb5, 0x38, 0x63, 0x16, 0x25, 0xa5,
+	0x49, 0x7c, 0x07, 0xbd, 0x00, 0x35, 0xcf, 0x7d, 0xd0, 0x73, 0x1d, 0x97, 0x1f, 0x5a, 0x5d, 0xea,
+	0xf4, 0x3c, 0x62, 0x54, 0x25, 0x53, 0x35, 0xa6, 0xdf, 0x95, 0x64, 0xb4, 0x02, 0x73, 0x7d, 0x27,
+	0x6c, 0x1f, 0xbb, 0xbc, 0xcd, 0x68, 0x2f, 0x30, 0x6a, 0x0b, 0xa9, 0xc5, 0x59, 0xf3, 0x5c, 0x32,
+	0xf7, 0x4e, 0x34, 0x85, 0xfe, 0x1f, 0x0c, 0xb7, 0x65, 0x5b, 0x3e, 0x39, 0xe0, 0x56, 0xb2, 0x0e,
+	0x56, 0x07, 0x87, 0x1d, 0xe3, 0xec, 0x42, 0x6a, 0xb1, 0x6c, 0x9e, 0x77, 0x5b, 0xf6, 0x3d, 0x72,
+	0xc0, 0xe3, 0x0f, 0x09, 0xef, 0xe0, 0xb0, 0x83, 0x0e, 0x61, 0x3e, 0xe6, 0xb7, 0x42, 0xe2, 0xe9,
+	0x68, 0x83, 0x3d, 0xe1, 0x90, 0xe2, 0xa7, 0x81, 0x16, 0x52, 0x8b, 0xd9, 0xb5, 0x57, 0x1f, 0x1e,
+	0xd5, 0x97, 0x4f, 0xe6, 0x7c, 0x39, 0xe4, 0xcc, 0xf5, 0xdb, 0x2f, 0xd3, 0xae, 0xcb, 0xc5, 0xce,
+	0x1e, 0x9a, 0x57, 0x63, 0x81, 0x66, 0xc4, 0xbf, 0x1a, 0xb3, 0xa3, 0x1f, 0xc3, 0xb9, 0x0e, 0xf5,
+	0x1c, 0xd7, 0x6f, 0x87, 0xfd, 0xfa, 0xce, 0x49, 0x7d, 0x8b, 0x0f, 0x8f, 0xea, 0xcf, 0x8c, 0x98,
+	0x1e, 0x56, 0x82, 0x22, 0xae, 0x3e, 0x68, 0x13, 0xce, 0x4a, 0xe7, 0x25, 0x01, 0xb5, 0x3b, 0x56,
+	0x87, 0xb8, 0xed, 0x0e, 0x37, 0xe6, 0x16, 0x52, 0x8b, 0x99, 0xb5, 0xe7, 0x1e, 0x1e, 0xd5, 0x1b,
+	0x43, 0x93, 0xc3, 0xb0, 0x55, 0xc1, 0x73, 0x5b, 0xb0, 0xdc, 0x91, 0x1c, 0xe8, 0x1e, 0x64, 0xf8,
+	0x9e, 0x67, 0x9c, 0x9f, 0x82, 0xff, 0x0b, 0x20, 0xb4, 0x05, 0xb5, 0x9e, 0xdf, 0xa2, 0xbe, 0xb0,
+	0xdd, 0x0a, 0x08, 0x73, 0xa9, 0x63, 0x5c, 0x90, 0x26, 0x3e, 0xfb, 0xf0, 0xa8, 0xfe, 0xd4, 0xf1,
+	0xb9, 0x11, 0x16, 0xc6, 0x2c, 0x5b, 0x92, 0x03, 0xbd, 0x05, 0xd5, 0x2e, 0x09, 0x43, 0xdc, 0x26,
+	0xa1, 0x10, 0xb2, 0xf8, 0x81, 0x71, 0x51, 0x02, 0x3e, 0xf3, 0xf0, 0xa8, 0xbe, 0x70, 0x6c, 0x6a,
+	0x18, 0x6f, 0x36, 0xe2, 0xd8, 0x22, 0x6c, 0xfb, 0x00, 0x7d, 0x07, 0x66, 0x1c, 0x62, 0xbb, 0x5d,
+	0xec, 0x85, 0x86, 0x21, 0x61, 0xae, 0x3d, 0x3c, 0xaa, 0x5f, 0x8a, 0x68, 0xc3, 0xf2, 0x31, 0x3b,
+	0x7a, 0x09, 0xce, 0x26, 0xe6, 0x13, 0x1f, 0xb7, 0x3c, 0xe2, 0x18, 0x97, 0xa4, 0xb3, 0x27, 0xdf,
+	0x7c, 0x5b, 0xd1, 0xc5, 0xc1, 0xd0, 0x19, 0x26, 0x8c, 0x79, 0x2f, 0xab, 0x83, 0x11, 0xd1, 0x23,
+	0xd6, 0x45, 0xa8, 0x31, 0xc2, 0x7b, 0xcc, 0xb7, 0x38, 0x95, 0xc7, 0x8c, 0x30, 0xe3, 0x8a, 0x64,
+	0xad, 0x28, 0xfa, 0x36, 0x6d, 0x4a, 0x2a, 0x3a, 0x0f, 0x79, 0x37, 0xb4, 0x56, 0x56, 0x6e, 0x19,
+	0x57, 0xe5, 0x7c, 0xce, 0x0d, 0x57, 0x56, 0x6e, 0xa1, 0xb7, 0xa1, 0x14, 0xf6, 0x5a, 0x1f, 0x52,
+	0x9f, 0x6c, 0xfa, 0x3b, 0xd4, 0xb8, 0x26, 0x03, 0xff, 0xf5, 0xf1, 0x21, 0xa1, 0x99, 0x08, 0x99,
+	0xfd, 0x08, 0xe8, 0x16, 0x18, 0xc7, 0x02, 0xa4, 0xd5, 0xf3, 0x6d, 0x1c, 0x04, 0xc4, 0x31, 0xe6,
+	0xa5, 0xe6, 0x0b, 0x83, 0x71, 0xf5, 0xbe, 0x9e, 0x6d, 0xdc, 0x83, 0x52, 0x1f, 0x2a, 0xba, 0x0a,
+	0x45, 0xdc, 0xe3, 0x1d, 0xca, 0x5c, 0x7e, 0xa8, 0x13, 0x7d, 0x42, 0x40, 0x4f, 0x41, 0x59, 0xa6,
+	0x04, 0x95, 0xda, 0x37, 0x74, 0xa6, 0x2f, 0x09, 0xda, 0xba, 0x22, 0x35, 0xfe, 0x9c, 0x86, 0xc2,
+	0x5b, 0x61, 0x77, 0x1d, 0x07, 0x21, 0xc2, 0x30, 0x9b, 0x1c, 0x55, 0x1b, 0x07, 0x46, 0x6a, 0x0a,
+	0x4e, 0x5b, 0x8e, 0x21, 0xd7, 0x71, 0x80, 0x3e, 0x00, 0x94, 0xa8, 0x10, 0x3b, 0x2a, 0xf5, 0xa4,
+	0xa7, 0xa0, 0xa7, 0x16, 0xe3, 0xae, 0x51, 0xdf, 0x11, 0xba, 0xde, 0x03, 0x68, 0x7b, 0xb4, 0x85,
+	0x3d, 0xa9, 0x23, 0x33, 0x05, 0x1d, 0x45, 0x85, 0xb7, 0x8e, 0x83, 0xc6, 0xef, 0xd2, 0x00, 0x49,
+	0x5e, 0x47, 0x37, 0xa0, 0x10, 0x95, 0x05, 0x6a, 0xd1, 0x8c, 0xcf, 0x3f, 0xb9, 0x3e, 0xa7, 0x45,
+	0x75, 0xa6, 0x6f, 0x4a, 0xcf, 0x37, 0x23, 0x46, 0x44, 0xa0, 0xd0, 0xc2, 0x9e, 0xa8, 0x33, 0x8c,
+	0xb4, 0x4c, 0x32, 0x97, 0x96, 0xb4, 0x80, 0xd8, 0xa0, 0x25, 0x5d, 0x35, 0x2e, 0xad, 0x53, 0xd7,
+	0x5f, 0x7b, 0x45, 0xd8, 0xfd, 0xf1, 0x17, 0xf5, 0xc5, 0x53, 0xd8, 0x2d, 0x04, 0x42, 0x33, 0xc2,
+	0x46, 0x57, 0xa0, 0x18, 0x50, 0xc6, 0x2d, 0x1f, 0x77, 0x89, 0x5a, 0x05, 0x73, 0x46, 0x10, 0xee,
+	0xe1, 0x2e, 0x41, 0xd7, 0x1f, 0x59, 0x95, 0x15, 0x47, 0xd5, 0x59, 0x2f, 0xc1, 0x59, 0x0d, 0xdb,
+	0x97, 0x5f, 0x72, 0x32, 0xbf, 0xd4, 0xf4, 0x44, 0x9c, 0x5c, 0x1a, 0xdf, 0x83, 0xf2, 0x86, 0x2b,
+	0x8e, 0x7b, 0xab, 0x27, 0xa3, 0xab, 0x01, 0x85, 0x3d, 0xec, 0xd1, 0x80, 0x30, 0xed, 0xa9, 0xd1,
+	0x10, 0x5d, 0x80, 0x3c, 0xee, 0x8a, 0x75, 0x94, 0x9e, 0x90, 0x35, 0xf5, 0xa8, 0xf1, 0x75, 0x0e,
+	0x6a, 0xef, 0xc4, 0x46, 0x98, 0xc4, 0xa6, 0x6c, 0xb0, 0x74, 0x4d, 0x0d, 0x96, 0xae, 0x37, 0xa1,
+	0xa8, 0xeb, 0x2b, 0xca, 0x8c, 0xf4, 0x98, 0x7d, 0x48, 0x58, 0x91, 0x09, 0x65, 0xa7, 0xcf, 0x52,
+	0x23, 0x23, 0xb7, 0x63, 0x69, 0xfc, 0x01, 0xef, 0xff, 0x3e, 0x73, 0x00, 0x43, 0xd8, 0xc2, 0x88,
+	0xed, 0x06, 0xae, 0x28, 0x22, 0xb2, 0xe3, 0x6c, 0x89, 0x59, 0x91, 0x1d, 0xaf, 0x45, 0x6e, 0xfa,
+	0x4e, 0xa1, 0xa1, 0xd1, 0x87, 0x50, 0x6a, 0x89, 0x78, 0xa8, 0x35, 0xa9, 0x4a, 0xf6, 0x04, 0x4d,
+	0xdf, 0xd5, 0xc7, 0xe6, 0xf9, 0x53, 0x6a, 0xfa, 0xfc, 0x93, 0xeb, 0x25, 0x0d, 0x26, 0x86, 0x26,
+	0x08, 0x6d, 0xab, 0x4a, 0xf7, 0x05, 0xc8, 0xf3, 0x03, 0x59, 0x61, 0xa8, 0x3a, 0x57, 0x8f, 0x04,
+	0x3d, 0xe4, 0x98, 0xf7, 0x42, 0x59, 0xdb, 0xe6, 0x4c, 0x3d, 0x42, 0x77, 0xa1, 0x6a, 0xd3, 0x6e,
+	0xe0, 0x11, 0x19, 0x2b, 0xb9, 0xdb, 0x25, 0xb2, 0xb8, 0x2d, 0xdd, 0xb8, 0xbc, 0xa4, 0x7a, 0xa2,
+	0xa5, 0xa8, 0x27, 0x5a, 0xda, 0x8e, 0x7a, 0xa2, 0xb5, 0x19, 0x61, 0xf0, 0x47, 0x5f, 0xd4, 0x53,
+	0x66, 0x25, 0x11, 0x16, 0xd3, 0xe8, 0x32, 0xcc, 0x30, 0xf2, 0xa0, 0x47, 0x7a, 0xc4, 0x91, 0x05,
+	0xf0, 0x8c, 0x19, 0x8f, 0x51, 0x03, 0xca, 0xd8, 0xde, 0xf5, 0xe9, 0xbe, 0x47, 0x9c, 0x36, 0x71,
+	0x64, 0xd1, 0x3a, 0x63, 0x0e, 0xd0, 0x44, 0x4c, 0x55, 0x15, 0x80, 0xdf, 0xeb, 0xb6, 0x08, 0x33,
+	0xca, 0x22, 0xc7, 0x99, 0x25, 0x49, 0xbb, 0x27, 0x49, 0xe8, 0x75, 0x28, 0x07, 0xcc, 0x95, 0x21,
+	0xd8, 0xda, 0x21, 0xc4, 0x98, 0x1d, 0xb3, 0xbc, 0x66, 0x29, 0x62, 0x7f, 0x83, 0x90, 0xc6, 0xaf,
+	0x33, 0x50, 0xbd, 0x1f, 0x65, 0xbb, 0xf1, 0x3e, 0x7f, 0xdc, 0x9e, 0xf4, 0xb0, 0x3d, 0x37, 0xa1,
+	0x18, 0x07, 0x47, 0x23, 0x33, 0xce, 0x15, 0x63, 0x56, 0xd1, 0x99, 0x30, 0xe2, 0x61, 0x4e, 0x1c,
+	0x4b, 0xef, 0x58, 0x76, 0x21, 0x23, 0x3a, 0x13, 0x4d, 0xdd, 0x56, 0x1b, 0xf7, 0xa0, 0xcf, 0x63,
+	0x9f, 0xb0, 0x1f, 0x45, 0xfe, 0x3b, 0xc2, 0x27, 0xf2, 0xdf, 0xc0, 0x27, 0x9e, 0x87, 0xaa, 0xcd,
+	0x88, 0xea, 0xee, 0x74, 0xd5, 0x57, 0x90, 0xcb, 0x58, 0x89, 0xc8, 0xaa, 0x98, 0x6b, 0xfc, 0x21,
+	0x0d, 0xc8, 0x24, 0x3a, 0x70, 0x88, 0x33, 0x3f, 0x8d, 0xed, 0x79, 0x05, 0xf2, 0x21, 0xed, 0x31,
+	0x9b, 0x8c, 0xdd, 0x1b, 0xcd, 0x87, 0x5e, 0x83, 0x92, 0x43, 0x42, 0xee, 0xfa, 0xaa, 0xf4, 0x1d,
+	0x17, 0x5d, 0xfa, 0x99, 0xd1, 0x85, 0x81, 0xdd, 0xca, 0x3c, 0xa1, 0x25, 0x6d, 0xfc, 0x37, 0x05,
+	0x95, 0x6d, 0x86, 0xfd, 0x70, 0x87, 0x30, 0xbd, 0x4a, 0xe2, 0x3b, 0x55, 0xf1, 0x95, 0x1a, 0xfb,
+	0x9d, 0x92, 0x6f, 0x30, 0x86, 0xa6, 0x4f, 0x1f, 0x43, 0x13, 0x8f, 0xcc, 0x7c, 0x4b, 0x1e, 0xd9,
+	0x38, 0xca, 0x43, 0x31, 0xee, 0x91, 0xd0, 0x2a, 0x54, 0x75, 0x6e, 0xb3, 0x4e, 0x5b, 0x16, 0x54,
+	0xb4, 0xc0, 0x6a, 0x5c, 0x1d, 0x88, 0xfd, 0xe8, 0xba, 0x61, 0x18, 0xf7, 0xd0, 0xd3, 0x28, 0x93,
+	0x2a, 0x09, 0xa8, 0xec, 0x9f, 0xdb, 0x50, 0xd3, 0xee, 0x2c, 0xda, 0xb3, 0x0e, 0x66, 0x24, 0x9c,
+	0x4a, 0xa9, 0x54, 0x8d, 0x51, 0x9b, 0x12, 0x14, 0x59, 0x50, 0xde, 0xa3, 0x5c, 0x36, 0x26, 0x74,
+	0x9f, 0x30, 0x23, 0x3b, 0xb1, 0x92, 0x4d, 0x9f, 0xf7, 0x29, 0xd9, 0xf4, 0xb9, 0x59, 0x52, 0x88,
+	0x5b, 0x02, 0x10, 0x99, 0x90, 0x0b, 0x6d, 0xca, 0x88, 0x91, 0x9b, 0x18, 0x79, 0xd8, 0x7c, 0x05,
+	0xd5, 0x97, 0x93, 0xf2, 0x2a, 0x57, 0xa9, 0x91, 0xa0, 0x7f, 0x80, 0x5d, 0xd1, 0x72, 0x14, 0x64,
+	0x8a, 0xd0, 0x23, 0x34, 0x0f, 0xc0, 0x69, 0xb7, 0x15, 0x72, 0xea, 0x13, 0x47, 0xe6, 0xb1, 0x19,
+	0xb3, 0x8f, 0x82, 0xde, 0x84, 0xb2, 0xe2, 0xb4, 0x42, 0xd7, 0xb7, 0x27, 0x4b, 0x64, 0x25, 0x25,
+	0xd9, 0x14, 0x82, 0xe8, 0x67, 0x29, 0x38, 0x7f, 0xac, 0x90, 0xd6, 0x9b, 0xa7, 0x2e, 0x75, 0xee,
+	0x4d, 0xf6, 0xf5, 0x5f, 0x1f, 0xd5, 0xaf, 0x1e, 0xe2, 0xae, 0xf7, 0x5a, 0x63, 0x24, 0x68, 0xc3,
+	0x3c, 0x37, 0x50, 0x5d, 0xeb, 0x2d, 0xdd, 0x85, 0x59, 0x75, 0x07, 0x11, 0xe9, 0x56, 0x97, 0x3c,
+	0x6f, 0x4c, 0xac, 0x7b, 0x4e, 0xe9, 0x1e, 0x00, 0x6b, 0x98, 0x65, 0x35, 0x56, 0xca, 0x1a, 0x7f,
+	0x4c, 0x41, 0x75, 0x23, 0xf2, 0x29, 0x7d, 0x77, 0x32, 0x50, 0xef, 0xa5, 0x4e, 0x5f, 0xef, 0x61,
+	0x28, 0xa8, 0xdb, 0x9d, 0xd0, 0x48, 0x4f, 0xf7, 0x7a, 0x27, 0xc2, 0x6d, 0xfc, 0x35, 0x05, 0xd5,
+	0x63, 0xb3, 0x68, 0x6d, 0xf2, 0xa8, 0x70, 0x5c, 0x00, 0x11, 0xc8, 0xef, 0xab, 0x0c, 0xa5, 0xa2,
+	0xc1, 0xdd, 0x89, 0x17, 0x7b, 0x56, 0x2d, 0xb6, 0x42, 0x69, 0x1c, 0xf3, 0xfb, 0x7c, 0x44, 0x4e,
+	0x03, 0x6c, 0xc4, 0x69, 0x0e, 0xbd, 0x39, 0xf2, 0x02, 0x74, 0x9c, 0xf1, 0x23, 0x2e, 0x3b, 0x6f,
+	0xc3, 0xd9, 0xc4, 0xc3, 0x22, 0x9c, 0x71, 0x91, 0x3d, 0x69, 0xed, 0x22, 0x98, 0x6f, 0x3f, 0xc0,
+	0x8b, 0x23, 0xaf, 0x4b, 0x83, 0xac, 0xca, 0x9b, 0x6a, 0x24, 0xee, 0x21, 0x58, 0x5f, 0x45, 0x60,
+	0x89, 0x5b, 0x3c, 0x95, 0x59, 0xab, 0xfd, 0xf4, 0xdb, 0xbe, 0xd3, 0x68, 0xc2, 0xb9, 0x2d, 0xca,
+	0xf8, 0x7a, 0x7c, 0x11, 0xbf, 0xdd, 0x0b, 0xbc, 0x53, 0x5e, 0xd8, 0x5f, 0x84, 0x82, 0xec, 0xe2,
+	0xe2, 0xfb, 0xfa, 0xbc, 0x18, 0x6e, 0x3a, 0x8d, 0x7f, 0xa6, 0xa1, 0x60, 0x12, 0x9b, 0xb8, 0x01,
+	0x3f, 0xa9, 0x0e, 0x49, 0x92, 0x6f, 0xfa, 0x94, 0xc9, 0x37, 0xa9, 0xd3, 0x33, 0x03, 0x75, 0x7a,
+	0xd2, 0xa0, 0x64, 0x9f, 0x5c, 0x83, 0xb2, 0x0e, 0xb0, 0xe3, 0xb2, 0x90, 0x5b, 0x21, 0x21, 0xbe,
+	0x91, 0x3b, 0x55, 0x98, 0x4c, 0xc9, 0x30, 0x59, 0x94, 0x72, 0x4d, 0x42, 0x7c, 0xb4, 0x06, 0x45,
+	0x5d, 0x95, 0x10, 0xc7, 0xc8, 0x4f, 0x82, 0x11, 0x8b, 0x89, 0x3a, 0x06, 0xad, 0xbb, 0xcc, 0xee,
+	0xb9, 0x7c, 0x8d, 0x11, 0xbc, 0x4b, 0xd8, 0x36, 0x73, 0x03, 0x74, 0x0f, 0xf2, 0x58, 0x6e, 0x8d,
+	0x5c, 0xe7, 0xca, 0x8d, 0x9b, 0xe3, 0x03, 0xc8, 0x20, 0xca, 0xaa, 0x94, 0x36, 0x35, 0x8a, 0x58,
+	0x6c, 0x46, 0x70, 0x48, 0xfd, 0x68, 0x77, 0xd5, 0x48, 0xdc, 0x0e, 0x73, 0xe6, 0x8a, 0x9b, 0x1f,
+	0xab, 0x75, 0xa8, 0x37, 0xa2, 0xa8, 0x29, 0x6b, 0x87, 0x8f, 0x74, 0xca, 0x5b, 0x90, 0x95, 0x15,
+	0x5c, 0x6e, 0x82, 0xfc, 0x22, 0x25, 0x1a, 0x3f, 0x81, 0xca, 0xa0, 0xa1, 0x27, 0x39, 0xd5, 0x16,
+	0xe4, 0x84, 0x2d, 0x51, 0x14, 0xfd, 0xbf, 0x49, 0x17, 0x41, 0x2c, 0xe5, 0x5a, 0x56, 0x58, 0x60,
+	0x2a, 0xa0, 0xc6, 0x5f, 0xb2, 0x50, 0x6a, 0x7a, 0x38, 0xec, 0x9c, 0xaa, 0xd9, 0x4f, 0xba, 0x9a,
+	0xf4, 0xe9, 0xbb, 0x9a, 0x64, 0xcd, 0x32, 0x23, 0xd7, 0x2c, 0x3b, 0xe9, 0x9a, 0xa1, 0x1f, 0xc1,
+	0xcc, 0x0e, 0xd3, 0xee, 0x30, 0x8d, 0xe2, 0x23, 0x46, 0x13, 0x69, 0xbe, 0x4a, 0x0e, 0x02, 0x62,
+	0x8b, 0x1e, 0xec, 0xdb, 0x6a, 0xd6, 0x2b, 0x91, 0x46, 0xdd, 0xb0, 0x0b, 0x23, 0x18, 0x11, 0xe1,
+	0x26, 0x31, 0xa2, 0xf0, 0xc4, 0x8d, 0x88, 0x34, 0x6a, 0x23, 0xe6, 0x01, 0x18, 0xb1, 0xa9, 0x6f,
+	0xbb, 0x5e, 0x52, 0x59, 0x25, 0x94, 0xc6, 0xf7, 0xa1, 0xd0, 0xdc, 0xc7, 0xc1, 0x1d, 0x1a, 0xa8,
+	0x50, 0x49, 0xbd, 0xc8, 0x65, 0xb2, 0x22, 0x54, 0x52, 0x6f, 0xd3, 0x41, 0xcf, 0x41, 0x95, 0xd3,
+	0x5d, 0xe2, 0x5b, 0xb4, 0xc7, 0xf5, 0x33, 0x99, 0x3a, 0x6d, 0xb3, 0x92, 0xfc, 0x76, 0x8f, 0xcb,
+	0xa7, 0xb2, 0xc6, 0xdf, 0x52, 0x50, 0x35, 0xc9, 0x3e, 0x66, 0x8e, 0x80, 0x34, 0x69, 0x8f, 0x13,
+	0x34, 0x07, 0x39, 0x25, 0xa1, 0xbc, 0x50, 0x0d, 0xd0, 0x3a, 0x64, 0x3b, 0x34, 0xf6, 0xff, 0x17,
+	0x4e, 0x71, 0x23, 0xac, 0x6c, 0xd4, 0x4e, 0x2f, 0x85, 0x45, 0x65, 0xdc, 0xc5, 0x07, 0x56, 0xe8,
+	0xb9, 0x41, 0x80, 0xdb, 0x64, 0x2a, 0xe5, 0x77, 0xa9, 0x8b, 0x0f, 0x9a, 0x1a, 0xb0, 0xf1, 0x53,
+	0xa8, 0x25, 0x9f, 0xb3, 0x4e, 0xfd, 0x1d, 0xb7, 0x7d, 0xd2, 0xc1, 0x7a, 0x1b, 0xf2, 0x4c, 0x7c,
+	0xf3, 0x04, 0xc5, 0xd1, 0xb1, 0xd5, 0xd2, 0x9f, 0xa7, 0x61, 0x1a, 0xbf, 0x49, 0xc1, 0x8c, 0x98,
+	0xdb, 0xa2, 0xd4, 0x3b, 0x49, 0x71, 0xdf, 0xc6, 0xa5, 0x07, 0x36, 0x0e, 0x41, 0x56, 0xfc, 0x92,
+	0x2b, 0x53, 0x36, 0xe5, 0x6f, 0x51, 0x4a, 0xcb, 0xe7, 0x98, 0x5e, 0xe0, 0x60, 0x11, 0xdf, 0x27,
+	0x39, 0xb6, 0x25, 0x21, 0x79, 0x5f, 0x09, 0x36, 0x7e, 0x95, 0x85, 0xb2, 0x78, 0x38, 0x7f, 0xc7,
+	0xf5, 0x9d, 0x0d, 0xba, 0xef, 0x9f, 0x64, 0xe1, 0x9d, 0xb8, 0x1f, 0x48, 0xcb, 0xb0, 0xff, 0xca,
+	0xf8, 0xa5, 0x89, 0x60, 0x9b, 0x52, 0x2e, 0xee, 0x20, 0x6e, 0xc2, 0xc5, 0xae, 0xdb, 0x66, 0xaa,
+	0x66, 0x18, 0x4c, 0xff, 0x2a, 0xca, 0x9f, 0x8f, 0xa7, 0xd7, 0xfb, 0xeb, 0x80, 0x67, 0xa1, 0x12,
+	0x72, 0x2c, 0x8f, 0xe2, 0x40, 0xe4, 0x9f, 0xd5, 0x54, 0xfd, 0xea, 0xb4, 0x0e, 0x10, 0xb1, 0x61,
+	0x3e, 0x51, 0x1a, 0x28, 0x6a, 0xb9, 0x55, 0x8e, 0x02, 0x38, 0xbf, 0xe3, 0xfa, 0xd8, 0x1b, 0x7a,
+	0xcc, 0xcd, 0x4f, 0xc1, 0x43, 0xcf, 0x49, 0xe8, 0x63, 0xaf, 0xb9, 0xa3, 0x1e, 0x75, 0x0a, 0xa3,
+	0x1f, 0x75, 0xe4, 0x63, 0x51, 0x48, 0x38, 0x17, 0xdd, 0x94, 0x78, 0xcb, 0x23, 0x4c, 0xdc, 0x1c,
+	0x8a, 0xfb, 0xa9, 0x5a, 0x3c, 0x71, 0x47, 0xd1, 0x05, 0x6e, 0x9c, 0xd2, 0xa3, 0x75, 0x2b, 0xaa,
+	0x22, 0x2d, 0xa6, 0xeb, 0x2b, 0x9e, 0x5f, 0x68, 0x77, 0x68, 0xfa, 0x38, 0x08, 0x3b, 0x94, 0x7f,
+	0xc3, 0xcb, 0x9d, 0x47, 0x65, 0x9b, 0x35, 0x28, 0xc6, 0xff, 0xc1, 0x31, 0x91, 0xef, 0x26, 0x62,
+	0xa3, 0x9e, 0xf4, 0x73, 0x4f, 0xe0, 0x49, 0x7f, 0x1b, 0xf2, 0x61, 0x2f, 0x08, 0xbc, 0x43, 0x23,
+	0x3f, 0x85, 0x9e, 0x5d, 0x63, 0x45, 0xef, 0xa2, 0x85, 0x29, 0x40, 0x0a, 0x20, 0x81, 0x87, 0x03,
+	0x66, 0xcc, 0x4c, 0x8c, 0x37, 0xe2, 0x9d, 0x15, 0x07, 0xac, 0xf1, 0xa7, 0x34, 0x14, 0xe5, 0x3b,
+	0xae, 0x7c, 0x1a, 0x7b, 0x1f, 0x4a, 0xda, 0x01, 0x1f, 0xf3, 0x61, 0x6c, 0xd8, 0x6a, 0xd0, 0x80,
+	0xe2, 0xa9, 0xca, 0x86, 0xbe, 0x45, 0x7f, 0xcc, 0x27, 0xb1, 0x61, 0x0d, 0xb3, 0x09, 0xa6, 0x50,
+	0xf2, 0x3e, 0x94, 0x74, 0xc7, 0xf5, 0x98, 0x0f, 0x62, 0x23, 0xbe, 0x41, 0x03, 0x8a, 0x17, 0xb1,
+	0xdf, 0xa6, 0x61, 0x36, 0x5a, 0xb0, 0xfb, 0xe2, 0x41, 0xf8, 0xa4, 0x93, 0x63, 0x24, 0xef, 0x65,
+	0xfa, 0xdf, 0x8f, 0xf4, 0x70, 0xe8, 0x4c, 0x65, 0x86, 0xcf, 0xd4, 0xbb, 0x50, 0xd4, 0x6b, 0xa7,
+	0xe3, 0xfe, 0x37, 0xfd, 0x8c, 0x04, 0x4e, 0xd4, 0x72, 0x62, 0xd5, 0x48, 0x97, 0x38, 0x46, 0x6e,
+	0x0a, 0xd0, 0x31, 0xda, 0x8b, 0xff, 0x49, 0xc1, 0xdc, 0xa8, 0x1e, 0x00, 0x3d, 0x05, 0xd7, 0x46,
+	0xd1, 0xef, 0xfb, 0x0e, 0xd9, 0x71, 0x7d, 0xe2, 0xd4, 0xce, 0xa0, 0x05, 0xb8, 0x3a, 0x8a, 0x65,
+	0x43, 0xc7, 0xc4, 0x5a, 0x0a, 0x3d, 0x0d, 0xf5, 0x51, 0x1c, 0x49, 0x7c, 0x0d, 0x6b, 0xe9, 0x47,
+	0x69, 0x32, 0x89, 0x7e, 0xbb, 0xab, 0x65, 0x50, 0x1d, 0xae, 0x8c, 0x66, 0x11, 0x09, 0x3e, 0xac,
+	0x65, 0xd1, 0x15, 0xb8, 0x38, 0x8a, 0x61, 0x73, 0x7d, 0xb5, 0x96, 0xbb, 0x9c, 0xfd, 0xe5, 0xef,
+	0xe7, 0xcf, 0xbc, 0xf8, 0xf3, 0x14, 0x54, 0x06, 0xd3, 0x1e, 0x32, 0x60, 0x6e, 0x90, 0x22, 0xa4,
+	0xf6, 0x48, 0xed, 0x0c, 0xba, 0x0c, 0x17, 0x06, 0x67, 0x36, 0x18, 0x76, 0x7d, 0xd7, 0x6f, 0xd7,
+	0x52, 0xe8, 0x12, 0x9c, 0x1f, 0x9c, 0x33, 0x49, 0x97, 0xee, 0x11, 0xa7, 0x96, 0x1e, 0x16, 0xbb,
+	0x2b, 0xd3, 0x25, 0x71, 0x6a, 0x19, 0x65, 0xc5, 0xda, 0x7b, 0x9f, 0x7e, 0x39, 0x9f, 0xfa, 0xec,
+	0xcb, 0xf9, 0xd4, 0xbf, 0xbe, 0x9c, 0x4f, 0x7d, 0xf4, 0xd5, 0xfc, 0x99, 0xcf, 0xbe, 0x9a, 0x3f,
+	0xf3, 0xf7, 0xaf, 0xe6, 0xcf, 0xbc, 0xbb, 0xda, 0xb7, 0x93, 0x7d, 0xf9, 0xfb, 0xba, 0x78, 0x55,
+	0xef, 0x27, 0x2c, 0x1f, 0x8c, 0xf8, 0xb7, 0x40, 0xb9, 0xd1, 0xad, 0xbc, 0x8c, 0xd1, 0xaf, 0xfe,
+	0x6f, 0x00, 0xaa, 0xcc, 0x02, 0xc7, 0x44, 0x28, 0x00, 0x00,
+}
+
+func (m *Zone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1722,336 +2319,315 @@ func (m *LsmCaps) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LsmCaps) MarshalTo(dAtA []byte) (int, error) {
+func (m *Zone) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LsmCaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Zone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.GlobalCap.Size()
-		i -= size
-		if _, err := m.GlobalCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.RedemptionRateUncapped {
+		i--
+		if m.RedemptionRateUncapped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.ValidatorBondCap.Size()
-		i -= size
-		if _, err := m.ValidatorBondCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.ValidatorCap.Size()
-		i -= size
-		if _, err := m.ValidatorCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.SubzoneInfo != nil {
+		{
+			size, err := m.SubzoneInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ICAAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BalanceWaitgroup != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.BalanceWaitgroup))
 		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.WithdrawalAddress) > 0 {
-		i -= len(m.WithdrawalAddress)
-		copy(dAtA[i:], m.WithdrawalAddress)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.WithdrawalAddress)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xea
 	}
-	if len(m.PortName) > 0 {
-		i -= len(m.PortName)
-		copy(dAtA[i:], m.PortName)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.PortName)))
+	if m.Is_118 {
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Balance) > 0 {
-		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+		if m.Is_118 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Address)))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Distribution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Distribution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Amount))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xe0
 	}
-	if len(m.Valoper) > 0 {
-		i -= len(m.Valoper)
-		copy(dAtA[i:], m.Valoper)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Valoper)))
+	if m.ReturnToSender {
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *WithdrawalRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *WithdrawalRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *WithdrawalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.EpochNumber))
+		if m.ReturnToSender {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x60
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
 	}
-	if m.Acknowledged {
+	if m.DepositsEnabled {
 		i--
-		if m.Acknowledged {
+		if m.DepositsEnabled {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
 	}
-	if m.Requeued {
+	if m.UnbondingEnabled {
 		i--
-		if m.Requeued {
+		if m.UnbondingEnabled {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
 	}
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err6 != nil {
-		return 0, err6
+	if m.Decimals != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
 	}
-	i -= n6
-	i = encodeVarintInterchainstaking(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x4a
-	if m.Status != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Status))
+	if m.MessagesPerTx != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.MessagesPerTx))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	if len(m.Txhash) > 0 {
-		i -= len(m.Txhash)
-		copy(dAtA[i:], m.Txhash)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Txhash)))
+	if m.UnbondingPeriod != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.UnbondingPeriod))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
 	{
-		size := m.BurnAmount.Size()
+		size := m.Tvl.Size()
 		i -= size
-		if _, err := m.BurnAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Recipient)))
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.LastEpochHeight != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.LastEpochHeight))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Distribution) > 0 {
-		for iNdEx := len(m.Distribution) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Distribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Delegator)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa0
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+	if m.HoldingsAllocation != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.HoldingsAllocation))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UnbondingRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.ValidatorSelectionAllocation != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.ValidatorSelectionAllocation))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.IbcNextValidatorsHash) > 0 {
+		i -= len(m.IbcNextValidatorsHash)
+		copy(dAtA[i:], m.IbcNextValidatorsHash)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.IbcNextValidatorsHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.WithdrawalWaitgroup != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.WithdrawalWaitgroup))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.LiquidityModule {
+		i--
+		if m.LiquidityModule {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MultiSend {
+		i--
+		if m.MultiSend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.AggregateIntent) > 0 {
+		for iNdEx := len(m.AggregateIntent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateIntent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UnbondingRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UnbondingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	{
-		size := m.Amount.Size()
+		size := m.LastRedemptionRate.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LastRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.RelatedTxhash) > 0 {
-		for iNdEx := len(m.RelatedTxhash) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RelatedTxhash[iNdEx])
-			copy(dAtA[i:], m.RelatedTxhash[iNdEx])
-			i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.RelatedTxhash[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	dAtA[i] = 0x5a
+	{
+		size := m.RedemptionRate.Size()
+		i -= size
+		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Validator)))
+	i--
+	dAtA[i] = 0x52
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.BaseDenom)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x4a
 	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.EpochNumber))
+	if len(m.LocalDenom) > 0 {
+		i -= len(m.LocalDenom)
+		copy(dAtA[i:], m.LocalDenom)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.LocalDenom)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x42
+	}
+	if len(m.AccountPrefix) > 0 {
+		i -= len(m.AccountPrefix)
+		copy(dAtA[i:], m.AccountPrefix)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.AccountPrefix)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DelegationAddress != nil {
+		{
+			size, err := m.DelegationAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PerformanceAddress != nil {
+		{
+			size, err := m.PerformanceAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.WithdrawalAddress != nil {
+		{
+			size, err := m.WithdrawalAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DepositAddress != nil {
+		{
+			size, err := m.DepositAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
 		copy(dAtA[i:], m.ChainId)
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationRecord) Marshal() (dAtA []byte, err error) {
+func (m *SubzoneInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2061,59 +2637,34 @@ func (m *RedelegationRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubzoneInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubzoneInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err9 != nil {
-		return 0, err9
-	}
-	i -= n9
-	i = encodeVarintInterchainstaking(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x32
-	if m.Amount != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Destination)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.EpochNumber))
+	if len(m.BaseChainID) > 0 {
+		i -= len(m.BaseChainID)
+		copy(dAtA[i:], m.BaseChainID)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.BaseChainID)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Authority)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TransferRecord) Marshal() (dAtA []byte, err error) {
+func (m *LsmCaps) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2123,169 +2674,50 @@ func (m *TransferRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransferRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *LsmCaps) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LsmCaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Amount.Size()
+		size := m.GlobalCap.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GlobalCap.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.LiquidShares.Size()
-		i -= size
-		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.ValidatorBondShares.Size()
-		i -= size
-		if _, err := m.ValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedSince, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedSince):])
-	if err11 != nil {
-		return 0, err11
-	}
-	i -= n11
-	i = encodeVarintInterchainstaking(dAtA, i, uint64(n11))
-	i--
-	dAtA[i] = 0x4a
-	if m.Tombstoned {
-		i--
-		if m.Tombstoned {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Jailed {
-		i--
-		if m.Jailed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x32
-	}
-	{
-		size := m.Score.Size()
-		i -= size
-		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.VotingPower.Size()
-		i -= size
-		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
 	{
-		size := m.DelegatorShares.Size()
+		size := m.ValidatorBondCap.Size()
 		i -= size
-		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ValidatorBondCap.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x12
 	{
-		size := m.CommissionRate.Size()
+		size := m.ValidatorCap.Size()
 		i -= size
-		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ValidatorCap.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.ValoperAddress) > 0 {
-		i -= len(m.ValoperAddress)
-		copy(dAtA[i:], m.ValoperAddress)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValoperAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorIntent) Marshal() (dAtA []byte, err error) {
+func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2295,20 +2727,39 @@ func (m *DelegatorIntent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorIntent) MarshalTo(dAtA []byte) (int, error) {
+func (m *ICAAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Intents) > 0 {
-		for iNdEx := len(m.Intents) - 1; iNdEx >= 0; iNdEx-- {
+	if m.BalanceWaitgroup != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.BalanceWaitgroup))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.WithdrawalAddress) > 0 {
+		i -= len(m.WithdrawalAddress)
+		copy(dAtA[i:], m.WithdrawalAddress)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.WithdrawalAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortName) > 0 {
+		i -= len(m.PortName)
+		copy(dAtA[i:], m.PortName)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.PortName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Intents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2319,17 +2770,17 @@ func (m *DelegatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Delegator)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorIntent) Marshal() (dAtA []byte, err error) {
+func (m *Distribution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2339,37 +2790,32 @@ func (m *ValidatorIntent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorIntent) MarshalTo(dAtA []byte) (int, error) {
+func (m *Distribution) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Weight.Size()
-		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	if m.Amount != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ValoperAddress) > 0 {
-		i -= len(m.ValoperAddress)
-		copy(dAtA[i:], m.ValoperAddress)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValoperAddress)))
+	if len(m.Valoper) > 0 {
+		i -= len(m.Valoper)
+		copy(dAtA[i:], m.Valoper)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Valoper)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Delegation) Marshal() (dAtA []byte, err error) {
+func (m *WithdrawalRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2379,54 +2825,136 @@ func (m *Delegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *WithdrawalRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WithdrawalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RedelegationEnd != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.RedelegationEnd))
+	if m.PriorityFee != nil {
+		{
+			size, err := m.PriorityFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x6a
 	}
-	if m.Height != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Height))
+	if m.EpochNumber != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.EpochNumber))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x60
+	}
+	if m.Acknowledged {
+		i--
+		if m.Acknowledged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Requeued {
+		i--
+		if m.Requeued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x4a
+	if m.Status != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Txhash) > 0 {
+		i -= len(m.Txhash)
+		copy(dAtA[i:], m.Txhash)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Txhash)))
+		i--
+		dAtA[i] = 0x3a
 	}
 	{
-		size := m.Amount.Size()
+		size := m.BurnAmount.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.BurnAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+	dAtA[i] = 0x32
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Distribution) > 0 {
+		for iNdEx := len(m.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Delegator)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.DelegationAddress) > 0 {
-		i -= len(m.DelegationAddress)
-		copy(dAtA[i:], m.DelegationAddress)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.DelegationAddress)))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PortConnectionTuple) Marshal() (dAtA []byte, err error) {
+func (m *UnbondingRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2436,34 +2964,71 @@ func (m *PortConnectionTuple) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PortConnectionTuple) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnbondingRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PortConnectionTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnbondingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.PortId)))
+	if m.CreationHeight != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.CreationHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x38
 	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ConnectionId)))
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.RelatedTxhash) > 0 {
+		for iNdEx := len(m.RelatedTxhash) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RelatedTxhash[iNdEx])
+			copy(dAtA[i:], m.RelatedTxhash[iNdEx])
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.RelatedTxhash[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Receipt) Marshal() (dAtA []byte, err error) {
+func (m *RedelegationRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2473,63 +3038,47 @@ func (m *Receipt) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
+func (m *RedelegationRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RedelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Completed != nil {
-		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Completed, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Completed):])
-		if err13 != nil {
-			return 0, err13
-		}
-		i -= n13
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(n13))
-		i--
-		dAtA[i] = 0x32
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	if m.FirstSeen != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FirstSeen, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FirstSeen):])
-		if err14 != nil {
-			return 0, err14
-		}
-		i -= n14
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(n14))
+	i -= n11
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x32
+	if m.Amount != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Amount))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Txhash) > 0 {
-		i -= len(m.Txhash)
-		copy(dAtA[i:], m.Txhash)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Txhash)))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Source)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Sender)))
+	if m.EpochNumber != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.EpochNumber))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
@@ -2541,489 +3090,4179 @@ func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintInterchainstaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovInterchainstaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TransferRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Zone) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TransferRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if m.DepositAddress != nil {
-		l = m.DepositAddress.Size()
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if m.WithdrawalAddress != nil {
-		l = m.WithdrawalAddress.Size()
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if m.PerformanceAddress != nil {
-		l = m.PerformanceAddress.Size()
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	if m.DelegationAddress != nil {
-		l = m.DelegationAddress.Size()
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.AccountPrefix)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.LocalDenom)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = m.RedemptionRate.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = m.LastRedemptionRate.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovInterchainstaking(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidShares.Size()
+		i -= size
+		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	if len(m.AggregateIntent) > 0 {
-		for _, e := range m.AggregateIntent {
-			l = e.Size()
-			n += 1 + l + sovInterchainstaking(uint64(l))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ValidatorBondShares.Size()
+		i -= size
+		if _, err := m.ValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	if m.MultiSend {
-		n += 2
-	}
-	if m.LiquidityModule {
-		n += 2
-	}
-	if m.WithdrawalWaitgroup != 0 {
-		n += 2 + sovInterchainstaking(uint64(m.WithdrawalWaitgroup))
-	}
-	l = len(m.IbcNextValidatorsHash)
-	if l > 0 {
-		n += 2 + l + sovInterchainstaking(uint64(l))
-	}
-	if m.ValidatorSelectionAllocation != 0 {
-		n += 2 + sovInterchainstaking(uint64(m.ValidatorSelectionAllocation))
-	}
-	if m.HoldingsAllocation != 0 {
-		n += 2 + sovInterchainstaking(uint64(m.HoldingsAllocation))
-	}
-	if m.LastEpochHeight != 0 {
-		n += 2 + sovInterchainstaking(uint64(m.LastEpochHeight))
+	i--
+	dAtA[i] = 0x52
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedSince, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedSince):])
+	if err13 != nil {
+		return 0, err13
 	}
-	l = m.Tvl.Size()
-	n += 2 + l + sovInterchainstaking(uint64(l))
-	if m.UnbondingPeriod != 0 {
-		n += 2 + sovInterchainstaking(uint64(m.UnbondingPeriod))
+	i -= n13
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x4a
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.MessagesPerTx != 0 {
-		n += 2 + sovInterchainstaking(uint64(m.MessagesPerTx))
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Decimals != 0 {
-		n += 2 + sovInterchainstaking(uint64(m.Decimals))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.UnbondingEnabled {
-		n += 3
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	if m.DepositsEnabled {
-		n += 3
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	if m.ReturnToSender {
-		n += 3
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DelegatorShares.Size()
+		i -= size
+		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	if m.Is_118 {
-		n += 3
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	if m.SubzoneInfo != nil {
-		l = m.SubzoneInfo.Size()
-		n += 2 + l + sovInterchainstaking(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValoperAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SubzoneInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = len(m.BaseChainID)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+func (m *DelegatorIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LsmCaps) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ValidatorCap.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = m.ValidatorBondCap.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = m.GlobalCap.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	return n
+func (m *DelegatorIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ICAAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DelegatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.Intents) > 0 {
+		for iNdEx := len(m.Intents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Intents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.PortName)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = len(m.WithdrawalAddress)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if m.BalanceWaitgroup != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.BalanceWaitgroup))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Distribution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Valoper)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.Amount))
+func (m *ValidatorIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *WithdrawalRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if len(m.Distribution) > 0 {
-		for _, e := range m.Distribution {
-			l = e.Size()
-			n += 1 + l + sovInterchainstaking(uint64(l))
-		}
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovInterchainstaking(uint64(l))
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	l = m.BurnAmount.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = len(m.Txhash)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.Status))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	if m.Requeued {
-		n += 2
-	}
-	if m.Acknowledged {
-		n += 2
-	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.EpochNumber))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValoperAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.EpochNumber))
-	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if len(m.RelatedTxhash) > 0 {
-		for _, s := range m.RelatedTxhash {
-			l = len(s)
-			n += 1 + l + sovInterchainstaking(uint64(l))
-		}
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RedelegationRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.RedelegationEnd != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.RedelegationEnd))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.EpochNumber))
+	if m.Height != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Amount != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.Amount))
+	if len(m.DelegationAddress) > 0 {
+		i -= len(m.DelegationAddress)
+		copy(dAtA[i:], m.DelegationAddress)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.DelegationAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TransferRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PortConnectionTuple) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PortConnectionTuple) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PortConnectionTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Receipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValoperAddress)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.Completed != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Completed, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Completed):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = m.CommissionRate.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = m.DelegatorShares.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = m.VotingPower.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = m.Score.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.FirstSeen != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FirstSeen, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FirstSeen):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Jailed {
-		n += 2
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.Tombstoned {
-		n += 2
+	if len(m.Txhash) > 0 {
+		i -= len(m.Txhash)
+		copy(dAtA[i:], m.Txhash)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Txhash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedSince)
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = m.ValidatorBondShares.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = m.LiquidShares.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorIntent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CircuitBreakerTrip) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerTrip) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerTrip) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err17 != nil {
+		return 0, err17
 	}
-	if len(m.Intents) > 0 {
-		for _, e := range m.Intents {
-			l = e.Size()
-			n += 1 + l + sovInterchainstaking(uint64(l))
+	i -= n17
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TrippedBy) > 0 {
+		i -= len(m.TrippedBy)
+		copy(dAtA[i:], m.TrippedBy)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.TrippedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Action != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trips) > 0 {
+		for iNdEx := len(m.Trips) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trips[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reconciled {
+		i--
+		if m.Reconciled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.ReportedAmount.Size()
+		i -= size
+		if _, err := m.ReportedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ExpectedAmount.Size()
+		i -= size
+		if _, err := m.ExpectedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardSwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardSwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardSwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardSwapConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardSwapConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardSwapConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUpdated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdated):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZoneWindDown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZoneWindDown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneWindDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedHeight != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.CompletedHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.UnsettledHolders) > 0 {
+		for iNdEx := len(m.UnsettledHolders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UnsettledHolders[iNdEx])
+			copy(dAtA[i:], m.UnsettledHolders[iNdEx])
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.UnsettledHolders[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.DepositsEnabled {
+		i--
+		if m.DepositsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.FinalRedemptionRate.Size()
+		i -= size
+		if _, err := m.FinalRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x2a
+	if m.StartedHeight != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.StartedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MigrationConnectionId) > 0 {
+		i -= len(m.MigrationConnectionId)
+		copy(dAtA[i:], m.MigrationConnectionId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.MigrationConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZoneSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZoneSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Tvl.Size()
+		i -= size
+		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RedemptionRate.Size()
+		i -= size
+		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochCaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochCaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochCaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AddressCap.Size()
+		i -= size
+		if _, err := m.AddressCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RedemptionCap.Size()
+		i -= size
+		if _, err := m.RedemptionCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.DepositCap.Size()
+		i -= size
+		if _, err := m.DepositCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochCapUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochCapUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochCapUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Redeemed.Size()
+		i -= size
+		if _, err := m.Redeemed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Deposited.Size()
+		i -= size
+		if _, err := m.Deposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.EpochNumber != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInterchainstaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInterchainstaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Zone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.DepositAddress != nil {
+		l = m.DepositAddress.Size()
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.WithdrawalAddress != nil {
+		l = m.WithdrawalAddress.Size()
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.PerformanceAddress != nil {
+		l = m.PerformanceAddress.Size()
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.DelegationAddress != nil {
+		l = m.DelegationAddress.Size()
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.AccountPrefix)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.LocalDenom)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.RedemptionRate.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.LastRedemptionRate.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	if len(m.AggregateIntent) > 0 {
+		for _, e := range m.AggregateIntent {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	if m.MultiSend {
+		n += 2
+	}
+	if m.LiquidityModule {
+		n += 2
+	}
+	if m.WithdrawalWaitgroup != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.WithdrawalWaitgroup))
+	}
+	l = len(m.IbcNextValidatorsHash)
+	if l > 0 {
+		n += 2 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.ValidatorSelectionAllocation != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.ValidatorSelectionAllocation))
+	}
+	if m.HoldingsAllocation != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.HoldingsAllocation))
+	}
+	if m.LastEpochHeight != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.LastEpochHeight))
+	}
+	l = m.Tvl.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	if m.UnbondingPeriod != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.UnbondingPeriod))
+	}
+	if m.MessagesPerTx != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.MessagesPerTx))
+	}
+	if m.Decimals != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.Decimals))
+	}
+	if m.UnbondingEnabled {
+		n += 3
+	}
+	if m.DepositsEnabled {
+		n += 3
+	}
+	if m.ReturnToSender {
+		n += 3
+	}
+	if m.Is_118 {
+		n += 3
+	}
+	if m.SubzoneInfo != nil {
+		l = m.SubzoneInfo.Size()
+		n += 2 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.RedemptionRateUncapped {
+		n += 3
+	}
+	return n
+}
+
+func (m *SubzoneInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.BaseChainID)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *LsmCaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValidatorCap.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.ValidatorBondCap.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.GlobalCap.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *ICAAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	l = len(m.PortName)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.WithdrawalAddress)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.BalanceWaitgroup != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.BalanceWaitgroup))
+	}
+	return n
+}
+
+func (m *Distribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Valoper)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *WithdrawalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.Distribution) > 0 {
+		for _, e := range m.Distribution {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	l = m.BurnAmount.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = len(m.Txhash)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Status))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.Requeued {
+		n += 2
+	}
+	if m.Acknowledged {
+		n += 2
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.EpochNumber))
+	}
+	if m.PriorityFee != nil {
+		l = m.PriorityFee.Size()
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *UnbondingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.EpochNumber))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.RelatedTxhash) > 0 {
+		for _, s := range m.RelatedTxhash {
+			l = len(s)
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *RedelegationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.EpochNumber))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Amount))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *TransferRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.VotingPower.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Score.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedSince)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.ValidatorBondShares.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.LiquidShares.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *DelegatorIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.Intents) > 0 {
+		for _, e := range m.Intents {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegationAddress)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Height))
+	}
+	if m.RedelegationEnd != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.RedelegationEnd))
+	}
+	return n
+}
+
+func (m *PortConnectionTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *Receipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Txhash)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	if m.FirstSeen != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FirstSeen)
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Completed != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Completed)
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *CircuitBreakerTrip) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Action))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.TrippedBy)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.Trips) > 0 {
+		for _, e := range m.Trips {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Fraction.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.ExpectedAmount.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.ReportedAmount.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.Reconciled {
+		n += 2
+	}
+	return n
+}
+
+func (m *SwapHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.PoolId))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardSwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *RewardSwapConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SwapPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.PoolId))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdated)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *ZoneWindDown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Status))
+	}
+	l = len(m.MigrationConnectionId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.StartedHeight != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.StartedHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.FinalRedemptionRate.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.DepositsEnabled {
+		n += 2
+	}
+	if len(m.UnsettledHolders) > 0 {
+		for _, s := range m.UnsettledHolders {
+			l = len(s)
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	if m.CompletedHeight != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.CompletedHeight))
+	}
+	return n
+}
+
+func (m *ZoneSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.EpochNumber))
+	}
+	if m.Height != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.RedemptionRate.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Tvl.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Apr.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *EpochCaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositCap.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.RedemptionCap.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.AddressCap.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *EpochCapUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.EpochNumber))
+	}
+	l = m.Deposited.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Redeemed.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func sovInterchainstaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInterchainstaking(x uint64) (n int) {
+	return sovInterchainstaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Zone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Zone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Zone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositAddress == nil {
+				m.DepositAddress = &ICAAccount{}
+			}
+			if err := m.DepositAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithdrawalAddress == nil {
+				m.WithdrawalAddress = &ICAAccount{}
+			}
+			if err := m.WithdrawalAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PerformanceAddress == nil {
+				m.PerformanceAddress = &ICAAccount{}
+			}
+			if err := m.PerformanceAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegationAddress == nil {
+				m.DelegationAddress = &ICAAccount{}
+			}
+			if err := m.DelegationAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateIntent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateIntent = append(m.AggregateIntent, &ValidatorIntent{})
+			if err := m.AggregateIntent[len(m.AggregateIntent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MultiSend = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityModule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiquidityModule = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalWaitgroup", wireType)
+			}
+			m.WithdrawalWaitgroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalWaitgroup |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcNextValidatorsHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcNextValidatorsHash = append(m.IbcNextValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.IbcNextValidatorsHash == nil {
+				m.IbcNextValidatorsHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionAllocation", wireType)
+			}
+			m.ValidatorSelectionAllocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSelectionAllocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldingsAllocation", wireType)
+			}
+			m.HoldingsAllocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldingsAllocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochHeight", wireType)
+			}
+			m.LastEpochHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEpochHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			m.UnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesPerTx", wireType)
+			}
+			m.MessagesPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessagesPerTx |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnbondingEnabled = bool(v != 0)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositsEnabled = bool(v != 0)
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnToSender", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReturnToSender = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Is_118", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Is_118 = bool(v != 0)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubzoneInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubzoneInfo == nil {
+				m.SubzoneInfo = &SubzoneInfo{}
+			}
+			if err := m.SubzoneInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateUncapped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RedemptionRateUncapped = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubzoneInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubzoneInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubzoneInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LsmCaps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LsmCaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LsmCaps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceWaitgroup", wireType)
+			}
+			m.BalanceWaitgroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceWaitgroup |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Distribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valoper", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valoper = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distribution = append(m.Distribution, &Distribution{})
+			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requeued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Requeued = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Acknowledged = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriorityFee == nil {
+				m.PriorityFee = &types.Coin{}
+			}
+			if err := m.PriorityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelatedTxhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelatedTxhash = append(m.RelatedTxhash, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *ValidatorIntent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValoperAddress)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = m.Weight.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	return n
-}
-
-func (m *Delegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegationAddress)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	if m.Height != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.Height))
-	}
-	if m.RedelegationEnd != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.RedelegationEnd))
-	}
-	return n
-}
-
-func (m *PortConnectionTuple) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	return n
-}
 
-func (m *Receipt) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = len(m.Txhash)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovInterchainstaking(uint64(l))
-		}
-	}
-	if m.FirstSeen != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FirstSeen)
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if m.Completed != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Completed)
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovInterchainstaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozInterchainstaking(x uint64) (n int) {
-	return sovInterchainstaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Zone) Unmarshal(dAtA []byte) error {
+func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3046,15 +7285,15 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Zone: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Zone: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3082,11 +7321,126 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3114,13 +7468,13 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3130,33 +7484,31 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DepositAddress == nil {
-				m.DepositAddress = &ICAAccount{}
-			}
-			if err := m.DepositAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3166,33 +7518,31 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WithdrawalAddress == nil {
-				m.WithdrawalAddress = &ICAAccount{}
-			}
-			if err := m.WithdrawalAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3202,33 +7552,31 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PerformanceAddress == nil {
-				m.PerformanceAddress = &ICAAccount{}
-			}
-			if err := m.PerformanceAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3238,31 +7586,29 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelegationAddress == nil {
-				m.DelegationAddress = &ICAAccount{}
-			}
-			if err := m.DelegationAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3290,13 +7636,13 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountPrefix = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3306,29 +7652,17 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LocalDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			m.Jailed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3338,29 +7672,17 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			m.Tombstoned = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedSince", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3370,29 +7692,28 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedSince, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3420,15 +7741,15 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3438,85 +7759,81 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateIntent", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInterchainstaking
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateIntent = append(m.AggregateIntent, &ValidatorIntent{})
-			if err := m.AggregateIntent[len(m.AggregateIntent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
 			}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiSend", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.MultiSend = bool(v != 0)
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityModule", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3526,36 +7843,29 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LiquidityModule = bool(v != 0)
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalWaitgroup", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-			m.WithdrawalWaitgroup = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WithdrawalWaitgroup |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-		case 17:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcNextValidatorsHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Intents", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3565,69 +7875,81 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcNextValidatorsHash = append(m.IbcNextValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.IbcNextValidatorsHash == nil {
-				m.IbcNextValidatorsHash = []byte{}
+			m.Intents = append(m.Intents, &ValidatorIntent{})
+			if err := m.Intents[len(m.Intents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionAllocation", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ValidatorSelectionAllocation = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorSelectionAllocation |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HoldingsAllocation", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.HoldingsAllocation = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HoldingsAllocation |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
 			}
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochHeight", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LastEpochHeight = 0
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3637,14 +7959,27 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastEpochHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 21:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3672,72 +8007,65 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.UnbondingPeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingPeriod |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessagesPerTx", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MessagesPerTx = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MessagesPerTx |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
 			}
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 25:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEnabled", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAddress", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3747,17 +8075,29 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UnbondingEnabled = bool(v != 0)
-		case 26:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositsEnabled", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3767,17 +8107,29 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DepositsEnabled = bool(v != 0)
-		case 27:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnToSender", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3787,17 +8139,30 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReturnToSender = bool(v != 0)
-		case 28:
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Is_118", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3807,17 +8172,16 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Is_118 = bool(v != 0)
-		case 29:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubzoneInfo", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEnd", wireType)
 			}
-			var msglen int
+			m.RedelegationEnd = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3827,28 +8191,11 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RedelegationEnd |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SubzoneInfo == nil {
-				m.SubzoneInfo = &SubzoneInfo{}
-			}
-			if err := m.SubzoneInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
@@ -3870,7 +8217,7 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubzoneInfo) Unmarshal(dAtA []byte) error {
+func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3893,15 +8240,15 @@ func (m *SubzoneInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubzoneInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PortConnectionTuple: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubzoneInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PortConnectionTuple: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3929,11 +8276,11 @@ func (m *SubzoneInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3961,7 +8308,7 @@ func (m *SubzoneInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseChainID = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3984,7 +8331,7 @@ func (m *SubzoneInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LsmCaps) Unmarshal(dAtA []byte) error {
+func (m *Receipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4007,15 +8354,15 @@ func (m *LsmCaps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LsmCaps: wiretype end group for non-group")
+			return fmt.Errorf("proto: Receipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LsmCaps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Receipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4043,13 +8390,11 @@ func (m *LsmCaps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4077,13 +8422,11 @@ func (m *LsmCaps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorBondCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4111,7 +8454,111 @@ func (m *LsmCaps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GlobalCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Txhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSeen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstSeen == nil {
+				m.FirstSeen = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FirstSeen, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Completed == nil {
+				m.Completed = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Completed, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4136,7 +8583,7 @@ func (m *LsmCaps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+func (m *CircuitBreakerTrip) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4159,17 +8606,17 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: CircuitBreakerTrip: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CircuitBreakerTrip: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var stringLen uint64
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4179,29 +8626,16 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Action |= CircuitBreakerAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4211,29 +8645,27 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = append(m.Balance, types.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4261,13 +8693,13 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortName = string(dAtA[iNdEx:postIndex])
+			m.TrippedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4277,29 +8709,16 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WithdrawalAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BalanceWaitgroup", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.BalanceWaitgroup = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4309,11 +8728,25 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BalanceWaitgroup |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
@@ -4335,7 +8768,7 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Distribution) Unmarshal(dAtA []byte) error {
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4358,15 +8791,15 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valoper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4394,13 +8827,13 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valoper = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trips", wireType)
 			}
-			m.Amount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4410,11 +8843,26 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trips = append(m.Trips, CircuitBreakerTrip{})
+			if err := m.Trips[len(m.Trips)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
@@ -4436,7 +8884,7 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4459,10 +8907,10 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawalRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4499,7 +8947,7 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4527,13 +8975,13 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4543,31 +8991,16 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Distribution = append(m.Distribution, &Distribution{})
-			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4577,29 +9010,30 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4609,29 +9043,29 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4658,64 +9092,13 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpectedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Txhash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4742,33 +9125,13 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReportedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requeued", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Requeued = bool(v != 0)
-		case 11:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledged", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconciled", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -4780,31 +9143,12 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Acknowledged = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
-			}
-			m.EpochNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Reconciled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
@@ -4826,7 +9170,7 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
+func (m *SwapHop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4849,68 +9193,17 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapHop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapHop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
-			}
-			m.EpochNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4920,27 +9213,14 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelatedTxhash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4968,40 +9248,7 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelatedTxhash = append(m.RelatedTxhash, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5024,7 +9271,7 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
+func (m *RewardSwapRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5047,15 +9294,15 @@ func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardSwapRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardSwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5083,13 +9330,13 @@ func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
 			}
-			m.EpochNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5099,14 +9346,29 @@ func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, SwapHop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5134,11 +9396,63 @@ func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardSwapConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardSwapConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardSwapConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5166,30 +9480,11 @@ func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5216,7 +9511,8 @@ func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, RewardSwapRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5241,7 +9537,7 @@ func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferRecord) Unmarshal(dAtA []byte) error {
+func (m *SwapPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5264,15 +9560,15 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5300,13 +9596,32 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5316,27 +9631,29 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Pool = append(m.Pool[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pool == nil {
+				m.Pool = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5363,7 +9680,7 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdated, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5388,7 +9705,7 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *ZoneWindDown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5411,15 +9728,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ZoneWindDown: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ZoneWindDown: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5447,13 +9764,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5463,29 +9780,14 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= WindDownStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5513,15 +9815,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MigrationConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedHeight", wireType)
 			}
-			var stringLen uint64
+			m.StartedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5531,31 +9831,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5565,29 +9850,28 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalRedemptionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5615,11 +9899,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			if err := m.FinalRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositsEnabled", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -5636,63 +9922,10 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
+			m.DepositsEnabled = bool(v != 0)
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Tombstoned = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedSince", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedSince, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsettledHolders", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5720,15 +9953,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UnsettledHolders = append(m.UnsettledHolders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedHeight", wireType)
 			}
-			var stringLen uint64
+			m.CompletedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5738,26 +9969,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CompletedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
@@ -5779,7 +9995,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
+func (m *ZoneSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5802,15 +10018,15 @@ func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorIntent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ZoneSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ZoneSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5838,13 +10054,13 @@ func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Intents", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			var msglen int
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5854,81 +10070,35 @@ func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EpochNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Intents = append(m.Intents, &ValidatorIntent{})
-			if err := m.Intents[len(m.Intents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInterchainstaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorIntent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorIntent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5938,27 +10108,28 @@ func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5986,63 +10157,13 @@ func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Delegation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInterchainstaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6070,11 +10191,13 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegationAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6102,13 +10225,15 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -6118,63 +10243,26 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEnd", wireType)
-			}
-			m.RedelegationEnd = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RedelegationEnd |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
@@ -6196,7 +10284,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
+func (m *EpochCaps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6219,15 +10307,15 @@ func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PortConnectionTuple: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochCaps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PortConnectionTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochCaps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6255,11 +10343,13 @@ func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			if err := m.DepositCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6287,7 +10377,43 @@ func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.RedemptionCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddressCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6310,7 +10436,7 @@ func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Receipt) Unmarshal(dAtA []byte) error {
+func (m *EpochCapUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6333,10 +10459,10 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Receipt: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochCapUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Receipt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochCapUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6373,7 +10499,7 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6401,13 +10527,13 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			var stringLen uint64
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -6417,29 +10543,16 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Txhash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposited", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -6449,67 +10562,31 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstSeen", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FirstSeen == nil {
-				m.FirstSeen = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FirstSeen, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemed", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -6519,25 +10596,23 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Completed == nil {
-				m.Completed = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Completed, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redeemed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
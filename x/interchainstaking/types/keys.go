@@ -63,6 +63,15 @@ var (
 	KeyPrefixRedelegationRecord          = []byte{0x10}
 	KeyPrefixLsmCaps                     = []byte{0x11}
 	KeyPrefixLocalDenomZoneMapping       = []byte{0x12}
+	KeyPrefixCircuitBreaker              = []byte{0x13}
+	KeyEmergencyAuthority                = []byte{0x14}
+	KeyPrefixSlashRecord                 = []byte{0x15}
+	KeyPrefixRewardSwapConfig            = []byte{0x16}
+	KeyPrefixSwapPool                    = []byte{0x17}
+	KeyPrefixZoneWindDown                = []byte{0x18}
+	KeyPrefixZoneSnapshot                = []byte{0x19}
+	KeyPrefixEpochCaps                   = []byte{0x1a}
+	KeyPrefixEpochCapUsage               = []byte{0x1b}
 )
 
 // ParseStakingDelegationKey parses the KV store key for a delegation from Cosmos x/staking module,
@@ -151,6 +160,53 @@ func GetUnbondingKey(chainID, validator string, epochNumber int64) []byte {
 	return append(KeyPrefixUnbondingRecord, append(append([]byte(chainID), []byte(validator)...), epochBytes...)...)
 }
 
+// GetSlashRecordKey gets the slash record key.
+// slash records are keyed by chainId, validator and the height at which the slash was detected.
+func GetSlashRecordKey(chainID, validator string, height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return append(GetZoneSlashRecordsKey(chainID, validator), heightBytes...)
+}
+
+// GetZoneSlashRecordsKey gets the slash records key prefix for a given chain and,
+// optionally, validator.
+func GetZoneSlashRecordsKey(chainID, validator string) []byte {
+	return append(KeyPrefixSlashRecord, append([]byte(chainID), []byte(validator)...)...)
+}
+
+// GetSwapPoolKey gets the swap pool key.
+// swap pools are keyed by chainId and pool id.
+func GetSwapPoolKey(chainID string, poolID uint64) []byte {
+	return append(GetZoneSwapPoolsKey(chainID), sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// GetZoneSwapPoolsKey gets the swap pools key prefix for a given chain.
+func GetZoneSwapPoolsKey(chainID string) []byte {
+	return append(KeyPrefixSwapPool, []byte(chainID)...)
+}
+
+// GetZoneSnapshotKey gets the zone snapshot key.
+// zone snapshots are keyed by chainId and epoch number.
+func GetZoneSnapshotKey(chainID string, epochNumber int64) []byte {
+	return append(GetZoneSnapshotsKey(chainID), sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// GetZoneSnapshotsKey gets the zone snapshots key prefix for a given chain.
+func GetZoneSnapshotsKey(chainID string) []byte {
+	return append(KeyPrefixZoneSnapshot, []byte(chainID)...)
+}
+
+// GetEpochCapUsageKey gets the epoch cap usage key.
+// usage is keyed by chainId and address; the usage of the zone as a whole has an empty address.
+func GetEpochCapUsageKey(chainID string, address string) []byte {
+	return append(GetZoneEpochCapUsagesKey(chainID), []byte(address)...)
+}
+
+// GetZoneEpochCapUsagesKey gets the epoch cap usage key prefix for a given chain.
+func GetZoneEpochCapUsagesKey(chainID string) []byte {
+	return append(KeyPrefixEpochCapUsage, []byte(chainID)...)
+}
+
 // GetZoneValidatorsKey gets the validators key prefix for a given chain.
 func GetZoneValidatorsKey(chainID string) []byte {
 	return append(KeyPrefixValidatorsInfo, []byte(chainID)...)
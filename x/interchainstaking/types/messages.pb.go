@@ -37,6 +37,9 @@ type MsgRequestRedemption struct {
 	Value              types.Coin `protobuf:"bytes,1,opt,name=value,proto3" json:"value" yaml:"coin"`
 	DestinationAddress string     `protobuf:"bytes,2,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
 	FromAddress        string     `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// priority_fee optionally specifies a qAsset fee, in the same denom as value,
+	// that is burned to move the request up the redemption queue.
+	PriorityFee *types.Coin `protobuf:"bytes,4,opt,name=priority_fee,json=priorityFee,proto3" json:"priority_fee,omitempty" yaml:"priority_fee"`
 }
 
 func (m *MsgRequestRedemption) Reset()         { *m = MsgRequestRedemption{} }
@@ -109,11 +112,15 @@ func (m *MsgRequestRedemptionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRequestRedemptionResponse proto.InternalMessageInfo
 
-// MsgCancelQueuedRedemption represents a message type to cancel .
+// MsgCancelQueuedRedemption represents a message type to cancel a queued or
+// unbonding redemption request.
 type MsgCancelQueuedRedemption struct {
 	ChainId     string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	Hash        string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 	FromAddress string `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// amount optionally specifies the qAsset amount to cancel from a queued
+	// record. If unset, the whole record is cancelled.
+	Amount *types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty" yaml:"amount"`
 }
 
 func (m *MsgCancelQueuedRedemption) Reset()         { *m = MsgCancelQueuedRedemption{} }
@@ -152,6 +159,9 @@ var xxx_messageInfo_MsgCancelQueuedRedemption proto.InternalMessageInfo
 // MsgRequestRedemptionResponse defines the MsgRequestRedemption response type.
 type MsgCancelQueuedRedemptionResponse struct {
 	Returned types.Coin `protobuf:"bytes,1,opt,name=returned,proto3" json:"returned" yaml:"coin"`
+	// pending is true when the cancellation awaits acknowledgement from the
+	// host chain; qAssets are returned once the unbonding has been cancelled.
+	Pending bool `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
 }
 
 func (m *MsgCancelQueuedRedemptionResponse) Reset()         { *m = MsgCancelQueuedRedemptionResponse{} }
@@ -194,6 +204,13 @@ func (m *MsgCancelQueuedRedemptionResponse) GetReturned() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgCancelQueuedRedemptionResponse) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
 // MsgSignalIntent represents a message type for signalling voting intent for
 // one or more validators.
 type MsgSignalIntent struct {
@@ -272,6 +289,86 @@ func (m *MsgSignalIntentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSignalIntentResponse proto.InternalMessageInfo
 
+// MsgTripCircuitBreaker represents a message type to pause one or more actions
+// for a zone.
+type MsgTripCircuitBreaker struct {
+	ChainId   string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	Actions   []CircuitBreakerAction `protobuf:"varint,2,rep,packed,name=actions,proto3,enum=quicksilver.interchainstaking.v1.CircuitBreakerAction" json:"actions,omitempty" yaml:"actions"`
+	Reason    string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+	Authority string                 `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgTripCircuitBreaker) Reset()         { *m = MsgTripCircuitBreaker{} }
+func (m *MsgTripCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgTripCircuitBreaker) ProtoMessage()    {}
+func (*MsgTripCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{6}
+}
+func (m *MsgTripCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTripCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTripCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTripCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTripCircuitBreaker.Merge(m, src)
+}
+func (m *MsgTripCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTripCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTripCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTripCircuitBreaker proto.InternalMessageInfo
+
+// MsgTripCircuitBreakerResponse defines the MsgTripCircuitBreaker response
+// type.
+type MsgTripCircuitBreakerResponse struct {
+}
+
+func (m *MsgTripCircuitBreakerResponse) Reset()         { *m = MsgTripCircuitBreakerResponse{} }
+func (m *MsgTripCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTripCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgTripCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{7}
+}
+func (m *MsgTripCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTripCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTripCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTripCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTripCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgTripCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTripCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTripCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTripCircuitBreakerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRequestRedemption)(nil), "quicksilver.interchainstaking.v1.MsgRequestRedemption")
 	proto.RegisterType((*MsgRequestRedemptionResponse)(nil), "quicksilver.interchainstaking.v1.MsgRequestRedemptionResponse")
@@ -279,6 +376,8 @@ func init() {
 	proto.RegisterType((*MsgCancelQueuedRedemptionResponse)(nil), "quicksilver.interchainstaking.v1.MsgCancelQueuedRedemptionResponse")
 	proto.RegisterType((*MsgSignalIntent)(nil), "quicksilver.interchainstaking.v1.MsgSignalIntent")
 	proto.RegisterType((*MsgSignalIntentResponse)(nil), "quicksilver.interchainstaking.v1.MsgSignalIntentResponse")
+	proto.RegisterType((*MsgTripCircuitBreaker)(nil), "quicksilver.interchainstaking.v1.MsgTripCircuitBreaker")
+	proto.RegisterType((*MsgTripCircuitBreakerResponse)(nil), "quicksilver.interchainstaking.v1.MsgTripCircuitBreakerResponse")
 }
 
 func init() {
@@ -286,55 +385,81 @@ func init() {
 }
 
 var fileDescriptor_ee484030fa140a82 = []byte{
-	// 760 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x4f, 0x1b, 0x47,
-	0x18, 0xc6, 0x3d, 0xd0, 0x16, 0x18, 0x68, 0xa1, 0x0b, 0x52, 0xf1, 0x0a, 0xad, 0xe9, 0x9e, 0x50,
-	0x5b, 0x76, 0xb1, 0x69, 0x69, 0x6b, 0x0a, 0x12, 0x76, 0x25, 0x44, 0x55, 0x1f, 0xba, 0xdc, 0xda,
-	0x83, 0x35, 0xde, 0x7d, 0xbb, 0x5e, 0xb1, 0x3b, 0xb3, 0xec, 0x8c, 0x57, 0x90, 0x63, 0x4e, 0x39,
-	0x26, 0xca, 0x17, 0xe0, 0x23, 0xe4, 0x80, 0x72, 0xcd, 0x21, 0x39, 0x70, 0x44, 0xc9, 0x21, 0x39,
-	0x59, 0x11, 0xe4, 0x90, 0x53, 0x0e, 0x7c, 0x82, 0x68, 0xff, 0xd8, 0xd8, 0xe0, 0x88, 0xc5, 0xe1,
-	0xb6, 0x3b, 0xef, 0x3c, 0xcf, 0x3e, 0xbf, 0x77, 0xde, 0xb1, 0x8c, 0xf5, 0xfd, 0x96, 0x63, 0xee,
-	0x71, 0xc7, 0x0d, 0x21, 0xd0, 0x1d, 0x2a, 0x20, 0x30, 0x9b, 0xc4, 0xa1, 0x5c, 0x90, 0x3d, 0x87,
-	0xda, 0x7a, 0x58, 0xd4, 0x3d, 0xe0, 0x9c, 0xd8, 0xc0, 0x35, 0x3f, 0x60, 0x82, 0x49, 0x8b, 0x3d,
-	0x02, 0xed, 0x9a, 0x40, 0x0b, 0x8b, 0xb2, 0x62, 0x32, 0xee, 0x31, 0xae, 0x37, 0x08, 0x07, 0x3d,
-	0x2c, 0x36, 0x40, 0x90, 0xa2, 0x6e, 0x32, 0x87, 0x26, 0x0e, 0x72, 0x3e, 0xa9, 0xd7, 0xe3, 0x37,
-	0x3d, 0x79, 0x49, 0x4b, 0x73, 0x36, 0xb3, 0x59, 0xb2, 0x1e, 0x3d, 0xa5, 0xab, 0x0b, 0x36, 0x63,
-	0xb6, 0x0b, 0x3a, 0xf1, 0x1d, 0x9d, 0x50, 0xca, 0x04, 0x11, 0x0e, 0xa3, 0x1d, 0xcd, 0xca, 0x8d,
-	0x04, 0x7e, 0xc0, 0x7c, 0xc6, 0x89, 0x9b, 0x2a, 0xd4, 0x0f, 0x08, 0xcf, 0xd5, 0xb8, 0x6d, 0xc0,
-	0x7e, 0x0b, 0xb8, 0x30, 0xc0, 0x02, 0xcf, 0x8f, 0x1c, 0xa5, 0x3f, 0xf1, 0x97, 0x21, 0x71, 0x5b,
-	0x30, 0x8f, 0x16, 0xd1, 0xd2, 0x64, 0x29, 0xaf, 0xa5, 0xe1, 0x22, 0x12, 0x2d, 0x25, 0xd1, 0xaa,
-	0xcc, 0xa1, 0x95, 0xd9, 0x93, 0x76, 0x21, 0x77, 0xd1, 0x2e, 0x4c, 0x1e, 0x12, 0xcf, 0x2d, 0xab,
-	0x11, 0x9d, 0x6a, 0x24, 0x62, 0x69, 0x07, 0xcf, 0x5a, 0xc0, 0x85, 0x43, 0xe3, 0x98, 0x75, 0x62,
-	0x59, 0x01, 0x70, 0x3e, 0x3f, 0xb2, 0x88, 0x96, 0x26, 0x2a, 0xf3, 0x2f, 0x8f, 0x97, 0xe7, 0x52,
-	0xdb, 0xad, 0xa4, 0xb2, 0x2b, 0x02, 0x87, 0xda, 0x86, 0xd4, 0x23, 0x4a, 0x2b, 0xd2, 0x3a, 0x9e,
-	0xfa, 0x3f, 0x60, 0x5e, 0xd7, 0x63, 0xf4, 0x06, 0x8f, 0xc9, 0x68, 0x77, 0xba, 0x54, 0x1e, 0x7f,
-	0x70, 0x54, 0xc8, 0xbd, 0x3f, 0x2a, 0xe4, 0x54, 0x05, 0x2f, 0x0c, 0xe2, 0x35, 0x80, 0xfb, 0x8c,
-	0x72, 0x50, 0x1f, 0x21, 0x9c, 0xaf, 0x71, 0xbb, 0x4a, 0xa8, 0x09, 0xee, 0x3f, 0x2d, 0x68, 0x81,
-	0xd5, 0xd3, 0x95, 0x3c, 0x1e, 0x8f, 0x3b, 0x5a, 0x77, 0xac, 0xb8, 0x31, 0x13, 0xc6, 0x58, 0xfc,
-	0xbe, 0x63, 0x49, 0x12, 0xfe, 0xa2, 0x49, 0x78, 0x33, 0x61, 0x33, 0xe2, 0xe7, 0xbb, 0xca, 0xcc,
-	0xf0, 0xf7, 0x9f, 0x8c, 0xd4, 0x09, 0x2e, 0xfd, 0x85, 0xc7, 0x03, 0x10, 0xad, 0x80, 0x82, 0x35,
-	0xe4, 0x99, 0x75, 0xf5, 0xea, 0x53, 0x84, 0xa7, 0x6b, 0xdc, 0xde, 0x75, 0x6c, 0x4a, 0xdc, 0x1d,
-	0x2a, 0x80, 0x0a, 0x49, 0xbb, 0x8a, 0x5e, 0x99, 0xbd, 0x68, 0x17, 0xa6, 0x53, 0x83, 0xb4, 0xa2,
-	0x5e, 0xf6, 0xe3, 0x27, 0x3c, 0xe6, 0xc4, 0xca, 0xce, 0x71, 0x4b, 0x17, 0xed, 0xc2, 0x37, 0xc9,
-	0xf6, 0xb4, 0xa0, 0x1a, 0x9d, 0x2d, 0x77, 0xd5, 0xa9, 0x3c, 0xfe, 0xee, 0x4a, 0xee, 0x4e, 0x7f,
-	0x4a, 0x4f, 0x26, 0xf0, 0x68, 0x8d, 0xdb, 0xd2, 0x73, 0x84, 0xbf, 0xbd, 0x3e, 0xee, 0x6b, 0xda,
-	0x4d, 0x77, 0x59, 0x1b, 0x34, 0x36, 0xf2, 0xe6, 0x70, 0xba, 0xee, 0xb8, 0xad, 0xdd, 0x7f, 0xf5,
-	0xee, 0xf1, 0xc8, 0x4a, 0x19, 0xfd, 0xa0, 0xfe, 0xd8, 0xf7, 0xfb, 0x23, 0x0e, 0xa2, 0xeb, 0x7a,
-	0xfd, 0x0e, 0x07, 0x60, 0x01, 0x78, 0xd2, 0x31, 0xc2, 0x53, 0x7d, 0xc7, 0x53, 0xcc, 0x14, 0xa4,
-	0x57, 0x22, 0xff, 0x7e, 0x6b, 0xc9, 0xf0, 0xb1, 0x93, 0x73, 0x96, 0x5e, 0x23, 0x3c, 0x93, 0xcc,
-	0x71, 0x4f, 0xef, 0xd7, 0x33, 0xe5, 0x18, 0x3c, 0xfe, 0x72, 0xf5, 0x33, 0xc4, 0x5d, 0x9c, 0xad,
-	0x18, 0x67, 0x3d, 0xc2, 0x59, 0xcb, 0x84, 0x63, 0xc6, 0x7e, 0xf5, 0xe0, 0x12, 0xe2, 0x05, 0xc2,
-	0xd3, 0xdb, 0x2c, 0xac, 0xba, 0x8c, 0x43, 0xb5, 0x49, 0x28, 0x05, 0x57, 0xfa, 0x39, 0x53, 0xb6,
-	0x2b, 0x2a, 0xf9, 0x8f, 0x61, 0x54, 0x5d, 0x94, 0x8d, 0x18, 0xe5, 0xd7, 0x08, 0xa5, 0x94, 0x0d,
-	0x25, 0x72, 0xa9, 0x9b, 0x69, 0xe4, 0x13, 0x84, 0x67, 0xb6, 0x59, 0x68, 0x00, 0xf3, 0x81, 0x76,
-	0x38, 0x7e, 0xc9, 0x9a, 0xa8, 0x4f, 0x26, 0x6f, 0x0c, 0x25, 0xeb, 0x92, 0x6c, 0xc6, 0x24, 0xbf,
-	0x45, 0x24, 0xab, 0x19, 0xaf, 0x46, 0x64, 0xd3, 0x45, 0x79, 0x86, 0xf0, 0xd7, 0xdb, 0x2c, 0xdc,
-	0x05, 0xf1, 0x37, 0xf7, 0xaa, 0xc4, 0xe7, 0x52, 0x29, 0x6b, 0xa0, 0x4b, 0x8d, 0x5c, 0xbe, 0xbd,
-	0xe6, 0xae, 0x08, 0x2a, 0xff, 0x9d, 0x9c, 0x29, 0xe8, 0xf4, 0x4c, 0x41, 0x6f, 0xcf, 0x14, 0xf4,
-	0xf0, 0x5c, 0xc9, 0x9d, 0x9e, 0x2b, 0xb9, 0x37, 0xe7, 0x4a, 0xee, 0xdf, 0x2d, 0xdb, 0x11, 0xcd,
-	0x56, 0x43, 0x33, 0x99, 0xd7, 0x6b, 0xbc, 0x7c, 0x8f, 0x51, 0xe8, 0xfb, 0xd2, 0xc1, 0x80, 0xaf,
-	0x88, 0x43, 0x1f, 0x78, 0xe3, 0xab, 0xf8, 0x0f, 0xc0, 0xea, 0xc7, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x96, 0x7f, 0x1e, 0x07, 0xf6, 0x08, 0x00, 0x00,
+	// 1172 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x53, 0xdb, 0xc6,
+	0x1b, 0xc6, 0x2d, 0x92, 0x6f, 0x20, 0x0b, 0x09, 0x41, 0xf0, 0x6d, 0x8c, 0x26, 0xb5, 0xa9, 0x4e,
+	0x94, 0x36, 0x76, 0x20, 0x94, 0x04, 0xf3, 0xab, 0xc6, 0x10, 0x86, 0x4e, 0x39, 0x54, 0xb4, 0x97,
+	0xf4, 0xa0, 0x59, 0xa4, 0x37, 0xb2, 0x06, 0x7b, 0x57, 0xd9, 0x5d, 0x9b, 0xd0, 0x63, 0x4f, 0xed,
+	0xad, 0x33, 0xbd, 0xf5, 0x94, 0x3f, 0x22, 0xd3, 0x6b, 0x0f, 0x6d, 0x66, 0x38, 0xf4, 0x90, 0x69,
+	0x3a, 0x93, 0x9e, 0x3c, 0x2d, 0xf4, 0xd0, 0x5e, 0xf9, 0x0b, 0x3a, 0x5a, 0xfd, 0x40, 0x60, 0x77,
+	0x2c, 0xec, 0xde, 0x2c, 0xed, 0x3e, 0x8f, 0xde, 0xcf, 0xf3, 0xae, 0x5e, 0x01, 0x2a, 0x3e, 0x6d,
+	0xb8, 0xd6, 0x3e, 0x77, 0x6b, 0x4d, 0x60, 0x45, 0x97, 0x08, 0x60, 0x56, 0x15, 0xbb, 0x84, 0x0b,
+	0xbc, 0xef, 0x12, 0xa7, 0xd8, 0x9c, 0x2d, 0xd6, 0x81, 0x73, 0xec, 0x00, 0x2f, 0x78, 0x8c, 0x0a,
+	0xaa, 0x4e, 0x25, 0x04, 0x85, 0x36, 0x41, 0xa1, 0x39, 0xab, 0xe5, 0x2c, 0xca, 0xeb, 0x94, 0x17,
+	0xf7, 0x30, 0x87, 0x62, 0x73, 0x76, 0x0f, 0x04, 0x9e, 0x2d, 0x5a, 0xd4, 0x25, 0x81, 0x83, 0x36,
+	0x19, 0xac, 0x9b, 0xf2, 0xaa, 0x18, 0x5c, 0x84, 0x4b, 0x13, 0x0e, 0x75, 0x68, 0x70, 0xdf, 0xff,
+	0x15, 0xde, 0xbd, 0xe3, 0x50, 0xea, 0xd4, 0xa0, 0x88, 0x3d, 0xb7, 0x88, 0x09, 0xa1, 0x02, 0x0b,
+	0x97, 0x92, 0x48, 0xf3, 0xb0, 0x2b, 0x41, 0x7b, 0x95, 0x81, 0xf2, 0x5e, 0x57, 0xa5, 0xc7, 0xa8,
+	0x47, 0x39, 0xae, 0x85, 0xcf, 0xd2, 0x5f, 0x0e, 0xa0, 0x89, 0x1d, 0xee, 0x18, 0xf0, 0xb4, 0x01,
+	0x5c, 0x18, 0x60, 0x43, 0xdd, 0xf3, 0x6b, 0x51, 0x37, 0xd0, 0xff, 0x9a, 0xb8, 0xd6, 0x80, 0xac,
+	0x32, 0xa5, 0x4c, 0x0f, 0xcf, 0x4d, 0x16, 0x42, 0x2c, 0x3f, 0x83, 0x42, 0x98, 0x41, 0xa1, 0x42,
+	0x5d, 0xb2, 0x3e, 0x7e, 0xd4, 0xca, 0x67, 0x4e, 0x5b, 0xf9, 0xe1, 0x43, 0x5c, 0xaf, 0x95, 0x74,
+	0x3f, 0x17, 0xdd, 0x08, 0xc4, 0xea, 0x36, 0x1a, 0xb7, 0x81, 0x0b, 0x97, 0x48, 0x40, 0x13, 0xdb,
+	0x36, 0x03, 0xce, 0xb3, 0x03, 0x53, 0xca, 0xf4, 0xf5, 0xf5, 0xec, 0x2f, 0x2f, 0xee, 0x4e, 0x84,
+	0xb6, 0xe5, 0x60, 0x65, 0x57, 0x30, 0x97, 0x38, 0x86, 0x9a, 0x10, 0x85, 0x2b, 0xea, 0x12, 0x1a,
+	0x79, 0xc2, 0x68, 0x3d, 0xf6, 0xb8, 0xd2, 0xc5, 0x63, 0xd8, 0xdf, 0x1d, 0x89, 0x3f, 0x43, 0x23,
+	0x1e, 0x73, 0x29, 0x73, 0xc5, 0xa1, 0xf9, 0x04, 0x20, 0x7b, 0xb5, 0x1b, 0xd4, 0xed, 0xd3, 0x56,
+	0x7e, 0x3c, 0x00, 0x4a, 0x0a, 0x75, 0x63, 0x38, 0xba, 0x7c, 0x04, 0x50, 0x1a, 0xfa, 0xea, 0x79,
+	0x3e, 0xf3, 0xd7, 0xf3, 0x7c, 0x46, 0xcf, 0xa1, 0x3b, 0x9d, 0x62, 0x34, 0x80, 0x7b, 0x94, 0x70,
+	0xd0, 0xdf, 0x28, 0x68, 0x72, 0x87, 0x3b, 0x15, 0x4c, 0x2c, 0xa8, 0x7d, 0xd2, 0x80, 0x06, 0xd8,
+	0x89, 0xb0, 0x27, 0xd1, 0x90, 0x6c, 0x94, 0xe9, 0xda, 0x32, 0xef, 0xeb, 0xc6, 0xa0, 0xbc, 0xde,
+	0xb6, 0x55, 0x15, 0x5d, 0xad, 0x62, 0x5e, 0x0d, 0x22, 0x33, 0xe4, 0xef, 0xfe, 0xa2, 0xd8, 0x40,
+	0xd7, 0x70, 0x9d, 0x36, 0x88, 0xe8, 0x1e, 0xc2, 0xd8, 0x69, 0x2b, 0x7f, 0x23, 0x08, 0x21, 0x90,
+	0xe8, 0x46, 0xa8, 0x4d, 0x90, 0x7f, 0xad, 0xa0, 0x77, 0xfe, 0x95, 0x2c, 0xe2, 0x57, 0x3f, 0x42,
+	0x43, 0x0c, 0x44, 0x83, 0x11, 0xb0, 0x7b, 0x3c, 0x51, 0xb1, 0x5e, 0xcd, 0xa2, 0x41, 0x0f, 0x88,
+	0xed, 0x12, 0x47, 0xa6, 0x32, 0x64, 0x44, 0x97, 0xfa, 0xf7, 0x0a, 0x1a, 0xdd, 0xe1, 0xce, 0xae,
+	0xeb, 0x10, 0x5c, 0xdb, 0x26, 0x02, 0x88, 0x50, 0x0b, 0x17, 0xb3, 0x5d, 0x1f, 0x3f, 0x6d, 0xe5,
+	0x47, 0x43, 0xeb, 0x70, 0x45, 0x3f, 0x0b, 0xfc, 0x7d, 0x34, 0xe8, 0x4a, 0x65, 0x74, 0x4c, 0xd5,
+	0xd3, 0x56, 0xfe, 0x66, 0xb0, 0x3d, 0x5c, 0xd0, 0x8d, 0x68, 0x4b, 0x5f, 0xad, 0x48, 0x84, 0x38,
+	0x89, 0x6e, 0x5f, 0xa8, 0x3b, 0x3e, 0x39, 0xdf, 0x0d, 0xa0, 0xff, 0xef, 0x70, 0xe7, 0x53, 0xe6,
+	0x7a, 0x15, 0x97, 0x59, 0x0d, 0x57, 0xac, 0x33, 0xc0, 0xfb, 0xc0, 0x2e, 0x4d, 0x66, 0xa3, 0x41,
+	0x6c, 0xc9, 0x41, 0x93, 0x1d, 0x98, 0xba, 0x32, 0x7d, 0x73, 0x6e, 0xa1, 0xd0, 0x6d, 0xf4, 0x15,
+	0xce, 0x3f, 0xb2, 0x2c, 0xe5, 0xc9, 0x44, 0x42, 0x43, 0xdd, 0x88, 0xac, 0xd5, 0x77, 0xd1, 0x35,
+	0x06, 0x98, 0x53, 0x12, 0x66, 0x91, 0x38, 0x44, 0xc1, 0x7d, 0xdd, 0x08, 0x37, 0xa8, 0x0b, 0xe8,
+	0x3a, 0x6e, 0x88, 0xaa, 0x7c, 0x9d, 0xb2, 0x57, 0xbb, 0x24, 0x77, 0xb6, 0x35, 0x91, 0x5b, 0x1e,
+	0xbd, 0xdd, 0x31, 0x9b, 0x28, 0xbd, 0xb9, 0xd7, 0xe3, 0xe8, 0xca, 0x0e, 0x77, 0xd4, 0x1f, 0x15,
+	0x34, 0xd6, 0x3e, 0xe4, 0x52, 0x04, 0xd0, 0xe9, 0xad, 0xd6, 0x56, 0x7b, 0xd3, 0xc5, 0x3d, 0x5d,
+	0xf8, 0xf2, 0xf5, 0x9f, 0xdf, 0x0e, 0xdc, 0xd3, 0xdf, 0x3b, 0xf7, 0xb1, 0x12, 0xcf, 0x3a, 0xce,
+	0xf6, 0x22, 0x03, 0x1b, 0xa0, 0x5e, 0x52, 0x66, 0xd4, 0x17, 0x0a, 0x1a, 0x39, 0x77, 0xb8, 0x67,
+	0x53, 0x15, 0x92, 0x94, 0x68, 0x8b, 0x97, 0x96, 0xf4, 0x58, 0x76, 0xf0, 0x8a, 0xf8, 0x65, 0xbf,
+	0x51, 0xd0, 0xad, 0x60, 0x3e, 0x24, 0xb2, 0x5f, 0x4a, 0x55, 0x47, 0xe7, 0xb1, 0xa2, 0x55, 0xfa,
+	0x10, 0xc7, 0x38, 0x65, 0x89, 0xb3, 0xa4, 0x2f, 0xa4, 0xc2, 0xb1, 0xa4, 0x99, 0xc9, 0x62, 0x1f,
+	0x9f, 0xec, 0x27, 0x05, 0x8d, 0x6e, 0xd1, 0x66, 0xa5, 0x46, 0x39, 0x54, 0xaa, 0x98, 0x10, 0xa8,
+	0xa9, 0xf3, 0xa9, 0x6a, 0xbb, 0xa0, 0xd2, 0x96, 0x7b, 0x51, 0xc5, 0x28, 0x2b, 0x12, 0xe5, 0x81,
+	0x3e, 0x97, 0x0e, 0xc5, 0xb7, 0x30, 0xad, 0xc0, 0xc3, 0xc7, 0x38, 0x52, 0xd0, 0xad, 0x2d, 0xda,
+	0x34, 0x80, 0x7a, 0x40, 0x22, 0x8e, 0x0f, 0xd2, 0x56, 0x74, 0x4e, 0xa6, 0xad, 0xf4, 0x24, 0x8b,
+	0x49, 0x56, 0x25, 0xc9, 0x43, 0xfd, 0x7e, 0xca, 0x57, 0xc3, 0xf7, 0x48, 0xa2, 0xfc, 0xa0, 0xa0,
+	0x1b, 0x5b, 0xb4, 0xb9, 0x0b, 0xe2, 0x63, 0x5e, 0xaf, 0x60, 0x8f, 0xab, 0x73, 0x69, 0x0b, 0x3a,
+	0xd3, 0x68, 0xa5, 0xcb, 0x6b, 0xfe, 0x33, 0x82, 0x5f, 0x15, 0xa4, 0x76, 0x98, 0xf6, 0x0f, 0x52,
+	0x95, 0xd4, 0x2e, 0xd4, 0xd6, 0x7a, 0x14, 0xc6, 0x40, 0x1b, 0x12, 0x68, 0x55, 0x5f, 0x4c, 0x05,
+	0x24, 0x98, 0xeb, 0x99, 0x56, 0xe0, 0x64, 0xee, 0x05, 0x56, 0x3e, 0xd6, 0x1f, 0x0a, 0x7a, 0x4b,
+	0x76, 0x9d, 0x83, 0xb8, 0x80, 0xb6, 0x94, 0xfe, 0xc8, 0xb4, 0x89, 0xb5, 0x4a, 0x1f, 0xe2, 0x18,
+	0x71, 0x53, 0x22, 0xae, 0xe9, 0xa5, 0x94, 0x3d, 0xe3, 0x20, 0x3a, 0x31, 0xfe, 0xad, 0xa0, 0x6c,
+	0x70, 0x28, 0x36, 0xeb, 0xc0, 0x1c, 0x20, 0xd6, 0x61, 0x39, 0xfa, 0x6a, 0xa9, 0x2b, 0x97, 0x38,
+	0x53, 0xed, 0x72, 0x6d, 0xb3, 0x2f, 0x79, 0x4c, 0xba, 0x25, 0x49, 0xcb, 0xfa, 0x72, 0x2a, 0x52,
+	0x9f, 0x13, 0x22, 0x33, 0xf3, 0xec, 0x0b, 0xac, 0xcc, 0xa8, 0xc7, 0x41, 0x3f, 0x77, 0x41, 0x18,
+	0x70, 0x80, 0x99, 0xbd, 0x7b, 0x80, 0x3d, 0x83, 0x36, 0x04, 0xf0, 0xf4, 0xfd, 0xec, 0x20, 0xd6,
+	0x2a, 0x7d, 0x88, 0x63, 0xca, 0x47, 0x92, 0xf2, 0x43, 0x7d, 0x29, 0x35, 0x25, 0x93, 0x56, 0x26,
+	0x3f, 0xc0, 0x9e, 0xc9, 0xa4, 0x99, 0x0f, 0xf9, 0x32, 0x98, 0xef, 0x7e, 0xa4, 0x1e, 0xb5, 0xaa,
+	0x72, 0x9e, 0xcc, 0x5f, 0xa6, 0x11, 0x91, 0x4a, 0x5b, 0xee, 0x45, 0xd5, 0xe3, 0x4c, 0x91, 0x5d,
+	0xf3, 0x3d, 0x4c, 0x0b, 0x7b, 0x92, 0xe3, 0x67, 0x05, 0x8d, 0x6d, 0xd1, 0xe6, 0x06, 0x30, 0x70,
+	0x5c, 0x2e, 0x80, 0x3d, 0xa6, 0x04, 0x52, 0xfe, 0xf9, 0xd3, 0xa6, 0xd3, 0x56, 0x7b, 0xd3, 0xc5,
+	0x34, 0x6b, 0x92, 0x66, 0xb1, 0xa4, 0xcc, 0xe8, 0xf3, 0xa9, 0x80, 0xec, 0xd8, 0xc7, 0xfc, 0x82,
+	0x12, 0x58, 0xff, 0xfc, 0xe8, 0x38, 0xa7, 0xbc, 0x3a, 0xce, 0x29, 0xbf, 0x1f, 0xe7, 0x94, 0x6f,
+	0x4e, 0x72, 0x99, 0x57, 0x27, 0xb9, 0xcc, 0x6f, 0x27, 0xb9, 0xcc, 0xe3, 0xb2, 0xe3, 0x8a, 0x6a,
+	0x63, 0xaf, 0x60, 0xd1, 0x7a, 0xd2, 0xf9, 0xae, 0x2f, 0x3b, 0xf7, 0xa8, 0x67, 0x1d, 0x1e, 0x23,
+	0x0e, 0x3d, 0xe0, 0x7b, 0xd7, 0xe4, 0x7f, 0xc6, 0xf7, 0xff, 0x19, 0x00, 0x1a, 0xab, 0x62, 0x53,
+	0x49, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -363,6 +488,24 @@ type MsgClient interface {
 	GovCloseChannel(ctx context.Context, in *MsgGovCloseChannel, opts ...grpc.CallOption) (*MsgGovCloseChannelResponse, error)
 	GovReopenChannel(ctx context.Context, in *MsgGovReopenChannel, opts ...grpc.CallOption) (*MsgGovReopenChannelResponse, error)
 	GovSetLsmCaps(ctx context.Context, in *MsgGovSetLsmCaps, opts ...grpc.CallOption) (*MsgGovSetLsmCapsResponse, error)
+	// TripCircuitBreaker defines a method for pausing one or more zone actions.
+	// It may be signed by governance or the emergency authority.
+	TripCircuitBreaker(ctx context.Context, in *MsgTripCircuitBreaker, opts ...grpc.CallOption) (*MsgTripCircuitBreakerResponse, error)
+	// GovResetCircuitBreaker defines a governance method for resuming paused
+	// zone actions.
+	GovResetCircuitBreaker(ctx context.Context, in *MsgGovResetCircuitBreaker, opts ...grpc.CallOption) (*MsgGovResetCircuitBreakerResponse, error)
+	// GovSetEmergencyAuthority defines a governance method for setting the
+	// address permitted to trip circuit breakers.
+	GovSetEmergencyAuthority(ctx context.Context, in *MsgGovSetEmergencyAuthority, opts ...grpc.CallOption) (*MsgGovSetEmergencyAuthorityResponse, error)
+	// GovSetRewardSwapRoutes defines a governance method for setting the routes
+	// by which a zone's non-base-denom rewards are swapped into its base denom.
+	GovSetRewardSwapRoutes(ctx context.Context, in *MsgGovSetRewardSwapRoutes, opts ...grpc.CallOption) (*MsgGovSetRewardSwapRoutesResponse, error)
+	// GovSetEpochCaps defines a governance method for setting the per-epoch
+	// deposit, redemption and per-address caps of a zone.
+	GovSetEpochCaps(ctx context.Context, in *MsgGovSetEpochCaps, opts ...grpc.CallOption) (*MsgGovSetEpochCapsResponse, error)
+	// GovDeregisterZone defines a governance method for winding down a zone,
+	// optionally migrating it to a new connection.
+	GovDeregisterZone(ctx context.Context, in *MsgGovDeregisterZone, opts ...grpc.CallOption) (*MsgGovDeregisterZoneResponse, error)
 }
 
 type msgClient struct {
@@ -427,6 +570,60 @@ func (c *msgClient) GovSetLsmCaps(ctx context.Context, in *MsgGovSetLsmCaps, opt
 	return out, nil
 }
 
+func (c *msgClient) TripCircuitBreaker(ctx context.Context, in *MsgTripCircuitBreaker, opts ...grpc.CallOption) (*MsgTripCircuitBreakerResponse, error) {
+	out := new(MsgTripCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/TripCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GovResetCircuitBreaker(ctx context.Context, in *MsgGovResetCircuitBreaker, opts ...grpc.CallOption) (*MsgGovResetCircuitBreakerResponse, error) {
+	out := new(MsgGovResetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/GovResetCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GovSetEmergencyAuthority(ctx context.Context, in *MsgGovSetEmergencyAuthority, opts ...grpc.CallOption) (*MsgGovSetEmergencyAuthorityResponse, error) {
+	out := new(MsgGovSetEmergencyAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/GovSetEmergencyAuthority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GovSetRewardSwapRoutes(ctx context.Context, in *MsgGovSetRewardSwapRoutes, opts ...grpc.CallOption) (*MsgGovSetRewardSwapRoutesResponse, error) {
+	out := new(MsgGovSetRewardSwapRoutesResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/GovSetRewardSwapRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GovSetEpochCaps(ctx context.Context, in *MsgGovSetEpochCaps, opts ...grpc.CallOption) (*MsgGovSetEpochCapsResponse, error) {
+	out := new(MsgGovSetEpochCapsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/GovSetEpochCaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GovDeregisterZone(ctx context.Context, in *MsgGovDeregisterZone, opts ...grpc.CallOption) (*MsgGovDeregisterZoneResponse, error) {
+	out := new(MsgGovDeregisterZoneResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/GovDeregisterZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RequestRedemption defines a method for requesting burning of qAssets for
@@ -443,6 +640,24 @@ type MsgServer interface {
 	GovCloseChannel(context.Context, *MsgGovCloseChannel) (*MsgGovCloseChannelResponse, error)
 	GovReopenChannel(context.Context, *MsgGovReopenChannel) (*MsgGovReopenChannelResponse, error)
 	GovSetLsmCaps(context.Context, *MsgGovSetLsmCaps) (*MsgGovSetLsmCapsResponse, error)
+	// TripCircuitBreaker defines a method for pausing one or more zone actions.
+	// It may be signed by governance or the emergency authority.
+	TripCircuitBreaker(context.Context, *MsgTripCircuitBreaker) (*MsgTripCircuitBreakerResponse, error)
+	// GovResetCircuitBreaker defines a governance method for resuming paused
+	// zone actions.
+	GovResetCircuitBreaker(context.Context, *MsgGovResetCircuitBreaker) (*MsgGovResetCircuitBreakerResponse, error)
+	// GovSetEmergencyAuthority defines a governance method for setting the
+	// address permitted to trip circuit breakers.
+	GovSetEmergencyAuthority(context.Context, *MsgGovSetEmergencyAuthority) (*MsgGovSetEmergencyAuthorityResponse, error)
+	// GovSetRewardSwapRoutes defines a governance method for setting the routes
+	// by which a zone's non-base-denom rewards are swapped into its base denom.
+	GovSetRewardSwapRoutes(context.Context, *MsgGovSetRewardSwapRoutes) (*MsgGovSetRewardSwapRoutesResponse, error)
+	// GovSetEpochCaps defines a governance method for setting the per-epoch
+	// deposit, redemption and per-address caps of a zone.
+	GovSetEpochCaps(context.Context, *MsgGovSetEpochCaps) (*MsgGovSetEpochCapsResponse, error)
+	// GovDeregisterZone defines a governance method for winding down a zone,
+	// optionally migrating it to a new connection.
+	GovDeregisterZone(context.Context, *MsgGovDeregisterZone) (*MsgGovDeregisterZoneResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -467,6 +682,24 @@ func (*UnimplementedMsgServer) GovReopenChannel(ctx context.Context, req *MsgGov
 func (*UnimplementedMsgServer) GovSetLsmCaps(ctx context.Context, req *MsgGovSetLsmCaps) (*MsgGovSetLsmCapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GovSetLsmCaps not implemented")
 }
+func (*UnimplementedMsgServer) TripCircuitBreaker(ctx context.Context, req *MsgTripCircuitBreaker) (*MsgTripCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) GovResetCircuitBreaker(ctx context.Context, req *MsgGovResetCircuitBreaker) (*MsgGovResetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovResetCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) GovSetEmergencyAuthority(ctx context.Context, req *MsgGovSetEmergencyAuthority) (*MsgGovSetEmergencyAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovSetEmergencyAuthority not implemented")
+}
+func (*UnimplementedMsgServer) GovSetRewardSwapRoutes(ctx context.Context, req *MsgGovSetRewardSwapRoutes) (*MsgGovSetRewardSwapRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovSetRewardSwapRoutes not implemented")
+}
+func (*UnimplementedMsgServer) GovSetEpochCaps(ctx context.Context, req *MsgGovSetEpochCaps) (*MsgGovSetEpochCapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovSetEpochCaps not implemented")
+}
+func (*UnimplementedMsgServer) GovDeregisterZone(ctx context.Context, req *MsgGovDeregisterZone) (*MsgGovDeregisterZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovDeregisterZone not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -580,6 +813,114 @@ func _Msg_GovSetLsmCaps_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TripCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTripCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TripCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Msg/TripCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TripCircuitBreaker(ctx, req.(*MsgTripCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GovResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovResetCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovResetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Msg/GovResetCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovResetCircuitBreaker(ctx, req.(*MsgGovResetCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GovSetEmergencyAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovSetEmergencyAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovSetEmergencyAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Msg/GovSetEmergencyAuthority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovSetEmergencyAuthority(ctx, req.(*MsgGovSetEmergencyAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GovSetRewardSwapRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovSetRewardSwapRoutes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovSetRewardSwapRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Msg/GovSetRewardSwapRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovSetRewardSwapRoutes(ctx, req.(*MsgGovSetRewardSwapRoutes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GovSetEpochCaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovSetEpochCaps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovSetEpochCaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Msg/GovSetEpochCaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovSetEpochCaps(ctx, req.(*MsgGovSetEpochCaps))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GovDeregisterZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovDeregisterZone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovDeregisterZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Msg/GovDeregisterZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovDeregisterZone(ctx, req.(*MsgGovDeregisterZone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "quicksilver.interchainstaking.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -608,6 +949,30 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GovSetLsmCaps",
 			Handler:    _Msg_GovSetLsmCaps_Handler,
 		},
+		{
+			MethodName: "TripCircuitBreaker",
+			Handler:    _Msg_TripCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "GovResetCircuitBreaker",
+			Handler:    _Msg_GovResetCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "GovSetEmergencyAuthority",
+			Handler:    _Msg_GovSetEmergencyAuthority_Handler,
+		},
+		{
+			MethodName: "GovSetRewardSwapRoutes",
+			Handler:    _Msg_GovSetRewardSwapRoutes_Handler,
+		},
+		{
+			MethodName: "GovSetEpochCaps",
+			Handler:    _Msg_GovSetEpochCaps_Handler,
+		},
+		{
+			MethodName: "GovDeregisterZone",
+			Handler:    _Msg_GovDeregisterZone_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "quicksilver/interchainstaking/v1/messages.proto",
@@ -633,6 +998,18 @@ func (m *MsgRequestRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PriorityFee != nil {
+		{
+			size, err := m.PriorityFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.FromAddress) > 0 {
 		i -= len(m.FromAddress)
 		copy(dAtA[i:], m.FromAddress)
@@ -703,6 +1080,18 @@ func (m *MsgCancelQueuedRedemption) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.FromAddress) > 0 {
 		i -= len(m.FromAddress)
 		copy(dAtA[i:], m.FromAddress)
@@ -747,6 +1136,16 @@ func (m *MsgCancelQueuedRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Returned.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -827,6 +1226,91 @@ func (m *MsgSignalIntentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTripCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTripCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTripCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Actions) > 0 {
+		dAtA6 := make([]byte, len(m.Actions)*10)
+		var j5 int
+		for _, num := range m.Actions {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintMessages(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTripCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTripCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTripCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessages(v)
 	base := offset
@@ -854,6 +1338,10 @@ func (m *MsgRequestRedemption) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessages(uint64(l))
 	}
+	if m.PriorityFee != nil {
+		l = m.PriorityFee.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -884,6 +1372,10 @@ func (m *MsgCancelQueuedRedemption) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessages(uint64(l))
 	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -895,6 +1387,9 @@ func (m *MsgCancelQueuedRedemptionResponse) Size() (n int) {
 	_ = l
 	l = m.Returned.Size()
 	n += 1 + l + sovMessages(uint64(l))
+	if m.Pending {
+		n += 2
+	}
 	return n
 }
 
@@ -928,7 +1423,44 @@ func (m *MsgSignalIntentResponse) Size() (n int) {
 	return n
 }
 
-func sovMessages(x uint64) (n int) {
+func (m *MsgTripCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		l = 0
+		for _, e := range m.Actions {
+			l += sovMessages(uint64(e))
+		}
+		n += 1 + sovMessages(uint64(l)) + l
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTripCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMessages(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozMessages(x uint64) (n int) {
@@ -1060,6 +1592,42 @@ func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
 			}
 			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriorityFee == nil {
+				m.PriorityFee = &types.Coin{}
+			}
+			if err := m.PriorityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -1256,6 +1824,42 @@ func (m *MsgCancelQueuedRedemption) Unmarshal(dAtA []byte) error {
 			}
 			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -1339,6 +1943,26 @@ func (m *MsgCancelQueuedRedemptionResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -1556,6 +2180,271 @@ func (m *MsgSignalIntentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTripCircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTripCircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTripCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v CircuitBreakerAction
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessages
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CircuitBreakerAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Actions = append(m.Actions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessages
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMessages
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMessages
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Actions) == 0 {
+					m.Actions = make([]CircuitBreakerAction, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CircuitBreakerAction
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CircuitBreakerAction(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Actions = append(m.Actions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTripCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTripCircuitBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTripCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessages(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
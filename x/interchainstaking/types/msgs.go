@@ -21,6 +21,7 @@ const (
 	TypeMsgRequestRedemption      = "requestredemption"
 	TypeMsgCancelQueuedRedemption = "cancelqueuedredemption"
 	TypeMsgSignalIntent           = "signalintent"
+	TypeMsgTripCircuitBreaker     = "tripcircuitbreaker"
 )
 
 var (
@@ -30,9 +31,16 @@ var (
 	_ sdk.Msg            = &MsgGovCloseChannel{}
 	_ sdk.Msg            = &MsgGovReopenChannel{}
 	_ sdk.Msg            = &MsgGovSetLsmCaps{}
+	_ sdk.Msg            = &MsgTripCircuitBreaker{}
+	_ sdk.Msg            = &MsgGovResetCircuitBreaker{}
+	_ sdk.Msg            = &MsgGovSetEmergencyAuthority{}
+	_ sdk.Msg            = &MsgGovSetRewardSwapRoutes{}
+	_ sdk.Msg            = &MsgGovDeregisterZone{}
+	_ sdk.Msg            = &MsgGovSetEpochCaps{}
 	_ legacytx.LegacyMsg = &MsgRequestRedemption{}
 	_ legacytx.LegacyMsg = &MsgCancelQueuedRedemption{}
 	_ legacytx.LegacyMsg = &MsgSignalIntent{}
+	_ legacytx.LegacyMsg = &MsgTripCircuitBreaker{}
 )
 
 // NewMsgRequestRedemption - construct a msg to request redemption.
@@ -70,6 +78,20 @@ func (msg MsgRequestRedemption) ValidateBasic() error {
 		errs["DestinationAddress"] = errors.New("recipient address not provided")
 	}
 
+	// check priority fee, if provided
+	if msg.PriorityFee != nil {
+		switch {
+		case msg.PriorityFee.Amount.IsNil():
+			errs["PriorityFee"] = ErrCoinAmountNil
+		case msg.PriorityFee.Validate() != nil:
+			errs["PriorityFee"] = msg.PriorityFee.Validate()
+		case !msg.PriorityFee.IsPositive():
+			errs["PriorityFee"] = errors.New("priority fee must be positive")
+		case msg.PriorityFee.Denom != msg.Value.Denom:
+			errs["PriorityFee"] = fmt.Errorf("priority fee denom %s does not match redemption denom %s", msg.PriorityFee.Denom, msg.Value.Denom)
+		}
+	}
+
 	if len(errs) > 0 {
 		return multierror.New(errs)
 	}
@@ -97,6 +119,11 @@ func NewMsgCancelQueuedRedemption(chainID string, hash string, fromAddress sdk.A
 	return &MsgCancelQueuedRedemption{ChainId: chainID, Hash: hash, FromAddress: fromAddress.String()}
 }
 
+// NewMsgCancelQueuedRedemptionPartial - construct a msg to cancel part of a queued redemption.
+func NewMsgCancelQueuedRedemptionPartial(chainID string, hash string, amount sdk.Coin, fromAddress sdk.Address) *MsgCancelQueuedRedemption {
+	return &MsgCancelQueuedRedemption{ChainId: chainID, Hash: hash, FromAddress: fromAddress.String(), Amount: &amount}
+}
+
 // Route Implements Msg.
 func (MsgCancelQueuedRedemption) Route() string { return RouterKey }
 
@@ -123,6 +150,15 @@ func (msg MsgCancelQueuedRedemption) ValidateBasic() error {
 		errs["ChainId"] = errors.New("chainId not provided")
 	}
 
+	// check optional partial amount
+	if msg.Amount != nil {
+		if err := msg.Amount.Validate(); err != nil {
+			errs["Amount"] = err
+		} else if !msg.Amount.IsPositive() {
+			errs["Amount"] = errors.New("cancellation amount must be positive")
+		}
+	}
+
 	if len(errs) > 0 {
 		return multierror.New(errs)
 	}
@@ -330,6 +366,223 @@ func (msg MsgGovSetLsmCaps) ValidateBasic() error {
 	return msg.Caps.Validate()
 }
 
+// MsgTripCircuitBreaker
+
+// NewMsgTripCircuitBreaker - construct a msg to pause one or more zone actions.
+func NewMsgTripCircuitBreaker(chainID string, actions []CircuitBreakerAction, reason string, fromAddress sdk.Address) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{ChainId: chainID, Actions: actions, Reason: reason, Authority: fromAddress.String()}
+}
+
+// Route Implements Msg.
+func (MsgTripCircuitBreaker) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (MsgTripCircuitBreaker) Type() string { return TypeMsgTripCircuitBreaker }
+
+// GetSignBytes Implements Msg.
+func (msg MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := addressutils.AccAddressFromBech32(msg.Authority, "")
+	return []sdk.AccAddress{fromAddress}
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgTripCircuitBreaker) ValidateBasic() error {
+	errs := make(map[string]error)
+
+	if _, err := addressutils.AccAddressFromBech32(msg.Authority, ""); err != nil {
+		errs["Authority"] = err
+	}
+
+	if len(msg.ChainId) == 0 || len(msg.ChainId) > 100 {
+		errs["ChainId"] = errors.New("invalid chain id")
+	}
+
+	if err := ValidateCircuitBreakerActions(msg.Actions); err != nil {
+		errs["Actions"] = err
+	}
+
+	if strings.TrimSpace(msg.Reason) == "" {
+		errs["Reason"] = errors.New("reason must be provided")
+	}
+
+	if len(errs) > 0 {
+		return multierror.New(errs)
+	}
+
+	return nil
+}
+
+// MsgGovResetCircuitBreaker
+
+// NewMsgGovResetCircuitBreaker - construct a msg to resume paused zone actions.
+func NewMsgGovResetCircuitBreaker(chainID string, actions []CircuitBreakerAction, fromAddress sdk.Address) *MsgGovResetCircuitBreaker {
+	return &MsgGovResetCircuitBreaker{ChainId: chainID, Actions: actions, Authority: fromAddress.String()}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGovResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGovResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := addressutils.AccAddressFromBech32(msg.Authority, "")
+	return []sdk.AccAddress{fromAddress}
+}
+
+// ValidateBasic
+func (msg MsgGovResetCircuitBreaker) ValidateBasic() error {
+	_, err := addressutils.AccAddressFromBech32(msg.Authority, "")
+	if err != nil {
+		return err
+	}
+
+	if len(msg.ChainId) == 0 || len(msg.ChainId) > 100 {
+		return errors.New("invalid chain id")
+	}
+
+	return ValidateCircuitBreakerActions(msg.Actions)
+}
+
+// MsgGovSetEmergencyAuthority
+
+// NewMsgGovSetEmergencyAuthority - construct a msg to set the emergency authority.
+func NewMsgGovSetEmergencyAuthority(emergencyAuthority string, fromAddress sdk.Address) *MsgGovSetEmergencyAuthority {
+	return &MsgGovSetEmergencyAuthority{EmergencyAuthority: emergencyAuthority, Authority: fromAddress.String()}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGovSetEmergencyAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGovSetEmergencyAuthority) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := addressutils.AccAddressFromBech32(msg.Authority, "")
+	return []sdk.AccAddress{fromAddress}
+}
+
+// ValidateBasic
+func (msg MsgGovSetEmergencyAuthority) ValidateBasic() error {
+	_, err := addressutils.AccAddressFromBech32(msg.Authority, "")
+	if err != nil {
+		return err
+	}
+
+	// an empty emergency authority removes the existing one.
+	if msg.EmergencyAuthority == "" {
+		return nil
+	}
+
+	_, err = addressutils.AccAddressFromBech32(msg.EmergencyAuthority, "")
+	return err
+}
+
+// MsgGovSetRewardSwapRoutes
+
+// NewMsgGovSetRewardSwapRoutes - construct a msg to set the reward swap routes for a zone.
+func NewMsgGovSetRewardSwapRoutes(chainID string, routes []RewardSwapRoute, fromAddress sdk.Address) *MsgGovSetRewardSwapRoutes {
+	return &MsgGovSetRewardSwapRoutes{ChainId: chainID, Routes: routes, Authority: fromAddress.String()}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGovSetRewardSwapRoutes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGovSetRewardSwapRoutes) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := addressutils.AccAddressFromBech32(msg.Authority, "")
+	return []sdk.AccAddress{fromAddress}
+}
+
+// ValidateBasic
+func (msg MsgGovSetRewardSwapRoutes) ValidateBasic() error {
+	_, err := addressutils.AccAddressFromBech32(msg.Authority, "")
+	if err != nil {
+		return err
+	}
+
+	if len(msg.ChainId) == 0 || len(msg.ChainId) > 100 {
+		return errors.New("invalid chain id")
+	}
+
+	return ValidateRewardSwapRoutes(msg.Routes)
+}
+
+// NewMsgGovDeregisterZone - construct a msg to wind down a zone.
+func NewMsgGovDeregisterZone(chainID, migrationConnectionID string, fromAddress sdk.Address) *MsgGovDeregisterZone {
+	return &MsgGovDeregisterZone{ChainId: chainID, MigrationConnectionId: migrationConnectionID, Authority: fromAddress.String()}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGovDeregisterZone) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGovDeregisterZone) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := addressutils.AccAddressFromBech32(msg.Authority, "")
+	return []sdk.AccAddress{fromAddress}
+}
+
+// ValidateBasic
+func (msg MsgGovDeregisterZone) ValidateBasic() error {
+	_, err := addressutils.AccAddressFromBech32(msg.Authority, "")
+	if err != nil {
+		return err
+	}
+
+	if len(msg.ChainId) == 0 || len(msg.ChainId) > 100 {
+		return errors.New("invalid chain id")
+	}
+
+	if msg.MigrationConnectionId != "" {
+		if err := ValidateConnection(msg.MigrationConnectionId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MsgGovSetEpochCaps
+
+// NewMsgGovSetEpochCaps - construct a msg to set the per-epoch caps for a zone.
+func NewMsgGovSetEpochCaps(chainID string, caps EpochCaps, fromAddress sdk.Address) *MsgGovSetEpochCaps {
+	return &MsgGovSetEpochCaps{ChainId: chainID, Caps: caps, Authority: fromAddress.String()}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGovSetEpochCaps) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGovSetEpochCaps) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := addressutils.AccAddressFromBech32(msg.Authority, "")
+	return []sdk.AccAddress{fromAddress}
+}
+
+// ValidateBasic
+func (msg MsgGovSetEpochCaps) ValidateBasic() error {
+	_, err := addressutils.AccAddressFromBech32(msg.Authority, "")
+	if err != nil {
+		return err
+	}
+
+	if len(msg.ChainId) == 0 || len(msg.ChainId) > 100 {
+		return errors.New("invalid chain id")
+	}
+
+	return msg.Caps.Validate()
+}
+
 // Helpers
 func ValidateConnection(connectionID string) error {
 	if !strings.HasPrefix(connectionID, "connection-") {
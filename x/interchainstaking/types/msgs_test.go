@@ -214,6 +214,7 @@ func TestMsgCancelQueuedRedemption_ValidateBasic(t *testing.T) {
 		ChainID     string
 		Hash        string
 		FromAddress string
+		Amount      *sdk.Coin
 	}
 	tests := []struct {
 		name    string
@@ -270,6 +271,36 @@ func TestMsgCancelQueuedRedemption_ValidateBasic(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid amount - zero",
+			fields{
+				ChainID:     "cosmoshub-4",
+				Hash:        randomutils.GenerateRandomHashAsHex(64),
+				FromAddress: addressutils.GenerateAddressForTestWithPrefix("quick"),
+				Amount:      &sdk.Coin{Denom: "uqatom", Amount: sdk.ZeroInt()},
+			},
+			true,
+		},
+		{
+			"invalid amount - negative",
+			fields{
+				ChainID:     "cosmoshub-4",
+				Hash:        randomutils.GenerateRandomHashAsHex(64),
+				FromAddress: addressutils.GenerateAccAddressForTest().String(),
+				Amount:      &sdk.Coin{Denom: "uqatom", Amount: sdk.NewInt(-1)},
+			},
+			true,
+		},
+		{
+			"valid partial",
+			fields{
+				ChainID:     "cosmoshub-4",
+				Hash:        randomutils.GenerateRandomHashAsHex(32),
+				FromAddress: addressutils.GenerateAccAddressForTest().String(),
+				Amount:      &sdk.Coin{Denom: "uqatom", Amount: sdk.NewInt(100)},
+			},
+			false,
+		},
 		{
 			"valid",
 			fields{
@@ -286,6 +317,7 @@ func TestMsgCancelQueuedRedemption_ValidateBasic(t *testing.T) {
 				ChainId:     tt.fields.ChainID,
 				Hash:        tt.fields.Hash,
 				FromAddress: tt.fields.FromAddress,
+				Amount:      tt.fields.Amount,
 			}
 			err := msg.ValidateBasic()
 			if tt.wantErr {
@@ -327,6 +359,7 @@ func TestMsgRequestRedemption_ValidateBasic(t *testing.T) {
 		Value              sdk.Coin
 		DestinationAddress string
 		FromAddress        string
+		PriorityFee        *sdk.Coin
 	}
 	tests := []struct {
 		name    string
@@ -409,6 +442,58 @@ func TestMsgRequestRedemption_ValidateBasic(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid_priority_fee",
+			fields{
+				Value: sdk.Coin{
+					Denom:  "stake",
+					Amount: sdkmath.OneInt(),
+				},
+				DestinationAddress: addressutils.GenerateAccAddressForTest().String(),
+				FromAddress:        addressutils.GenerateAccAddressForTest().String(),
+				PriorityFee:        &sdk.Coin{Denom: "stake", Amount: sdkmath.OneInt()},
+			},
+			false,
+		},
+		{
+			"invalid_nil_priority_fee_amount",
+			fields{
+				Value: sdk.Coin{
+					Denom:  "stake",
+					Amount: sdkmath.OneInt(),
+				},
+				DestinationAddress: addressutils.GenerateAccAddressForTest().String(),
+				FromAddress:        addressutils.GenerateAccAddressForTest().String(),
+				PriorityFee:        &sdk.Coin{Denom: "stake"},
+			},
+			true,
+		},
+		{
+			"invalid_zero_priority_fee",
+			fields{
+				Value: sdk.Coin{
+					Denom:  "stake",
+					Amount: sdkmath.OneInt(),
+				},
+				DestinationAddress: addressutils.GenerateAccAddressForTest().String(),
+				FromAddress:        addressutils.GenerateAccAddressForTest().String(),
+				PriorityFee:        &sdk.Coin{Denom: "stake", Amount: sdkmath.ZeroInt()},
+			},
+			true,
+		},
+		{
+			"invalid_priority_fee_denom",
+			fields{
+				Value: sdk.Coin{
+					Denom:  "stake",
+					Amount: sdkmath.OneInt(),
+				},
+				DestinationAddress: addressutils.GenerateAccAddressForTest().String(),
+				FromAddress:        addressutils.GenerateAccAddressForTest().String(),
+				PriorityFee:        &sdk.Coin{Denom: "other", Amount: sdkmath.OneInt()},
+			},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -416,6 +501,7 @@ func TestMsgRequestRedemption_ValidateBasic(t *testing.T) {
 				Value:              tt.fields.Value,
 				DestinationAddress: tt.fields.DestinationAddress,
 				FromAddress:        tt.fields.FromAddress,
+				PriorityFee:        tt.fields.PriorityFee,
 			}
 			err := msg.ValidateBasic()
 			if tt.wantErr {
@@ -882,3 +968,291 @@ func TestMsgGovSetLsmCaps(t *testing.T) {
 	wantSigners := []sdk.AccAddress{fromAddr}
 	require.Equal(t, wantSigners, gotSigners, "mismatch in signers")
 }
+
+func TestMsgTripCircuitBreaker_ValidateBasic(t *testing.T) {
+	authority := addressutils.GenerateAddressForTestWithPrefix("quick")
+	cases := []struct {
+		Name string
+		Msg  types.MsgTripCircuitBreaker
+		Err  string
+	}{
+		{
+			Name: "valid",
+			Msg:  types.MsgTripCircuitBreaker{ChainId: "chain-1", Actions: []types.CircuitBreakerAction{types.CircuitBreakerActionDeposits}, Reason: "test", Authority: authority},
+			Err:  "",
+		},
+		{
+			Name: "valid all actions",
+			Msg:  types.MsgTripCircuitBreaker{ChainId: "chain-1", Reason: "test", Authority: authority},
+			Err:  "",
+		},
+		{
+			Name: "invalid empty chain id",
+			Msg:  types.MsgTripCircuitBreaker{Actions: []types.CircuitBreakerAction{types.CircuitBreakerActionDeposits}, Reason: "test", Authority: authority},
+			Err:  "invalid chain id",
+		},
+		{
+			Name: "invalid bad authority",
+			Msg:  types.MsgTripCircuitBreaker{ChainId: "chain-1", Reason: "test", Authority: "raa"},
+			Err:  "decoding bech32 failed",
+		},
+		{
+			Name: "invalid undefined action",
+			Msg:  types.MsgTripCircuitBreaker{ChainId: "chain-1", Actions: []types.CircuitBreakerAction{types.CircuitBreakerActionUndefined}, Reason: "test", Authority: authority},
+			Err:  "invalid circuit breaker action",
+		},
+		{
+			Name: "invalid duplicate action",
+			Msg:  types.MsgTripCircuitBreaker{ChainId: "chain-1", Actions: []types.CircuitBreakerAction{types.CircuitBreakerActionICA, types.CircuitBreakerActionICA}, Reason: "test", Authority: authority},
+			Err:  "duplicate circuit breaker action ica",
+		},
+		{
+			Name: "invalid empty reason",
+			Msg:  types.MsgTripCircuitBreaker{ChainId: "chain-1", Reason: " ", Authority: authority},
+			Err:  "reason must be provided",
+		},
+	}
+
+	for _, c := range cases {
+		err := c.Msg.ValidateBasic()
+		if c.Err == "" { // happy
+			require.NoError(t, err, c.Name)
+		} else {
+			require.ErrorContains(t, err, c.Err, c.Name)
+		}
+	}
+}
+
+func TestMsgTripCircuitBreaker(t *testing.T) {
+	fromAddr := addressutils.GenerateAccAddressForTest()
+	msg := types.NewMsgTripCircuitBreaker("cosmoshub-4", []types.CircuitBreakerAction{types.CircuitBreakerActionDeposits}, "test", fromAddr)
+
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgTripCircuitBreaker, msg.Type())
+
+	// Check the signBytes.
+	signBytes := msg.GetSignBytes()
+	require.True(t, len(signBytes) != 0, "expecting signBytes to be produced")
+
+	// Signers should return the from address.
+	gotSigners := msg.GetSigners()
+	wantSigners := []sdk.AccAddress{fromAddr}
+	require.Equal(t, wantSigners, gotSigners, "mismatch in signers")
+}
+
+func TestGovResetCircuitBreaker_ValidateBasic(t *testing.T) {
+	authority := addressutils.GenerateAddressForTestWithPrefix("quick")
+	cases := []struct {
+		Name string
+		Msg  types.MsgGovResetCircuitBreaker
+		Err  string
+	}{
+		{
+			Name: "valid",
+			Msg:  types.MsgGovResetCircuitBreaker{Title: "test", Description: "test", ChainId: "chain-1", Actions: []types.CircuitBreakerAction{types.CircuitBreakerActionRewards}, Authority: authority},
+			Err:  "",
+		},
+		{
+			Name: "invalid empty chain id",
+			Msg:  types.MsgGovResetCircuitBreaker{Title: "test", Description: "test", Authority: authority},
+			Err:  "invalid chain id",
+		},
+		{
+			Name: "invalid bad authority",
+			Msg:  types.MsgGovResetCircuitBreaker{Title: "test", Description: "test", ChainId: "chain-1", Authority: "raa"},
+			Err:  "decoding bech32 failed",
+		},
+		{
+			Name: "invalid unknown action",
+			Msg:  types.MsgGovResetCircuitBreaker{Title: "test", Description: "test", ChainId: "chain-1", Actions: []types.CircuitBreakerAction{99}, Authority: authority},
+			Err:  "invalid circuit breaker action",
+		},
+	}
+
+	for _, c := range cases {
+		err := c.Msg.ValidateBasic()
+		if c.Err == "" { // happy
+			require.NoError(t, err, c.Name)
+		} else {
+			require.ErrorContains(t, err, c.Err, c.Name)
+		}
+	}
+}
+
+func TestGovSetEmergencyAuthority_ValidateBasic(t *testing.T) {
+	authority := addressutils.GenerateAddressForTestWithPrefix("quick")
+	cases := []struct {
+		Name string
+		Msg  types.MsgGovSetEmergencyAuthority
+		Err  string
+	}{
+		{
+			Name: "valid",
+			Msg:  types.MsgGovSetEmergencyAuthority{Title: "test", Description: "test", EmergencyAuthority: addressutils.GenerateAddressForTestWithPrefix("quick"), Authority: authority},
+			Err:  "",
+		},
+		{
+			Name: "valid removal",
+			Msg:  types.MsgGovSetEmergencyAuthority{Title: "test", Description: "test", Authority: authority},
+			Err:  "",
+		},
+		{
+			Name: "invalid emergency authority",
+			Msg:  types.MsgGovSetEmergencyAuthority{Title: "test", Description: "test", EmergencyAuthority: "raa", Authority: authority},
+			Err:  "decoding bech32 failed",
+		},
+		{
+			Name: "invalid bad authority",
+			Msg:  types.MsgGovSetEmergencyAuthority{Title: "test", Description: "test", Authority: "raa"},
+			Err:  "decoding bech32 failed",
+		},
+	}
+
+	for _, c := range cases {
+		err := c.Msg.ValidateBasic()
+		if c.Err == "" { // happy
+			require.NoError(t, err, c.Name)
+		} else {
+			require.ErrorContains(t, err, c.Err, c.Name)
+		}
+	}
+}
+
+func TestGovSetRewardSwapRoutes_ValidateBasic(t *testing.T) {
+	authority := addressutils.GenerateAddressForTestWithPrefix("quick")
+	route := types.RewardSwapRoute{Denom: "uosmo", Hops: []types.SwapHop{{PoolId: 1, TokenOutDenom: "uatom"}}, MaxSlippage: sdk.MustNewDecFromStr("0.01")}
+	cases := []struct {
+		Name string
+		Msg  types.MsgGovSetRewardSwapRoutes
+		Err  string
+	}{
+		{
+			Name: "valid",
+			Msg:  types.MsgGovSetRewardSwapRoutes{Title: "test", Description: "test", ChainId: "cosmoshub-4", Routes: []types.RewardSwapRoute{route}, Authority: authority},
+			Err:  "",
+		},
+		{
+			Name: "valid removal",
+			Msg:  types.MsgGovSetRewardSwapRoutes{Title: "test", Description: "test", ChainId: "cosmoshub-4", Authority: authority},
+			Err:  "",
+		},
+		{
+			Name: "invalid chain id",
+			Msg:  types.MsgGovSetRewardSwapRoutes{Title: "test", Description: "test", Routes: []types.RewardSwapRoute{route}, Authority: authority},
+			Err:  "invalid chain id",
+		},
+		{
+			Name: "invalid route",
+			Msg:  types.MsgGovSetRewardSwapRoutes{Title: "test", Description: "test", ChainId: "cosmoshub-4", Routes: []types.RewardSwapRoute{{Denom: "uosmo", MaxSlippage: sdk.ZeroDec()}}, Authority: authority},
+			Err:  "has no hops",
+		},
+		{
+			Name: "duplicate route",
+			Msg:  types.MsgGovSetRewardSwapRoutes{Title: "test", Description: "test", ChainId: "cosmoshub-4", Routes: []types.RewardSwapRoute{route, route}, Authority: authority},
+			Err:  "duplicate route",
+		},
+		{
+			Name: "invalid bad authority",
+			Msg:  types.MsgGovSetRewardSwapRoutes{Title: "test", Description: "test", ChainId: "cosmoshub-4", Routes: []types.RewardSwapRoute{route}, Authority: "raa"},
+			Err:  "decoding bech32 failed",
+		},
+	}
+
+	for _, c := range cases {
+		err := c.Msg.ValidateBasic()
+		if c.Err == "" { // happy
+			require.NoError(t, err, c.Name)
+		} else {
+			require.ErrorContains(t, err, c.Err, c.Name)
+		}
+	}
+}
+
+func TestGovSetEpochCaps_ValidateBasic(t *testing.T) {
+	authority := addressutils.GenerateAddressForTestWithPrefix("quick")
+	caps := types.EpochCaps{DepositCap: sdk.NewInt(1000), RedemptionCap: sdk.NewInt(1000), AddressCap: sdk.ZeroInt()}
+	cases := []struct {
+		Name string
+		Msg  types.MsgGovSetEpochCaps
+		Err  string
+	}{
+		{
+			Name: "valid",
+			Msg:  types.MsgGovSetEpochCaps{Title: "test", Description: "test", ChainId: "cosmoshub-4", Caps: caps, Authority: authority},
+			Err:  "",
+		},
+		{
+			Name: "invalid chain id",
+			Msg:  types.MsgGovSetEpochCaps{Title: "test", Description: "test", Caps: caps, Authority: authority},
+			Err:  "invalid chain id",
+		},
+		{
+			Name: "invalid nil caps",
+			Msg:  types.MsgGovSetEpochCaps{Title: "test", Description: "test", ChainId: "cosmoshub-4", Authority: authority},
+			Err:  "must not be nil",
+		},
+		{
+			Name: "invalid negative cap",
+			Msg:  types.MsgGovSetEpochCaps{Title: "test", Description: "test", ChainId: "cosmoshub-4", Caps: types.EpochCaps{DepositCap: sdk.NewInt(1000), RedemptionCap: sdk.NewInt(-1), AddressCap: sdk.ZeroInt()}, Authority: authority},
+			Err:  "redemption cap must not be negative",
+		},
+		{
+			Name: "invalid bad authority",
+			Msg:  types.MsgGovSetEpochCaps{Title: "test", Description: "test", ChainId: "cosmoshub-4", Caps: caps, Authority: "raa"},
+			Err:  "decoding bech32 failed",
+		},
+	}
+
+	for _, c := range cases {
+		err := c.Msg.ValidateBasic()
+		if c.Err == "" { // happy
+			require.NoError(t, err, c.Name)
+		} else {
+			require.ErrorContains(t, err, c.Err, c.Name)
+		}
+	}
+}
+
+func TestGovDeregisterZone_ValidateBasic(t *testing.T) {
+	authority := addressutils.GenerateAddressForTestWithPrefix("quick")
+	cases := []struct {
+		Name string
+		Msg  types.MsgGovDeregisterZone
+		Err  string
+	}{
+		{
+			Name: "valid",
+			Msg:  types.MsgGovDeregisterZone{Title: "test", Description: "test", ChainId: "cosmoshub-4", Authority: authority},
+			Err:  "",
+		},
+		{
+			Name: "valid with migration",
+			Msg:  types.MsgGovDeregisterZone{Title: "test", Description: "test", ChainId: "cosmoshub-4", MigrationConnectionId: "connection-1", Authority: authority},
+			Err:  "",
+		},
+		{
+			Name: "invalid chain id",
+			Msg:  types.MsgGovDeregisterZone{Title: "test", Description: "test", Authority: authority},
+			Err:  "invalid chain id",
+		},
+		{
+			Name: "invalid migration connection",
+			Msg:  types.MsgGovDeregisterZone{Title: "test", Description: "test", ChainId: "cosmoshub-4", MigrationConnectionId: "conn-1", Authority: authority},
+			Err:  "invalid connection",
+		},
+		{
+			Name: "invalid bad authority",
+			Msg:  types.MsgGovDeregisterZone{Title: "test", Description: "test", ChainId: "cosmoshub-4", Authority: "raa"},
+			Err:  "decoding bech32 failed",
+		},
+	}
+
+	for _, c := range cases {
+		err := c.Msg.ValidateBasic()
+		if c.Err == "" { // happy
+			require.NoError(t, err, c.Name)
+		} else {
+			require.ErrorContains(t, err, c.Err, c.Name)
+		}
+	}
+}
@@ -13,10 +13,11 @@ import (
 
 // Default ics params.
 var (
-	DefaultDepositInterval      uint64  = 20
-	DefaultValidatorSetInterval uint64  = 200
-	DefaultCommissionRate       sdk.Dec = sdk.MustNewDecFromStr("0.025")
-	DefaultUnbondingEnabled             = false
+	DefaultDepositInterval            uint64  = 20
+	DefaultValidatorSetInterval       uint64  = 200
+	DefaultCommissionRate             sdk.Dec = sdk.MustNewDecFromStr("0.025")
+	DefaultUnbondingEnabled                   = false
+	DefaultZoneHistoryRetentionEpochs uint64  = 180
 
 	// KeyDepositInterval is store's key for the DepositInterval option.
 	KeyDepositInterval = []byte("DepositInterval")
@@ -26,6 +27,8 @@ var (
 	KeyCommissionRate = []byte("CommissionRate")
 	// KeyUnbondingEnabled is a global flag to indicated whether unbonding txs are permitted.
 	KeyUnbondingEnabled = []byte("UnbondingEnabled")
+	// KeyZoneHistoryRetentionEpochs is store's key for the ZoneHistoryRetentionEpochs option.
+	KeyZoneHistoryRetentionEpochs = []byte("ZoneHistoryRetentionEpochs")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -55,12 +58,14 @@ func NewParams(
 	valsetInterval uint64,
 	commissionRate sdk.Dec,
 	unbondingEnabled bool,
+	zoneHistoryRetentionEpochs uint64,
 ) Params {
 	return Params{
-		DepositInterval:      depositInterval,
-		ValidatorsetInterval: valsetInterval,
-		CommissionRate:       commissionRate,
-		UnbondingEnabled:     unbondingEnabled,
+		DepositInterval:            depositInterval,
+		ValidatorsetInterval:       valsetInterval,
+		CommissionRate:             commissionRate,
+		UnbondingEnabled:           unbondingEnabled,
+		ZoneHistoryRetentionEpochs: zoneHistoryRetentionEpochs,
 	}
 }
 
@@ -71,6 +76,7 @@ func DefaultParams() Params {
 		DefaultValidatorSetInterval,
 		DefaultCommissionRate,
 		DefaultUnbondingEnabled,
+		DefaultZoneHistoryRetentionEpochs,
 	)
 }
 
@@ -88,6 +94,10 @@ func (p Params) Validate() error {
 		return fmt.Errorf("invalid commission rate: %w", err)
 	}
 
+	if err := validatePositiveInt(p.ZoneHistoryRetentionEpochs); err != nil {
+		return fmt.Errorf("invalid zone history retention epochs: %w", err)
+	}
+
 	return nil
 }
 
@@ -103,6 +113,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyValidatorSetInterval, &p.ValidatorsetInterval, validatePositiveInt),
 		paramtypes.NewParamSetPair(KeyCommissionRate, &p.CommissionRate, validateNonNegativeDec),
 		paramtypes.NewParamSetPair(KeyUnbondingEnabled, &p.UnbondingEnabled, validateBoolean),
+		paramtypes.NewParamSetPair(KeyZoneHistoryRetentionEpochs, &p.ZoneHistoryRetentionEpochs, validatePositiveInt),
 	}
 }
 
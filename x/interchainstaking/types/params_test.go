@@ -12,8 +12,9 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate(), "default")
-	require.NoError(t, types.NewParams(1, 1, sdk.NewDec(1), true).Validate(), "valid")
-	require.Error(t, types.NewParams(0, 1, sdk.NewDec(1), true).Validate(), "0 deposit interval")
-	require.Error(t, types.NewParams(1, 0, sdk.NewDec(1), true).Validate(), "0 valset interval")
-	require.Error(t, types.NewParams(1, 1, sdk.NewDec(-1), true).Validate(), "negative commission rate")
+	require.NoError(t, types.NewParams(1, 1, sdk.NewDec(1), true, 1).Validate(), "valid")
+	require.Error(t, types.NewParams(0, 1, sdk.NewDec(1), true, 1).Validate(), "0 deposit interval")
+	require.Error(t, types.NewParams(1, 0, sdk.NewDec(1), true, 1).Validate(), "0 valset interval")
+	require.Error(t, types.NewParams(1, 1, sdk.NewDec(-1), true, 1).Validate(), "negative commission rate")
+	require.Error(t, types.NewParams(1, 1, sdk.NewDec(1), true, 0).Validate(), "0 zone history retention epochs")
 }
@@ -491,6 +491,399 @@ func (m *MsgGovSetLsmCapsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgGovSetLsmCapsResponse proto.InternalMessageInfo
 
+type MsgGovSetEpochCaps struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId     string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// caps replace any existing epoch caps for the zone; if every cap is zero,
+	// the caps are removed.
+	Caps      EpochCaps `protobuf:"bytes,4,opt,name=caps,proto3" json:"caps" yaml:"caps"`
+	Authority string    `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgGovSetEpochCaps) Reset()         { *m = MsgGovSetEpochCaps{} }
+func (m *MsgGovSetEpochCaps) String() string { return proto.CompactTextString(m) }
+func (*MsgGovSetEpochCaps) ProtoMessage()    {}
+func (*MsgGovSetEpochCaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{11}
+}
+func (m *MsgGovSetEpochCaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovSetEpochCaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovSetEpochCaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovSetEpochCaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovSetEpochCaps.Merge(m, src)
+}
+func (m *MsgGovSetEpochCaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovSetEpochCaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovSetEpochCaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovSetEpochCaps proto.InternalMessageInfo
+
+type MsgGovSetEpochCapsResponse struct {
+}
+
+func (m *MsgGovSetEpochCapsResponse) Reset()         { *m = MsgGovSetEpochCapsResponse{} }
+func (m *MsgGovSetEpochCapsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovSetEpochCapsResponse) ProtoMessage()    {}
+func (*MsgGovSetEpochCapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{12}
+}
+func (m *MsgGovSetEpochCapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovSetEpochCapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovSetEpochCapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovSetEpochCapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovSetEpochCapsResponse.Merge(m, src)
+}
+func (m *MsgGovSetEpochCapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovSetEpochCapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovSetEpochCapsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovSetEpochCapsResponse proto.InternalMessageInfo
+
+type MsgGovSetEmergencyAuthority struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// emergency_authority is the address permitted to trip zone circuit
+	// breakers. An empty value removes the emergency authority.
+	EmergencyAuthority string `protobuf:"bytes,3,opt,name=emergency_authority,json=emergencyAuthority,proto3" json:"emergency_authority,omitempty" yaml:"emergency_authority"`
+	Authority          string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgGovSetEmergencyAuthority) Reset()         { *m = MsgGovSetEmergencyAuthority{} }
+func (m *MsgGovSetEmergencyAuthority) String() string { return proto.CompactTextString(m) }
+func (*MsgGovSetEmergencyAuthority) ProtoMessage()    {}
+func (*MsgGovSetEmergencyAuthority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{13}
+}
+func (m *MsgGovSetEmergencyAuthority) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovSetEmergencyAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovSetEmergencyAuthority.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovSetEmergencyAuthority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovSetEmergencyAuthority.Merge(m, src)
+}
+func (m *MsgGovSetEmergencyAuthority) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovSetEmergencyAuthority) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovSetEmergencyAuthority.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovSetEmergencyAuthority proto.InternalMessageInfo
+
+type MsgGovSetEmergencyAuthorityResponse struct {
+}
+
+func (m *MsgGovSetEmergencyAuthorityResponse) Reset()         { *m = MsgGovSetEmergencyAuthorityResponse{} }
+func (m *MsgGovSetEmergencyAuthorityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovSetEmergencyAuthorityResponse) ProtoMessage()    {}
+func (*MsgGovSetEmergencyAuthorityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{14}
+}
+func (m *MsgGovSetEmergencyAuthorityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovSetEmergencyAuthorityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovSetEmergencyAuthorityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovSetEmergencyAuthorityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovSetEmergencyAuthorityResponse.Merge(m, src)
+}
+func (m *MsgGovSetEmergencyAuthorityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovSetEmergencyAuthorityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovSetEmergencyAuthorityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovSetEmergencyAuthorityResponse proto.InternalMessageInfo
+
+type MsgGovResetCircuitBreaker struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId     string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// actions to reset; if empty, every tripped action for the zone is reset.
+	Actions   []CircuitBreakerAction `protobuf:"varint,4,rep,packed,name=actions,proto3,enum=quicksilver.interchainstaking.v1.CircuitBreakerAction" json:"actions,omitempty" yaml:"actions"`
+	Authority string                 `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgGovResetCircuitBreaker) Reset()         { *m = MsgGovResetCircuitBreaker{} }
+func (m *MsgGovResetCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgGovResetCircuitBreaker) ProtoMessage()    {}
+func (*MsgGovResetCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{15}
+}
+func (m *MsgGovResetCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovResetCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovResetCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovResetCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovResetCircuitBreaker.Merge(m, src)
+}
+func (m *MsgGovResetCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovResetCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovResetCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovResetCircuitBreaker proto.InternalMessageInfo
+
+type MsgGovResetCircuitBreakerResponse struct {
+}
+
+func (m *MsgGovResetCircuitBreakerResponse) Reset()         { *m = MsgGovResetCircuitBreakerResponse{} }
+func (m *MsgGovResetCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovResetCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgGovResetCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{16}
+}
+func (m *MsgGovResetCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovResetCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovResetCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovResetCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovResetCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgGovResetCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovResetCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovResetCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovResetCircuitBreakerResponse proto.InternalMessageInfo
+
+type MsgGovSetRewardSwapRoutes struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId     string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// routes replace any existing routes for the zone; only reward denoms with
+	// a route are swapped. If empty, reward swapping is disabled for the zone.
+	Routes    []RewardSwapRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	Authority string            `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgGovSetRewardSwapRoutes) Reset()         { *m = MsgGovSetRewardSwapRoutes{} }
+func (m *MsgGovSetRewardSwapRoutes) String() string { return proto.CompactTextString(m) }
+func (*MsgGovSetRewardSwapRoutes) ProtoMessage()    {}
+func (*MsgGovSetRewardSwapRoutes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{17}
+}
+func (m *MsgGovSetRewardSwapRoutes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovSetRewardSwapRoutes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovSetRewardSwapRoutes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovSetRewardSwapRoutes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovSetRewardSwapRoutes.Merge(m, src)
+}
+func (m *MsgGovSetRewardSwapRoutes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovSetRewardSwapRoutes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovSetRewardSwapRoutes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovSetRewardSwapRoutes proto.InternalMessageInfo
+
+type MsgGovSetRewardSwapRoutesResponse struct {
+}
+
+func (m *MsgGovSetRewardSwapRoutesResponse) Reset()         { *m = MsgGovSetRewardSwapRoutesResponse{} }
+func (m *MsgGovSetRewardSwapRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovSetRewardSwapRoutesResponse) ProtoMessage()    {}
+func (*MsgGovSetRewardSwapRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{18}
+}
+func (m *MsgGovSetRewardSwapRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovSetRewardSwapRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovSetRewardSwapRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovSetRewardSwapRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovSetRewardSwapRoutesResponse.Merge(m, src)
+}
+func (m *MsgGovSetRewardSwapRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovSetRewardSwapRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovSetRewardSwapRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovSetRewardSwapRoutesResponse proto.InternalMessageInfo
+
+type MsgGovDeregisterZone struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId     string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// migration_connection_id, if set, is a connection to the same host chain
+	// on which the zone is registered afresh once wound down.
+	MigrationConnectionId string `protobuf:"bytes,4,opt,name=migration_connection_id,json=migrationConnectionId,proto3" json:"migration_connection_id,omitempty" yaml:"migration_connection_id"`
+	Authority             string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgGovDeregisterZone) Reset()         { *m = MsgGovDeregisterZone{} }
+func (m *MsgGovDeregisterZone) String() string { return proto.CompactTextString(m) }
+func (*MsgGovDeregisterZone) ProtoMessage()    {}
+func (*MsgGovDeregisterZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{19}
+}
+func (m *MsgGovDeregisterZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovDeregisterZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovDeregisterZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovDeregisterZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovDeregisterZone.Merge(m, src)
+}
+func (m *MsgGovDeregisterZone) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovDeregisterZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovDeregisterZone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovDeregisterZone proto.InternalMessageInfo
+
+type MsgGovDeregisterZoneResponse struct {
+}
+
+func (m *MsgGovDeregisterZoneResponse) Reset()         { *m = MsgGovDeregisterZoneResponse{} }
+func (m *MsgGovDeregisterZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovDeregisterZoneResponse) ProtoMessage()    {}
+func (*MsgGovDeregisterZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{20}
+}
+func (m *MsgGovDeregisterZoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovDeregisterZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovDeregisterZoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovDeregisterZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovDeregisterZoneResponse.Merge(m, src)
+}
+func (m *MsgGovDeregisterZoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovDeregisterZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovDeregisterZoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovDeregisterZoneResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*RegisterZoneProposal)(nil), "quicksilver.interchainstaking.v1.RegisterZoneProposal")
 	proto.RegisterType((*RegisterZoneProposalWithDeposit)(nil), "quicksilver.interchainstaking.v1.RegisterZoneProposalWithDeposit")
@@ -503,6 +896,16 @@ func init() {
 	proto.RegisterType((*MsgGovCloseChannelResponse)(nil), "quicksilver.interchainstaking.v1.MsgGovCloseChannelResponse")
 	proto.RegisterType((*MsgGovSetLsmCaps)(nil), "quicksilver.interchainstaking.v1.MsgGovSetLsmCaps")
 	proto.RegisterType((*MsgGovSetLsmCapsResponse)(nil), "quicksilver.interchainstaking.v1.MsgGovSetLsmCapsResponse")
+	proto.RegisterType((*MsgGovSetEpochCaps)(nil), "quicksilver.interchainstaking.v1.MsgGovSetEpochCaps")
+	proto.RegisterType((*MsgGovSetEpochCapsResponse)(nil), "quicksilver.interchainstaking.v1.MsgGovSetEpochCapsResponse")
+	proto.RegisterType((*MsgGovSetEmergencyAuthority)(nil), "quicksilver.interchainstaking.v1.MsgGovSetEmergencyAuthority")
+	proto.RegisterType((*MsgGovSetEmergencyAuthorityResponse)(nil), "quicksilver.interchainstaking.v1.MsgGovSetEmergencyAuthorityResponse")
+	proto.RegisterType((*MsgGovResetCircuitBreaker)(nil), "quicksilver.interchainstaking.v1.MsgGovResetCircuitBreaker")
+	proto.RegisterType((*MsgGovResetCircuitBreakerResponse)(nil), "quicksilver.interchainstaking.v1.MsgGovResetCircuitBreakerResponse")
+	proto.RegisterType((*MsgGovSetRewardSwapRoutes)(nil), "quicksilver.interchainstaking.v1.MsgGovSetRewardSwapRoutes")
+	proto.RegisterType((*MsgGovSetRewardSwapRoutesResponse)(nil), "quicksilver.interchainstaking.v1.MsgGovSetRewardSwapRoutesResponse")
+	proto.RegisterType((*MsgGovDeregisterZone)(nil), "quicksilver.interchainstaking.v1.MsgGovDeregisterZone")
+	proto.RegisterType((*MsgGovDeregisterZoneResponse)(nil), "quicksilver.interchainstaking.v1.MsgGovDeregisterZoneResponse")
 }
 
 func init() {
@@ -510,73 +913,89 @@ func init() {
 }
 
 var fileDescriptor_04d034c830a7acfe = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xb6, 0xf3, 0xdb, 0xcf, 0x49, 0x9c, 0x4e, 0x13, 0xd8, 0xa6, 0xc4, 0x6b, 0xcd, 0xa1, 0x4a,
-	0x55, 0x6a, 0xe3, 0x12, 0x41, 0x54, 0x09, 0x89, 0x3a, 0x6d, 0x21, 0x12, 0x45, 0xd1, 0xa6, 0x80,
-	0xd4, 0x1e, 0x56, 0x9b, 0xdd, 0xc1, 0x19, 0x65, 0x3d, 0xb3, 0xdd, 0x19, 0x47, 0x31, 0x7f, 0x41,
-	0x0f, 0x1c, 0xb8, 0x20, 0x71, 0xcc, 0x1f, 0xc1, 0x1f, 0x81, 0x38, 0x55, 0x9c, 0x38, 0xad, 0x50,
-	0x72, 0xe1, 0xca, 0x72, 0xe4, 0x82, 0x76, 0x66, 0x37, 0xde, 0xd8, 0x8b, 0x2c, 0x7e, 0x15, 0xa4,
-	0xde, 0xe6, 0x7d, 0xdf, 0x7b, 0x6f, 0x66, 0xbe, 0x7d, 0xdf, 0xae, 0x0d, 0x6f, 0x3d, 0xeb, 0x53,
-	0xf7, 0x48, 0x50, 0xff, 0x98, 0x84, 0x2d, 0xca, 0x24, 0x09, 0xdd, 0x43, 0x87, 0x32, 0x21, 0x9d,
-	0x23, 0xca, 0xba, 0xad, 0xe3, 0x76, 0x2b, 0x08, 0x79, 0xc0, 0x85, 0xe3, 0x8b, 0x66, 0x10, 0x72,
-	0xc9, 0x51, 0x23, 0x57, 0xd1, 0x1c, 0xab, 0x68, 0x1e, 0xb7, 0xd7, 0xaf, 0xb9, 0x5c, 0xf4, 0xb8,
-	0xb0, 0x55, 0x7e, 0x4b, 0x07, 0xba, 0x78, 0x7d, 0xb5, 0xcb, 0xbb, 0x5c, 0xe3, 0xc9, 0x2a, 0x45,
-	0xb7, 0x27, 0x1e, 0x62, 0x7c, 0x1f, 0x55, 0x89, 0x7f, 0x9d, 0x81, 0x55, 0x8b, 0x74, 0xa9, 0x90,
-	0x24, 0x7c, 0xc2, 0x19, 0xd9, 0x4b, 0x0f, 0x8b, 0x56, 0x61, 0x56, 0x52, 0xe9, 0x13, 0xa3, 0xdc,
-	0x28, 0x6f, 0x56, 0x2c, 0x1d, 0xa0, 0x06, 0x54, 0x3d, 0x22, 0xdc, 0x90, 0x06, 0x92, 0x72, 0x66,
-	0x4c, 0x29, 0x2e, 0x0f, 0xa1, 0xf7, 0x60, 0xc9, 0xe5, 0x8c, 0x11, 0x37, 0x89, 0x6c, 0xea, 0x19,
-	0xd3, 0x49, 0x4e, 0xc7, 0x88, 0x23, 0x73, 0x75, 0xe0, 0xf4, 0xfc, 0xbb, 0xf8, 0x12, 0x8d, 0xad,
-	0xc5, 0x61, 0xbc, 0xeb, 0xa1, 0x2d, 0x80, 0x03, 0x47, 0x10, 0xdb, 0x23, 0x8c, 0xf7, 0x8c, 0x19,
-	0x55, 0xbb, 0x16, 0x47, 0xe6, 0x15, 0x5d, 0x3b, 0xe4, 0xb0, 0x55, 0x49, 0x82, 0xfb, 0xc9, 0x1a,
-	0xbd, 0x0b, 0x55, 0x9f, 0xbb, 0x8e, 0x9f, 0x96, 0xcd, 0xaa, 0xb2, 0xd7, 0xe2, 0xc8, 0x44, 0xba,
-	0x2c, 0x47, 0x62, 0x0b, 0x54, 0xa4, 0x0b, 0xdf, 0x87, 0x65, 0xc7, 0x75, 0x79, 0x9f, 0x49, 0x3b,
-	0x08, 0xc9, 0xe7, 0xf4, 0xc4, 0x98, 0x53, 0xb5, 0xd7, 0xe2, 0xc8, 0x5c, 0xd3, 0xb5, 0x97, 0x79,
-	0x6c, 0x2d, 0xa5, 0xc0, 0x9e, 0x8a, 0xd1, 0x06, 0x40, 0xaf, 0xef, 0x4b, 0x6a, 0x0b, 0xc2, 0x3c,
-	0x63, 0xbe, 0x51, 0xde, 0x5c, 0xb0, 0x2a, 0x0a, 0xd9, 0x27, 0xcc, 0x43, 0x37, 0x61, 0xc5, 0xa7,
-	0xcf, 0xfa, 0xd4, 0xa3, 0x72, 0x60, 0xf7, 0xb8, 0xd7, 0xf7, 0x89, 0xb1, 0xa0, 0x92, 0x6a, 0x17,
-	0xf8, 0x23, 0x05, 0xa3, 0x1b, 0x50, 0xeb, 0x11, 0x21, 0x9c, 0x2e, 0x11, 0x76, 0x40, 0x42, 0x5b,
-	0x9e, 0x18, 0x95, 0x46, 0x79, 0x73, 0xda, 0x5a, 0xca, 0xe0, 0x3d, 0x12, 0x3e, 0x3e, 0x41, 0x9b,
-	0xb0, 0x12, 0x12, 0xd9, 0x0f, 0x99, 0x2d, 0xb9, 0xda, 0x95, 0x84, 0x06, 0xa8, 0x96, 0xcb, 0x1a,
-	0x7f, 0xcc, 0xf7, 0x15, 0x9a, 0x6c, 0xee, 0x91, 0x80, 0x0b, 0x2a, 0x85, 0x4d, 0x98, 0x73, 0xe0,
-	0x13, 0xcf, 0xa8, 0xea, 0xcd, 0x33, 0xfc, 0x81, 0x86, 0xd1, 0x2d, 0xb8, 0xd2, 0x67, 0x07, 0x9c,
-	0x79, 0x94, 0x75, 0x2f, 0x72, 0x17, 0x55, 0xee, 0xca, 0x05, 0x91, 0x25, 0xaf, 0xc3, 0x82, 0x47,
-	0x5c, 0xda, 0x73, 0x7c, 0x61, 0x2c, 0xa9, 0x23, 0x5e, 0xc4, 0x68, 0x0d, 0xe6, 0xa8, 0xb0, 0xdb,
-	0xed, 0x6d, 0x63, 0x59, 0x55, 0xcf, 0x52, 0xd1, 0x6e, 0x6f, 0xdf, 0x5d, 0x7c, 0x7e, 0x6a, 0x96,
-	0xbe, 0x39, 0x35, 0x4b, 0x3f, 0x9f, 0x9a, 0x25, 0x1c, 0xcf, 0x81, 0x59, 0x34, 0x75, 0x9f, 0x51,
-	0x79, 0x78, 0x5f, 0x9f, 0x0c, 0xdd, 0xb8, 0x34, 0x80, 0x9d, 0x95, 0x38, 0x32, 0x17, 0xf5, 0x13,
-	0x51, 0x30, 0xce, 0x46, 0x72, 0xbb, 0x60, 0x24, 0xf3, 0xcf, 0x3e, 0x47, 0xe2, 0x57, 0x7b, 0x54,
-	0xb7, 0xc6, 0x47, 0x35, 0x7f, 0xe0, 0x21, 0x87, 0xf3, 0x13, 0xfc, 0xf0, 0x8f, 0x26, 0xb8, 0x73,
-	0x3d, 0x8e, 0xcc, 0xd7, 0xd3, 0x53, 0x8f, 0x64, 0xe0, 0xf1, 0xf1, 0x7e, 0x13, 0xe6, 0xd3, 0xa1,
-	0x53, 0x63, 0x5d, 0xe9, 0xa0, 0x38, 0x32, 0x97, 0xb3, 0x67, 0xa4, 0x08, 0x6c, 0x65, 0x29, 0x45,
-	0x66, 0x80, 0x22, 0x33, 0x3c, 0x28, 0x30, 0x43, 0x75, 0xf4, 0x74, 0xa3, 0x19, 0x78, 0xcc, 0x29,
-	0x0f, 0x0b, 0x9c, 0xb2, 0x38, 0xda, 0x66, 0x34, 0x03, 0x8f, 0xdb, 0xe8, 0xc3, 0x22, 0x1b, 0x2d,
-	0x4d, 0x6e, 0x34, 0xee, 0xb1, 0x56, 0xce, 0x63, 0x89, 0x93, 0xa6, 0x3b, 0x57, 0xe3, 0xc8, 0xac,
-	0x65, 0x0d, 0x34, 0x83, 0x0b, 0x8d, 0x57, 0xcb, 0x1b, 0x6f, 0xe1, 0x79, 0x66, 0xba, 0xaf, 0xa7,
-	0x00, 0x7d, 0x12, 0x78, 0x8e, 0x24, 0x97, 0x5e, 0xf4, 0xff, 0xbe, 0xcf, 0x9a, 0xb0, 0xa0, 0xbe,
-	0x3c, 0x43, 0x8b, 0xe5, 0xae, 0x92, 0x31, 0xd8, 0x9a, 0x57, 0xcb, 0x5d, 0x0f, 0xd9, 0x90, 0x2c,
-	0x59, 0x97, 0x08, 0x63, 0xa6, 0x31, 0xbd, 0x59, 0xbd, 0xd3, 0x6e, 0x4e, 0xfa, 0x64, 0x36, 0x87,
-	0x17, 0xfb, 0xd4, 0xf1, 0xfb, 0x24, 0x3f, 0x5c, 0x69, 0x2f, 0xbd, 0x41, 0xb2, 0x1a, 0x79, 0x19,
-	0x7d, 0x3f, 0x05, 0x1b, 0xe3, 0xba, 0xbc, 0xdc, 0x57, 0xd1, 0xff, 0x4d, 0xa2, 0xbc, 0x5b, 0x67,
-	0x27, 0xba, 0x35, 0x37, 0x64, 0x4f, 0xa1, 0x36, 0xb2, 0x0f, 0x6a, 0xc0, 0xf4, 0x11, 0x19, 0xa4,
-	0xda, 0x2d, 0xc7, 0x91, 0x09, 0xba, 0xcd, 0x11, 0x19, 0x60, 0x2b, 0xa1, 0x12, 0x7d, 0x8f, 0x93,
-	0xd4, 0x54, 0xb1, 0x9c, 0xbe, 0x0a, 0xc6, 0x96, 0xa6, 0xf1, 0x6f, 0x65, 0xb8, 0xfa, 0x48, 0x74,
-	0x3f, 0xe0, 0xc7, 0x16, 0xe1, 0x01, 0x61, 0x3b, 0x87, 0x0e, 0x63, 0xe4, 0x3f, 0xfb, 0xad, 0x72,
-	0x0b, 0xe6, 0x03, 0x1e, 0xca, 0xa4, 0x70, 0x66, 0x54, 0xa3, 0x94, 0xc0, 0xd6, 0x5c, 0xb2, 0xda,
-	0xf5, 0xd0, 0x3b, 0x50, 0x71, 0xfa, 0xf2, 0x90, 0x87, 0x54, 0x0e, 0x52, 0x49, 0x8d, 0x1f, 0xbe,
-	0xbd, 0xbd, 0x9a, 0xfe, 0xba, 0xbb, 0xe7, 0x79, 0x21, 0x11, 0x62, 0x5f, 0x86, 0x94, 0x75, 0xad,
-	0x61, 0x6a, 0x4e, 0xda, 0x0d, 0xb8, 0x5e, 0x70, 0x79, 0x8b, 0x88, 0x80, 0x33, 0x41, 0xf0, 0x2f,
-	0x65, 0x40, 0x9a, 0xdf, 0xf1, 0xb9, 0x20, 0x7f, 0x57, 0x9b, 0x2d, 0x00, 0x57, 0xb7, 0x18, 0x0a,
-	0x93, 0xfb, 0x58, 0x0c, 0x39, 0x6c, 0x55, 0xd2, 0xe0, 0xe5, 0x4b, 0xf2, 0x06, 0xac, 0x8f, 0x5f,
-	0xf9, 0x42, 0x91, 0x2f, 0xa7, 0x60, 0x45, 0xd3, 0xfb, 0x44, 0x7e, 0x24, 0x7a, 0x3b, 0x4e, 0x20,
-	0xfe, 0xb2, 0x1e, 0x7f, 0xd6, 0xa1, 0x1f, 0xc3, 0x8c, 0xeb, 0x04, 0x42, 0xc9, 0x50, 0xbd, 0x73,
-	0x73, 0xb2, 0x3d, 0xd3, 0x03, 0x76, 0x6a, 0x71, 0x64, 0x56, 0xd3, 0xb6, 0x4e, 0x20, 0xb0, 0xa5,
-	0xfa, 0xfc, 0x03, 0x62, 0xad, 0x83, 0x31, 0xaa, 0x46, 0x26, 0x55, 0xe7, 0xe9, 0x77, 0x67, 0xf5,
-	0xf2, 0x8b, 0xb3, 0x7a, 0xf9, 0xa7, 0xb3, 0x7a, 0xf9, 0xab, 0xf3, 0x7a, 0xe9, 0xc5, 0x79, 0xbd,
-	0xf4, 0xe3, 0x79, 0xbd, 0xf4, 0xe4, 0x5e, 0x97, 0xca, 0xc3, 0xfe, 0x41, 0xd3, 0xe5, 0xbd, 0x56,
-	0xee, 0x0e, 0xb7, 0xbf, 0xe0, 0x8c, 0xe4, 0x81, 0xd6, 0x49, 0xc1, 0x1f, 0x0f, 0x39, 0x08, 0x88,
-	0x38, 0x98, 0x53, 0x7f, 0x35, 0xde, 0xfe, 0x3d, 0x00, 0x00, 0xff, 0xff, 0x16, 0xfd, 0xa1, 0x2f,
-	0x2b, 0x0d, 0x00, 0x00,
+	// 1301 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x8f, 0xdb, 0x44,
+	0x14, 0xdf, 0x64, 0x3f, 0x33, 0xd9, 0xaf, 0x7a, 0x77, 0xa9, 0xbb, 0x6d, 0xe3, 0x30, 0x15, 0xd5,
+	0x56, 0xa5, 0x09, 0x29, 0x55, 0x59, 0x55, 0x42, 0x62, 0xb3, 0x6d, 0xa1, 0x12, 0x45, 0x95, 0x53,
+	0x40, 0x6a, 0x0f, 0xc6, 0x6b, 0x3f, 0xb2, 0xa3, 0x75, 0x3c, 0xae, 0x67, 0xb2, 0xdd, 0x70, 0xe2,
+	0xd8, 0x03, 0x07, 0x0e, 0x20, 0x71, 0xe0, 0xd0, 0x1b, 0x07, 0xae, 0xfc, 0x11, 0x15, 0xa7, 0x8a,
+	0x13, 0x27, 0x0b, 0xb5, 0x17, 0xae, 0x98, 0x23, 0x17, 0xe4, 0x19, 0xdb, 0x71, 0x12, 0x2f, 0x81,
+	0x7e, 0x2c, 0x48, 0xdc, 0x66, 0xde, 0xef, 0xbd, 0x37, 0x6f, 0x7e, 0x7e, 0xbf, 0x99, 0x49, 0xd0,
+	0x1b, 0xf7, 0xba, 0xc4, 0xda, 0x63, 0xc4, 0xd9, 0x07, 0xbf, 0x4e, 0x5c, 0x0e, 0xbe, 0xb5, 0x6b,
+	0x12, 0x97, 0x71, 0x73, 0x8f, 0xb8, 0xed, 0xfa, 0x7e, 0xa3, 0xee, 0xf9, 0xd4, 0xa3, 0xcc, 0x74,
+	0x58, 0xcd, 0xf3, 0x29, 0xa7, 0x4a, 0x35, 0x13, 0x51, 0x1b, 0x89, 0xa8, 0xed, 0x37, 0xd6, 0x4f,
+	0x58, 0x94, 0x75, 0x28, 0x33, 0x84, 0x7f, 0x5d, 0x4e, 0x64, 0xf0, 0xfa, 0x6a, 0x9b, 0xb6, 0xa9,
+	0xb4, 0x47, 0xa3, 0xd8, 0xba, 0x39, 0xb6, 0x88, 0xd1, 0x75, 0x44, 0x24, 0xfe, 0x7d, 0x0a, 0xad,
+	0xea, 0xd0, 0x26, 0x8c, 0x83, 0x7f, 0x87, 0xba, 0x70, 0x2b, 0x2e, 0x56, 0x59, 0x45, 0xd3, 0x9c,
+	0x70, 0x07, 0xd4, 0x42, 0xb5, 0xb0, 0x51, 0xd2, 0xe5, 0x44, 0xa9, 0xa2, 0xb2, 0x0d, 0xcc, 0xf2,
+	0x89, 0xc7, 0x09, 0x75, 0xd5, 0xa2, 0xc0, 0xb2, 0x26, 0xe5, 0x6d, 0xb4, 0x60, 0x51, 0xd7, 0x05,
+	0x2b, 0x9a, 0x19, 0xc4, 0x56, 0x27, 0x23, 0x9f, 0xa6, 0x1a, 0x06, 0xda, 0x6a, 0xcf, 0xec, 0x38,
+	0x57, 0xf0, 0x00, 0x8c, 0xf5, 0xf9, 0xfe, 0xfc, 0x86, 0xad, 0x5c, 0x42, 0x68, 0xc7, 0x64, 0x60,
+	0xd8, 0xe0, 0xd2, 0x8e, 0x3a, 0x25, 0x62, 0xd7, 0xc2, 0x40, 0x3b, 0x26, 0x63, 0xfb, 0x18, 0xd6,
+	0x4b, 0xd1, 0xe4, 0x6a, 0x34, 0x56, 0xde, 0x42, 0x65, 0x87, 0x5a, 0xa6, 0x13, 0x87, 0x4d, 0x8b,
+	0xb0, 0x57, 0xc2, 0x40, 0x53, 0x64, 0x58, 0x06, 0xc4, 0x3a, 0x12, 0x33, 0x19, 0xf8, 0x0e, 0x5a,
+	0x34, 0x2d, 0x8b, 0x76, 0x5d, 0x6e, 0x78, 0x3e, 0x7c, 0x4a, 0x0e, 0xd4, 0x19, 0x11, 0x7b, 0x22,
+	0x0c, 0xb4, 0x35, 0x19, 0x3b, 0x88, 0x63, 0x7d, 0x21, 0x36, 0xdc, 0x12, 0x73, 0xe5, 0x34, 0x42,
+	0x9d, 0xae, 0xc3, 0x89, 0xc1, 0xc0, 0xb5, 0xd5, 0xd9, 0x6a, 0x61, 0x63, 0x4e, 0x2f, 0x09, 0x4b,
+	0x0b, 0x5c, 0x5b, 0x39, 0x87, 0x96, 0x1d, 0x72, 0xaf, 0x4b, 0x6c, 0xc2, 0x7b, 0x46, 0x87, 0xda,
+	0x5d, 0x07, 0xd4, 0x39, 0xe1, 0xb4, 0x94, 0xda, 0x6f, 0x0a, 0xb3, 0x72, 0x16, 0x2d, 0x75, 0x80,
+	0x31, 0xb3, 0x0d, 0xcc, 0xf0, 0xc0, 0x37, 0xf8, 0x81, 0x5a, 0xaa, 0x16, 0x36, 0x26, 0xf5, 0x85,
+	0xc4, 0x7c, 0x0b, 0xfc, 0xdb, 0x07, 0xca, 0x06, 0x5a, 0xf6, 0x81, 0x77, 0x7d, 0xd7, 0xe0, 0x54,
+	0xac, 0x0a, 0xbe, 0x8a, 0x44, 0xca, 0x45, 0x69, 0xbf, 0x4d, 0x5b, 0xc2, 0x1a, 0x2d, 0x6e, 0x83,
+	0x47, 0x19, 0xe1, 0xcc, 0x00, 0xd7, 0xdc, 0x71, 0xc0, 0x56, 0xcb, 0x72, 0xf1, 0xc4, 0x7e, 0x4d,
+	0x9a, 0x95, 0xf3, 0xe8, 0x58, 0xd7, 0xdd, 0xa1, 0xae, 0x4d, 0xdc, 0x76, 0xea, 0x3b, 0x2f, 0x7c,
+	0x97, 0x53, 0x20, 0x71, 0x5e, 0x47, 0x73, 0x36, 0x58, 0xa4, 0x63, 0x3a, 0x4c, 0x5d, 0x10, 0x25,
+	0xa6, 0x73, 0x65, 0x0d, 0xcd, 0x10, 0x66, 0x34, 0x1a, 0x9b, 0xea, 0xa2, 0x88, 0x9e, 0x26, 0xac,
+	0xd1, 0xd8, 0xbc, 0x32, 0xff, 0xe0, 0xa1, 0x36, 0xf1, 0xcd, 0x43, 0x6d, 0xe2, 0xd7, 0x87, 0xda,
+	0x04, 0x0e, 0x67, 0x90, 0x96, 0xd7, 0x75, 0x1f, 0x13, 0xbe, 0x7b, 0x55, 0x56, 0xa6, 0x9c, 0x1d,
+	0x68, 0xc0, 0xe6, 0x72, 0x18, 0x68, 0xf3, 0xf2, 0x8b, 0x08, 0x33, 0x4e, 0x5a, 0x72, 0x33, 0xa7,
+	0x25, 0xb3, 0xdf, 0x3e, 0x03, 0xe2, 0xff, 0x77, 0xab, 0x5e, 0x1a, 0x6d, 0xd5, 0x6c, 0xc1, 0x7d,
+	0x0c, 0x67, 0x3b, 0xf8, 0xfa, 0x61, 0x1d, 0xdc, 0x3c, 0x19, 0x06, 0xda, 0xf1, 0xb8, 0xea, 0x21,
+	0x0f, 0x3c, 0xda, 0xde, 0xaf, 0xa3, 0xd9, 0xb8, 0xe9, 0x44, 0x5b, 0x97, 0x9a, 0x4a, 0x18, 0x68,
+	0x8b, 0xc9, 0x37, 0x12, 0x00, 0xd6, 0x13, 0x97, 0x3c, 0x31, 0xa0, 0x3c, 0x31, 0x5c, 0xcb, 0x11,
+	0x43, 0x79, 0xb8, 0xba, 0x61, 0x0f, 0x3c, 0xa2, 0x94, 0xeb, 0x39, 0x4a, 0x99, 0x1f, 0x4e, 0x33,
+	0xec, 0x81, 0x47, 0x65, 0xf4, 0x5e, 0x9e, 0x8c, 0x16, 0xc6, 0x27, 0x1a, 0xd5, 0x58, 0x3d, 0xa3,
+	0xb1, 0x48, 0x49, 0x93, 0xcd, 0x95, 0x30, 0xd0, 0x96, 0x92, 0x04, 0x12, 0xc1, 0xb9, 0xc2, 0x5b,
+	0xca, 0x0a, 0x6f, 0xee, 0x41, 0x22, 0xba, 0xaf, 0x8b, 0x48, 0xf9, 0xd0, 0xb3, 0x4d, 0x0e, 0x03,
+	0x07, 0xfd, 0xcb, 0xd7, 0x59, 0x0d, 0xcd, 0x89, 0x9b, 0xa7, 0x2f, 0xb1, 0xcc, 0x56, 0x12, 0x04,
+	0xeb, 0xb3, 0x62, 0x78, 0xc3, 0x56, 0x0c, 0x14, 0x0d, 0xdd, 0x36, 0x30, 0x75, 0xaa, 0x3a, 0xb9,
+	0x51, 0xbe, 0xd8, 0xa8, 0x8d, 0xbb, 0x32, 0x6b, 0xfd, 0x8d, 0x7d, 0x64, 0x3a, 0x5d, 0xc8, 0x36,
+	0x57, 0x9c, 0x4b, 0x2e, 0x10, 0x8d, 0x86, 0x0e, 0xa3, 0x1f, 0x8b, 0xe8, 0xf4, 0x28, 0x2f, 0x47,
+	0x7b, 0x14, 0xfd, 0xd7, 0x28, 0xca, 0xaa, 0x75, 0x7a, 0xac, 0x5a, 0x33, 0x4d, 0x76, 0x17, 0x2d,
+	0x0d, 0xad, 0xa3, 0x54, 0xd1, 0xe4, 0x1e, 0xf4, 0x62, 0xee, 0x16, 0xc3, 0x40, 0x43, 0x32, 0xcd,
+	0x1e, 0xf4, 0xb0, 0x1e, 0x41, 0x11, 0xbf, 0xfb, 0x91, 0xab, 0x5a, 0x1c, 0xe6, 0x57, 0x98, 0xb1,
+	0x2e, 0x61, 0xfc, 0x47, 0x01, 0xad, 0xdc, 0x64, 0xed, 0x77, 0xe9, 0xbe, 0x0e, 0xd4, 0x03, 0x77,
+	0x7b, 0xd7, 0x74, 0x5d, 0xf8, 0xd7, 0xde, 0x2a, 0xe7, 0xd1, 0xac, 0x47, 0x7d, 0x1e, 0x05, 0x4e,
+	0x0d, 0x73, 0x14, 0x03, 0x58, 0x9f, 0x89, 0x46, 0x37, 0x6c, 0xe5, 0x32, 0x2a, 0x99, 0x5d, 0xbe,
+	0x4b, 0x7d, 0xc2, 0x7b, 0x31, 0xa5, 0xea, 0x4f, 0x3f, 0x5c, 0x58, 0x8d, 0x5f, 0x77, 0x5b, 0xb6,
+	0xed, 0x03, 0x63, 0x2d, 0xee, 0x13, 0xb7, 0xad, 0xf7, 0x5d, 0x33, 0xd4, 0x9e, 0x46, 0x27, 0x73,
+	0x36, 0xaf, 0x03, 0xf3, 0xa8, 0xcb, 0x00, 0xff, 0x56, 0x40, 0x8a, 0xc4, 0xb7, 0x1d, 0xca, 0xe0,
+	0x79, 0xb9, 0xb9, 0x84, 0x90, 0x25, 0x53, 0xf4, 0x89, 0xc9, 0x5c, 0x16, 0x7d, 0x0c, 0xeb, 0xa5,
+	0x78, 0x72, 0xf4, 0x94, 0x9c, 0x42, 0xeb, 0xa3, 0x5b, 0x4e, 0x19, 0xf9, 0xa2, 0x88, 0x96, 0x25,
+	0xdc, 0x02, 0xfe, 0x3e, 0xeb, 0x6c, 0x9b, 0x1e, 0x7b, 0x66, 0x3e, 0xfe, 0xa9, 0x42, 0x3f, 0x40,
+	0x53, 0x96, 0xe9, 0x31, 0x41, 0x43, 0xf9, 0xe2, 0xb9, 0xf1, 0xf2, 0x8c, 0x0b, 0x6c, 0x2e, 0x85,
+	0x81, 0x56, 0x8e, 0xd3, 0x9a, 0x1e, 0xc3, 0xba, 0xc8, 0xf3, 0x02, 0xc8, 0x5a, 0x47, 0xea, 0x30,
+	0x1b, 0x29, 0x55, 0x5f, 0x15, 0x93, 0xe6, 0x69, 0x01, 0xbf, 0xe6, 0x51, 0x6b, 0xf7, 0x48, 0xc9,
+	0xba, 0x3d, 0x40, 0xd6, 0xf9, 0xf1, 0x64, 0xa5, 0x25, 0x36, 0x57, 0x1e, 0x05, 0xda, 0xc4, 0xcb,
+	0xa2, 0x2c, 0xed, 0xaf, 0x2c, 0x2b, 0x29, 0x69, 0x9f, 0x17, 0xd1, 0xc9, 0x3e, 0xdc, 0x01, 0xbf,
+	0x0d, 0xae, 0xd5, 0xdb, 0x4a, 0xf2, 0x3c, 0x33, 0x7b, 0x6d, 0xb4, 0x02, 0x49, 0x36, 0xa3, 0xbf,
+	0x03, 0x49, 0xe4, 0xe5, 0x30, 0xd0, 0xd6, 0xe5, 0x5e, 0x73, 0x9c, 0xf0, 0xa1, 0xfb, 0x53, 0x60,
+	0xb4, 0xc0, 0xe7, 0x27, 0xe8, 0x35, 0x74, 0xe6, 0x2f, 0x18, 0x48, 0x99, 0xfa, 0xbe, 0x88, 0x4e,
+	0x24, 0x67, 0x17, 0x03, 0xbe, 0x4d, 0x7c, 0xab, 0x4b, 0x78, 0xd3, 0x07, 0x73, 0x0f, 0xfc, 0x23,
+	0xeb, 0x32, 0x1b, 0xcd, 0x9a, 0xe2, 0xec, 0x96, 0x97, 0xe6, 0xe2, 0xc5, 0xcb, 0xe3, 0x1b, 0x6d,
+	0xb0, 0xd4, 0x2d, 0x11, 0x9e, 0x3d, 0xd4, 0xe2, 0x84, 0x58, 0x4f, 0x52, 0xbf, 0x00, 0x52, 0xcf,
+	0xa0, 0x57, 0x0f, 0x25, 0x2b, 0xa5, 0xf4, 0xbb, 0x94, 0xd2, 0x16, 0x70, 0x1d, 0xee, 0x9b, 0xbe,
+	0xdd, 0xba, 0x6f, 0x7a, 0x3a, 0xed, 0x72, 0x38, 0x3a, 0xe1, 0x7e, 0x82, 0x66, 0x7c, 0xb1, 0xe2,
+	0xdf, 0x7f, 0x86, 0x0c, 0xd5, 0xda, 0x5c, 0x8b, 0x05, 0xbc, 0x20, 0x17, 0x91, 0xe9, 0xb0, 0x1e,
+	0xe7, 0x7d, 0x91, 0x74, 0xe6, 0x10, 0x95, 0xd2, 0xf9, 0x6d, 0x11, 0xad, 0x4a, 0xaf, 0xab, 0xe0,
+	0x67, 0x7e, 0x99, 0x1e, 0x19, 0x93, 0x77, 0xd0, 0xf1, 0x0e, 0x69, 0xfb, 0x66, 0x14, 0x6c, 0x0c,
+	0xbe, 0x4a, 0xe4, 0x4d, 0x8a, 0xc3, 0x40, 0xab, 0xc8, 0xf0, 0x43, 0x1c, 0xb1, 0xbe, 0x96, 0x22,
+	0xdb, 0xd9, 0x87, 0xca, 0xf3, 0x73, 0x58, 0x41, 0xa7, 0xf2, 0xd8, 0x49, 0xe8, 0x6b, 0xde, 0x7d,
+	0xf4, 0xa4, 0x52, 0x78, 0xfc, 0xa4, 0x52, 0xf8, 0xe5, 0x49, 0xa5, 0xf0, 0xe5, 0xd3, 0xca, 0xc4,
+	0xe3, 0xa7, 0x95, 0x89, 0x9f, 0x9f, 0x56, 0x26, 0xee, 0x6c, 0xb5, 0x09, 0xdf, 0xed, 0xee, 0xd4,
+	0x2c, 0xda, 0xa9, 0x67, 0x7a, 0xe3, 0xc2, 0x67, 0xd4, 0x85, 0xac, 0xa1, 0x7e, 0x90, 0xf3, 0xc7,
+	0x15, 0xef, 0x79, 0xc0, 0x76, 0x66, 0xc4, 0x5f, 0x55, 0x6f, 0xfe, 0x39, 0x00, 0x4f, 0x8c, 0xb7,
+	0x98, 0x6b, 0x13, 0x00, 0x00,
 }
 
 func (m *RegisterZoneProposal) Marshal() (dAtA []byte, err error) {
@@ -1264,287 +1683,437 @@ func (m *MsgGovSetLsmCapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProposals(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgGovSetEpochCaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RegisterZoneProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgGovSetEpochCaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovSetEpochCaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.LocalDenom)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.AccountPrefix)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	if m.MultiSend {
-		n += 2
-	}
-	if m.LiquidityModule {
-		n += 2
-	}
-	if m.MessagesPerTx != 0 {
-		n += 1 + sovProposals(uint64(m.MessagesPerTx))
-	}
-	if m.ReturnToSender {
-		n += 2
-	}
-	if m.DepositsEnabled {
-		n += 2
+	{
+		size, err := m.Caps.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposals(dAtA, i, uint64(size))
 	}
-	if m.UnbondingEnabled {
-		n += 2
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Decimals != 0 {
-		n += 1 + sovProposals(uint64(m.Decimals))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Is_118 {
-		n += 2
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RegisterZoneProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.LocalDenom)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.AccountPrefix)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	if m.MultiSend {
-		n += 2
-	}
-	if m.LiquidityModule {
-		n += 2
-	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	if m.MessagesPerTx != 0 {
-		n += 1 + sovProposals(uint64(m.MessagesPerTx))
-	}
-	if m.ReturnToSender {
-		n += 2
-	}
-	if m.DepositsEnabled {
-		n += 2
-	}
-	if m.UnbondingEnabled {
-		n += 2
-	}
-	if m.Decimals != 0 {
-		n += 1 + sovProposals(uint64(m.Decimals))
-	}
-	if m.Is_118 {
-		n += 2
+func (m *MsgGovSetEpochCapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpdateZoneProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgGovSetEpochCapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovSetEpochCapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	if len(m.Changes) > 0 {
-		for _, e := range m.Changes {
-			l = e.Size()
-			n += 1 + l + sovProposals(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateZoneProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovSetEmergencyAuthority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovSetEmergencyAuthority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovSetEmergencyAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.EmergencyAuthority) > 0 {
+		i -= len(m.EmergencyAuthority)
+		copy(dAtA[i:], m.EmergencyAuthority)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.EmergencyAuthority)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Changes) > 0 {
-		for _, e := range m.Changes {
-			l = e.Size()
-			n += 1 + l + sovProposals(uint64(l))
-		}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateZoneValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovSetEmergencyAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovSetEmergencyAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovSetEmergencyAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovReopenChannel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovResetCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovResetCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovResetCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Actions) > 0 {
+		dAtA4 := make([]byte, len(m.Actions)*10)
+		var j3 int
+		for _, num := range m.Actions {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintProposals(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovReopenChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovResetCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovResetCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovResetCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovCloseChannel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovSetRewardSwapRoutes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovSetRewardSwapRoutes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovSetRewardSwapRoutes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposals(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovCloseChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovSetRewardSwapRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovSetRewardSwapRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovSetRewardSwapRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovSetLsmCaps) Size() (n int) {
+func (m *MsgGovDeregisterZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovDeregisterZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovDeregisterZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MigrationConnectionId) > 0 {
+		i -= len(m.MigrationConnectionId)
+		copy(dAtA[i:], m.MigrationConnectionId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.MigrationConnectionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovDeregisterZoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovDeregisterZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovDeregisterZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposals(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RegisterZoneProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1558,37 +2127,1719 @@ func (m *MsgGovSetLsmCaps) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProposals(uint64(l))
 	}
-	l = len(m.ChainId)
+	l = len(m.ConnectionId)
 	if l > 0 {
 		n += 1 + l + sovProposals(uint64(l))
 	}
-	if m.Caps != nil {
-		l = m.Caps.Size()
+	l = len(m.BaseDenom)
+	if l > 0 {
 		n += 1 + l + sovProposals(uint64(l))
 	}
-	l = len(m.Authority)
+	l = len(m.LocalDenom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.AccountPrefix)
 	if l > 0 {
 		n += 1 + l + sovProposals(uint64(l))
 	}
-	return n
-}
+	if m.MultiSend {
+		n += 2
+	}
+	if m.LiquidityModule {
+		n += 2
+	}
+	if m.MessagesPerTx != 0 {
+		n += 1 + sovProposals(uint64(m.MessagesPerTx))
+	}
+	if m.ReturnToSender {
+		n += 2
+	}
+	if m.DepositsEnabled {
+		n += 2
+	}
+	if m.UnbondingEnabled {
+		n += 2
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovProposals(uint64(m.Decimals))
+	}
+	if m.Is_118 {
+		n += 2
+	}
+	return n
+}
+
+func (m *RegisterZoneProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.LocalDenom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.AccountPrefix)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.MultiSend {
+		n += 2
+	}
+	if m.LiquidityModule {
+		n += 2
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.MessagesPerTx != 0 {
+		n += 1 + sovProposals(uint64(m.MessagesPerTx))
+	}
+	if m.ReturnToSender {
+		n += 2
+	}
+	if m.DepositsEnabled {
+		n += 2
+	}
+	if m.UnbondingEnabled {
+		n += 2
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovProposals(uint64(m.Decimals))
+	}
+	if m.Is_118 {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateZoneProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateZoneProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateZoneValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGovReopenChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGovReopenChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovCloseChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGovCloseChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovSetLsmCaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.Caps != nil {
+		l = m.Caps.Size()
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGovSetLsmCapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovSetEpochCaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.Caps.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGovSetEpochCapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovSetEmergencyAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.EmergencyAuthority)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGovSetEmergencyAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovResetCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		l = 0
+		for _, e := range m.Actions {
+			l += sovProposals(uint64(e))
+		}
+		n += 1 + sovProposals(uint64(l)) + l
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGovResetCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovSetRewardSwapRoutes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGovSetRewardSwapRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovDeregisterZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.MigrationConnectionId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGovDeregisterZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovProposals(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposals(x uint64) (n int) {
+	return sovProposals(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterZoneProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterZoneProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MultiSend = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityModule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiquidityModule = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesPerTx", wireType)
+			}
+			m.MessagesPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessagesPerTx |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnToSender", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReturnToSender = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositsEnabled = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnbondingEnabled = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Is_118", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Is_118 = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterZoneProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterZoneProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MultiSend = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityModule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiquidityModule = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesPerTx", wireType)
+			}
+			m.MessagesPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessagesPerTx |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnToSender", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReturnToSender = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositsEnabled = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnbondingEnabled = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Is_118", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Is_118 = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateZoneProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateZoneProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateZoneProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &UpdateZoneValue{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateZoneProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateZoneProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &UpdateZoneValue{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgGovSetLsmCapsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovProposals(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProposals(x uint64) (n int) {
-	return sovProposals(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateZoneValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1611,15 +3862,15 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterZoneProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateZoneValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterZoneProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateZoneValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1647,11 +3898,11 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1679,11 +3930,61 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovReopenChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovReopenChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovReopenChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1711,11 +4012,11 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1743,11 +4044,11 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1775,11 +4076,11 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1802,156 +4103,18 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 			}
 			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccountPrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiSend", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.MultiSend = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityModule", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.LiquidityModule = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessagesPerTx", wireType)
-			}
-			m.MessagesPerTx = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MessagesPerTx |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnToSender", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ReturnToSender = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositsEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DepositsEnabled = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UnbondingEnabled = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return ErrInvalidLengthProposals
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Is_118", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1961,12 +4124,24 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Is_118 = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -1988,7 +4163,7 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgGovReopenChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2011,10 +4186,60 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterZoneProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovReopenChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterZoneProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovReopenChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovCloseChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovCloseChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovCloseChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2083,7 +4308,7 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2111,11 +4336,175 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovCloseChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovCloseChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovCloseChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovSetLsmCaps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovSetLsmCaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovSetLsmCaps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2143,11 +4532,11 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2175,11 +4564,11 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2207,13 +4596,13 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountPrefix = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiSend", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caps", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -2223,35 +4612,31 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MultiSend = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityModule", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
 			}
-			m.LiquidityModule = bool(v != 0)
-		case 9:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Caps == nil {
+				m.Caps = &LsmCaps{}
+			}
+			if err := m.Caps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2279,126 +4664,58 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposit = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessagesPerTx", wireType)
-			}
-			m.MessagesPerTx = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MessagesPerTx |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnToSender", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ReturnToSender = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositsEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DepositsEnabled = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEnabled", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
 			}
-			m.UnbondingEnabled = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovSetLsmCapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Is_118", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Is_118 = bool(v != 0)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovSetLsmCapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovSetLsmCapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -2420,7 +4737,7 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateZoneProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgGovSetEpochCaps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2443,10 +4760,10 @@ func (m *UpdateZoneProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateZoneProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovSetEpochCaps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateZoneProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovSetEpochCaps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2545,11 +4862,44 @@ func (m *UpdateZoneProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Caps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -2559,26 +4909,74 @@ func (m *UpdateZoneProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposals
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposals
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Changes = append(m.Changes, &UpdateZoneValue{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovSetEpochCapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovSetEpochCapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovSetEpochCapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -2600,7 +4998,7 @@ func (m *UpdateZoneProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgGovSetEmergencyAuthority) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2623,10 +5021,10 @@ func (m *UpdateZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateZoneProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovSetEmergencyAuthority: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateZoneProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovSetEmergencyAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2695,7 +5093,7 @@ func (m *UpdateZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAuthority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2723,45 +5121,11 @@ func (m *UpdateZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Changes = append(m.Changes, &UpdateZoneValue{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EmergencyAuthority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2789,7 +5153,7 @@ func (m *UpdateZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposit = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2812,7 +5176,7 @@ func (m *UpdateZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateZoneValue) Unmarshal(dAtA []byte) error {
+func (m *MsgGovSetEmergencyAuthorityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2835,76 +5199,12 @@ func (m *UpdateZoneValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateZoneValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovSetEmergencyAuthorityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateZoneValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovSetEmergencyAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -2926,7 +5226,7 @@ func (m *UpdateZoneValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovReopenChannel) Unmarshal(dAtA []byte) error {
+func (m *MsgGovResetCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2949,10 +5249,10 @@ func (m *MsgGovReopenChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovReopenChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovResetCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovReopenChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovResetCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3021,7 +5321,7 @@ func (m *MsgGovReopenChannel) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3049,40 +5349,77 @@ func (m *MsgGovReopenChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v CircuitBreakerAction
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposals
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CircuitBreakerAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Actions = append(m.Actions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposals
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProposals
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProposals
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				var elementCount int
+				if elementCount != 0 && len(m.Actions) == 0 {
+					m.Actions = make([]CircuitBreakerAction, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CircuitBreakerAction
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProposals
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CircuitBreakerAction(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Actions = append(m.Actions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
@@ -3136,7 +5473,7 @@ func (m *MsgGovReopenChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovReopenChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGovResetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3159,10 +5496,10 @@ func (m *MsgGovReopenChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovReopenChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovResetCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovReopenChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovResetCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3186,7 +5523,7 @@ func (m *MsgGovReopenChannelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovCloseChannel) Unmarshal(dAtA []byte) error {
+func (m *MsgGovSetRewardSwapRoutes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3209,10 +5546,10 @@ func (m *MsgGovCloseChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovCloseChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovSetRewardSwapRoutes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovCloseChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovSetRewardSwapRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3281,7 +5618,7 @@ func (m *MsgGovCloseChannel) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3309,13 +5646,13 @@ func (m *MsgGovCloseChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -3325,23 +5662,25 @@ func (m *MsgGovCloseChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProposals
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposals
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Routes = append(m.Routes, RewardSwapRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -3396,7 +5735,7 @@ func (m *MsgGovCloseChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovCloseChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGovSetRewardSwapRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3419,10 +5758,10 @@ func (m *MsgGovCloseChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovCloseChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovSetRewardSwapRoutesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovCloseChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovSetRewardSwapRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3446,7 +5785,7 @@ func (m *MsgGovCloseChannelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovSetLsmCaps) Unmarshal(dAtA []byte) error {
+func (m *MsgGovDeregisterZone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3469,10 +5808,10 @@ func (m *MsgGovSetLsmCaps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovSetLsmCaps: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovDeregisterZone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovSetLsmCaps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovDeregisterZone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3573,9 +5912,9 @@ func (m *MsgGovSetLsmCaps) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationConnectionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -3585,27 +5924,23 @@ func (m *MsgGovSetLsmCaps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposals
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposals
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Caps == nil {
-				m.Caps = &LsmCaps{}
-			}
-			if err := m.Caps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MigrationConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -3660,7 +5995,7 @@ func (m *MsgGovSetLsmCaps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovSetLsmCapsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGovDeregisterZoneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3683,10 +6018,10 @@ func (m *MsgGovSetLsmCapsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovSetLsmCapsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovDeregisterZoneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovSetLsmCapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovDeregisterZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
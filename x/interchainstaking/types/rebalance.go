@@ -68,7 +68,8 @@ func CalculateAllocationDeltas(
 		} else {
 			if _, found := locked[valoper]; !found {
 				// only append to sources if the delegation is not locked - i.e. it doesn't have an incoming redelegation.
-				// TODO: this needs to be locked amounts for unbonding purposes. Redelegations do not care about amounts, but unbondings do.
+				// redelegations do not care about locked amounts, but unbondings do; DetermineAllocationsForUndelegation
+				// further limits each source to its unlocked amount.
 				sources = append(sources, &AllocationDelta{Amount: delta.Abs(), ValoperAddress: valoper})
 			}
 		}
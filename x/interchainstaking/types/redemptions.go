@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	"cosmossdk.io/math"
 
@@ -21,6 +23,16 @@ func filter(in map[string]math.Int) map[string]math.Int {
 	return out
 }
 
+// IsLocked returns true if the redelegated tokens may not yet be unbonded from the destination validator at the given
+// time; that is, if the redelegation is yet to be acknowledged by the host chain, or is yet to complete.
+func (r RedelegationRecord) IsLocked(blockTime time.Time) bool {
+	return r.CompletionTime.IsZero() || r.CompletionTime.After(blockTime)
+}
+
+// DetermineAllocationsForUndelegation determines the amount to undelegate from each validator in order to satisfy the
+// given amount, moving current allocations toward the target allocations. The amount undelegated from each validator
+// never exceeds its unlocked amount in availablePerValidator; an error is returned if the total unlocked amount cannot
+// satisfy the given amount.
 func DetermineAllocationsForUndelegation(currentAllocations map[string]math.Int, lockedAllocations map[string]bool, currentSum math.Int, targetAllocations ValidatorIntents, availablePerValidator map[string]math.Int, amount sdk.Coins) (map[string]math.Int, error) {
 	outWeights := make(map[string]math.Int)
 	if len(amount) != 1 {
@@ -30,9 +42,43 @@ func DetermineAllocationsForUndelegation(currentAllocations map[string]math.Int,
 	if !amount[0].Amount.IsPositive() {
 		return outWeights, fmt.Errorf("amount was invalid, expected positive value, got %s", amount[0].Amount.String())
 	}
+
+	// copy availablePerValidator, as remaining availability is tracked below.
+	available := make(map[string]math.Int, len(availablePerValidator))
+	totalAvailable := sdk.ZeroInt()
+	for valoper, amount := range availablePerValidator {
+		if amount.IsNil() || !amount.IsPositive() {
+			available[valoper] = sdk.ZeroInt()
+			continue
+		}
+		available[valoper] = amount
+		totalAvailable = totalAvailable.Add(amount)
+	}
+	// validators without an unlocked amount have nothing available.
+	for _, valoper := range utils.Keys(currentAllocations) {
+		if _, ok := available[valoper]; !ok {
+			available[valoper] = sdk.ZeroInt()
+		}
+	}
+	for _, intent := range targetAllocations {
+		if _, ok := available[intent.ValoperAddress]; !ok {
+			available[intent.ValoperAddress] = sdk.ZeroInt()
+		}
+	}
+	availablePerValidator = available
+
+	if totalAvailable.LT(amount[0].Amount) {
+		return outWeights, fmt.Errorf("insufficient unlocked tokens to satisfy undelegation; required %s, available %s", amount[0].Amount.String(), totalAvailable.String())
+	}
+
 	input := amount[0].Amount
 	underAllocated, overAllocated := CalculateAllocationDeltas(currentAllocations, lockedAllocations, currentSum /* .Sub(input) */, targetAllocations, make(map[string]math.Int))
 
+	// overallocated validators may only be used as sources up to their unlocked amount.
+	for idx := range overAllocated {
+		overAllocated[idx].Amount = sdk.MinInt(overAllocated[idx].Amount, availablePerValidator[overAllocated[idx].ValoperAddress])
+	}
+
 	outSum := sdk.ZeroInt()
 
 	// deltas: +ve is below target; -ve is above target.
@@ -169,16 +215,64 @@ func DetermineAllocationsForUndelegation(currentAllocations map[string]math.Int,
 	// available balance permitting. this should be the biggest source. This will usually be a small amount, and will negated by
 	// the delta calculations on the next run.
 	dust := amount[0].Amount.Sub(outSum)
-	for idx := 0; idx <= len(deltas)-1; idx++ {
-		if dust.LTE(availablePerValidator[deltas[idx].ValoperAddress]) {
-			outWeights[deltas[idx].ValoperAddress] = outWeights[deltas[idx].ValoperAddress].Add(dust)
+	for idx := 0; idx <= len(deltas)-1 && dust.IsPositive(); idx++ {
+		valoper := deltas[idx].ValoperAddress
+		if dust.LTE(availablePerValidator[valoper]) {
+			value, ok := outWeights[valoper]
+			if !ok {
+				value = sdk.ZeroInt()
+			}
+			outWeights[valoper] = value.Add(dust)
+			availablePerValidator[valoper] = availablePerValidator[valoper].Sub(dust)
+			outSum = outSum.Add(dust)
 			break
 		}
 	}
 
+	// any remainder arises where unlocked amounts prevented the above from being satisfied in full; take it from the
+	// validators with the greatest remaining unlocked amounts.
+	outSum = outSum.Add(allocateRemainder(outWeights, availablePerValidator, amount[0].Amount.Sub(outSum)))
+	if !outSum.Equal(amount[0].Amount) {
+		return map[string]math.Int{}, fmt.Errorf("unable to allocate undelegation in full; allocated %s of %s", outSum.String(), amount[0].Amount.String())
+	}
+
 	return filter(outWeights), nil
 }
 
+// allocateRemainder allocates the remainder to validators in descending order of remaining availability, updating
+// outWeights and availablePerValidator. It returns the amount allocated.
+func allocateRemainder(outWeights, availablePerValidator map[string]math.Int, remainder math.Int) math.Int {
+	allocated := sdk.ZeroInt()
+	if !remainder.IsPositive() {
+		return allocated
+	}
+
+	valopers := utils.Keys(availablePerValidator)
+	sort.SliceStable(valopers, func(i, j int) bool {
+		return availablePerValidator[valopers[i]].GT(availablePerValidator[valopers[j]])
+	})
+
+	for _, valoper := range valopers {
+		if remainder.IsZero() {
+			break
+		}
+		allocation := sdk.MinInt(remainder, availablePerValidator[valoper])
+		if !allocation.IsPositive() {
+			continue
+		}
+		value, ok := outWeights[valoper]
+		if !ok {
+			value = sdk.ZeroInt()
+		}
+		outWeights[valoper] = value.Add(allocation)
+		availablePerValidator[valoper] = availablePerValidator[valoper].Sub(allocation)
+		allocated = allocated.Add(allocation)
+		remainder = remainder.Sub(allocation)
+	}
+
+	return allocated
+}
+
 func DetermineAllocationsForUndelegationPredef(currentAllocations map[string]math.Int, lockedAllocations map[string]bool, currentSum math.Int, targetAllocations ValidatorIntents, availablePerValidator map[string]math.Int, amount sdk.Coins) (map[string]math.Int, error) {
 	outWeights := make(map[string]math.Int, len(availablePerValidator))
 	if len(amount) != 1 {
@@ -2,7 +2,9 @@ package types_test
 
 import (
 	"fmt"
+	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -244,6 +246,69 @@ func TestDetermineAllocationsForUndelegation(t *testing.T) {
 	}
 }
 
+func TestRedelegationRecordIsLocked(t *testing.T) {
+	now := time.Now().UTC()
+	require.True(t, types.RedelegationRecord{}.IsLocked(now), "unacknowledged redelegations are locked")
+	require.True(t, types.RedelegationRecord{CompletionTime: now.Add(time.Second)}.IsLocked(now))
+	require.False(t, types.RedelegationRecord{CompletionTime: now}.IsLocked(now))
+	require.False(t, types.RedelegationRecord{CompletionTime: now.Add(-time.Second)}.IsLocked(now))
+}
+
+// TestDetermineAllocationsForUndelegationRandomLocks asserts, for random delegations, intents and locked amounts, that
+// undelegations are satisfied in full without exceeding the unlocked amount of any validator, and that undelegations
+// exceeding the total unlocked amount are rejected.
+func TestDetermineAllocationsForUndelegationRandomLocks(t *testing.T) {
+	r := rand.New(rand.NewSource(1)) //nolint:gosec // deterministic randomness is intended here.
+	for i := 0; i < 500; i++ {
+		vals := addressutils.GenerateValidatorsDeterministic(1 + r.Intn(8))
+
+		currentAllocations := make(map[string]sdkmath.Int, len(vals))
+		unlocked := make(map[string]sdkmath.Int, len(vals))
+		currentSum := sdk.ZeroInt()
+		unlockedSum := sdk.ZeroInt()
+		targetAllocations := make(types.ValidatorIntents, 0, len(vals))
+		for _, val := range vals {
+			delegated := sdkmath.NewInt(r.Int63n(1_000_000))
+			currentAllocations[val] = delegated
+			currentSum = currentSum.Add(delegated)
+
+			// a third of validators are fully unlocked, a third fully locked, and the remainder partially locked.
+			switch r.Intn(3) {
+			case 0:
+				unlocked[val] = delegated
+			case 1:
+				unlocked[val] = sdk.ZeroInt()
+			default:
+				unlocked[val] = delegated.Sub(sdkmath.NewInt(r.Int63n(delegated.Int64() + 1)))
+			}
+			unlockedSum = unlockedSum.Add(unlocked[val])
+
+			if r.Intn(4) != 0 {
+				targetAllocations = append(targetAllocations, &types.ValidatorIntent{ValoperAddress: val, Weight: sdk.NewDec(1 + r.Int63n(100))})
+			}
+		}
+		if len(targetAllocations) == 0 || !unlockedSum.IsPositive() {
+			continue
+		}
+		targetAllocations = targetAllocations.Normalize()
+
+		amount := sdkmath.NewInt(1 + r.Int63n(unlockedSum.Int64()))
+		allocations, err := types.DetermineAllocationsForUndelegation(currentAllocations, map[string]bool{}, currentSum, targetAllocations, unlocked, sdk.NewCoins(sdk.NewCoin("uatom", amount)))
+		require.NoError(t, err, "iteration %d", i)
+
+		sum := sdk.ZeroInt()
+		for valoper, allocation := range allocations {
+			require.True(t, allocation.IsPositive(), "iteration %d: non-positive allocation for %s", i, valoper)
+			require.True(t, allocation.LTE(unlocked[valoper]), "iteration %d: allocation %s exceeds unlocked %s for %s", i, allocation, unlocked[valoper], valoper)
+			sum = sum.Add(allocation)
+		}
+		require.Equal(t, amount, sum, "iteration %d", i)
+
+		_, err = types.DetermineAllocationsForUndelegation(currentAllocations, map[string]bool{}, currentSum, targetAllocations, unlocked, sdk.NewCoins(sdk.NewCoin("uatom", unlockedSum.AddRaw(1))))
+		require.Error(t, err, "iteration %d", i)
+	}
+}
+
 // The function should correctly calculate allocations for undelegation when there are overallocated validators.
 func TestOverAndUnderAllocatedValidators(t *testing.T) {
 	currentAllocations := map[string]sdkmath.Int{
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RewardSwapPoolMaxAge is the maximum age of the cached pool state from which
+// the minimum output of a reward swap is derived. Pool state is refreshed at the
+// end of every epoch, ahead of distribution.
+const RewardSwapPoolMaxAge = time.Hour
+
+// Validate checks the route is well formed. It does not check that the route
+// ends in a zone's base denom, as the zone is not known here.
+func (r RewardSwapRoute) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+
+	if len(r.Hops) == 0 {
+		return fmt.Errorf("route for %s has no hops", r.Denom)
+	}
+
+	denomIn := r.Denom
+	for i, hop := range r.Hops {
+		if hop.PoolId == 0 {
+			return fmt.Errorf("route for %s has invalid pool id at hop %d", r.Denom, i)
+		}
+		if err := sdk.ValidateDenom(hop.TokenOutDenom); err != nil {
+			return fmt.Errorf("route for %s has invalid output denom at hop %d: %w", r.Denom, i, err)
+		}
+		if hop.TokenOutDenom == denomIn {
+			return fmt.Errorf("route for %s swaps %s for itself at hop %d", r.Denom, denomIn, i)
+		}
+		denomIn = hop.TokenOutDenom
+	}
+
+	if r.MaxSlippage.IsNil() || r.MaxSlippage.IsNegative() || r.MaxSlippage.GTE(sdk.OneDec()) {
+		return fmt.Errorf("route for %s has invalid max slippage; must be in the range [0, 1)", r.Denom)
+	}
+
+	return nil
+}
+
+// OutDenom returns the denom produced by the final hop of the route.
+func (r RewardSwapRoute) OutDenom() string {
+	if len(r.Hops) == 0 {
+		return ""
+	}
+	return r.Hops[len(r.Hops)-1].TokenOutDenom
+}
+
+// ValidateRewardSwapRoutes checks each route is well formed, and that no denom
+// has more than one route.
+func ValidateRewardSwapRoutes(routes []RewardSwapRoute) error {
+	seen := make(map[string]struct{}, len(routes))
+	for _, route := range routes {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[route.Denom]; ok {
+			return fmt.Errorf("duplicate route for %s", route.Denom)
+		}
+		seen[route.Denom] = struct{}{}
+	}
+	return nil
+}
+
+// Validate checks the config is well formed.
+func (c RewardSwapConfig) Validate() error {
+	if c.ChainId == "" {
+		return errors.New("chain id cannot be empty")
+	}
+	return ValidateRewardSwapRoutes(c.Routes)
+}
+
+// GetRoute returns the route for the given denom, if one exists.
+func (c RewardSwapConfig) GetRoute(denom string) (RewardSwapRoute, bool) {
+	for _, route := range c.Routes {
+		if route.Denom == denom {
+			return route, true
+		}
+	}
+	return RewardSwapRoute{}, false
+}
+
+// PoolIDs returns the distinct ids of pools used by the config's routes, in
+// ascending order.
+func (c RewardSwapConfig) PoolIDs() []uint64 {
+	seen := make(map[uint64]struct{})
+	out := make([]uint64, 0)
+	for _, route := range c.Routes {
+		for _, hop := range route.Hops {
+			if _, ok := seen[hop.PoolId]; ok {
+				continue
+			}
+			seen[hop.PoolId] = struct{}{}
+			out = append(out, hop.PoolId)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+func TestRewardSwapRoute_Validate(t *testing.T) {
+	slippage := sdk.MustNewDecFromStr("0.01")
+	tests := []struct {
+		Name  string
+		Route types.RewardSwapRoute
+		Err   string
+	}{
+		{
+			Name:  "valid single hop",
+			Route: types.RewardSwapRoute{Denom: "uosmo", Hops: []types.SwapHop{{PoolId: 1, TokenOutDenom: "uatom"}}, MaxSlippage: slippage},
+		},
+		{
+			Name:  "valid multi hop",
+			Route: types.RewardSwapRoute{Denom: "ujuno", Hops: []types.SwapHop{{PoolId: 2, TokenOutDenom: "uosmo"}, {PoolId: 1, TokenOutDenom: "uatom"}}, MaxSlippage: sdk.ZeroDec()},
+		},
+		{
+			Name:  "invalid denom",
+			Route: types.RewardSwapRoute{Denom: "", Hops: []types.SwapHop{{PoolId: 1, TokenOutDenom: "uatom"}}, MaxSlippage: slippage},
+			Err:   "invalid denom",
+		},
+		{
+			Name:  "no hops",
+			Route: types.RewardSwapRoute{Denom: "uosmo", MaxSlippage: slippage},
+			Err:   "has no hops",
+		},
+		{
+			Name:  "zero pool id",
+			Route: types.RewardSwapRoute{Denom: "uosmo", Hops: []types.SwapHop{{PoolId: 0, TokenOutDenom: "uatom"}}, MaxSlippage: slippage},
+			Err:   "invalid pool id",
+		},
+		{
+			Name:  "invalid output denom",
+			Route: types.RewardSwapRoute{Denom: "uosmo", Hops: []types.SwapHop{{PoolId: 1, TokenOutDenom: "a"}}, MaxSlippage: slippage},
+			Err:   "invalid output denom",
+		},
+		{
+			Name:  "swap for self",
+			Route: types.RewardSwapRoute{Denom: "uosmo", Hops: []types.SwapHop{{PoolId: 1, TokenOutDenom: "uosmo"}}, MaxSlippage: slippage},
+			Err:   "for itself",
+		},
+		{
+			Name:  "nil slippage",
+			Route: types.RewardSwapRoute{Denom: "uosmo", Hops: []types.SwapHop{{PoolId: 1, TokenOutDenom: "uatom"}}},
+			Err:   "invalid max slippage",
+		},
+		{
+			Name:  "negative slippage",
+			Route: types.RewardSwapRoute{Denom: "uosmo", Hops: []types.SwapHop{{PoolId: 1, TokenOutDenom: "uatom"}}, MaxSlippage: sdk.MustNewDecFromStr("-0.1")},
+			Err:   "invalid max slippage",
+		},
+		{
+			Name:  "slippage of one",
+			Route: types.RewardSwapRoute{Denom: "uosmo", Hops: []types.SwapHop{{PoolId: 1, TokenOutDenom: "uatom"}}, MaxSlippage: sdk.OneDec()},
+			Err:   "invalid max slippage",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			err := tt.Route.Validate()
+			if tt.Err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.Err)
+			}
+		})
+	}
+}
+
+func TestValidateRewardSwapRoutes_Duplicate(t *testing.T) {
+	route := types.RewardSwapRoute{Denom: "uosmo", Hops: []types.SwapHop{{PoolId: 1, TokenOutDenom: "uatom"}}, MaxSlippage: sdk.MustNewDecFromStr("0.01")}
+	require.NoError(t, types.ValidateRewardSwapRoutes([]types.RewardSwapRoute{route}))
+	require.ErrorContains(t, types.ValidateRewardSwapRoutes([]types.RewardSwapRoute{route, route}), "duplicate route")
+}
+
+func TestRewardSwapConfig(t *testing.T) {
+	config := types.RewardSwapConfig{
+		ChainId: "cosmoshub-4",
+		Routes: []types.RewardSwapRoute{
+			{Denom: "ujuno", Hops: []types.SwapHop{{PoolId: 5, TokenOutDenom: "uosmo"}, {PoolId: 1, TokenOutDenom: "uatom"}}, MaxSlippage: sdk.MustNewDecFromStr("0.02")},
+			{Denom: "uosmo", Hops: []types.SwapHop{{PoolId: 1, TokenOutDenom: "uatom"}}, MaxSlippage: sdk.MustNewDecFromStr("0.01")},
+		},
+	}
+	require.NoError(t, config.Validate())
+	require.Equal(t, []uint64{1, 5}, config.PoolIDs())
+
+	route, found := config.GetRoute("uosmo")
+	require.True(t, found)
+	require.Equal(t, "uatom", route.OutDenom())
+
+	_, found = config.GetRoute("uatom")
+	require.False(t, found)
+
+	config.ChainId = ""
+	require.ErrorContains(t, config.Validate(), "chain id cannot be empty")
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// SlashDetectionThreshold is the minimum relative increase in a validator's
+	// shares per token that is treated as a slash, so that rounding in the host
+	// chain's share accounting is not mistaken for one.
+	SlashDetectionThreshold = sdk.NewDecWithPrec(1, 6)
+
+	// SlashedValidatorWeightMultiplier is applied to the aggregate intent weight
+	// of a validator slashed within the zone's unbonding period.
+	SlashedValidatorWeightMultiplier = sdk.NewDecWithPrec(5, 1)
+)
+
+// SlashFractionFromDelta returns the fraction of tokens removed by a slash,
+// given the ratio of a validator's new shares per token to its previous
+// shares per token.
+func SlashFractionFromDelta(delta sdk.Dec) sdk.Dec {
+	if !delta.GT(sdk.OneDec()) {
+		return sdk.ZeroDec()
+	}
+	return delta.Sub(sdk.OneDec()).Quo(delta)
+}
+
+// Loss returns the amount of the zone's delegation lost to the slash. It is
+// never negative.
+func (r SlashRecord) Loss() sdk.Coin {
+	if r.ExpectedAmount.IsNil() || r.ReportedAmount.IsNil() || r.ExpectedAmount.Denom != r.ReportedAmount.Denom || !r.ReportedAmount.IsLT(r.ExpectedAmount) {
+		return sdk.Coin{Denom: r.ExpectedAmount.Denom, Amount: sdk.ZeroInt()}
+	}
+	return r.ExpectedAmount.Sub(r.ReportedAmount)
+}
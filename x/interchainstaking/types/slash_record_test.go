@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+func TestSlashFractionFromDelta(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Delta    sdk.Dec
+		Expected sdk.Dec
+	}{
+		{"no change", sdk.OneDec(), sdk.ZeroDec()},
+		{"shares per token decreased", sdk.MustNewDecFromStr("0.9"), sdk.ZeroDec()},
+		{"5% slash", sdk.NewDec(20).QuoInt64(19), sdk.MustNewDecFromStr("0.05")},
+		{"20% slash", sdk.MustNewDecFromStr("1.25"), sdk.MustNewDecFromStr("0.2")},
+		{"50% slash", sdk.NewDec(2), sdk.MustNewDecFromStr("0.5")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Expected, types.SlashFractionFromDelta(tt.Delta))
+		})
+	}
+}
+
+func TestSlashRecordLoss(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Record   types.SlashRecord
+		Expected sdk.Coin
+	}{
+		{
+			"loss",
+			types.SlashRecord{ExpectedAmount: sdk.NewInt64Coin("uatom", 1000), ReportedAmount: sdk.NewInt64Coin("uatom", 950)},
+			sdk.NewInt64Coin("uatom", 50),
+		},
+		{
+			"no loss",
+			types.SlashRecord{ExpectedAmount: sdk.NewInt64Coin("uatom", 1000), ReportedAmount: sdk.NewInt64Coin("uatom", 1000)},
+			sdk.NewInt64Coin("uatom", 0),
+		},
+		{
+			"reported exceeds expected",
+			types.SlashRecord{ExpectedAmount: sdk.NewInt64Coin("uatom", 1000), ReportedAmount: sdk.NewInt64Coin("uatom", 1100)},
+			sdk.NewInt64Coin("uatom", 0),
+		},
+		{
+			"denom mismatch",
+			types.SlashRecord{ExpectedAmount: sdk.NewInt64Coin("uatom", 1000), ReportedAmount: sdk.NewInt64Coin("uosmo", 900)},
+			sdk.NewInt64Coin("uatom", 0),
+		},
+		{
+			"unset amounts",
+			types.SlashRecord{},
+			sdk.Coin{Amount: sdk.ZeroInt()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Expected, tt.Record.Loss())
+		})
+	}
+}
+
+func TestGetSlashRecordKey(t *testing.T) {
+	key := types.GetSlashRecordKey("cosmoshub-4", "cosmosvaloper1zcuaqawcpzn7q9wmulagvjjv7f72qearkd4q7c", 256)
+	require.True(t, bytes.HasPrefix(key, types.GetZoneSlashRecordsKey("cosmoshub-4", "cosmosvaloper1zcuaqawcpzn7q9wmulagvjjv7f72qearkd4q7c")))
+	require.True(t, bytes.HasPrefix(key, types.GetZoneSlashRecordsKey("cosmoshub-4", "")))
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 1, 0}, key[len(key)-8:])
+
+	// records are ordered by height within a validator.
+	later := types.GetSlashRecordKey("cosmoshub-4", "cosmosvaloper1zcuaqawcpzn7q9wmulagvjjv7f72qearkd4q7c", 257)
+	require.Equal(t, -1, bytes.Compare(key, later))
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"sort"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,7 @@ const (
 	WithdrawStatusUnbond    int32 = 3
 	WithdrawStatusSend      int32 = 4
 	WithdrawStatusCompleted int32 = 5
+	WithdrawStatusCancel    int32 = 6
 )
 
 // DelayCompletion updates a withdrawal record completion date to:
@@ -23,3 +25,29 @@ const (
 func (w *WithdrawalRecord) DelayCompletion(ctx sdk.Context, delay time.Duration) {
 	w.CompletionTime = ctx.BlockTime().Add(delay)
 }
+
+// PriorityFeeRate returns the priority fee paid per unit of qAsset redeemed.
+func (w WithdrawalRecord) PriorityFeeRate() sdk.Dec {
+	if w.PriorityFee == nil || w.PriorityFee.Amount.IsNil() || w.BurnAmount.Amount.IsNil() || !w.BurnAmount.Amount.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(w.PriorityFee.Amount).QuoInt(w.BurnAmount.Amount)
+}
+
+// SortWithdrawalRecordsByPriority sorts queued withdrawal records into the order
+// in which they are processed: by descending priority fee rate, then by
+// ascending epoch. Records that are otherwise equal retain their order.
+func SortWithdrawalRecordsByPriority(records []WithdrawalRecord) {
+	rates := make(map[string]sdk.Dec, len(records))
+	for _, record := range records {
+		rates[record.Txhash] = record.PriorityFeeRate()
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		rateI, rateJ := rates[records[i].Txhash], rates[records[j].Txhash]
+		if !rateI.Equal(rateJ) {
+			return rateI.GT(rateJ)
+		}
+		return records[i].EpochNumber < records[j].EpochNumber
+	})
+}
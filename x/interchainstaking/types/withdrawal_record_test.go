@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
@@ -28,3 +30,35 @@ func TestWithdrawalRecord_DelayCompletion(t *testing.T) {
 	wdr.DelayCompletion(ctx, time.Hour)
 	require.Equal(t, ctx.BlockTime().Add(time.Hour), wdr.CompletionTime)
 }
+
+func TestSortWithdrawalRecordsByPriority(t *testing.T) {
+	fee := func(amount int64) *sdk.Coin {
+		coin := sdk.NewCoin("uqatom", sdkmath.NewInt(amount))
+		return &coin
+	}
+	burn := func(amount int64) sdk.Coin {
+		return sdk.NewCoin("uqatom", sdkmath.NewInt(amount))
+	}
+
+	records := []types.WithdrawalRecord{
+		{Txhash: "a", EpochNumber: 2, BurnAmount: burn(1000)},
+		{Txhash: "b", EpochNumber: 1, BurnAmount: burn(1000)},
+		{Txhash: "c", EpochNumber: 3, BurnAmount: burn(1000), PriorityFee: fee(10)},
+		{Txhash: "d", EpochNumber: 3, BurnAmount: burn(100), PriorityFee: fee(10)},
+		{Txhash: "e", EpochNumber: 1, BurnAmount: burn(1000)},
+		{Txhash: "f", EpochNumber: 2, BurnAmount: burn(2000), PriorityFee: fee(20)},
+	}
+
+	types.SortWithdrawalRecordsByPriority(records)
+
+	order := make([]string, 0, len(records))
+	for _, record := range records {
+		order = append(order, record.Txhash)
+	}
+	// d has the highest fee rate; f and c have equal rates so the earlier epoch wins;
+	// records without fees are ordered by epoch, retaining their relative order.
+	require.Equal(t, []string{"d", "f", "c", "b", "e", "a"}, order)
+
+	require.Equal(t, sdk.ZeroDec(), types.WithdrawalRecord{BurnAmount: burn(1000)}.PriorityFeeRate())
+	require.Equal(t, sdk.MustNewDecFromStr("0.01"), types.WithdrawalRecord{BurnAmount: burn(1000), PriorityFee: fee(10)}.PriorityFeeRate())
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SecondsPerYear is the number of seconds over which rates are annualised.
+const SecondsPerYear int64 = 365 * 24 * 60 * 60
+
+// AnnualisedRate returns the simple annual rate implied by a redemption rate
+// moving from `from` to `to` over elapsed. It returns zero if either rate is
+// not positive or less than one second has elapsed.
+func AnnualisedRate(from, to sdk.Dec, elapsed time.Duration) sdk.Dec {
+	seconds := int64(elapsed / time.Second)
+	if seconds <= 0 || from.IsNil() || to.IsNil() || !from.IsPositive() || !to.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return to.Sub(from).Quo(from).MulInt64(SecondsPerYear).QuoInt64(seconds)
+}
+
+// CompoundedYield returns the annual yield of apr compounded periodsPerYear
+// times, i.e. (1 + apr/n)^n - 1. If periodsPerYear is less than one, apr is
+// returned unchanged.
+func CompoundedYield(apr sdk.Dec, periodsPerYear int64) sdk.Dec {
+	if periodsPerYear < 1 {
+		return apr
+	}
+	base := sdk.OneDec().Add(apr.QuoInt64(periodsPerYear))
+	if !base.IsPositive() {
+		return sdk.OneDec().Neg()
+	}
+	return base.Power(uint64(periodsPerYear)).Sub(sdk.OneDec())
+}
+
+// ZoneYield returns the annualised rate and compounded yield implied by the
+// change in redemption rate between the first and last of snapshots, which
+// must be ordered by epoch. Yield is compounded at the average interval
+// between snapshots. Both are zero if fewer than two snapshots are given.
+func ZoneYield(snapshots []ZoneSnapshot) (apr, apy sdk.Dec) {
+	if len(snapshots) < 2 {
+		return sdk.ZeroDec(), sdk.ZeroDec()
+	}
+
+	first, last := snapshots[0], snapshots[len(snapshots)-1]
+	elapsed := last.Timestamp.Sub(first.Timestamp)
+	apr = AnnualisedRate(first.RedemptionRate, last.RedemptionRate, elapsed)
+
+	seconds := int64(elapsed / time.Second)
+	if seconds <= 0 {
+		return apr, apr
+	}
+	periodsPerYear := SecondsPerYear * int64(len(snapshots)-1) / seconds
+	return apr, CompoundedYield(apr, periodsPerYear)
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+func TestAnnualisedRate(t *testing.T) {
+	year := time.Duration(types.SecondsPerYear) * time.Second
+	cases := []struct {
+		Name     string
+		From     sdk.Dec
+		To       sdk.Dec
+		Elapsed  time.Duration
+		Expected sdk.Dec
+	}{
+		{"one year", sdk.OneDec(), sdk.MustNewDecFromStr("1.1"), year, sdk.MustNewDecFromStr("0.1")},
+		{"half year", sdk.OneDec(), sdk.MustNewDecFromStr("1.05"), year / 2, sdk.MustNewDecFromStr("0.1")},
+		{"decrease", sdk.OneDec(), sdk.MustNewDecFromStr("0.99"), year, sdk.MustNewDecFromStr("-0.01")},
+		{"no time elapsed", sdk.OneDec(), sdk.MustNewDecFromStr("1.1"), 0, sdk.ZeroDec()},
+		{"zero from", sdk.ZeroDec(), sdk.OneDec(), year, sdk.ZeroDec()},
+		{"nil to", sdk.OneDec(), sdk.Dec{}, year, sdk.ZeroDec()},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.Expected, types.AnnualisedRate(c.From, c.To, c.Elapsed), c.Name)
+	}
+}
+
+func TestCompoundedYield(t *testing.T) {
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), types.CompoundedYield(sdk.MustNewDecFromStr("0.1"), 1))
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), types.CompoundedYield(sdk.MustNewDecFromStr("0.1"), 0))
+	require.Equal(t, sdk.MustNewDecFromStr("0.1025"), types.CompoundedYield(sdk.MustNewDecFromStr("0.1"), 2))
+	require.Equal(t, sdk.OneDec().Neg(), types.CompoundedYield(sdk.NewDec(-5), 2))
+
+	// compounding increases a positive yield.
+	require.True(t, types.CompoundedYield(sdk.MustNewDecFromStr("0.1"), 365).GT(sdk.MustNewDecFromStr("0.105")))
+}
+
+func TestZoneYield(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	year := time.Duration(types.SecondsPerYear) * time.Second
+
+	apr, apy := types.ZoneYield(nil)
+	require.True(t, apr.IsZero())
+	require.True(t, apy.IsZero())
+
+	apr, apy = types.ZoneYield([]types.ZoneSnapshot{{RedemptionRate: sdk.OneDec(), Timestamp: start}})
+	require.True(t, apr.IsZero())
+	require.True(t, apy.IsZero())
+
+	snapshots := []types.ZoneSnapshot{
+		{EpochNumber: 1, RedemptionRate: sdk.OneDec(), Timestamp: start},
+		{EpochNumber: 2, RedemptionRate: sdk.MustNewDecFromStr("1.04"), Timestamp: start.Add(year / 2)},
+		{EpochNumber: 3, RedemptionRate: sdk.MustNewDecFromStr("1.1"), Timestamp: start.Add(year)},
+	}
+	apr, apy = types.ZoneYield(snapshots)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), apr)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1025"), apy)
+}
@@ -15,12 +15,51 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 	for _, kpd := range genState.ProtocolData {
 		k.SetProtocolData(ctx, []byte(kpd.Key), kpd.ProtocolData)
 	}
+
+	if genState.ValidatorScoreWeights != nil {
+		k.SetValidatorScoreWeights(ctx, *genState.ValidatorScoreWeights)
+	}
+
+	for _, score := range genState.ValidatorScores {
+		k.SetValidatorScore(ctx, score)
+	}
+
+	for _, proposal := range genState.HostProposals {
+		k.SetHostProposal(ctx, proposal)
+	}
+
+	for _, window := range genState.SignedBlocksWindows {
+		k.SetSignedBlocksWindow(ctx, window.ChainId, window.Window)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
-	return &types.GenesisState{
+	genState := &types.GenesisState{
 		Params:       k.GetParams(ctx),
 		ProtocolData: k.AllKeyedProtocolDatas(ctx),
 	}
+
+	if k.HasValidatorScoreWeights(ctx) {
+		weights := k.GetValidatorScoreWeights(ctx)
+		genState.ValidatorScoreWeights = &weights
+	}
+
+	// an empty chain id iterates the scores and proposals of every zone.
+	k.IterateValidatorScores(ctx, "", func(_ int64, score types.ValidatorScore) (stop bool) {
+		genState.ValidatorScores = append(genState.ValidatorScores, score)
+		return false
+	})
+
+	k.IterateHostProposals(ctx, "", func(_ int64, proposal types.HostProposal) (stop bool) {
+		genState.HostProposals = append(genState.HostProposals, proposal)
+		return false
+	})
+
+	k.IterateSignedBlocksWindows(ctx, func(_ int64, chainID string, window int64) (stop bool) {
+		genState.SignedBlocksWindows = append(genState.SignedBlocksWindows, types.SignedBlocksWindow{ChainId: chainID, Window: window})
+		return false
+	})
+
+	return genState
 }
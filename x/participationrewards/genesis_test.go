@@ -93,3 +93,40 @@ func TestParticipationRewardsInitGenesis(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, types.ProtocolDataType_name[int32(types.ProtocolDataTypeOsmosisPool)], pd.Type)
 }
+
+func TestParticipationRewardsGenesisValidatorScoreState(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	score := types.ValidatorScore{
+		ChainId:                "testzone-1",
+		ValoperAddress:         "cosmosvaloper1759teakrsvnx7rnur8ezc4qaq8669nhtgukm0x",
+		MissedBlocks:           20,
+		CommissionRate:         sdk.NewDecWithPrec(1, 1),
+		PreviousCommissionRate: sdk.NewDecWithPrec(5, 2),
+		DistributionScore:      sdk.OneDec(),
+		PerformanceScore:       sdk.OneDec(),
+		UptimeScore:            sdk.NewDecWithPrec(8, 1),
+		CommissionScore:        sdk.OneDec(),
+		GovernanceScore:        sdk.OneDec(),
+		Score:                  sdk.NewDecWithPrec(9, 1),
+	}
+	proposal := types.HostProposal{ChainId: "testzone-1", ProposalId: 7, VotingEndTime: ctx.BlockTime(), Voters: []string{score.ValoperAddress}, VotesQueried: true}
+	app.ParticipationRewardsKeeper.SetValidatorScore(ctx, score)
+	app.ParticipationRewardsKeeper.SetHostProposal(ctx, proposal)
+	app.ParticipationRewardsKeeper.SetSignedBlocksWindow(ctx, "testzone-1", 100)
+
+	genesis := participationrewards.ExportGenesis(ctx, app.ParticipationRewardsKeeper)
+	require.Equal(t, []types.ValidatorScore{score}, genesis.ValidatorScores)
+	require.Equal(t, []types.HostProposal{proposal}, genesis.HostProposals)
+	require.Equal(t, []types.SignedBlocksWindow{{ChainId: "testzone-1", Window: 100}}, genesis.SignedBlocksWindows)
+	require.NoError(t, genesis.Validate())
+
+	fresh := simapp.Setup(t, false)
+	freshCtx := fresh.BaseApp.NewContext(false, tmproto.Header{Time: ctx.BlockTime()})
+	participationrewards.InitGenesis(freshCtx, fresh.ParticipationRewardsKeeper, *genesis)
+	require.Equal(t, genesis, participationrewards.ExportGenesis(freshCtx, fresh.ParticipationRewardsKeeper))
+
+	genesis.SignedBlocksWindows[0].Window = 0
+	require.Error(t, genesis.Validate())
+}
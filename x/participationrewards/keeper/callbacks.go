@@ -10,9 +10,13 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 
 	"github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types/gamm"
 	umeetypes "github.com/quicksilver-zone/quicksilver/third-party-chains/umee-types/leverage/types"
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	icqtypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
 )
@@ -29,6 +33,10 @@ const (
 	CrescentPoolUpdateCallbackID              = "crescentpoolupdate"
 	CrescentReserveBalanceUpdateCallbackID    = "reservebalanceupdate"
 	CrescentPoolCoinSupplyUpdateCallbackID    = "poolcoinsupplyupdate"
+	SlashingParamsCallbackID                  = "slashingparams"
+	ValidatorSigningInfoCallbackID            = "validatorsigninginfo"
+	HostProposalsCallbackID                   = "hostproposals"
+	HostProposalVoteCallbackID                = "hostproposalvote"
 )
 
 // Callback wrapper struct for interchainstaking keeper.
@@ -72,7 +80,11 @@ func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 		AddCallback(UmeeTotalBorrowsUpdateCallbackID, Callback(UmeeTotalBorrowsUpdateCallback)).
 		AddCallback(UmeeInterestScalarUpdateCallbackID, Callback(UmeeInterestScalarUpdateCallback)).
 		AddCallback(UmeeUTokenSupplyUpdateCallbackID, Callback(UmeeUTokenSupplyUpdateCallback)).
-		AddCallback(UmeeLeverageModuleBalanceUpdateCallbackID, Callback(UmeeLeverageModuleBalanceUpdateCallback))
+		AddCallback(UmeeLeverageModuleBalanceUpdateCallbackID, Callback(UmeeLeverageModuleBalanceUpdateCallback)).
+		AddCallback(SlashingParamsCallbackID, Callback(SlashingParamsCallback)).
+		AddCallback(ValidatorSigningInfoCallbackID, Callback(ValidatorSigningInfoCallback)).
+		AddCallback(HostProposalsCallbackID, Callback(HostProposalsCallback)).
+		AddCallback(HostProposalVoteCallbackID, Callback(HostProposalVoteCallback))
 
 	return a.(Callbacks)
 }
@@ -414,3 +426,115 @@ func SetEpochBlockCallback(ctx sdk.Context, k *Keeper, args []byte, query icqtyp
 	k.SetProtocolData(ctx, connectionData.GenerateKey(), &data)
 	return nil
 }
+
+// SlashingParamsCallback records the slashing signed blocks window of the
+// zone, against which validator uptime is measured.
+func SlashingParamsCallback(ctx sdk.Context, k *Keeper, args []byte, query icqtypes.Query) error {
+	paramsResponse := slashingtypes.QueryParamsResponse{}
+	if err := k.cdc.Unmarshal(args, &paramsResponse); err != nil {
+		return err
+	}
+
+	k.SetSignedBlocksWindow(ctx, query.ChainId, paramsResponse.Params.SignedBlocksWindow)
+	return nil
+}
+
+// ValidatorSigningInfoCallback records the missed blocks counter of a zone
+// validator.
+func ValidatorSigningInfoCallback(ctx sdk.Context, k *Keeper, args []byte, query icqtypes.Query) error {
+	if len(args) == 0 {
+		k.Logger(ctx).Error("unable to find signing info for validator", "query", query.Request)
+		return nil
+	}
+
+	signingInfo := slashingtypes.ValidatorSigningInfo{}
+	if err := k.cdc.Unmarshal(args, &signingInfo); err != nil {
+		return err
+	}
+
+	consAddr, err := addressutils.AddressFromBech32(signingInfo.Address, "")
+	if err != nil {
+		return err
+	}
+	valAddr, found := k.icsKeeper.GetValidatorAddrByConsAddr(ctx, query.ChainId, consAddr)
+	if !found {
+		return fmt.Errorf("can not get validator address from consensus address: %s", signingInfo.Address)
+	}
+
+	score, found := k.GetValidatorScore(ctx, query.ChainId, valAddr)
+	if !found {
+		k.Logger(ctx).Info("no score inputs for validator, skipping signing info", "validator", valAddr)
+		return nil
+	}
+	score.MissedBlocks = signingInfo.MissedBlocksCounter
+	k.SetValidatorScore(ctx, score)
+	return nil
+}
+
+// HostProposalsCallback tracks the host chain proposals in their voting
+// period, and queries the votes of the zone validators on each.
+func HostProposalsCallback(ctx sdk.Context, k *Keeper, args []byte, query icqtypes.Query) error {
+	proposalsResponse := govv1beta1.QueryProposalsResponse{}
+	if err := k.cdc.Unmarshal(args, &proposalsResponse); err != nil {
+		return err
+	}
+
+	zone, found := k.icsKeeper.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	for _, hostProposal := range proposalsResponse.Proposals {
+		proposal, found := k.GetHostProposal(ctx, zone.ChainId, hostProposal.ProposalId)
+		if !found {
+			proposal = types.HostProposal{ChainId: zone.ChainId, ProposalId: hostProposal.ProposalId}
+		}
+		proposal.VotingEndTime = hostProposal.VotingEndTime
+		k.SetHostProposal(ctx, proposal)
+		k.EmitHostProposalVoteQueries(ctx, &zone, proposal)
+	}
+
+	return nil
+}
+
+// HostProposalVoteCallback records the vote of a zone validator on a tracked
+// host proposal. An empty response indicates the validator has not voted.
+func HostProposalVoteCallback(ctx sdk.Context, k *Keeper, args []byte, query icqtypes.Query) error {
+	if len(args) == 0 {
+		return nil
+	}
+
+	// votes are keyed 0x20 | proposal id (8 bytes) | length prefixed voter address.
+	if len(query.Request) < 11 {
+		return errors.New("query request not sufficient length")
+	}
+	if query.Request[0] != govtypes.VotesKeyPrefix[0] {
+		return errors.New("query request has unexpected prefix")
+	}
+	proposalID := sdk.BigEndianToUint64(query.Request[1:9])
+	voter := query.Request[10:]
+	if int(query.Request[9]) != len(voter) {
+		return errors.New("query request has unexpected voter address length")
+	}
+
+	zone, found := k.icsKeeper.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	proposal, found := k.GetHostProposal(ctx, zone.ChainId, proposalID)
+	if !found {
+		k.Logger(ctx).Info("host proposal no longer tracked, skipping vote", "proposal", proposalID)
+		return nil
+	}
+
+	valoper, err := addressutils.EncodeAddressToBech32(zone.GetValoperPrefix(), sdk.ValAddress(voter))
+	if err != nil {
+		return err
+	}
+	if !proposal.HasVoted(valoper) {
+		proposal.Voters = append(proposal.Voters, valoper)
+		k.SetHostProposal(ctx, proposal)
+	}
+	return nil
+}
@@ -3,6 +3,10 @@ package keeper
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -36,3 +40,24 @@ func (k *Keeper) ProtocolData(c context.Context, q *types.QueryProtocolDataReque
 
 	return &types.QueryProtocolDataResponse{Data: out}, nil
 }
+
+// ValidatorScores returns the validator score weights and the score components
+// of each validator of the given zone.
+func (k *Keeper) ValidatorScores(c context.Context, q *types.QueryValidatorScoresRequest) (*types.QueryValidatorScoresResponse, error) {
+	if q == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.icsKeeper.GetZone(ctx, q.ChainId); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", q.ChainId))
+	}
+
+	scores := make([]types.ValidatorScore, 0)
+	k.IterateValidatorScores(ctx, q.ChainId, func(_ int64, score types.ValidatorScore) (stop bool) {
+		scores = append(scores, score)
+		return false
+	})
+
+	return &types.QueryValidatorScoresResponse{Weights: k.GetValidatorScoreWeights(ctx), Scores: scores}, nil
+}
@@ -163,16 +163,18 @@ func (suite *KeeperTestSuite) setupTestZones() {
 
 	// test zone
 	testzone := icstypes.Zone{
-		ConnectionId:     suite.path.EndpointA.ConnectionID,
-		ChainId:          suite.chainB.ChainID,
-		AccountPrefix:    "cosmos",
-		LocalDenom:       "uqatom",
-		BaseDenom:        "uatom",
-		ReturnToSender:   false,
-		LiquidityModule:  true,
-		DepositsEnabled:  true,
-		UnbondingEnabled: false,
-		Is_118:           true,
+		ConnectionId:       suite.path.EndpointA.ConnectionID,
+		ChainId:            suite.chainB.ChainID,
+		AccountPrefix:      "cosmos",
+		LocalDenom:         "uqatom",
+		BaseDenom:          "uatom",
+		ReturnToSender:     false,
+		LiquidityModule:    true,
+		DepositsEnabled:    true,
+		UnbondingEnabled:   false,
+		Is_118:             true,
+		RedemptionRate:     sdk.OneDec(),
+		LastRedemptionRate: sdk.OneDec(),
 		WithdrawalAddress: &icstypes.ICAAccount{
 			Address:           withdrawalAddress1,
 			PortName:          suite.chainB.ChainID + ".withrawal",
@@ -180,16 +182,18 @@ func (suite *KeeperTestSuite) setupTestZones() {
 		},
 	}
 	selftestzone := icstypes.Zone{
-		ConnectionId:     suite.path.EndpointB.ConnectionID,
-		ChainId:          suite.chainA.ChainID,
-		AccountPrefix:    "osmo",
-		LocalDenom:       "uqosmo",
-		BaseDenom:        "uosmo",
-		ReturnToSender:   false,
-		LiquidityModule:  true,
-		DepositsEnabled:  true,
-		UnbondingEnabled: false,
-		Is_118:           true,
+		ConnectionId:       suite.path.EndpointB.ConnectionID,
+		ChainId:            suite.chainA.ChainID,
+		AccountPrefix:      "osmo",
+		LocalDenom:         "uqosmo",
+		BaseDenom:          "uosmo",
+		ReturnToSender:     false,
+		LiquidityModule:    true,
+		DepositsEnabled:    true,
+		UnbondingEnabled:   false,
+		Is_118:             true,
+		RedemptionRate:     sdk.OneDec(),
+		LastRedemptionRate: sdk.OneDec(),
 		WithdrawalAddress: &icstypes.ICAAccount{
 			Address:           withdrawalAddress2,
 			PortName:          suite.chainA.ChainID + ".withrawal",
@@ -233,6 +237,8 @@ func (suite *KeeperTestSuite) setupTestZones() {
 		LiquidityModule:    true,
 		DepositsEnabled:    true,
 		Is_118:             true,
+		RedemptionRate:     sdk.OneDec(),
+		LastRedemptionRate: sdk.OneDec(),
 		Decimals:           6,
 		PerformanceAddress: performanceAccountOsmo,
 		WithdrawalAddress:  withdrawalAccountOsmo,
@@ -283,6 +289,8 @@ func (suite *KeeperTestSuite) setupTestZones() {
 		LiquidityModule:    true,
 		PerformanceAddress: performanceAccountCosmos,
 		Is_118:             true,
+		RedemptionRate:     sdk.OneDec(),
+		LastRedemptionRate: sdk.OneDec(),
 		WithdrawalAddress:  withdrawalAccountCosmos,
 	}
 	quicksilver.InterchainstakingKeeper.SetZone(suite.chainA.GetContext(), &zoneCosmos)
@@ -335,7 +343,9 @@ func (suite *KeeperTestSuite) setupTestZones() {
 			PortName:          "osmosis-1.withrawal",
 			WithdrawalAddress: withdrawalAddress,
 		},
-		Is_118: true,
+		Is_118:             true,
+		RedemptionRate:     sdk.OneDec(),
+		LastRedemptionRate: sdk.OneDec(),
 	}
 	quicksilver.InterchainstakingKeeper.SetZone(suite.chainA.GetContext(), &zoneOsmosis)
 }
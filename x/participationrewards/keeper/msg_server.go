@@ -138,3 +138,34 @@ func (k msgServer) GovRemoveProtocolData(goCtx context.Context, msg *types.MsgGo
 
 	return &types.MsgGovRemoveProtocolDataResponse{}, nil
 }
+
+// GovSetValidatorScoreWeights sets the weights of the supplementary validator scoring inputs.
+func (k msgServer) GovSetValidatorScoreWeights(goCtx context.Context, msg *types.MsgGovSetValidatorScoreWeights) (*types.MsgGovSetValidatorScoreWeightsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// checking msg authority is the gov module address
+	if k.Keeper.GetGovAuthority(ctx) != msg.Authority {
+		return &types.MsgGovSetValidatorScoreWeightsResponse{},
+			govtypes.ErrInvalidSigner.Wrapf(
+				"invalid authority: expected %s, got %s",
+				k.Keeper.GetGovAuthority(ctx), msg.Authority,
+			)
+	}
+
+	k.Keeper.SetValidatorScoreWeights(ctx, msg.Weights)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetValidatorScoreWeights,
+			sdk.NewAttribute(types.AttributeKeyUptimeWeight, msg.Weights.Uptime.String()),
+			sdk.NewAttribute(types.AttributeKeyCommissionWeight, msg.Weights.Commission.String()),
+			sdk.NewAttribute(types.AttributeKeyGovernanceWeight, msg.Weights.Governance.String()),
+		),
+	})
+
+	return &types.MsgGovSetValidatorScoreWeightsResponse{}, nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/quicksilver-zone/quicksilver/utils"
 	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	cmtypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/keeper"
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
 )
@@ -467,6 +468,11 @@ func (suite *KeeperTestSuite) Test_msgServer_SubmitLocalClaim() {
 				suite.NoError(appA.BankKeeper.MintCoins(ctx, "mint", sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(100)))))
 				suite.NoError(appA.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", address, sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(100)))))
 
+				// back the minted qAssets for each zone issuing uqatom, as the supply invariant is asserted as blocks are committed.
+				for _, chainID := range []string{suite.chainB.ChainID, "cosmoshub-4"} {
+					appA.InterchainstakingKeeper.SetDelegation(ctx, chainID, icstypes.NewDelegation(addressutils.GenerateAddressForTestWithPrefix("cosmos"), addressutils.GenerateAddressForTestWithPrefix("cosmosvaloper"), sdk.NewCoin("uatom", sdk.NewInt(100))))
+				}
+
 				// add uqatom to the list of allowed denoms for this zone
 				rawPd := types.LiquidAllowedDenomProtocolData{
 					ChainID:               suite.chainA.ChainID,
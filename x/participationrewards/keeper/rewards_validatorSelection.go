@@ -14,12 +14,15 @@ import (
 // AllocateValidatorSelectionRewards utilizes IBC to query the performance
 // rewards account for each zone to determine validator performance and
 // corresponding rewards allocations. Each zone's response is dealt with
-// individually in a callback.
+// individually in a callback. The supplementary validator scoring inputs are
+// refreshed alongside.
 func (k Keeper) AllocateValidatorSelectionRewards(ctx sdk.Context) {
 	k.icsKeeper.IterateZones(ctx, func(_ int64, zone *icstypes.Zone) (stop bool) {
 		if zone.PerformanceAddress != nil {
 			k.Logger(ctx).Info("zones", "chain_id", zone.ChainId, "performance address", zone.PerformanceAddress.Address)
 
+			k.UpdateValidatorScoreInputs(ctx, zone)
+
 			// obtain zone performance account rewards
 			rewardsQuery := distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: zone.PerformanceAddress.Address}
 			bz := k.cdc.MustMarshal(&rewardsQuery)
@@ -141,7 +144,9 @@ func (k Keeper) CalcDistributionScores(ctx sdk.Context, zone icstypes.Zone, zs *
 }
 
 // CalcOverallScores calculates the overall validator scores for the given zone
-// based on the combination of performance score and distribution score.
+// based on the combination of performance score and distribution score,
+// reduced by the weighted shortfall of the uptime, commission and governance
+// scores.
 //
 // The performance score is first calculated based on validator rewards earned
 // from the zone performance account that delegates an exact amount to each
@@ -150,6 +155,11 @@ func (k Keeper) CalcDistributionScores(ctx sdk.Context, zone icstypes.Zone, zs *
 // performance score for each validator is then simply the percentage of actual
 // rewards compared to the expected rewards (capped at 100%).
 //
+// The uptime, commission and governance scores are derived from the inputs
+// gathered from the host chain (see UpdateValidatorScoreInputs), and weighted
+// according to the governance set ValidatorScoreWeights. The score components
+// of each validator are recorded for query.
+//
 // On completion a msg is submitted to withdraw the zone performance rewards,
 // resetting zone performance scoring for the next epoch.
 func (k Keeper) CalcOverallScores(
@@ -180,6 +190,9 @@ func (k Keeper) CalcOverallScores(
 		"expected", expected,
 	)
 
+	weights := k.GetValidatorScoreWeights(ctx)
+	concluded := k.ConcludedHostProposals(ctx, zone.ChainId)
+
 	msgs := make([]sdk.Msg, 0)
 	limit := sdk.NewDec(1.0)
 	for _, reward := range rewards {
@@ -195,12 +208,24 @@ func (k Keeper) CalcOverallScores(
 		}
 		k.Logger(ctx).Info("performance score", "validator", vs.ValoperAddress, "performance", vs.PerformanceScore)
 
+		k.setScoreComponents(ctx, zone.ChainId, concluded, vs)
+		k.Logger(ctx).Info(
+			"supplementary scores",
+			"validator", vs.ValoperAddress,
+			"uptime", vs.UptimeScore,
+			"commission", vs.CommissionScore,
+			"governance", vs.GovernanceScore,
+		)
+
 		// calculate and set overall score
-		vs.Score = vs.DistributionScore.Mul(vs.PerformanceScore)
+		vs.Score = vs.DistributionScore.Mul(vs.PerformanceScore).Mul(
+			weights.Modifier(vs.UptimeScore, vs.CommissionScore, vs.GovernanceScore),
+		)
 		k.Logger(ctx).Info("overall score", "validator", vs.ValoperAddress, "overall", vs.Score)
 		if err := k.icsKeeper.SetValidator(ctx, zone.ChainId, *(vs.Validator)); err != nil {
 			k.Logger(ctx).Error("unable to set score for validator", "validator", vs.ValoperAddress)
 		}
+		k.recordValidatorScore(ctx, zone.ChainId, vs)
 
 		// prepare validator performance withdrawal msg
 		msg := &distrtypes.MsgWithdrawDelegatorReward{
@@ -0,0 +1,307 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+// GetValidatorScoreWeights returns the validator score weights, defaulting to
+// zero weights if unset.
+func (k *Keeper) GetValidatorScoreWeights(ctx sdk.Context) types.ValidatorScoreWeights {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyValidatorScoreWeights)
+	if len(bz) == 0 {
+		return types.DefaultValidatorScoreWeights()
+	}
+	weights := types.ValidatorScoreWeights{}
+	k.cdc.MustUnmarshal(bz, &weights)
+	return weights
+}
+
+// SetValidatorScoreWeights sets the validator score weights.
+func (k *Keeper) SetValidatorScoreWeights(ctx sdk.Context, weights types.ValidatorScoreWeights) {
+	ctx.KVStore(k.storeKey).Set(types.KeyValidatorScoreWeights, k.cdc.MustMarshal(&weights))
+}
+
+// HasValidatorScoreWeights returns true if the validator score weights have been set.
+func (k *Keeper) HasValidatorScoreWeights(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyValidatorScoreWeights)
+}
+
+// GetValidatorScore returns the score of the given validator.
+func (k *Keeper) GetValidatorScore(ctx sdk.Context, chainID, valoperAddress string) (types.ValidatorScore, bool) {
+	score := types.ValidatorScore{}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetValidatorScoreKey(chainID, valoperAddress))
+	if len(bz) == 0 {
+		return score, false
+	}
+	k.cdc.MustUnmarshal(bz, &score)
+	return score, true
+}
+
+// SetValidatorScore sets the score of a validator.
+func (k *Keeper) SetValidatorScore(ctx sdk.Context, score types.ValidatorScore) {
+	ctx.KVStore(k.storeKey).Set(types.GetValidatorScoreKey(score.ChainId, score.ValoperAddress), k.cdc.MustMarshal(&score))
+}
+
+// IterateValidatorScores iterates through the validator scores of the given zone.
+func (k *Keeper) IterateValidatorScores(ctx sdk.Context, chainID string, fn func(index int64, score types.ValidatorScore) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetZoneValidatorScoresKey(chainID))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		score := types.ValidatorScore{}
+		k.cdc.MustUnmarshal(iterator.Value(), &score)
+		if fn(i, score) {
+			break
+		}
+		i++
+	}
+}
+
+// GetSignedBlocksWindow returns the slashing signed blocks window of the given zone.
+func (k *Keeper) GetSignedBlocksWindow(ctx sdk.Context, chainID string) int64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSignedBlocksWindow)
+	bz := store.Get([]byte(chainID))
+	if len(bz) == 0 {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// SetSignedBlocksWindow sets the slashing signed blocks window of the given zone.
+func (k *Keeper) SetSignedBlocksWindow(ctx sdk.Context, chainID string, window int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSignedBlocksWindow)
+	store.Set([]byte(chainID), sdk.Uint64ToBigEndian(uint64(window)))
+}
+
+// IterateSignedBlocksWindows iterates through the slashing signed blocks window
+// of each zone.
+func (k *Keeper) IterateSignedBlocksWindows(ctx sdk.Context, fn func(index int64, chainID string, window int64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSignedBlocksWindow)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(i, string(iterator.Key()), int64(sdk.BigEndianToUint64(iterator.Value()))) {
+			break
+		}
+		i++
+	}
+}
+
+// GetHostProposal returns the given tracked host proposal.
+func (k *Keeper) GetHostProposal(ctx sdk.Context, chainID string, proposalID uint64) (types.HostProposal, bool) {
+	proposal := types.HostProposal{}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetHostProposalKey(chainID, proposalID))
+	if len(bz) == 0 {
+		return proposal, false
+	}
+	k.cdc.MustUnmarshal(bz, &proposal)
+	return proposal, true
+}
+
+// SetHostProposal sets a tracked host proposal.
+func (k *Keeper) SetHostProposal(ctx sdk.Context, proposal types.HostProposal) {
+	ctx.KVStore(k.storeKey).Set(types.GetHostProposalKey(proposal.ChainId, proposal.ProposalId), k.cdc.MustMarshal(&proposal))
+}
+
+// DeleteHostProposal deletes a tracked host proposal.
+func (k *Keeper) DeleteHostProposal(ctx sdk.Context, chainID string, proposalID uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetHostProposalKey(chainID, proposalID))
+}
+
+// IterateHostProposals iterates through the tracked host proposals of the given
+// zone, in order of proposal id.
+func (k *Keeper) IterateHostProposals(ctx sdk.Context, chainID string, fn func(index int64, proposal types.HostProposal) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetZoneHostProposalsKey(chainID))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		proposal := types.HostProposal{}
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+		if fn(i, proposal) {
+			break
+		}
+		i++
+	}
+}
+
+// ConcludedHostProposals returns the tracked host proposals of the given zone
+// whose voting period has ended, most recent first.
+func (k *Keeper) ConcludedHostProposals(ctx sdk.Context, chainID string) []types.HostProposal {
+	proposals := make([]types.HostProposal, 0)
+	k.IterateHostProposals(ctx, chainID, func(_ int64, proposal types.HostProposal) (stop bool) {
+		if !proposal.VotingEndTime.After(ctx.BlockTime()) {
+			proposals = append(proposals, proposal)
+		}
+		return false
+	})
+	sort.SliceStable(proposals, func(i, j int) bool {
+		return proposals[i].ProposalId > proposals[j].ProposalId
+	})
+	return proposals
+}
+
+// UpdateValidatorScoreInputs snapshots the commission rate of each validator
+// of the given zone, prunes stale host proposals, and queries the host chain
+// for the signing info of each validator and for proposals in their voting
+// period. Votes on tracked proposals whose voting period has since ended are
+// queried once more, such that votes cast after the last epoch are counted.
+// Query responses are handled by callbacks and used when the zone is next
+// scored.
+func (k *Keeper) UpdateValidatorScoreInputs(ctx sdk.Context, zone *icstypes.Zone) {
+	for _, val := range k.icsKeeper.GetValidators(ctx, zone.ChainId) {
+		score, found := k.GetValidatorScore(ctx, zone.ChainId, val.ValoperAddress)
+		if !found {
+			score = newValidatorScore(zone.ChainId, val.ValoperAddress, val.CommissionRate)
+		}
+		score.PreviousCommissionRate = score.CommissionRate
+		score.CommissionRate = val.CommissionRate
+		k.SetValidatorScore(ctx, score)
+	}
+
+	concluded := k.ConcludedHostProposals(ctx, zone.ChainId)
+	for i := types.HostProposalLookback; i < len(concluded); i++ {
+		k.DeleteHostProposal(ctx, zone.ChainId, concluded[i].ProposalId)
+	}
+	for i := 0; i < len(concluded) && i < types.HostProposalLookback; i++ {
+		if concluded[i].VotesQueried {
+			continue
+		}
+		k.EmitHostProposalVoteQueries(ctx, zone, concluded[i])
+		concluded[i].VotesQueried = true
+		k.SetHostProposal(ctx, concluded[i])
+	}
+
+	k.IcqKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"cosmos.slashing.v1beta1.Query/Params",
+		k.cdc.MustMarshal(&slashingtypes.QueryParamsRequest{}),
+		sdk.NewInt(-1),
+		types.ModuleName,
+		SlashingParamsCallbackID,
+		0,
+	)
+
+	k.icsKeeper.IterateValidatorAddrsByConsAddr(ctx, zone.ChainId, func(_ int64, consAddr []byte, _ string) (stop bool) {
+		k.IcqKeeper.MakeRequest(
+			ctx,
+			zone.ConnectionId,
+			zone.ChainId,
+			"store/slashing/key",
+			slashingtypes.ValidatorSigningInfoKey(consAddr),
+			sdk.NewInt(-1),
+			types.ModuleName,
+			ValidatorSigningInfoCallbackID,
+			0,
+		)
+		return false
+	})
+
+	k.IcqKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"cosmos.gov.v1beta1.Query/Proposals",
+		k.cdc.MustMarshal(&govv1beta1.QueryProposalsRequest{ProposalStatus: govv1beta1.StatusVotingPeriod}),
+		sdk.NewInt(-1),
+		types.ModuleName,
+		HostProposalsCallbackID,
+		0,
+	)
+}
+
+// EmitHostProposalVoteQueries queries the host chain for the vote of each
+// zone validator that has yet to be seen voting on the given proposal.
+func (k *Keeper) EmitHostProposalVoteQueries(ctx sdk.Context, zone *icstypes.Zone, proposal types.HostProposal) {
+	for _, val := range k.icsKeeper.GetValidators(ctx, zone.ChainId) {
+		if proposal.HasVoted(val.ValoperAddress) {
+			continue
+		}
+		valAddr, err := addressutils.ValAddressFromBech32(val.ValoperAddress, zone.GetValoperPrefix())
+		if err != nil {
+			k.Logger(ctx).Error("unable to decode validator address", "validator", val.ValoperAddress, "error", err)
+			continue
+		}
+		k.IcqKeeper.MakeRequest(
+			ctx,
+			zone.ConnectionId,
+			zone.ChainId,
+			"store/gov/key",
+			govtypes.VoteKey(proposal.ProposalId, sdk.AccAddress(valAddr)),
+			sdk.NewInt(-1),
+			types.ModuleName,
+			HostProposalVoteCallbackID,
+			0,
+		)
+	}
+}
+
+// setScoreComponents sets the uptime, commission and governance scores of the
+// given validator from the inputs gathered from the host chain. Validators
+// without inputs are given the full score for each component.
+func (k *Keeper) setScoreComponents(ctx sdk.Context, chainID string, concluded []types.HostProposal, vs *types.Validator) {
+	vs.UptimeScore = sdk.OneDec()
+	vs.CommissionScore = sdk.OneDec()
+	if score, found := k.GetValidatorScore(ctx, chainID, vs.ValoperAddress); found {
+		vs.UptimeScore = types.UptimeScore(score.MissedBlocks, k.GetSignedBlocksWindow(ctx, chainID))
+		vs.CommissionScore = types.CommissionScore(score.PreviousCommissionRate, score.CommissionRate)
+	}
+
+	voted := 0
+	for _, proposal := range concluded {
+		if proposal.HasVoted(vs.ValoperAddress) {
+			voted++
+		}
+	}
+	vs.GovernanceScore = types.GovernanceScore(voted, len(concluded))
+}
+
+// recordValidatorScore stores the score components of the given validator.
+func (k *Keeper) recordValidatorScore(ctx sdk.Context, chainID string, vs *types.Validator) {
+	score, found := k.GetValidatorScore(ctx, chainID, vs.ValoperAddress)
+	if !found {
+		score = newValidatorScore(chainID, vs.ValoperAddress, vs.CommissionRate)
+	}
+	score.DistributionScore = vs.DistributionScore
+	score.PerformanceScore = vs.PerformanceScore
+	score.UptimeScore = vs.UptimeScore
+	score.CommissionScore = vs.CommissionScore
+	score.GovernanceScore = vs.GovernanceScore
+	score.Score = vs.Score
+	k.SetValidatorScore(ctx, score)
+}
+
+func newValidatorScore(chainID, valoperAddress string, commissionRate sdk.Dec) types.ValidatorScore {
+	if commissionRate.IsNil() {
+		commissionRate = sdk.ZeroDec()
+	}
+	return types.ValidatorScore{
+		ChainId:                chainID,
+		ValoperAddress:         valoperAddress,
+		CommissionRate:         commissionRate,
+		PreviousCommissionRate: commissionRate,
+		DistributionScore:      sdk.ZeroDec(),
+		PerformanceScore:       sdk.ZeroDec(),
+		UptimeScore:            sdk.OneDec(),
+		CommissionScore:        sdk.OneDec(),
+		GovernanceScore:        sdk.OneDec(),
+		Score:                  sdk.ZeroDec(),
+	}
+}
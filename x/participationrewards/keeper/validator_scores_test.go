@@ -0,0 +1,210 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	icqkeeper "github.com/quicksilver-zone/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/keeper"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+func (suite *KeeperTestSuite) TestValidatorScores() {
+	suite.SetupTest()
+
+	appA := suite.GetQuicksilverApp(suite.chainA)
+	prk := appA.ParticipationRewardsKeeper
+	icsk := appA.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsk.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	vals := icsk.GetValidators(ctx, zone.ChainId)
+	suite.GreaterOrEqual(len(vals), 3)
+
+	// uptime is weighted most heavily.
+	msgServer := keeper.NewMsgServerImpl(prk)
+	_, err := msgServer.GovSetValidatorScoreWeights(ctx, &types.MsgGovSetValidatorScoreWeights{
+		Title:       "set validator score weights",
+		Description: "weight uptime, commission and governance",
+		Weights:     types.ValidatorScoreWeights{Uptime: sdk.NewDecWithPrec(5, 1), Commission: sdk.NewDecWithPrec(25, 2), Governance: sdk.NewDecWithPrec(25, 2)},
+		Authority:   prk.GetGovAuthority(ctx),
+	})
+	suite.NoError(err)
+
+	// vals[1] raises commission from 10% to 55% between epochs; half its headroom.
+	vals[1].CommissionRate = sdk.NewDecWithPrec(1, 1)
+	suite.NoError(icsk.SetValidator(ctx, zone.ChainId, vals[1]))
+	prk.UpdateValidatorScoreInputs(ctx, &zone)
+	vals[1].CommissionRate = sdk.NewDecWithPrec(55, 2)
+	suite.NoError(icsk.SetValidator(ctx, zone.ChainId, vals[1]))
+	prk.UpdateValidatorScoreInputs(ctx, &zone)
+
+	getQuery := func(queryType string, request []byte, callbackID string) icqtypes.Query {
+		query, found := prk.IcqKeeper.GetQuery(ctx, icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, queryType, request, types.ModuleName, callbackID))
+		suite.True(found, "%s %s", queryType, callbackID)
+		return query
+	}
+
+	// signed blocks window of 100, of which vals[0] missed 20.
+	query := getQuery("cosmos.slashing.v1beta1.Query/Params", prk.GetCodec().MustMarshal(&slashingtypes.QueryParamsRequest{}), keeper.SlashingParamsCallbackID)
+	resp := prk.GetCodec().MustMarshal(&slashingtypes.QueryParamsResponse{Params: slashingtypes.Params{SignedBlocksWindow: 100}})
+	suite.NoError(keeper.SlashingParamsCallback(ctx, prk, resp, query))
+
+	var consAddr sdk.ConsAddress
+	icsk.IterateValidatorAddrsByConsAddr(ctx, zone.ChainId, func(_ int64, addr []byte, valAddr string) (stop bool) {
+		if valAddr == vals[0].ValoperAddress {
+			consAddr = addr
+			return true
+		}
+		return false
+	})
+	suite.NotNil(consAddr)
+	query = getQuery("store/slashing/key", slashingtypes.ValidatorSigningInfoKey(consAddr), keeper.ValidatorSigningInfoCallbackID)
+	resp = prk.GetCodec().MustMarshal(&slashingtypes.ValidatorSigningInfo{Address: consAddr.String(), MissedBlocksCounter: 20})
+	suite.NoError(keeper.ValidatorSigningInfoCallback(ctx, prk, resp, query))
+
+	// two concluded host proposals; vals[0] votes on both, vals[1] on one, and
+	// vals[2] on neither. The third proposal is still in its voting period and
+	// does not yet count towards governance participation.
+	query = getQuery("cosmos.gov.v1beta1.Query/Proposals", prk.GetCodec().MustMarshal(&govv1beta1.QueryProposalsRequest{ProposalStatus: govv1beta1.StatusVotingPeriod}), keeper.HostProposalsCallbackID)
+	resp = prk.GetCodec().MustMarshal(&govv1beta1.QueryProposalsResponse{Proposals: []govv1beta1.Proposal{
+		{ProposalId: 1, VotingEndTime: ctx.BlockTime().Add(-time.Hour)},
+		{ProposalId: 2, VotingEndTime: ctx.BlockTime().Add(-time.Hour)},
+		{ProposalId: 3, VotingEndTime: ctx.BlockTime().Add(time.Hour)},
+	}})
+	suite.NoError(keeper.HostProposalsCallback(ctx, prk, resp, query))
+
+	vote := func(proposalID uint64, valoper string, voted bool) {
+		valAddr, err := addressutils.ValAddressFromBech32(valoper, zone.GetValoperPrefix())
+		suite.NoError(err)
+		query := getQuery("store/gov/key", govtypes.VoteKey(proposalID, sdk.AccAddress(valAddr)), keeper.HostProposalVoteCallbackID)
+		var resp []byte
+		if voted {
+			resp = prk.GetCodec().MustMarshal(&govv1beta1.Vote{ProposalId: proposalID, Voter: sdk.AccAddress(valAddr).String(), Options: govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes)})
+		}
+		suite.NoError(keeper.HostProposalVoteCallback(ctx, prk, resp, query))
+	}
+	vote(1, vals[0].ValoperAddress, true)
+	vote(2, vals[0].ValoperAddress, true)
+	vote(1, vals[1].ValoperAddress, true)
+	vote(2, vals[1].ValoperAddress, false)
+	vote(1, vals[2].ValoperAddress, false)
+	vote(3, vals[0].ValoperAddress, true)
+
+	proposal, found := prk.GetHostProposal(ctx, zone.ChainId, 1)
+	suite.True(found)
+	suite.ElementsMatch([]string{vals[0].ValoperAddress, vals[1].ValoperAddress}, proposal.Voters)
+	suite.Len(prk.ConcludedHostProposals(ctx, zone.ChainId), 2)
+
+	zs := types.ZoneScore{ZoneID: zone.ChainId, TotalVotingPower: sdk.ZeroInt(), ValidatorScores: make(map[string]*types.Validator)}
+	rewards := make([]distrtypes.DelegationDelegatorReward, 0)
+	for i := range vals[:3] {
+		zs.ValidatorScores[vals[i].ValoperAddress] = &types.Validator{
+			PowerPercentage:   sdk.OneDec(),
+			DistributionScore: sdk.OneDec(),
+			Validator:         &vals[i],
+		}
+		rewards = append(rewards, distrtypes.DelegationDelegatorReward{ValidatorAddress: vals[i].ValoperAddress, Reward: sdk.NewDecCoins(sdk.NewDecCoin(zone.BaseDenom, sdk.NewInt(10)))})
+	}
+	delegatorRewards := distrtypes.QueryDelegationTotalRewardsResponse{Rewards: rewards, Total: sdk.NewDecCoins(sdk.NewDecCoin(zone.BaseDenom, sdk.NewInt(30)))}
+	suite.NoError(prk.CalcOverallScores(ctx, zone, delegatorRewards, &zs))
+
+	want := map[string]struct{ uptime, commission, governance, score sdk.Dec }{
+		// 1 - 0.5*0.2
+		vals[0].ValoperAddress: {sdk.NewDecWithPrec(8, 1), sdk.OneDec(), sdk.OneDec(), sdk.NewDecWithPrec(9, 1)},
+		// 1 - 0.25*0.5 - 0.25*0.5
+		vals[1].ValoperAddress: {sdk.OneDec(), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(75, 2)},
+		// 1 - 0.25*1
+		vals[2].ValoperAddress: {sdk.OneDec(), sdk.OneDec(), sdk.ZeroDec(), sdk.NewDecWithPrec(75, 2)},
+	}
+
+	res, err := prk.ValidatorScores(ctx, &types.QueryValidatorScoresRequest{ChainId: zone.ChainId})
+	suite.NoError(err)
+	suite.Equal(sdk.NewDecWithPrec(5, 1), res.Weights.Uptime)
+	found = false
+	for _, score := range res.Scores {
+		w, ok := want[score.ValoperAddress]
+		if !ok {
+			continue
+		}
+		suite.Equal(w.uptime, score.UptimeScore, score.ValoperAddress)
+		suite.Equal(w.commission, score.CommissionScore, score.ValoperAddress)
+		suite.Equal(w.governance, score.GovernanceScore, score.ValoperAddress)
+		suite.Equal(w.score, score.Score, score.ValoperAddress)
+		suite.Equal(w.score, zs.ValidatorScores[score.ValoperAddress].Score, score.ValoperAddress)
+		found = true
+	}
+	suite.True(found)
+
+	_, err = prk.ValidatorScores(ctx, &types.QueryValidatorScoresRequest{ChainId: "unknown-1"})
+	suite.Error(err)
+
+	// only the gov module may set the weights.
+	_, err = msgServer.GovSetValidatorScoreWeights(ctx, &types.MsgGovSetValidatorScoreWeights{
+		Title:       "set validator score weights",
+		Description: "weight uptime, commission and governance",
+		Weights:     types.DefaultValidatorScoreWeights(),
+		Authority:   addressutils.GenerateAccAddressForTest().String(),
+	})
+	suite.ErrorContains(err, "invalid authority")
+}
+
+func (suite *KeeperTestSuite) TestHostProposalVotesQueriedAfterVotingEnds() {
+	suite.SetupTest()
+
+	appA := suite.GetQuicksilverApp(suite.chainA)
+	prk := appA.ParticipationRewardsKeeper
+	icsk := appA.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsk.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	vals := icsk.GetValidators(ctx, zone.ChainId)
+	valAddr, err := addressutils.ValAddressFromBech32(vals[0].ValoperAddress, zone.GetValoperPrefix())
+	suite.NoError(err)
+	voteQueryID := icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, "store/gov/key", govtypes.VoteKey(1, sdk.AccAddress(valAddr)), types.ModuleName, keeper.HostProposalVoteCallbackID)
+
+	// the proposal is seen in its voting period, before vals[0] has voted.
+	prk.UpdateValidatorScoreInputs(ctx, &zone)
+	proposalsQuery, found := prk.IcqKeeper.GetQuery(ctx, icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, "cosmos.gov.v1beta1.Query/Proposals", prk.GetCodec().MustMarshal(&govv1beta1.QueryProposalsRequest{ProposalStatus: govv1beta1.StatusVotingPeriod}), types.ModuleName, keeper.HostProposalsCallbackID))
+	suite.True(found)
+	resp := prk.GetCodec().MustMarshal(&govv1beta1.QueryProposalsResponse{Proposals: []govv1beta1.Proposal{{ProposalId: 1, VotingEndTime: ctx.BlockTime().Add(time.Hour)}}})
+	suite.NoError(keeper.HostProposalsCallback(ctx, prk, resp, proposalsQuery))
+	voteQuery, found := prk.IcqKeeper.GetQuery(ctx, voteQueryID)
+	suite.True(found)
+	suite.NoError(keeper.HostProposalVoteCallback(ctx, prk, nil, voteQuery))
+	appA.InterchainQueryKeeper.DeleteQuery(ctx, voteQueryID)
+
+	// votes are not queried again until the voting period has ended.
+	prk.UpdateValidatorScoreInputs(ctx, &zone)
+	_, found = prk.IcqKeeper.GetQuery(ctx, voteQueryID)
+	suite.False(found)
+
+	// by the next epoch the proposal has left its voting period, so is no
+	// longer returned by the proposals query, but votes are queried once more.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	prk.UpdateValidatorScoreInputs(ctx, &zone)
+	voteQuery, found = prk.IcqKeeper.GetQuery(ctx, voteQueryID)
+	suite.True(found)
+	resp = prk.GetCodec().MustMarshal(&govv1beta1.Vote{ProposalId: 1, Voter: sdk.AccAddress(valAddr).String(), Options: govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes)})
+	suite.NoError(keeper.HostProposalVoteCallback(ctx, prk, resp, voteQuery))
+	appA.InterchainQueryKeeper.DeleteQuery(ctx, voteQueryID)
+
+	proposal, found := prk.GetHostProposal(ctx, zone.ChainId, 1)
+	suite.True(found)
+	suite.True(proposal.VotesQueried)
+	suite.Equal([]string{vals[0].ValoperAddress}, proposal.Voters)
+
+	// and only once.
+	prk.UpdateValidatorScoreInputs(ctx, &zone)
+	_, found = prk.IcqKeeper.GetQuery(ctx, voteQueryID)
+	suite.False(found)
+}
@@ -0,0 +1,45 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+// RandomizedGenState generates a random GenesisState for participationrewards.
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+	params.DistributionProportions = genDistributionProportions(simState.Rand)
+	params.ClaimsEnabled = simState.Rand.Intn(2) == 0
+
+	participationrewardsGenesis := types.NewGenesisState(params)
+	if err := participationrewardsGenesis.Validate(); err != nil {
+		panic(err)
+	}
+
+	bz, err := json.MarshalIndent(&participationrewardsGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Selected deterministically generated participationrewards parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(participationrewardsGenesis)
+}
+
+// genDistributionProportions returns random distribution proportions in whole percent, summing to one.
+func genDistributionProportions(r *rand.Rand) types.DistributionProportions {
+	validatorSelection := r.Intn(101)
+	holdings := r.Intn(101 - validatorSelection)
+	return types.DistributionProportions{
+		ValidatorSelectionAllocation: sdk.NewDecWithPrec(int64(validatorSelection), 2),
+		HoldingsAllocation:           sdk.NewDecWithPrec(int64(holdings), 2),
+		LockupAllocation:             sdk.NewDecWithPrec(int64(100-validatorSelection-holdings), 2),
+	}
+}
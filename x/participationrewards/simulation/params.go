@@ -0,0 +1,33 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals on the simulation.
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDistributionProportions),
+			func(r *rand.Rand) string {
+				dp := genDistributionProportions(r)
+				return fmt.Sprintf(
+					`{"validator_selection_allocation":"%s","holdings_allocation":"%s","lockup_allocation":"%s"}`,
+					dp.ValidatorSelectionAllocation, dp.HoldingsAllocation, dp.LockupAllocation,
+				)
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyClaimsEnabled),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%t", r.Intn(2) == 0)
+			},
+		),
+	}
+}
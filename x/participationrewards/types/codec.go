@@ -33,6 +33,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgSubmitClaim{},
 		&MsgGovRemoveProtocolData{},
+		&MsgGovSetValidatorScoreWeights{},
 	)
 
 	registry.RegisterImplementations(
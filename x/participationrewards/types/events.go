@@ -1,8 +1,12 @@
 package types
 
 const (
-	AttributeValueCategory      = ModuleName
-	AttributeKeyProtocolDataKey = "protocoldata_key"
+	AttributeValueCategory       = ModuleName
+	AttributeKeyProtocolDataKey  = "protocoldata_key"
+	AttributeKeyUptimeWeight     = "uptime_weight"
+	AttributeKeyCommissionWeight = "commission_weight"
+	AttributeKeyGovernanceWeight = "governance_weight"
 
-	EventTypeDeleteKeyProposal = "protocoldata_delete"
+	EventTypeDeleteKeyProposal        = "protocoldata_delete"
+	EventTypeSetValidatorScoreWeights = "set_validator_score_weights"
 )
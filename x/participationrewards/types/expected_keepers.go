@@ -76,4 +76,6 @@ type InterchainStakingKeeper interface {
 	IterateDelegatorIntents(ctx sdk.Context, zone *interchainstakingtypes.Zone, snapshot bool, fn func(index int64, intent interchainstakingtypes.DelegatorIntent) (stop bool))
 	GetValidators(ctx sdk.Context, chainID string) []interchainstakingtypes.Validator
 	SetValidator(ctx sdk.Context, chainID string, val interchainstakingtypes.Validator) error
+	GetValidatorAddrByConsAddr(ctx sdk.Context, chainID string, consAddr []byte) (string, bool)
+	IterateValidatorAddrsByConsAddr(ctx sdk.Context, chainID string, fn func(index int64, consAddr []byte, valAddr string) (stop bool))
 }
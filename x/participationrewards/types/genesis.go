@@ -34,6 +34,33 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	if gs.ValidatorScoreWeights != nil {
+		if err := gs.ValidatorScoreWeights.ValidateBasic(); err != nil {
+			errors["ValidatorScoreWeights"] = err
+		}
+	}
+
+	for i, score := range gs.ValidatorScores {
+		if score.ChainId == "" || score.ValoperAddress == "" {
+			errors[fmt.Sprintf("ValidatorScores[%d]", i)] = ErrUndefinedAttribute
+		}
+	}
+
+	for i, proposal := range gs.HostProposals {
+		if proposal.ChainId == "" {
+			errors[fmt.Sprintf("HostProposals[%d]", i)] = ErrUndefinedAttribute
+		}
+	}
+
+	for i, window := range gs.SignedBlocksWindows {
+		switch {
+		case window.ChainId == "":
+			errors[fmt.Sprintf("SignedBlocksWindows[%d]", i)] = ErrUndefinedAttribute
+		case window.Window <= 0:
+			errors[fmt.Sprintf("SignedBlocksWindows[%d]", i)] = ErrNotPositive
+		}
+	}
+
 	if len(errors) > 0 {
 		return multierror.New(errors)
 	}
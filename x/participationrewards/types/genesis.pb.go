@@ -23,17 +23,74 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SignedBlocksWindow is the slashing signed blocks window of a zone.
+type SignedBlocksWindow struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Window  int64  `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *SignedBlocksWindow) Reset()         { *m = SignedBlocksWindow{} }
+func (m *SignedBlocksWindow) String() string { return proto.CompactTextString(m) }
+func (*SignedBlocksWindow) ProtoMessage()    {}
+func (*SignedBlocksWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1387494f116edd8c, []int{0}
+}
+func (m *SignedBlocksWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedBlocksWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedBlocksWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignedBlocksWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedBlocksWindow.Merge(m, src)
+}
+func (m *SignedBlocksWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedBlocksWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedBlocksWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedBlocksWindow proto.InternalMessageInfo
+
+func (m *SignedBlocksWindow) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *SignedBlocksWindow) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
 // GenesisState defines the participationrewards module's genesis state.
 type GenesisState struct {
-	Params       Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ProtocolData []*KeyedProtocolData `protobuf:"bytes,2,rep,name=protocol_data,json=protocolData,proto3" json:"protocol_data,omitempty"`
+	Params                Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ProtocolData          []*KeyedProtocolData   `protobuf:"bytes,2,rep,name=protocol_data,json=protocolData,proto3" json:"protocol_data,omitempty"`
+	ValidatorScoreWeights *ValidatorScoreWeights `protobuf:"bytes,3,opt,name=validator_score_weights,json=validatorScoreWeights,proto3" json:"validator_score_weights,omitempty"`
+	ValidatorScores       []ValidatorScore       `protobuf:"bytes,4,rep,name=validator_scores,json=validatorScores,proto3" json:"validator_scores"`
+	HostProposals         []HostProposal         `protobuf:"bytes,5,rep,name=host_proposals,json=hostProposals,proto3" json:"host_proposals"`
+	SignedBlocksWindows   []SignedBlocksWindow   `protobuf:"bytes,6,rep,name=signed_blocks_windows,json=signedBlocksWindows,proto3" json:"signed_blocks_windows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1387494f116edd8c, []int{0}
+	return fileDescriptor_1387494f116edd8c, []int{1}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +133,36 @@ func (m *GenesisState) GetProtocolData() []*KeyedProtocolData {
 	return nil
 }
 
+func (m *GenesisState) GetValidatorScoreWeights() *ValidatorScoreWeights {
+	if m != nil {
+		return m.ValidatorScoreWeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorScores() []ValidatorScore {
+	if m != nil {
+		return m.ValidatorScores
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHostProposals() []HostProposal {
+	if m != nil {
+		return m.HostProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSignedBlocksWindows() []SignedBlocksWindow {
+	if m != nil {
+		return m.SignedBlocksWindows
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterType((*SignedBlocksWindow)(nil), "quicksilver.participationrewards.v1.SignedBlocksWindow")
 	proto.RegisterType((*GenesisState)(nil), "quicksilver.participationrewards.v1.GenesisState")
 }
 
@@ -85,24 +171,70 @@ func init() {
 }
 
 var fileDescriptor_1387494f116edd8c = []byte{
-	// 261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x2c, 0x2c, 0xcd, 0x4c,
-	0xce, 0x2e, 0xce, 0xcc, 0x29, 0x4b, 0x2d, 0xd2, 0x2f, 0x48, 0x2c, 0x2a, 0xc9, 0x4c, 0xce, 0x2c,
-	0x48, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0x29, 0xd6, 0x2f, 0x33, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x46,
-	0xd2, 0xa2, 0x87, 0x4d, 0x8b, 0x5e, 0x99, 0xa1, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0xbd,
-	0x3e, 0x88, 0x05, 0xd1, 0x2a, 0x65, 0x47, 0x8c, 0x6d, 0x58, 0x8d, 0x04, 0xeb, 0x57, 0xda, 0xc6,
-	0xc8, 0xc5, 0xe3, 0x0e, 0x71, 0x4c, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x27, 0x17, 0x5b, 0x41,
-	0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xb6, 0x1e, 0x11, 0x8e,
-	0xd3, 0x0b, 0x00, 0x6b, 0x71, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0x6a, 0x80, 0x50, 0x34,
-	0x17, 0x2f, 0xd8, 0x92, 0xe4, 0xfc, 0x9c, 0xf8, 0x94, 0xc4, 0x92, 0x44, 0x09, 0x26, 0x05, 0x66,
-	0x0d, 0x6e, 0x23, 0x33, 0xa2, 0x4c, 0xf4, 0x4e, 0xad, 0x4c, 0x4d, 0x09, 0x80, 0x6a, 0x77, 0x49,
-	0x2c, 0x49, 0x0c, 0xe2, 0x29, 0x40, 0xe2, 0x39, 0xc5, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
-	0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3,
-	0xb1, 0x1c, 0x43, 0x94, 0x4b, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e,
-	0x92, 0x4d, 0xba, 0x55, 0xf9, 0x79, 0xa9, 0xc8, 0x02, 0xfa, 0x15, 0xd8, 0x03, 0xac, 0xa4, 0xb2,
-	0x20, 0xb5, 0x38, 0x89, 0x0d, 0x6c, 0x9b, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x8d, 0x1e,
-	0x64, 0xcf, 0x01, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x37, 0xdd, 0x75, 0xd5, 0x69, 0xab, 0x32, 0x5a, 0x8d, 0x3d, 0xc4, 0xa5, 0x5e, 0x16,
+	0xc4, 0x84, 0x6d, 0x41, 0xc1, 0x83, 0x87, 0xa5, 0x50, 0x8b, 0x97, 0x25, 0x0b, 0x16, 0x14, 0x1a,
+	0x66, 0x33, 0x43, 0x32, 0x34, 0xcd, 0x9b, 0xce, 0x9b, 0x66, 0xad, 0x9f, 0xc2, 0x8f, 0xd5, 0x63,
+	0x8f, 0x9e, 0x44, 0x36, 0x5f, 0x44, 0x3a, 0x89, 0x10, 0xdd, 0x1c, 0x82, 0xb7, 0xbc, 0x97, 0xf7,
+	0xff, 0xff, 0xde, 0x0b, 0xff, 0x90, 0xc9, 0xc5, 0xa5, 0x8c, 0xcf, 0x50, 0x66, 0x85, 0xd0, 0x81,
+	0x62, 0xda, 0xc8, 0x58, 0x2a, 0x66, 0x24, 0xe4, 0x5a, 0x2c, 0x99, 0xe6, 0x18, 0x14, 0x93, 0x20,
+	0x11, 0xb9, 0x40, 0x89, 0xbe, 0xd2, 0x60, 0x80, 0xbe, 0x6c, 0x48, 0xfc, 0x36, 0x89, 0x5f, 0x4c,
+	0x76, 0x9f, 0x24, 0x90, 0x80, 0x9d, 0x0f, 0x6e, 0x9f, 0x2a, 0xe9, 0xee, 0xfb, 0x2e, 0xb4, 0x56,
+	0x4b, 0xab, 0xdf, 0x3b, 0x22, 0x74, 0x2e, 0x93, 0x5c, 0xf0, 0x69, 0x06, 0xf1, 0x19, 0x9e, 0xc8,
+	0x9c, 0xc3, 0x92, 0x3e, 0x27, 0xf7, 0xe2, 0x94, 0xc9, 0x3c, 0x92, 0xdc, 0x75, 0x46, 0xce, 0xf8,
+	0x7e, 0x78, 0xd7, 0xd6, 0xc7, 0x9c, 0x3e, 0x25, 0xc3, 0xa5, 0x1d, 0x72, 0x37, 0x46, 0xce, 0xb8,
+	0x1f, 0xd6, 0xd5, 0x5e, 0x39, 0x20, 0x5b, 0x47, 0xd5, 0x55, 0x73, 0xc3, 0x8c, 0xa0, 0xc7, 0x64,
+	0xa8, 0x98, 0x66, 0xe7, 0x68, 0x1d, 0x36, 0xf7, 0x5f, 0xf9, 0x1d, 0xae, 0xf4, 0x67, 0x56, 0x32,
+	0x1d, 0x5c, 0xff, 0x7c, 0xd1, 0x0b, 0x6b, 0x03, 0xfa, 0x85, 0x6c, 0xdb, 0x6d, 0x63, 0xc8, 0x22,
+	0xce, 0x0c, 0x73, 0x37, 0x46, 0xfd, 0xf1, 0xe6, 0xfe, 0x9b, 0x4e, 0x8e, 0x1f, 0xc5, 0x95, 0xe0,
+	0xb3, 0x5a, 0x7e, 0xc8, 0x0c, 0x0b, 0xb7, 0x54, 0xa3, 0xa2, 0x9a, 0x3c, 0x2b, 0x58, 0x26, 0x39,
+	0x33, 0xa0, 0x23, 0x8c, 0x41, 0x8b, 0x68, 0x29, 0x64, 0x92, 0x1a, 0x74, 0xfb, 0x76, 0xf1, 0x77,
+	0x9d, 0x30, 0x9f, 0xfe, 0x78, 0xcc, 0x6f, 0x2d, 0x4e, 0x2a, 0x87, 0x70, 0xa7, 0x68, 0x6b, 0x53,
+	0x4e, 0x1e, 0xfd, 0xc3, 0x44, 0x77, 0x60, 0x6f, 0x3a, 0xf8, 0x0f, 0x58, 0xfd, 0xb5, 0x1e, 0xfe,
+	0xcd, 0x42, 0x7a, 0x4a, 0x1e, 0xa4, 0x80, 0x26, 0x52, 0x1a, 0x14, 0x20, 0xcb, 0xd0, 0xbd, 0x63,
+	0x19, 0x93, 0x4e, 0x8c, 0x0f, 0x80, 0x66, 0x56, 0x2b, 0x6b, 0xc2, 0x76, 0xda, 0xe8, 0x21, 0xbd,
+	0x20, 0x3b, 0x68, 0xb3, 0x13, 0x2d, 0x6c, 0x78, 0xa2, 0x2a, 0x0a, 0xe8, 0x0e, 0x2d, 0xe6, 0x6d,
+	0x27, 0xcc, 0x7a, 0xfa, 0x6a, 0xd8, 0x63, 0x5c, 0x7b, 0x83, 0xd3, 0xd3, 0xeb, 0x95, 0xe7, 0xdc,
+	0xac, 0x3c, 0xe7, 0xd7, 0xca, 0x73, 0xbe, 0x97, 0x5e, 0xef, 0xa6, 0xf4, 0x7a, 0x3f, 0x4a, 0xaf,
+	0xf7, 0xf9, 0x30, 0x91, 0x26, 0xbd, 0x5c, 0xf8, 0x31, 0x9c, 0x07, 0x0d, 0xee, 0xeb, 0x6f, 0x90,
+	0x8b, 0x66, 0x23, 0xf8, 0xda, 0xfe, 0x9b, 0x98, 0x2b, 0x25, 0x70, 0x31, 0xb4, 0xd1, 0x38, 0xf8,
+	0x3d, 0x00, 0x64, 0x29, 0x77, 0x17, 0xc5, 0x03, 0x00, 0x00,
+}
+
+func (m *SignedBlocksWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedBlocksWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedBlocksWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +257,60 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SignedBlocksWindows) > 0 {
+		for iNdEx := len(m.SignedBlocksWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignedBlocksWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.HostProposals) > 0 {
+		for iNdEx := len(m.HostProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValidatorScores) > 0 {
+		for iNdEx := len(m.ValidatorScores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorScores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ValidatorScoreWeights != nil {
+		{
+			size, err := m.ValidatorScoreWeights.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.ProtocolData) > 0 {
 		for iNdEx := len(m.ProtocolData) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -163,6 +349,22 @@ func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *SignedBlocksWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovGenesis(uint64(m.Window))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -177,6 +379,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.ValidatorScoreWeights != nil {
+		l = m.ValidatorScoreWeights.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ValidatorScores) > 0 {
+		for _, e := range m.ValidatorScores {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HostProposals) > 0 {
+		for _, e := range m.HostProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SignedBlocksWindows) > 0 {
+		for _, e := range m.SignedBlocksWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -186,6 +410,107 @@ func sovGenesis(x uint64) (n int) {
 func sozGenesis(x uint64) (n int) {
 	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *SignedBlocksWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedBlocksWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedBlocksWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -282,6 +607,144 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorScoreWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorScoreWeights == nil {
+				m.ValidatorScoreWeights = &ValidatorScoreWeights{}
+			}
+			if err := m.ValidatorScoreWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorScores = append(m.ValidatorScores, ValidatorScore{})
+			if err := m.ValidatorScores[len(m.ValidatorScores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostProposals = append(m.HostProposals, HostProposal{})
+			if err := m.HostProposals[len(m.HostProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedBlocksWindows = append(m.SignedBlocksWindows, SignedBlocksWindow{})
+			if err := m.SignedBlocksWindows[len(m.SignedBlocksWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -22,7 +22,13 @@ const (
 	ProofTypeLPFarm   = "lpfarm"
 )
 
-var KeyPrefixProtocolData = []byte{0x00}
+var (
+	KeyPrefixProtocolData       = []byte{0x00}
+	KeyValidatorScoreWeights    = []byte{0x01}
+	KeyPrefixValidatorScore     = []byte{0x02}
+	KeyPrefixHostProposal       = []byte{0x03}
+	KeyPrefixSignedBlocksWindow = []byte{0x04}
+)
 
 func GetProtocolDataKey(pdType ProtocolDataType, key []byte) []byte {
 	return append(sdk.Uint64ToBigEndian(uint64(pdType)), key...)
@@ -31,3 +37,23 @@ func GetProtocolDataKey(pdType ProtocolDataType, key []byte) []byte {
 func GetPrefixProtocolDataKey(pdType ProtocolDataType) []byte {
 	return sdk.Uint64ToBigEndian(uint64(pdType))
 }
+
+// GetZoneValidatorScoresKey returns the key prefix of the validator scores of the given zone.
+func GetZoneValidatorScoresKey(chainID string) []byte {
+	return append(KeyPrefixValidatorScore, []byte(chainID)...)
+}
+
+// GetValidatorScoreKey returns the key of the score of the given validator.
+func GetValidatorScoreKey(chainID string, valoperAddress string) []byte {
+	return append(GetZoneValidatorScoresKey(chainID), []byte(valoperAddress)...)
+}
+
+// GetZoneHostProposalsKey returns the key prefix of the tracked host proposals of the given zone.
+func GetZoneHostProposalsKey(chainID string) []byte {
+	return append(KeyPrefixHostProposal, []byte(chainID)...)
+}
+
+// GetHostProposalKey returns the key of the given tracked host proposal.
+func GetHostProposalKey(chainID string, proposalID uint64) []byte {
+	return append(GetZoneHostProposalsKey(chainID), sdk.Uint64ToBigEndian(proposalID)...)
+}
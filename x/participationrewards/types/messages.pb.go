@@ -121,40 +121,43 @@ func init() {
 }
 
 var fileDescriptor_b87e3ea017f90b50 = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0xc7, 0xe3, 0xa4, 0xbf, 0xaa, 0xbf, 0x2b, 0xea, 0x70, 0x2a, 0xc8, 0x58, 0xc8, 0x8d, 0xd2,
-	0x81, 0x08, 0x29, 0x36, 0x71, 0x16, 0xd4, 0x80, 0x04, 0xa5, 0xd0, 0xa9, 0x52, 0xe5, 0x32, 0x31,
-	0x10, 0x5d, 0x9c, 0xe3, 0x38, 0x61, 0xfb, 0x1d, 0x77, 0x17, 0xd3, 0x32, 0x32, 0x31, 0x21, 0x24,
-	0xfe, 0x81, 0xae, 0xec, 0x2c, 0xfc, 0x07, 0x8c, 0x15, 0x2c, 0x8c, 0x28, 0x01, 0x89, 0x3f, 0x03,
-	0xf9, 0xec, 0x56, 0xb6, 0x14, 0xa1, 0x08, 0xb6, 0xbb, 0xe7, 0xfb, 0xbc, 0xf7, 0xfd, 0xbe, 0xf7,
-	0x8c, 0x82, 0x17, 0x53, 0x1e, 0x3d, 0x57, 0x3c, 0xce, 0xa8, 0xf4, 0x05, 0x91, 0x9a, 0x47, 0x5c,
-	0x10, 0xcd, 0x21, 0x95, 0xf4, 0x25, 0x91, 0x13, 0xe5, 0x67, 0x7d, 0x3f, 0xa1, 0x4a, 0x11, 0x46,
-	0x95, 0x27, 0x24, 0x68, 0xc0, 0xdb, 0x15, 0xc6, 0x5b, 0xc4, 0x78, 0x59, 0xdf, 0xb9, 0x1a, 0x81,
-	0x4a, 0x40, 0x8d, 0x0c, 0xe2, 0x17, 0x97, 0x82, 0x77, 0x36, 0x19, 0x30, 0x28, 0xe2, 0xf9, 0xa9,
-	0x8c, 0x5e, 0x63, 0x00, 0x2c, 0xa6, 0x3e, 0x11, 0xdc, 0x27, 0x69, 0x0a, 0xda, 0x64, 0x3c, 0x67,
-	0x6e, 0x56, 0x75, 0x46, 0x31, 0xe1, 0x89, 0x4a, 0x48, 0x4a, 0x18, 0x95, 0xb9, 0xc0, 0x5a, 0xa0,
-	0x24, 0x06, 0xcb, 0x38, 0x13, 0x12, 0x04, 0x28, 0x12, 0x97, 0x65, 0x3a, 0x6f, 0x9b, 0x68, 0xe3,
-	0x40, 0xb1, 0xa3, 0xe9, 0x38, 0xe1, 0xfa, 0x7e, 0x9e, 0x15, 0xdf, 0x46, 0x97, 0xa6, 0x8a, 0xca,
-	0x11, 0x99, 0x4c, 0x24, 0x55, 0xca, 0xb6, 0xda, 0x56, 0xf7, 0xff, 0x5d, 0xfb, 0xcb, 0xc7, 0xde,
-	0x66, 0xe9, 0xea, 0x5e, 0xf1, 0xe5, 0x48, 0x4b, 0x9e, 0xb2, 0xb0, 0xf6, 0x1a, 0x63, 0xb4, 0xf2,
-	0x0a, 0x52, 0x6a, 0x37, 0x73, 0x2a, 0x34, 0x67, 0xec, 0xa0, 0x35, 0x25, 0xa3, 0x91, 0x89, 0xb7,
-	0x4c, 0xfc, 0xe2, 0x8e, 0xf7, 0x11, 0x32, 0x66, 0x46, 0xfa, 0x44, 0x50, 0x7b, 0xa5, 0x6d, 0x75,
-	0x37, 0x82, 0xeb, 0x5e, 0xb5, 0xe1, 0x75, 0xaf, 0x59, 0xdf, 0x33, 0x32, 0x1f, 0x9d, 0x08, 0x1a,
-	0x56, 0x50, 0x3c, 0x44, 0xab, 0x42, 0x02, 0x3c, 0x55, 0xf6, 0x7f, 0xed, 0x56, 0x77, 0x3d, 0xd8,
-	0xfe, 0x73, 0x92, 0xc3, 0xfc, 0x6d, 0x58, 0x22, 0x3b, 0x6b, 0x6f, 0x4e, 0xb7, 0x1a, 0xbf, 0x4e,
-	0xb7, 0x1a, 0x1d, 0x1b, 0x5d, 0xa9, 0xf7, 0x23, 0xa4, 0x4a, 0x40, 0xaa, 0x68, 0xf0, 0xa1, 0x85,
-	0x5a, 0x07, 0x8a, 0xe1, 0x4f, 0x16, 0x5a, 0xaf, 0xf6, 0x6b, 0xe0, 0x2d, 0xb1, 0x1e, 0x5e, 0x3d,
-	0xa9, 0x33, 0xfc, 0x0b, 0xe8, 0x5c, 0x49, 0xe7, 0xd6, 0xeb, 0xaf, 0x3f, 0xde, 0x37, 0x83, 0x1d,
-	0xeb, 0x46, 0xa7, 0xe7, 0x57, 0xa7, 0xae, 0x8f, 0xcd, 0x8c, 0x17, 0xcd, 0xde, 0x34, 0x00, 0xff,
-	0xb4, 0xd0, 0xe5, 0x7d, 0xc8, 0x42, 0x9a, 0x40, 0x46, 0x0f, 0xf3, 0x0d, 0x88, 0x20, 0xde, 0x23,
-	0x9a, 0xe0, 0x3b, 0xcb, 0x0a, 0x5a, 0x88, 0x3b, 0x0f, 0xfe, 0x09, 0xbf, 0x70, 0xf6, 0xd0, 0x38,
-	0xbb, 0x9b, 0x3b, 0x1b, 0x2e, 0xeb, 0x4c, 0x9a, 0x74, 0xc5, 0x7f, 0x17, 0x41, 0x3c, 0x21, 0x9a,
-	0xec, 0x3e, 0xf9, 0x3c, 0x73, 0xad, 0xb3, 0x99, 0x6b, 0x7d, 0x9f, 0xb9, 0xd6, 0xbb, 0xb9, 0xdb,
-	0x38, 0x9b, 0xbb, 0x8d, 0x6f, 0x73, 0xb7, 0xf1, 0x78, 0x8f, 0x71, 0xfd, 0x6c, 0x3a, 0xf6, 0x22,
-	0x48, 0xaa, 0x05, 0x7a, 0xf9, 0x32, 0xd6, 0x2a, 0x1e, 0x2f, 0xae, 0x96, 0xef, 0x9a, 0x1a, 0xaf,
-	0x9a, 0x6a, 0x83, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xd7, 0x14, 0x51, 0x4e, 0x04, 0x00,
-	0x00,
+	// 573 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x77, 0xfa, 0x8f, 0x3a, 0x95, 0x1e, 0x42, 0x95, 0x18, 0x25, 0x2d, 0xe9, 0xc1, 0x52,
+	0x68, 0x62, 0x77, 0x2f, 0xb2, 0xab, 0xa0, 0xdd, 0xea, 0x0a, 0x52, 0x29, 0x59, 0x51, 0xf0, 0x60,
+	0x98, 0xcd, 0x8e, 0xe9, 0x60, 0x92, 0x37, 0xce, 0xcc, 0xa6, 0xad, 0x47, 0x4f, 0x9e, 0x44, 0xf0,
+	0x0b, 0xf4, 0xee, 0xd5, 0x8b, 0xdf, 0xc0, 0x63, 0xd1, 0x8b, 0x47, 0xd9, 0x55, 0xf0, 0x5b, 0x28,
+	0x99, 0xec, 0x2e, 0x09, 0xac, 0xb2, 0xfe, 0xb9, 0x65, 0xde, 0xcc, 0xf3, 0xbc, 0xcf, 0xef, 0x9d,
+	0x19, 0x5c, 0x7d, 0xd6, 0x63, 0xfe, 0x53, 0xc1, 0xc2, 0x94, 0x72, 0x27, 0x21, 0x5c, 0x32, 0x9f,
+	0x25, 0x44, 0x32, 0x88, 0x39, 0x3d, 0x24, 0xbc, 0x2b, 0x9c, 0x74, 0xdb, 0x89, 0xa8, 0x10, 0x24,
+	0xa0, 0xc2, 0x4e, 0x38, 0x48, 0xd0, 0xd6, 0x0b, 0x1a, 0x7b, 0x92, 0xc6, 0x4e, 0xb7, 0x8d, 0x0b,
+	0x3e, 0x88, 0x08, 0x84, 0xa7, 0x24, 0x4e, 0xbe, 0xc8, 0xf5, 0xc6, 0x4a, 0x00, 0x01, 0xe4, 0xf5,
+	0xec, 0x6b, 0x58, 0xbd, 0x14, 0x00, 0x04, 0x21, 0x75, 0x48, 0xc2, 0x1c, 0x12, 0xc7, 0x20, 0x95,
+	0xe3, 0x48, 0x73, 0xa5, 0x98, 0xd3, 0x0f, 0x09, 0x8b, 0x44, 0x44, 0x62, 0x12, 0x50, 0x9e, 0x05,
+	0x2c, 0x15, 0x86, 0x8a, 0xda, 0x34, 0x64, 0x09, 0x87, 0x04, 0x04, 0x09, 0x87, 0x6d, 0xac, 0x57,
+	0x33, 0x78, 0x79, 0x4f, 0x04, 0xed, 0x5e, 0x27, 0x62, 0xb2, 0x99, 0xb9, 0x6a, 0xd7, 0xf0, 0xd9,
+	0x9e, 0xa0, 0xdc, 0x23, 0xdd, 0x2e, 0xa7, 0x42, 0xe8, 0x68, 0x0d, 0x6d, 0x9c, 0xd9, 0xd1, 0x3f,
+	0xbe, 0xdb, 0x5a, 0x19, 0x52, 0xdd, 0xcc, 0xff, 0xb4, 0x25, 0x67, 0x71, 0xe0, 0x96, 0x76, 0x6b,
+	0x1a, 0x9e, 0x7b, 0x0e, 0x31, 0xd5, 0x67, 0x32, 0x95, 0xab, 0xbe, 0x35, 0x03, 0x2f, 0x0a, 0xee,
+	0x7b, 0xaa, 0x3e, 0xab, 0xea, 0xe3, 0xb5, 0xd6, 0xc2, 0x58, 0xc1, 0x78, 0xf2, 0x38, 0xa1, 0xfa,
+	0xdc, 0x1a, 0xda, 0x58, 0xae, 0x5e, 0xb6, 0x8b, 0x03, 0x2f, 0xb3, 0xa6, 0xdb, 0xb6, 0x8a, 0x79,
+	0xff, 0x38, 0xa1, 0x6e, 0x41, 0xaa, 0x35, 0xf0, 0x42, 0xc2, 0x01, 0x9e, 0x08, 0x7d, 0x7e, 0x6d,
+	0x76, 0x63, 0xa9, 0xba, 0xfe, 0x7b, 0x93, 0xfd, 0x6c, 0xaf, 0x3b, 0x94, 0xd4, 0x17, 0x5f, 0x9e,
+	0xac, 0x56, 0xbe, 0x9f, 0xac, 0x56, 0x2c, 0x1d, 0x9f, 0x2f, 0xcf, 0xc3, 0xa5, 0x22, 0x81, 0x58,
+	0xd0, 0xea, 0xdb, 0x79, 0x3c, 0xbb, 0x27, 0x02, 0xed, 0x3d, 0xc2, 0x4b, 0xc5, 0x79, 0xd5, 0xec,
+	0x29, 0xae, 0x87, 0x5d, 0x36, 0x35, 0x1a, 0x7f, 0x21, 0x1a, 0x25, 0xb1, 0xae, 0xbe, 0xf8, 0xf4,
+	0xf5, 0xcd, 0x4c, 0xd5, 0xda, 0x72, 0x8a, 0x47, 0x2e, 0x8f, 0xd4, 0x01, 0x4f, 0x3a, 0x78, 0x45,
+	0x5f, 0x47, 0x9b, 0xda, 0x37, 0x84, 0xcf, 0xb5, 0x20, 0x75, 0x69, 0x04, 0x29, 0xdd, 0xcf, 0x6e,
+	0x80, 0x0f, 0xe1, 0x2e, 0x91, 0x44, 0xbb, 0x3e, 0x6d, 0xa0, 0x89, 0x72, 0xe3, 0xd6, 0x3f, 0xc9,
+	0xc7, 0x64, 0xb7, 0x15, 0xd9, 0x8d, 0x3a, 0xda, 0xb4, 0x1a, 0xd3, 0xc2, 0x71, 0x65, 0x97, 0xbf,
+	0x3b, 0x1f, 0xc2, 0x6e, 0x46, 0xf3, 0x03, 0xe1, 0x8b, 0x2d, 0x48, 0xdb, 0x54, 0x3e, 0x20, 0x21,
+	0xeb, 0x12, 0x09, 0xbc, 0xed, 0x03, 0xa7, 0x0f, 0x29, 0x0b, 0x0e, 0xa4, 0xd0, 0x9a, 0x7f, 0x10,
+	0xf7, 0x57, 0x26, 0xc6, 0xdd, 0xff, 0x60, 0x32, 0x26, 0xbf, 0xa7, 0xc8, 0xef, 0x58, 0xcd, 0x69,
+	0xb1, 0x05, 0x95, 0x5e, 0x3a, 0xb2, 0xf4, 0x44, 0xe6, 0xe9, 0x1d, 0xe6, 0xa6, 0x75, 0xb4, 0xb9,
+	0xf3, 0xf8, 0x43, 0xdf, 0x44, 0xa7, 0x7d, 0x13, 0x7d, 0xe9, 0x9b, 0xe8, 0xf5, 0xc0, 0xac, 0x9c,
+	0x0e, 0xcc, 0xca, 0xe7, 0x81, 0x59, 0x79, 0xb4, 0x1b, 0x30, 0x79, 0xd0, 0xeb, 0xd8, 0x3e, 0x44,
+	0xc5, 0x5e, 0x5b, 0xd9, 0x73, 0x2c, 0x35, 0x3f, 0x9a, 0xdc, 0x38, 0x7b, 0x6d, 0xa2, 0xb3, 0xa0,
+	0xe6, 0x5d, 0xfb, 0x39, 0x00, 0xc1, 0xaf, 0x31, 0x12, 0x50, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -173,6 +176,9 @@ type MsgClient interface {
 	// SignalIntent defines a method for signalling voting intent for one or more
 	// validators.
 	GovRemoveProtocolData(ctx context.Context, in *MsgGovRemoveProtocolData, opts ...grpc.CallOption) (*MsgGovRemoveProtocolDataResponse, error)
+	// GovSetValidatorScoreWeights defines a governance method for setting the
+	// weights of the supplementary validator scoring inputs.
+	GovSetValidatorScoreWeights(ctx context.Context, in *MsgGovSetValidatorScoreWeights, opts ...grpc.CallOption) (*MsgGovSetValidatorScoreWeightsResponse, error)
 }
 
 type msgClient struct {
@@ -201,12 +207,24 @@ func (c *msgClient) GovRemoveProtocolData(ctx context.Context, in *MsgGovRemoveP
 	return out, nil
 }
 
+func (c *msgClient) GovSetValidatorScoreWeights(ctx context.Context, in *MsgGovSetValidatorScoreWeights, opts ...grpc.CallOption) (*MsgGovSetValidatorScoreWeightsResponse, error) {
+	out := new(MsgGovSetValidatorScoreWeightsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.participationrewards.v1.Msg/GovSetValidatorScoreWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SubmitClaim(context.Context, *MsgSubmitClaim) (*MsgSubmitClaimResponse, error)
 	// SignalIntent defines a method for signalling voting intent for one or more
 	// validators.
 	GovRemoveProtocolData(context.Context, *MsgGovRemoveProtocolData) (*MsgGovRemoveProtocolDataResponse, error)
+	// GovSetValidatorScoreWeights defines a governance method for setting the
+	// weights of the supplementary validator scoring inputs.
+	GovSetValidatorScoreWeights(context.Context, *MsgGovSetValidatorScoreWeights) (*MsgGovSetValidatorScoreWeightsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -219,6 +237,9 @@ func (*UnimplementedMsgServer) SubmitClaim(ctx context.Context, req *MsgSubmitCl
 func (*UnimplementedMsgServer) GovRemoveProtocolData(ctx context.Context, req *MsgGovRemoveProtocolData) (*MsgGovRemoveProtocolDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GovRemoveProtocolData not implemented")
 }
+func (*UnimplementedMsgServer) GovSetValidatorScoreWeights(ctx context.Context, req *MsgGovSetValidatorScoreWeights) (*MsgGovSetValidatorScoreWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovSetValidatorScoreWeights not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -260,6 +281,24 @@ func _Msg_GovRemoveProtocolData_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GovSetValidatorScoreWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovSetValidatorScoreWeights)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovSetValidatorScoreWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.participationrewards.v1.Msg/GovSetValidatorScoreWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovSetValidatorScoreWeights(ctx, req.(*MsgGovSetValidatorScoreWeights))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "quicksilver.participationrewards.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -272,6 +311,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GovRemoveProtocolData",
 			Handler:    _Msg_GovRemoveProtocolData_Handler,
 		},
+		{
+			MethodName: "GovSetValidatorScoreWeights",
+			Handler:    _Msg_GovSetValidatorScoreWeights_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "quicksilver/participationrewards/v1/messages.proto",
@@ -24,6 +24,7 @@ var (
 	_ legacytx.LegacyMsg = &MsgSubmitClaim{}
 
 	_ sdk.Msg = &MsgGovRemoveProtocolData{}
+	_ sdk.Msg = &MsgGovSetValidatorScoreWeights{}
 )
 
 // NewMsgSubmitClaim - construct a msg to submit a claim.
@@ -149,3 +150,45 @@ func (msg MsgGovRemoveProtocolData) ValidateBasic() error {
 	_, err := addressutils.AddressFromBech32(msg.Authority, "")
 	return err
 }
+
+// NewMsgGovSetValidatorScoreWeights - construct a governance proposal msg to set the validator score weights.
+func NewMsgGovSetValidatorScoreWeights(weights ValidatorScoreWeights, fromAddress sdk.Address) *MsgGovSetValidatorScoreWeights {
+	return &MsgGovSetValidatorScoreWeights{Weights: weights, Authority: fromAddress.String()}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGovSetValidatorScoreWeights) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGovSetValidatorScoreWeights) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{fromAddress}
+}
+
+// Validate.
+func (msg MsgGovSetValidatorScoreWeights) ValidateBasic() error {
+	// check title is non-empty
+	if msg.Title == "" {
+		return errors.New("title must not be empty")
+	}
+
+	// check description is non-empty
+	if msg.Description == "" {
+		return errors.New("description must not be empty")
+	}
+
+	if err := msg.Weights.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// check authority is non-empty
+	if msg.Authority == "" {
+		return errors.New("authority must not be empty")
+	}
+
+	// check authority bech32 is valid
+	_, err := addressutils.AddressFromBech32(msg.Authority, "")
+	return err
+}
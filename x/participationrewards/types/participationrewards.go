@@ -145,6 +145,9 @@ type Validator struct {
 	PowerPercentage   sdk.Dec
 	PerformanceScore  sdk.Dec
 	DistributionScore sdk.Dec
+	UptimeScore       sdk.Dec
+	CommissionScore   sdk.Dec
+	GovernanceScore   sdk.Dec
 
 	*icstypes.Validator
 }
@@ -10,15 +10,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -281,12 +285,214 @@ func (m *ProtocolData) GetData() encoding_json.RawMessage {
 	return nil
 }
 
+// ValidatorScoreWeights defines the weight given to each of the supplementary
+// validator scoring inputs. Each input score is in the range [0, 1], and the
+// overall validator score is reduced by the weighted shortfall of each input.
+// Zero weights (the default) leave the overall score unaffected.
+type ValidatorScoreWeights struct {
+	Uptime     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=uptime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime"`
+	Commission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission"`
+	Governance github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=governance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"governance"`
+}
+
+func (m *ValidatorScoreWeights) Reset()         { *m = ValidatorScoreWeights{} }
+func (m *ValidatorScoreWeights) String() string { return proto.CompactTextString(m) }
+func (*ValidatorScoreWeights) ProtoMessage()    {}
+func (*ValidatorScoreWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4fb4e5bb851c124, []int{4}
+}
+func (m *ValidatorScoreWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorScoreWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorScoreWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorScoreWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorScoreWeights.Merge(m, src)
+}
+func (m *ValidatorScoreWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorScoreWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorScoreWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorScoreWeights proto.InternalMessageInfo
+
+// ValidatorScore holds the host chain data gathered for a validator, and each
+// of the score components calculated from it at the last epoch.
+type ValidatorScore struct {
+	ChainId        string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ValoperAddress string `protobuf:"bytes,2,opt,name=valoper_address,json=valoperAddress,proto3" json:"valoper_address,omitempty"`
+	// missed_blocks is the missed blocks counter of the validator signing info.
+	MissedBlocks int64 `protobuf:"varint,3,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+	// commission_rate is the commission rate of the validator at the last epoch.
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	// previous_commission_rate is the commission rate of the validator at the
+	// epoch before last.
+	PreviousCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=previous_commission_rate,json=previousCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"previous_commission_rate"`
+	DistributionScore      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=distribution_score,json=distributionScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"distribution_score"`
+	PerformanceScore       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=performance_score,json=performanceScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"performance_score"`
+	UptimeScore            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=uptime_score,json=uptimeScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime_score"`
+	CommissionScore        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=commission_score,json=commissionScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_score"`
+	GovernanceScore        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=governance_score,json=governanceScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"governance_score"`
+	Score                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score"`
+}
+
+func (m *ValidatorScore) Reset()         { *m = ValidatorScore{} }
+func (m *ValidatorScore) String() string { return proto.CompactTextString(m) }
+func (*ValidatorScore) ProtoMessage()    {}
+func (*ValidatorScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4fb4e5bb851c124, []int{5}
+}
+func (m *ValidatorScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorScore.Merge(m, src)
+}
+func (m *ValidatorScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorScore proto.InternalMessageInfo
+
+func (m *ValidatorScore) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ValidatorScore) GetValoperAddress() string {
+	if m != nil {
+		return m.ValoperAddress
+	}
+	return ""
+}
+
+func (m *ValidatorScore) GetMissedBlocks() int64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
+// HostProposal is a governance proposal of a host chain, tracked to determine
+// the governance participation of the zone validators.
+type HostProposal struct {
+	ChainId       string    `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ProposalId    uint64    `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	VotingEndTime time.Time `protobuf:"bytes,3,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time"`
+	// voters are the valoper addresses of the validators that voted on the
+	// proposal.
+	Voters []string `protobuf:"bytes,4,rep,name=voters,proto3" json:"voters,omitempty"`
+	// votes_queried is set once the votes of the zone validators have been
+	// queried at or after the end of the voting period.
+	VotesQueried bool `protobuf:"varint,5,opt,name=votes_queried,json=votesQueried,proto3" json:"votes_queried,omitempty"`
+}
+
+func (m *HostProposal) Reset()         { *m = HostProposal{} }
+func (m *HostProposal) String() string { return proto.CompactTextString(m) }
+func (*HostProposal) ProtoMessage()    {}
+func (*HostProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4fb4e5bb851c124, []int{6}
+}
+func (m *HostProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostProposal.Merge(m, src)
+}
+func (m *HostProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostProposal proto.InternalMessageInfo
+
+func (m *HostProposal) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *HostProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *HostProposal) GetVotingEndTime() time.Time {
+	if m != nil {
+		return m.VotingEndTime
+	}
+	return time.Time{}
+}
+
+func (m *HostProposal) GetVoters() []string {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+func (m *HostProposal) GetVotesQueried() bool {
+	if m != nil {
+		return m.VotesQueried
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("quicksilver.participationrewards.v1.ProtocolDataType", ProtocolDataType_name, ProtocolDataType_value)
 	proto.RegisterType((*DistributionProportions)(nil), "quicksilver.participationrewards.v1.DistributionProportions")
 	proto.RegisterType((*Params)(nil), "quicksilver.participationrewards.v1.Params")
 	proto.RegisterType((*KeyedProtocolData)(nil), "quicksilver.participationrewards.v1.KeyedProtocolData")
 	proto.RegisterType((*ProtocolData)(nil), "quicksilver.participationrewards.v1.ProtocolData")
+	proto.RegisterType((*ValidatorScoreWeights)(nil), "quicksilver.participationrewards.v1.ValidatorScoreWeights")
+	proto.RegisterType((*ValidatorScore)(nil), "quicksilver.participationrewards.v1.ValidatorScore")
+	proto.RegisterType((*HostProposal)(nil), "quicksilver.participationrewards.v1.HostProposal")
 }
 
 func init() {
@@ -294,54 +500,77 @@ func init() {
 }
 
 var fileDescriptor_d4fb4e5bb851c124 = []byte{
-	// 741 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x3f, 0x4f, 0x1b, 0x49,
-	0x14, 0xf7, 0x82, 0x8f, 0x83, 0xc1, 0x70, 0xcb, 0xdc, 0x49, 0x80, 0x0f, 0xd6, 0x9c, 0x2f, 0x41,
-	0x09, 0x92, 0xed, 0x98, 0x74, 0x08, 0x45, 0xc2, 0x38, 0x45, 0x14, 0x50, 0xd0, 0xda, 0xa4, 0x48,
-	0x11, 0x6b, 0x3c, 0xfb, 0x30, 0x13, 0x8f, 0x77, 0x96, 0x99, 0xb5, 0x89, 0x23, 0xd1, 0xa4, 0xa2,
-	0x4c, 0x99, 0x32, 0x52, 0xbe, 0x42, 0x8a, 0x7c, 0x04, 0x4a, 0x94, 0x2a, 0x4a, 0x81, 0x22, 0x68,
-	0xf2, 0x19, 0x52, 0x44, 0xd1, 0xec, 0xda, 0x64, 0xe3, 0xac, 0x23, 0x0a, 0x2a, 0xbf, 0x7d, 0xef,
-	0x37, 0xbf, 0xdf, 0xfb, 0x37, 0x63, 0x74, 0xef, 0xa0, 0xcd, 0x68, 0x53, 0x31, 0xde, 0x01, 0x59,
-	0xf0, 0x88, 0xf4, 0x19, 0x65, 0x1e, 0xf1, 0x99, 0x70, 0x25, 0x1c, 0x12, 0xe9, 0xa8, 0x42, 0xa7,
-	0x18, 0xeb, 0xcf, 0x7b, 0x52, 0xf8, 0x02, 0xff, 0x1f, 0x39, 0x9f, 0x8f, 0xc5, 0x75, 0x8a, 0xe9,
-	0x79, 0x2a, 0x54, 0x4b, 0xa8, 0x5a, 0x70, 0xa4, 0x10, 0x7e, 0x84, 0xe7, 0xd3, 0xff, 0x34, 0x44,
-	0x43, 0x84, 0x7e, 0x6d, 0x85, 0xde, 0xec, 0xb7, 0x11, 0x34, 0x5b, 0x66, 0xca, 0x97, 0xac, 0xde,
-	0xd6, 0x5c, 0x3b, 0x52, 0x78, 0x42, 0x6a, 0x4b, 0xe1, 0x97, 0x06, 0xb2, 0x3a, 0x84, 0x33, 0x87,
-	0xf8, 0x42, 0xd6, 0x14, 0x70, 0xa0, 0x3a, 0x50, 0x23, 0x9c, 0x0b, 0x1a, 0x28, 0xcf, 0x19, 0x4b,
-	0xc6, 0xad, 0x89, 0xd2, 0xfa, 0xc9, 0x59, 0x26, 0xf1, 0xe9, 0x2c, 0xb3, 0xdc, 0x60, 0xfe, 0x7e,
-	0xbb, 0x9e, 0xa7, 0xa2, 0xd5, 0xd3, 0xee, 0xfd, 0xe4, 0x94, 0xd3, 0x2c, 0xf8, 0x5d, 0x0f, 0x54,
-	0xbe, 0x0c, 0xf4, 0xc3, 0xbb, 0x1c, 0xea, 0xa5, 0x56, 0x06, 0x6a, 0x2f, 0x5c, 0x6a, 0x54, 0xfa,
-	0x12, 0x1b, 0x97, 0x0a, 0xb8, 0x85, 0xfe, 0xde, 0x17, 0xdc, 0x61, 0x6e, 0x43, 0x45, 0x85, 0x47,
-	0xae, 0x41, 0x18, 0xf7, 0x89, 0x23, 0x72, 0x0c, 0xcd, 0x70, 0x41, 0x9b, 0x6d, 0x2f, 0x2a, 0x36,
-	0x7a, 0x0d, 0x62, 0x66, 0x48, 0xfb, 0x43, 0x6a, 0x2d, 0x79, 0xfc, 0x26, 0x93, 0xc8, 0xbe, 0x37,
-	0xd0, 0xd8, 0x0e, 0x91, 0xa4, 0xa5, 0xf0, 0x11, 0x9a, 0x73, 0x22, 0xa3, 0xd0, 0x43, 0xec, 0xcf,
-	0x22, 0x68, 0xf4, 0xe4, 0xea, 0x7a, 0xfe, 0x0a, 0x4b, 0x90, 0x1f, 0x32, 0xcf, 0x52, 0x52, 0x17,
-	0x60, 0xcf, 0x3a, 0x43, 0xc6, 0x7d, 0x13, 0x4d, 0x53, 0x4e, 0x58, 0x4b, 0xd5, 0xc0, 0x25, 0x75,
-	0x0e, 0x4e, 0xd0, 0xe4, 0x71, 0x7b, 0x2a, 0xf4, 0xde, 0x0f, 0x9d, 0x6b, 0xe3, 0x3a, 0xed, 0xd7,
-	0x3a, 0xf5, 0x23, 0x34, 0xf3, 0x10, 0xba, 0xe0, 0xec, 0xe8, 0x4d, 0xa2, 0x82, 0x97, 0x89, 0x4f,
-	0xb0, 0x89, 0x46, 0x9b, 0xd0, 0x0d, 0x17, 0xc3, 0xd6, 0x26, 0x7e, 0x8c, 0xa6, 0xbc, 0x1e, 0xa2,
-	0xe6, 0x10, 0x9f, 0x04, 0xb4, 0x93, 0xab, 0xc5, 0x2b, 0xd5, 0x12, 0xe5, 0xb6, 0x53, 0x5e, 0xe4,
-	0x2b, 0x5b, 0x45, 0xa9, 0x9f, 0x94, 0x31, 0x4a, 0xea, 0xe6, 0xf7, 0xa4, 0x03, 0x1b, 0xdf, 0x41,
-	0xc9, 0x4b, 0xc9, 0x54, 0x69, 0xe1, 0xeb, 0x59, 0x66, 0x0e, 0x5c, 0x2a, 0xf4, 0xd4, 0x0b, 0xcf,
-	0x94, 0x70, 0xf3, 0x36, 0x39, 0xdc, 0x06, 0xa5, 0x48, 0x03, 0xec, 0x00, 0xb9, 0xf2, 0x25, 0x89,
-	0xcc, 0x28, 0x6d, 0x55, 0xd3, 0x2c, 0xa2, 0xf9, 0x41, 0xdf, 0xae, 0xeb, 0xc0, 0x1e, 0x73, 0xc1,
-	0x31, 0x13, 0xd8, 0x42, 0xe9, 0xc1, 0xf0, 0xa6, 0x70, 0xdd, 0x70, 0x97, 0x4d, 0x03, 0xff, 0x87,
-	0x16, 0x07, 0xe3, 0x8f, 0xf4, 0x62, 0x30, 0x15, 0x4e, 0xde, 0x1c, 0xc1, 0x19, 0xf4, 0xef, 0x20,
-	0x64, 0x8b, 0x1d, 0xb4, 0x99, 0x53, 0x15, 0x4d, 0x70, 0xcd, 0xd1, 0x38, 0x40, 0x9f, 0x43, 0x08,
-	0x6e, 0x26, 0xf1, 0x12, 0x5a, 0xf8, 0x25, 0x09, 0x09, 0x8a, 0x82, 0xeb, 0x07, 0x88, 0x3f, 0xe2,
-	0x10, 0x15, 0xb6, 0x47, 0xf7, 0x09, 0x73, 0x03, 0xc4, 0x58, 0x5c, 0x21, 0xbb, 0x2d, 0x80, 0x5e,
-	0x96, 0x7f, 0xc6, 0x31, 0xe8, 0xb8, 0x0d, 0x0a, 0x64, 0x07, 0x94, 0x39, 0x8e, 0x97, 0x51, 0x36,
-	0x0e, 0xf1, 0xc0, 0xf5, 0x41, 0x82, 0xf2, 0x2b, 0x94, 0x70, 0x22, 0xcd, 0x09, 0x7c, 0x03, 0x2d,
-	0xc5, 0xe1, 0xaa, 0xc2, 0x27, 0xbc, 0x24, 0xa4, 0x14, 0x87, 0xca, 0x44, 0xc3, 0x50, 0xbb, 0x41,
-	0x53, 0x2a, 0x6d, 0xcf, 0xe3, 0x5d, 0x73, 0x12, 0xe7, 0xd0, 0xed, 0x38, 0xd4, 0x16, 0x74, 0x40,
-	0x92, 0x06, 0x6c, 0x0b, 0xa7, 0xcd, 0xa1, 0x44, 0x38, 0x71, 0x29, 0x98, 0x29, 0x9c, 0x45, 0xd6,
-	0xd0, 0x46, 0x85, 0x85, 0x4e, 0xe1, 0x22, 0xca, 0x0d, 0xc3, 0xf4, 0x8a, 0xdd, 0x70, 0x1c, 0x09,
-	0x4a, 0xf5, 0x69, 0xa7, 0xf1, 0x0a, 0x5a, 0xfe, 0x5d, 0xff, 0x37, 0x05, 0xeb, 0x67, 0xfc, 0x57,
-	0x3a, 0x79, 0xfc, 0xd6, 0x4a, 0x94, 0x9e, 0x9e, 0x9c, 0x5b, 0xc6, 0xe9, 0xb9, 0x65, 0x7c, 0x3e,
-	0xb7, 0x8c, 0x57, 0x17, 0x56, 0xe2, 0xf4, 0xc2, 0x4a, 0x7c, 0xbc, 0xb0, 0x12, 0x4f, 0xca, 0x91,
-	0x27, 0x26, 0x72, 0x4b, 0x72, 0x2f, 0x84, 0x0b, 0x51, 0x47, 0xe1, 0x79, 0xfc, 0x3f, 0x49, 0xf0,
-	0x08, 0xd5, 0xc7, 0x82, 0xeb, 0x72, 0xf7, 0x7b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0xc9, 0x6b,
-	0xe2, 0x7a, 0x06, 0x00, 0x00,
+	// 1113 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xb7, 0x63, 0xd7, 0x71, 0x9e, 0x9d, 0x64, 0x33, 0x40, 0xeb, 0x84, 0xd4, 0x0e, 0x2e, 0x84,
+	0x52, 0x29, 0x36, 0x29, 0xb7, 0xaa, 0x42, 0xaa, 0x93, 0x4a, 0x44, 0xa4, 0x22, 0x6c, 0x9c, 0x22,
+	0x21, 0xc4, 0x6a, 0xbc, 0xfb, 0xb2, 0x19, 0xbc, 0xde, 0xd9, 0xcc, 0xac, 0x37, 0x04, 0xa9, 0x17,
+	0x4e, 0x3d, 0xf6, 0xc8, 0x11, 0x89, 0x0f, 0xc0, 0x05, 0x09, 0x3e, 0x42, 0x8f, 0x15, 0x12, 0x12,
+	0xe2, 0x10, 0x50, 0x72, 0xe1, 0x33, 0x70, 0x40, 0x68, 0x76, 0xd6, 0xf1, 0x26, 0x38, 0x55, 0x0f,
+	0x7b, 0xf2, 0xcc, 0x9b, 0xdf, 0xfe, 0x7e, 0xf3, 0xfe, 0xcd, 0x8c, 0xe1, 0xc3, 0xc3, 0x21, 0xb3,
+	0xfb, 0x92, 0x79, 0x11, 0x8a, 0x76, 0x40, 0x45, 0xc8, 0x6c, 0x16, 0xd0, 0x90, 0x71, 0x5f, 0xe0,
+	0x11, 0x15, 0x8e, 0x6c, 0x47, 0xeb, 0x13, 0xed, 0xad, 0x40, 0xf0, 0x90, 0x93, 0x5b, 0xa9, 0xef,
+	0x5b, 0x13, 0x71, 0xd1, 0xfa, 0xd2, 0xa2, 0xcd, 0xe5, 0x80, 0x4b, 0x2b, 0xfe, 0xa4, 0xad, 0x27,
+	0xfa, 0xfb, 0xa5, 0xd7, 0x5d, 0xee, 0x72, 0x6d, 0x57, 0xa3, 0xc4, 0xda, 0x70, 0x39, 0x77, 0x3d,
+	0x6c, 0xc7, 0xb3, 0xde, 0x70, 0xbf, 0x1d, 0xb2, 0x01, 0xca, 0x90, 0x0e, 0x02, 0x0d, 0x68, 0xfe,
+	0x3b, 0x05, 0x37, 0x36, 0x99, 0x0c, 0x05, 0xeb, 0x0d, 0x95, 0xd8, 0x8e, 0xe0, 0x01, 0x17, 0x6a,
+	0x24, 0xc9, 0xb7, 0x79, 0xa8, 0x47, 0xd4, 0x63, 0x0e, 0x0d, 0xb9, 0xb0, 0x24, 0x7a, 0x68, 0xab,
+	0x05, 0x8b, 0x7a, 0x1e, 0xb7, 0xe3, 0xad, 0xd5, 0xf2, 0x2b, 0xf9, 0xdb, 0x33, 0x9d, 0xfb, 0xcf,
+	0x4f, 0x1a, 0xb9, 0x3f, 0x4e, 0x1a, 0xab, 0x2e, 0x0b, 0x0f, 0x86, 0xbd, 0x96, 0xcd, 0x07, 0xc9,
+	0xe6, 0x92, 0x9f, 0x35, 0xe9, 0xf4, 0xdb, 0xe1, 0x71, 0x80, 0xb2, 0xb5, 0x89, 0xf6, 0xaf, 0x3f,
+	0xad, 0x41, 0xb2, 0xf7, 0x4d, 0xb4, 0xcd, 0xe5, 0x73, 0x8d, 0xdd, 0x91, 0xc4, 0x83, 0x73, 0x05,
+	0x32, 0x80, 0xd7, 0x0e, 0xb8, 0xe7, 0x30, 0xdf, 0x95, 0x69, 0xe1, 0xa9, 0x0c, 0x84, 0xc9, 0x88,
+	0x38, 0x25, 0xc7, 0x60, 0xc1, 0xe3, 0x76, 0x7f, 0x18, 0xa4, 0xc5, 0x0a, 0x19, 0x88, 0x19, 0x9a,
+	0x76, 0x2c, 0x75, 0xaf, 0xf8, 0xf4, 0xfb, 0x46, 0xae, 0xf9, 0x4b, 0x1e, 0x4a, 0x3b, 0x54, 0xd0,
+	0x81, 0x24, 0x4f, 0xa0, 0xe6, 0xa4, 0x52, 0x61, 0x05, 0xe3, 0x5c, 0xc4, 0x81, 0xae, 0xdc, 0xbd,
+	0xdf, 0x7a, 0x85, 0x2a, 0x69, 0x5d, 0x91, 0xcf, 0x4e, 0x51, 0x39, 0x60, 0xde, 0x70, 0xae, 0x48,
+	0xf7, 0x3b, 0x30, 0x67, 0x7b, 0x94, 0x0d, 0xa4, 0x85, 0x3e, 0xed, 0x79, 0xe8, 0xc4, 0x41, 0x2e,
+	0x9b, 0xb3, 0xda, 0xfa, 0x50, 0x1b, 0xef, 0x95, 0xd5, 0xb6, 0xbf, 0x53, 0x5b, 0x7f, 0x02, 0x0b,
+	0x1f, 0xe3, 0x31, 0x3a, 0x3b, 0x82, 0x87, 0xdc, 0xe6, 0xde, 0x26, 0x0d, 0x29, 0x31, 0xa0, 0xd0,
+	0xc7, 0x63, 0x5d, 0x18, 0xa6, 0x1a, 0x92, 0xc7, 0x30, 0x1b, 0x24, 0x08, 0xcb, 0xa1, 0x21, 0x8d,
+	0x69, 0x2b, 0x77, 0xd7, 0x5f, 0xc9, 0x97, 0x34, 0xb7, 0x59, 0x0d, 0x52, 0xb3, 0x66, 0x17, 0xaa,
+	0x17, 0x94, 0x09, 0x14, 0x55, 0xf0, 0x13, 0xe9, 0x78, 0x4c, 0xde, 0x87, 0xe2, 0xb9, 0x64, 0xb5,
+	0xb3, 0xfc, 0xcf, 0x49, 0xa3, 0x86, 0xbe, 0xcd, 0x55, 0xd6, 0xdb, 0x5f, 0x49, 0xee, 0xb7, 0x4c,
+	0x7a, 0xf4, 0x08, 0xa5, 0xa4, 0x2e, 0x9a, 0x31, 0xb2, 0xf9, 0xe3, 0x14, 0xbc, 0xf1, 0xf8, 0xbc,
+	0x20, 0x6d, 0x2e, 0xf0, 0x33, 0x64, 0xee, 0x41, 0x28, 0x49, 0x17, 0x4a, 0xc3, 0x40, 0xf5, 0x4f,
+	0x26, 0x55, 0x9f, 0x70, 0x91, 0x2f, 0x00, 0x6c, 0x3e, 0x18, 0x30, 0x29, 0xb3, 0x2a, 0xeb, 0x14,
+	0x9f, 0x62, 0x77, 0x79, 0x84, 0xc2, 0xa7, 0xbe, 0x8d, 0x99, 0xd4, 0x71, 0x8a, 0x2f, 0xa9, 0xe0,
+	0x9f, 0xa7, 0x61, 0xee, 0x62, 0xc4, 0xc8, 0x22, 0x94, 0xed, 0x03, 0xca, 0x7c, 0x8b, 0x39, 0x49,
+	0x3a, 0xa6, 0xe3, 0xf9, 0x96, 0x43, 0xde, 0x85, 0xf9, 0x88, 0x7a, 0x3c, 0x40, 0x61, 0x51, 0xc7,
+	0x11, 0x28, 0xa5, 0x76, 0xda, 0x9c, 0x4b, 0xcc, 0x0f, 0xb4, 0x95, 0xdc, 0x82, 0x59, 0xe5, 0x05,
+	0x3a, 0x56, 0x4f, 0xb5, 0x8e, 0x8c, 0x77, 0x5f, 0x30, 0xab, 0xda, 0xd8, 0x89, 0x6d, 0x04, 0x61,
+	0x7e, 0xec, 0xad, 0x25, 0x68, 0x88, 0xb5, 0x62, 0x06, 0x4e, 0xce, 0x8d, 0x49, 0x4d, 0x1a, 0x22,
+	0x89, 0xa0, 0x16, 0x08, 0x8c, 0x18, 0x1f, 0x4a, 0xeb, 0xb2, 0xde, 0xb5, 0x0c, 0xf4, 0xae, 0x8f,
+	0xd8, 0x37, 0x2e, 0xea, 0xf6, 0x81, 0x5c, 0x38, 0x11, 0xa4, 0x8a, 0x6e, 0xad, 0x94, 0x81, 0xe2,
+	0x42, 0x9a, 0x57, 0x27, 0x8d, 0xc1, 0x42, 0x80, 0x62, 0x9f, 0x8b, 0x81, 0x4a, 0x6e, 0xa2, 0x35,
+	0x9d, 0xc5, 0xd1, 0x97, 0xa2, 0xd5, 0x52, 0x16, 0x54, 0x75, 0xf9, 0x27, 0x2a, 0xe5, 0x0c, 0x54,
+	0x2a, 0x9a, 0x51, 0x0b, 0xb8, 0x60, 0xa4, 0xf2, 0xa4, 0x45, 0x66, 0x32, 0x10, 0x49, 0x55, 0xdb,
+	0xb9, 0xd0, 0xb8, 0x21, 0x12, 0x21, 0xc8, 0x42, 0x68, 0xcc, 0xaa, 0x85, 0x4c, 0xb8, 0xa6, 0xd9,
+	0x2b, 0x19, 0xb0, 0x6b, 0xaa, 0xe6, 0x6f, 0x79, 0xa8, 0x7e, 0xc4, 0x65, 0x18, 0xdf, 0x02, 0x92,
+	0x7a, 0x2f, 0xeb, 0xdb, 0x06, 0x54, 0x82, 0x04, 0xa6, 0x56, 0x55, 0xcf, 0x16, 0x4d, 0x18, 0x99,
+	0xb6, 0x1c, 0xb2, 0x0d, 0xf3, 0x11, 0x0f, 0x99, 0xef, 0x5a, 0xe8, 0x3b, 0x56, 0x7c, 0x4e, 0x16,
+	0xe2, 0x83, 0x7e, 0xa9, 0xa5, 0x1f, 0x21, 0xad, 0xd1, 0x23, 0xa4, 0xd5, 0x1d, 0x3d, 0x42, 0x3a,
+	0x65, 0xe5, 0xc6, 0xb3, 0x3f, 0x1b, 0x79, 0x73, 0x56, 0x7f, 0xfc, 0xd0, 0x77, 0xd4, 0x2a, 0xb9,
+	0x0e, 0xa5, 0x88, 0x87, 0x28, 0x64, 0xad, 0xb8, 0x52, 0xb8, 0x3d, 0x63, 0x26, 0x33, 0x75, 0x2a,
+	0xa8, 0x91, 0xb4, 0x0e, 0x87, 0x28, 0x18, 0x3a, 0x71, 0xfb, 0x95, 0xcd, 0x6a, 0x6c, 0xfc, 0x54,
+	0xdb, 0xee, 0xfc, 0x5d, 0x04, 0x23, 0x7d, 0x35, 0x74, 0xd5, 0x55, 0x70, 0x13, 0x16, 0x2f, 0xdb,
+	0xf6, 0x7c, 0x07, 0xf7, 0x99, 0x8f, 0x8e, 0x91, 0x23, 0x75, 0x58, 0xba, 0xbc, 0xbc, 0xc1, 0x7d,
+	0x5f, 0xbf, 0x47, 0x8c, 0x3c, 0x79, 0x0b, 0x6e, 0x5e, 0x5e, 0xff, 0x44, 0xc5, 0x93, 0x49, 0x7d,
+	0x7b, 0x1b, 0x53, 0xa4, 0x01, 0x6f, 0x5e, 0x86, 0x6c, 0xb3, 0xc3, 0x21, 0x73, 0xba, 0xbc, 0x8f,
+	0xbe, 0x51, 0x98, 0x04, 0x18, 0x71, 0x70, 0xee, 0x19, 0x45, 0xb2, 0x02, 0xcb, 0xff, 0xdb, 0x84,
+	0x40, 0x69, 0xa3, 0x1f, 0xc6, 0x88, 0x6b, 0x93, 0x10, 0xbb, 0x6c, 0x3f, 0x4e, 0x52, 0x8c, 0x28,
+	0x4d, 0x72, 0x64, 0x6f, 0x80, 0x98, 0xec, 0x72, 0x7a, 0x12, 0x83, 0x5a, 0x37, 0x51, 0xa2, 0x88,
+	0x50, 0x1a, 0x65, 0xb2, 0x0a, 0xcd, 0x49, 0x88, 0x2d, 0x3f, 0x44, 0x81, 0x32, 0xdc, 0xb5, 0xa9,
+	0x47, 0x85, 0x31, 0x43, 0xde, 0x86, 0x95, 0x49, 0xb8, 0x2e, 0x0f, 0xa9, 0xd7, 0xe1, 0x42, 0xf0,
+	0x23, 0x69, 0xc0, 0x55, 0xa8, 0xbd, 0x38, 0x28, 0xbb, 0xc3, 0x20, 0xf0, 0x8e, 0x8d, 0x0a, 0x59,
+	0x83, 0xf7, 0x26, 0xa1, 0xb6, 0x31, 0x42, 0x41, 0x5d, 0x7c, 0xc4, 0x9d, 0xa1, 0x87, 0x1d, 0xea,
+	0xa9, 0x86, 0x30, 0xaa, 0xa4, 0x09, 0xf5, 0x2b, 0x03, 0xa5, 0x1d, 0x9d, 0x25, 0xeb, 0xb0, 0x76,
+	0x15, 0x26, 0x71, 0x36, 0xb9, 0x6a, 0x46, 0xb4, 0x73, 0xe4, 0x0e, 0xac, 0xbe, 0x2c, 0xfe, 0x1b,
+	0x9c, 0x8d, 0x76, 0x3c, 0xbf, 0x54, 0x7c, 0xfa, 0x43, 0x3d, 0xd7, 0xf9, 0xf2, 0xf9, 0x69, 0x3d,
+	0xff, 0xe2, 0xb4, 0x9e, 0xff, 0xeb, 0xb4, 0x9e, 0x7f, 0x76, 0x56, 0xcf, 0xbd, 0x38, 0xab, 0xe7,
+	0x7e, 0x3f, 0xab, 0xe7, 0x3e, 0xdf, 0x4c, 0x75, 0x66, 0xea, 0xa5, 0xb3, 0xf6, 0x0d, 0xf7, 0x31,
+	0x6d, 0x68, 0x7f, 0x3d, 0xf9, 0xef, 0x42, 0xdc, 0xbb, 0xbd, 0x52, 0xdc, 0x34, 0x1f, 0xfc, 0x37,
+	0x00, 0x7c, 0xd4, 0x7c, 0x44, 0x5f, 0x0c, 0x00, 0x00,
 }
 
 func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
@@ -519,118 +748,1157 @@ func (m *ProtocolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParticipationrewards(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParticipationrewards(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *DistributionProportions) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorScoreWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ValidatorSelectionAllocation.Size()
-	n += 1 + l + sovParticipationrewards(uint64(l))
-	l = m.HoldingsAllocation.Size()
-	n += 1 + l + sovParticipationrewards(uint64(l))
-	l = m.LockupAllocation.Size()
-	n += 1 + l + sovParticipationrewards(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.DistributionProportions.Size()
-	n += 1 + l + sovParticipationrewards(uint64(l))
-	if m.ClaimsEnabled {
-		n += 2
-	}
-	return n
+func (m *ValidatorScoreWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KeyedProtocolData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorScoreWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovParticipationrewards(uint64(l))
+	{
+		size := m.Governance.Size()
+		i -= size
+		if _, err := m.Governance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
 	}
-	if m.ProtocolData != nil {
-		l = m.ProtocolData.Size()
-		n += 1 + l + sovParticipationrewards(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ProtocolData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovParticipationrewards(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovParticipationrewards(uint64(l))
+func (m *ValidatorScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovParticipationrewards(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParticipationrewards(x uint64) (n int) {
-	return sovParticipationrewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ValidatorScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParticipationrewards
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *ValidatorScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.GovernanceScore.Size()
+		i -= size
+		if _, err := m.GovernanceScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.CommissionScore.Size()
+		i -= size
+		if _, err := m.CommissionScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		switch fieldNum {
-		case 1:
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.UptimeScore.Size()
+		i -= size
+		if _, err := m.UptimeScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.PerformanceScore.Size()
+		i -= size
+		if _, err := m.PerformanceScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.DistributionScore.Size()
+		i -= size
+		if _, err := m.DistributionScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PreviousCommissionRate.Size()
+		i -= size
+		if _, err := m.PreviousCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MissedBlocks != 0 {
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.ValoperAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotesQueried {
+		i--
+		if m.VotesQueried {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Voters[iNdEx])
+			copy(dAtA[i:], m.Voters[iNdEx])
+			i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.Voters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParticipationrewards(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.ProposalId != 0 {
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParticipationrewards(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParticipationrewards(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DistributionProportions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValidatorSelectionAllocation.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.HoldingsAllocation.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.LockupAllocation.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DistributionProportions.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	if m.ClaimsEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *KeyedProtocolData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	if m.ProtocolData != nil {
+		l = m.ProtocolData.Size()
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	return n
+}
+
+func (m *ProtocolData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorScoreWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Uptime.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.Governance.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	return n
+}
+
+func (m *ValidatorScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovParticipationrewards(uint64(m.MissedBlocks))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.PreviousCommissionRate.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.DistributionScore.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.PerformanceScore.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.UptimeScore.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.CommissionScore.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.GovernanceScore.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.Score.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	return n
+}
+
+func (m *HostProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovParticipationrewards(uint64(m.ProposalId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	if len(m.Voters) > 0 {
+		for _, s := range m.Voters {
+			l = len(s)
+			n += 1 + l + sovParticipationrewards(uint64(l))
+		}
+	}
+	if m.VotesQueried {
+		n += 2
+	}
+	return n
+}
+
+func sovParticipationrewards(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParticipationrewards(x uint64) (n int) {
+	return sovParticipationrewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionAllocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSelectionAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldingsAllocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HoldingsAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupAllocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockupAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimsEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyedProtocolData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyedProtocolData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyedProtocolData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProtocolData == nil {
+				m.ProtocolData = &ProtocolData{}
+			}
+			if err := m.ProtocolData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtocolData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorScoreWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorScoreWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorScoreWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Governance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Governance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			m.MissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionAllocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -658,13 +1926,13 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorSelectionAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HoldingsAllocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousCommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -692,13 +1960,13 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HoldingsAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockupAllocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionScore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -726,65 +1994,49 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockupAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DistributionScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceScore", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthParticipationrewards
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParticipationrewards
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.PerformanceScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeScore", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParticipationrewards
@@ -794,30 +2046,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParticipationrewards
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParticipationrewards
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UptimeScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimsEnabled", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionScore", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParticipationrewards
@@ -827,65 +2080,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ClaimsEnabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParticipationrewards
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KeyedProtocolData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParticipationrewards
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CommissionScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KeyedProtocolData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyedProtocolData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceScore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -913,13 +2130,15 @@ func (m *KeyedProtocolData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.GovernanceScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParticipationrewards
@@ -929,25 +2148,23 @@ func (m *KeyedProtocolData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParticipationrewards
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParticipationrewards
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProtocolData == nil {
-				m.ProtocolData = &ProtocolData{}
-			}
-			if err := m.ProtocolData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -972,7 +2189,7 @@ func (m *KeyedProtocolData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtocolData) Unmarshal(dAtA []byte) error {
+func (m *HostProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -995,15 +2212,15 @@ func (m *ProtocolData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtocolData: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtocolData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1031,13 +2248,32 @@ func (m *ProtocolData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParticipationrewards
@@ -1047,26 +2283,77 @@ func (m *ProtocolData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParticipationrewards
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParticipationrewards
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Voters = append(m.Voters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesQueried", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VotesQueried = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
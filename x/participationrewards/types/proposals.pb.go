@@ -186,11 +186,95 @@ func (m *MsgGovRemoveProtocolDataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgGovRemoveProtocolDataResponse proto.InternalMessageInfo
 
+// MsgGovSetValidatorScoreWeights sets the weights of the supplementary
+// validator scoring inputs.
+type MsgGovSetValidatorScoreWeights struct {
+	Title       string                `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Weights     ValidatorScoreWeights `protobuf:"bytes,3,opt,name=weights,proto3" json:"weights"`
+	Authority   string                `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgGovSetValidatorScoreWeights) Reset()         { *m = MsgGovSetValidatorScoreWeights{} }
+func (m *MsgGovSetValidatorScoreWeights) String() string { return proto.CompactTextString(m) }
+func (*MsgGovSetValidatorScoreWeights) ProtoMessage()    {}
+func (*MsgGovSetValidatorScoreWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d94433b2236a43ef, []int{4}
+}
+func (m *MsgGovSetValidatorScoreWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovSetValidatorScoreWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovSetValidatorScoreWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovSetValidatorScoreWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovSetValidatorScoreWeights.Merge(m, src)
+}
+func (m *MsgGovSetValidatorScoreWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovSetValidatorScoreWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovSetValidatorScoreWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovSetValidatorScoreWeights proto.InternalMessageInfo
+
+// MsgGovSetValidatorScoreWeightsResponse defines the
+// MsgGovSetValidatorScoreWeights response type.
+type MsgGovSetValidatorScoreWeightsResponse struct {
+}
+
+func (m *MsgGovSetValidatorScoreWeightsResponse) Reset() {
+	*m = MsgGovSetValidatorScoreWeightsResponse{}
+}
+func (m *MsgGovSetValidatorScoreWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovSetValidatorScoreWeightsResponse) ProtoMessage()    {}
+func (*MsgGovSetValidatorScoreWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d94433b2236a43ef, []int{5}
+}
+func (m *MsgGovSetValidatorScoreWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovSetValidatorScoreWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovSetValidatorScoreWeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovSetValidatorScoreWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovSetValidatorScoreWeightsResponse.Merge(m, src)
+}
+func (m *MsgGovSetValidatorScoreWeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovSetValidatorScoreWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovSetValidatorScoreWeightsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovSetValidatorScoreWeightsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AddProtocolDataProposal)(nil), "quicksilver.participationrewards.v1.AddProtocolDataProposal")
 	proto.RegisterType((*AddProtocolDataProposalWithDeposit)(nil), "quicksilver.participationrewards.v1.AddProtocolDataProposalWithDeposit")
 	proto.RegisterType((*MsgGovRemoveProtocolData)(nil), "quicksilver.participationrewards.v1.MsgGovRemoveProtocolData")
 	proto.RegisterType((*MsgGovRemoveProtocolDataResponse)(nil), "quicksilver.participationrewards.v1.MsgGovRemoveProtocolDataResponse")
+	proto.RegisterType((*MsgGovSetValidatorScoreWeights)(nil), "quicksilver.participationrewards.v1.MsgGovSetValidatorScoreWeights")
+	proto.RegisterType((*MsgGovSetValidatorScoreWeightsResponse)(nil), "quicksilver.participationrewards.v1.MsgGovSetValidatorScoreWeightsResponse")
 }
 
 func init() {
@@ -198,39 +282,44 @@ func init() {
 }
 
 var fileDescriptor_d94433b2236a43ef = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xbf, 0x6b, 0xdb, 0x40,
-	0x14, 0x96, 0x12, 0x3b, 0x3f, 0x2e, 0x21, 0x01, 0xd5, 0xd0, 0x6b, 0x06, 0xc9, 0x5c, 0x96, 0x42,
-	0x1b, 0x8b, 0x10, 0xe8, 0xe0, 0xa5, 0x24, 0x18, 0x3a, 0x05, 0xc2, 0x75, 0x28, 0x74, 0x68, 0xb9,
-	0x48, 0x87, 0x7c, 0xb5, 0xad, 0x77, 0xbd, 0x3b, 0x2b, 0x55, 0xff, 0x82, 0x8c, 0x1d, 0x3b, 0xfa,
-	0x8f, 0x28, 0xfd, 0x1b, 0xba, 0x14, 0x42, 0xa7, 0x4e, 0xa2, 0xd8, 0x43, 0x3b, 0x7b, 0xec, 0x54,
-	0xf4, 0xc3, 0x89, 0x86, 0x18, 0x8a, 0x37, 0xbd, 0xef, 0x7b, 0xdf, 0xbd, 0xf7, 0x7d, 0xba, 0x43,
-	0x27, 0xef, 0xc7, 0x22, 0x18, 0x68, 0x31, 0x4c, 0xb8, 0xf2, 0x25, 0x53, 0x46, 0x04, 0x42, 0x32,
-	0x23, 0x20, 0x56, 0xfc, 0x8a, 0xa9, 0x50, 0xfb, 0xc9, 0xb1, 0x2f, 0x15, 0x48, 0xd0, 0x6c, 0xa8,
-	0x3b, 0x52, 0x81, 0x01, 0xe7, 0xb0, 0x26, 0xea, 0xdc, 0x27, 0xea, 0x24, 0xc7, 0x07, 0x8f, 0x02,
-	0xd0, 0x23, 0xd0, 0x6f, 0x0b, 0x89, 0x5f, 0x16, 0xa5, 0xfe, 0xa0, 0x15, 0x41, 0x04, 0x25, 0x9e,
-	0x7f, 0x95, 0x28, 0xf9, 0x6d, 0xa3, 0x87, 0xa7, 0x61, 0x78, 0x91, 0x17, 0x01, 0x0c, 0x7b, 0xcc,
-	0xb0, 0x8b, 0x6a, 0xb0, 0xd3, 0x42, 0x4d, 0x23, 0xcc, 0x90, 0x63, 0xbb, 0x6d, 0x3f, 0xde, 0xa6,
-	0x65, 0xe1, 0xb4, 0xd1, 0x4e, 0xc8, 0x75, 0xa0, 0x84, 0xcc, 0x67, 0xe3, 0xb5, 0x82, 0xab, 0x43,
-	0xce, 0x21, 0x6a, 0x98, 0x54, 0x72, 0xdc, 0xc8, 0xa9, 0xb3, 0xfd, 0x79, 0xe6, 0xed, 0xa4, 0x6c,
-	0x34, 0xec, 0x92, 0x1c, 0x25, 0xb4, 0x20, 0x9d, 0xe7, 0xa8, 0x11, 0x32, 0xc3, 0x70, 0xb3, 0x68,
-	0x7a, 0x72, 0xd7, 0x94, 0xa3, 0xe4, 0x6f, 0xe6, 0x61, 0x1e, 0x07, 0x10, 0x8a, 0x38, 0xf2, 0xdf,
-	0x69, 0x88, 0x3b, 0x94, 0x5d, 0x9d, 0x73, 0xad, 0x59, 0xc4, 0x69, 0x21, 0x74, 0xda, 0x68, 0x7d,
-	0xc0, 0x53, 0xbc, 0x51, 0xe8, 0xf7, 0xe6, 0x99, 0x87, 0x4a, 0xfd, 0x80, 0xa7, 0x84, 0xe6, 0x54,
-	0x77, 0xf7, 0x7a, 0xe2, 0x59, 0x9f, 0x27, 0x9e, 0xf5, 0x67, 0xe2, 0x59, 0xe4, 0xfb, 0x1a, 0x22,
-	0x4b, 0x9c, 0xbe, 0x12, 0xa6, 0xdf, 0xe3, 0x12, 0xb4, 0x30, 0x2b, 0x9b, 0xf6, 0xd1, 0x96, 0xac,
-	0x8e, 0xc6, 0xeb, 0xc5, 0x4e, 0x0f, 0xe6, 0x99, 0xb7, 0x5f, 0xee, 0xb4, 0x60, 0x08, 0xbd, 0x6d,
-	0xfa, 0xbf, 0x94, 0x2a, 0x93, 0xcd, 0xa5, 0x26, 0x6f, 0x73, 0xcc, 0x73, 0xd8, 0x5d, 0x25, 0xc7,
-	0xa7, 0x68, 0x33, 0x2c, 0xbd, 0xe3, 0xcd, 0x62, 0x8c, 0x33, 0xcf, 0xbc, 0xbd, 0xea, 0x8c, 0x92,
-	0x20, 0x74, 0xd1, 0xd2, 0xdd, 0xba, 0x5e, 0xe4, 0xf9, 0xd5, 0x46, 0xf8, 0x5c, 0x47, 0x2f, 0x20,
-	0xa1, 0x7c, 0x04, 0x09, 0xaf, 0x07, 0xbb, 0x72, 0x8a, 0x95, 0xdf, 0xf5, 0xe5, 0x7e, 0x9f, 0xa1,
-	0x6d, 0x36, 0x36, 0x7d, 0x50, 0xc2, 0xa4, 0x55, 0x76, 0xf8, 0xc7, 0x97, 0xa3, 0x56, 0x75, 0xd7,
-	0x4f, 0xc3, 0x50, 0x71, 0xad, 0x5f, 0x1a, 0x25, 0xe2, 0x88, 0xde, 0xb5, 0xd6, 0x16, 0x27, 0xa8,
-	0xbd, 0x6c, 0x6f, 0xca, 0xb5, 0x84, 0x58, 0xf3, 0xb3, 0x37, 0xdf, 0xa6, 0xae, 0x7d, 0x33, 0x75,
-	0xed, 0x5f, 0x53, 0xd7, 0xfe, 0x34, 0x73, 0xad, 0x9b, 0x99, 0x6b, 0xfd, 0x9c, 0xb9, 0xd6, 0xeb,
-	0x5e, 0x24, 0x4c, 0x7f, 0x7c, 0xd9, 0x09, 0x60, 0xe4, 0xd7, 0x5e, 0xe4, 0xd1, 0x47, 0x88, 0x79,
-	0x1d, 0xf0, 0x3f, 0xdc, 0xff, 0xb2, 0xf3, 0xdf, 0xaa, 0x2f, 0x37, 0x8a, 0x6b, 0x70, 0xf2, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x29, 0xda, 0xf5, 0xae, 0x0a, 0x04, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xb1, 0x6b, 0xdb, 0x4e,
+	0x18, 0x95, 0x12, 0x27, 0x4e, 0xce, 0x21, 0x01, 0xfd, 0x0c, 0x3f, 0x35, 0x83, 0x64, 0x2e, 0x50,
+	0x02, 0x6d, 0x24, 0xd2, 0x40, 0x07, 0x0f, 0x2d, 0x31, 0x86, 0x4e, 0x81, 0x70, 0x86, 0x06, 0x32,
+	0xb4, 0x5c, 0xa4, 0x43, 0xbe, 0x5a, 0xd6, 0xa9, 0x77, 0x67, 0xbb, 0xee, 0x5f, 0x90, 0xb1, 0x63,
+	0x47, 0xff, 0x11, 0xa5, 0x7f, 0x43, 0x96, 0x42, 0xe8, 0xd4, 0x49, 0x14, 0x7b, 0x68, 0x67, 0x0f,
+	0x1d, 0x3a, 0x15, 0x9d, 0xe4, 0x44, 0x83, 0x5d, 0x8c, 0xe9, 0xa6, 0xef, 0xfb, 0xde, 0xfb, 0xee,
+	0xbd, 0x87, 0xee, 0xc0, 0xc9, 0xdb, 0x1e, 0xf5, 0x3a, 0x82, 0x86, 0x7d, 0xc2, 0xdd, 0x18, 0x73,
+	0x49, 0x3d, 0x1a, 0x63, 0x49, 0x59, 0xc4, 0xc9, 0x00, 0x73, 0x5f, 0xb8, 0xfd, 0x63, 0x37, 0xe6,
+	0x2c, 0x66, 0x02, 0x87, 0xc2, 0x89, 0x39, 0x93, 0xcc, 0x38, 0x28, 0x90, 0x9c, 0x79, 0x24, 0xa7,
+	0x7f, 0xbc, 0xff, 0xc0, 0x63, 0xa2, 0xcb, 0xc4, 0x6b, 0x45, 0x71, 0xb3, 0x22, 0xe3, 0xef, 0x57,
+	0x03, 0x16, 0xb0, 0xac, 0x9f, 0x7e, 0xe5, 0xdd, 0x67, 0x4b, 0x49, 0x99, 0x77, 0x9a, 0xe2, 0xc3,
+	0x1f, 0x3a, 0xf8, 0xff, 0xd4, 0xf7, 0xcf, 0xd3, 0xc2, 0x63, 0x61, 0x13, 0x4b, 0x7c, 0x9e, 0x0b,
+	0x37, 0xaa, 0x60, 0x43, 0x52, 0x19, 0x12, 0x53, 0xaf, 0xe9, 0x87, 0xdb, 0x28, 0x2b, 0x8c, 0x1a,
+	0xa8, 0xf8, 0x44, 0x78, 0x9c, 0xc6, 0xe9, 0x36, 0x73, 0x4d, 0xcd, 0x8a, 0x2d, 0xe3, 0x00, 0x94,
+	0xe4, 0x30, 0x26, 0x66, 0x29, 0x1d, 0x35, 0xf6, 0xa6, 0x89, 0x5d, 0x19, 0xe2, 0x6e, 0x58, 0x87,
+	0x69, 0x17, 0x22, 0x35, 0x34, 0x9e, 0x83, 0x92, 0x8f, 0x25, 0x36, 0x37, 0x14, 0xe8, 0xd1, 0x3d,
+	0x28, 0xed, 0xc2, 0xdf, 0x89, 0x6d, 0x92, 0xc8, 0x63, 0x3e, 0x8d, 0x02, 0xf7, 0x8d, 0x60, 0x91,
+	0x83, 0xf0, 0xe0, 0x8c, 0x08, 0x81, 0x03, 0x82, 0x14, 0xd1, 0xa8, 0x81, 0xf5, 0x0e, 0x19, 0x9a,
+	0x9b, 0x8a, 0xbf, 0x3b, 0x4d, 0x6c, 0x90, 0xf1, 0x3b, 0x64, 0x08, 0x51, 0x3a, 0xaa, 0xef, 0x5c,
+	0x8f, 0x6c, 0xed, 0xe3, 0xc8, 0xd6, 0x7e, 0x8e, 0x6c, 0x0d, 0x7e, 0x59, 0x03, 0x70, 0x81, 0xd3,
+	0x0b, 0x2a, 0xdb, 0x4d, 0x12, 0x33, 0x41, 0xe5, 0xca, 0xa6, 0x5d, 0xb0, 0x15, 0xe7, 0xab, 0xcd,
+	0x75, 0xa5, 0xe9, 0xbf, 0x69, 0x62, 0xef, 0x65, 0x9a, 0x66, 0x13, 0x88, 0xee, 0x40, 0xcb, 0xa5,
+	0x94, 0x9b, 0xdc, 0x58, 0x68, 0xf2, 0x2e, 0xc7, 0x34, 0x87, 0x9d, 0x55, 0x72, 0x7c, 0x0c, 0xca,
+	0x7e, 0xe6, 0xdd, 0x2c, 0xab, 0x63, 0x8c, 0x69, 0x62, 0xef, 0xe6, 0x3b, 0xb2, 0x01, 0x44, 0x33,
+	0x48, 0x7d, 0xeb, 0x7a, 0x96, 0xe7, 0x67, 0x1d, 0x98, 0x67, 0x22, 0x78, 0xc1, 0xfa, 0x88, 0x74,
+	0x59, 0x9f, 0x14, 0x83, 0x5d, 0x39, 0xc5, 0xdc, 0xef, 0xfa, 0x62, 0xbf, 0x4f, 0xc1, 0x36, 0xee,
+	0xc9, 0x36, 0xe3, 0x54, 0x0e, 0xf3, 0xec, 0xcc, 0xaf, 0x9f, 0x8e, 0xaa, 0xf9, 0x5d, 0x39, 0xf5,
+	0x7d, 0x4e, 0x84, 0x68, 0x49, 0x4e, 0xa3, 0x00, 0xdd, 0x43, 0x0b, 0xc2, 0x21, 0xa8, 0x2d, 0xd2,
+	0x8d, 0x88, 0x88, 0x59, 0x24, 0x08, 0xfc, 0xa5, 0x03, 0x2b, 0x03, 0xb5, 0x88, 0x7c, 0x89, 0x43,
+	0xea, 0x63, 0xc9, 0x78, 0xcb, 0x63, 0x9c, 0x5c, 0x10, 0x1a, 0xb4, 0xa5, 0x58, 0xd9, 0xe2, 0x25,
+	0x28, 0x0f, 0xb2, 0x15, 0xca, 0x66, 0xe5, 0x49, 0xdd, 0x59, 0xe2, 0x65, 0x70, 0xe6, 0x8a, 0x68,
+	0x94, 0x6e, 0x12, 0x5b, 0x43, 0xb3, 0x85, 0xff, 0x20, 0x9c, 0x43, 0xf0, 0xf0, 0xef, 0xbe, 0x67,
+	0x11, 0x35, 0x5e, 0xdd, 0x8c, 0x2d, 0xfd, 0x76, 0x6c, 0xe9, 0xdf, 0xc7, 0x96, 0xfe, 0x61, 0x62,
+	0x69, 0xb7, 0x13, 0x4b, 0xfb, 0x36, 0xb1, 0xb4, 0xcb, 0x66, 0x40, 0x65, 0xbb, 0x77, 0xe5, 0x78,
+	0xac, 0xeb, 0x16, 0xac, 0x1d, 0xbd, 0x67, 0x11, 0x29, 0x36, 0xdc, 0x77, 0xf3, 0x5f, 0xac, 0xf4,
+	0xcf, 0x17, 0x57, 0x9b, 0xea, 0xa6, 0x9c, 0xfc, 0x19, 0x00, 0x8c, 0xaa, 0x0f, 0x86, 0x6d, 0x05,
+	0x00, 0x00,
 }
 
 func (m *AddProtocolDataProposal) Marshal() (dAtA []byte, err error) {
@@ -437,6 +526,83 @@ func (m *MsgGovRemoveProtocolDataResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgGovSetValidatorScoreWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovSetValidatorScoreWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovSetValidatorScoreWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Weights.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovSetValidatorScoreWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovSetValidatorScoreWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovSetValidatorScoreWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposals(v)
 	base := offset
@@ -548,6 +714,38 @@ func (m *MsgGovRemoveProtocolDataResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgGovSetValidatorScoreWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.Weights.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGovSetValidatorScoreWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovProposals(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1268,6 +1466,235 @@ func (m *MsgGovRemoveProtocolDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgGovSetValidatorScoreWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovSetValidatorScoreWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovSetValidatorScoreWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovSetValidatorScoreWeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovSetValidatorScoreWeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovSetValidatorScoreWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposals(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -212,11 +212,113 @@ func (m *QueryProtocolDataResponse) GetData() []encoding_json.RawMessage {
 	return nil
 }
 
+// QueryValidatorScoresRequest is the request type for querying the validator
+// scores of a zone.
+type QueryValidatorScoresRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryValidatorScoresRequest) Reset()         { *m = QueryValidatorScoresRequest{} }
+func (m *QueryValidatorScoresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorScoresRequest) ProtoMessage()    {}
+func (*QueryValidatorScoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{4}
+}
+func (m *QueryValidatorScoresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorScoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorScoresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorScoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorScoresRequest.Merge(m, src)
+}
+func (m *QueryValidatorScoresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorScoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorScoresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorScoresRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorScoresRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+// QueryValidatorScoresResponse is the response type for querying the validator
+// scores of a zone.
+type QueryValidatorScoresResponse struct {
+	Weights ValidatorScoreWeights `protobuf:"bytes,1,opt,name=weights,proto3" json:"weights"`
+	Scores  []ValidatorScore      `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores"`
+}
+
+func (m *QueryValidatorScoresResponse) Reset()         { *m = QueryValidatorScoresResponse{} }
+func (m *QueryValidatorScoresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorScoresResponse) ProtoMessage()    {}
+func (*QueryValidatorScoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{5}
+}
+func (m *QueryValidatorScoresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorScoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorScoresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorScoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorScoresResponse.Merge(m, src)
+}
+func (m *QueryValidatorScoresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorScoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorScoresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorScoresResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorScoresResponse) GetWeights() ValidatorScoreWeights {
+	if m != nil {
+		return m.Weights
+	}
+	return ValidatorScoreWeights{}
+}
+
+func (m *QueryValidatorScoresResponse) GetScores() []ValidatorScore {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "quicksilver.participationrewards.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "quicksilver.participationrewards.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryProtocolDataRequest)(nil), "quicksilver.participationrewards.v1.QueryProtocolDataRequest")
 	proto.RegisterType((*QueryProtocolDataResponse)(nil), "quicksilver.participationrewards.v1.QueryProtocolDataResponse")
+	proto.RegisterType((*QueryValidatorScoresRequest)(nil), "quicksilver.participationrewards.v1.QueryValidatorScoresRequest")
+	proto.RegisterType((*QueryValidatorScoresResponse)(nil), "quicksilver.participationrewards.v1.QueryValidatorScoresResponse")
 }
 
 func init() {
@@ -224,35 +326,43 @@ func init() {
 }
 
 var fileDescriptor_bc16b3ccc632b3de = []byte{
-	// 447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xc1, 0x8a, 0xd3, 0x40,
-	0x1c, 0xc6, 0x33, 0xdb, 0x5a, 0x70, 0x76, 0x0f, 0x32, 0xee, 0x21, 0x06, 0x49, 0x97, 0x78, 0x59,
-	0x28, 0x9b, 0x61, 0x77, 0x0f, 0x8a, 0x60, 0x5d, 0xca, 0x22, 0x78, 0x10, 0x34, 0x47, 0x11, 0x71,
-	0x36, 0x1d, 0xe2, 0xd8, 0x74, 0x26, 0xcd, 0x4c, 0xba, 0xc6, 0xd2, 0x8b, 0x4f, 0x20, 0xfa, 0x22,
-	0x3e, 0x46, 0x8f, 0x05, 0x11, 0x3c, 0x15, 0x69, 0x7d, 0x02, 0x8f, 0x9e, 0x64, 0x26, 0x39, 0xb4,
-	0x34, 0x87, 0x74, 0x6f, 0x7f, 0xfe, 0xc9, 0xf7, 0xfd, 0xbe, 0x6f, 0xfe, 0x10, 0x8f, 0x32, 0x16,
-	0x0e, 0x24, 0x8b, 0xc7, 0x34, 0xc5, 0x09, 0x49, 0x15, 0x0b, 0x59, 0x42, 0x14, 0x13, 0x3c, 0xa5,
-	0xd7, 0x24, 0xed, 0x4b, 0x3c, 0x3e, 0xc5, 0xa3, 0x8c, 0xa6, 0xb9, 0x9f, 0xa4, 0x42, 0x09, 0xf4,
-	0x60, 0x4d, 0xe0, 0x57, 0x09, 0xfc, 0xf1, 0xa9, 0x73, 0x18, 0x89, 0x48, 0x98, 0xff, 0xb1, 0x9e,
-	0x0a, 0xa9, 0x73, 0x3f, 0x12, 0x22, 0x8a, 0x29, 0x26, 0x09, 0xc3, 0x84, 0x73, 0xa1, 0x8c, 0x4c,
-	0x96, 0x5f, 0xbb, 0x75, 0x92, 0x54, 0x02, 0x8d, 0xde, 0x3b, 0x84, 0xe8, 0x95, 0xce, 0xf9, 0x92,
-	0xa4, 0x64, 0x28, 0x03, 0x3a, 0xca, 0xa8, 0x54, 0xde, 0x3b, 0x78, 0x77, 0x63, 0x2b, 0x13, 0xc1,
-	0x25, 0x45, 0xcf, 0x61, 0x2b, 0x31, 0x1b, 0x1b, 0x1c, 0x81, 0xe3, 0xfd, 0xb3, 0x8e, 0x5f, 0xa3,
-	0x96, 0x5f, 0x98, 0xf4, 0x9a, 0xb3, 0x45, 0xdb, 0x0a, 0x4a, 0x03, 0xef, 0x02, 0xda, 0x05, 0x41,
-	0xa7, 0x08, 0x45, 0x7c, 0x49, 0x14, 0x29, 0xe9, 0x08, 0xc1, 0xa6, 0xca, 0x13, 0x6a, 0x20, 0xb7,
-	0x03, 0x33, 0xa3, 0x3b, 0xb0, 0x31, 0xa0, 0xb9, 0xbd, 0x67, 0x56, 0x7a, 0xf4, 0xde, 0xc0, 0x7b,
-	0x15, 0x0e, 0x65, 0xd2, 0xa7, 0xb0, 0xd9, 0x27, 0x8a, 0xd8, 0xe0, 0xa8, 0x71, 0x7c, 0xd0, 0xeb,
-	0xfc, 0x5d, 0xb4, 0xf7, 0x73, 0x32, 0x8c, 0x1f, 0x7b, 0x7a, 0xeb, 0xfd, 0x5b, 0xb4, 0x6d, 0xca,
-	0x43, 0xd1, 0x67, 0x3c, 0xc2, 0x1f, 0xa4, 0xe0, 0x7e, 0x40, 0xae, 0x5f, 0x50, 0x29, 0x49, 0x44,
-	0x03, 0x23, 0x3c, 0xfb, 0xda, 0x80, 0xb7, 0x8c, 0x3d, 0xfa, 0x0e, 0x60, 0xab, 0xa8, 0x80, 0x1e,
-	0xd6, 0xea, 0xbb, 0xfd, 0x9e, 0xce, 0xa3, 0xdd, 0x85, 0x45, 0x11, 0xef, 0xfc, 0xf3, 0x8f, 0x3f,
-	0xdf, 0xf6, 0x4e, 0x50, 0x07, 0xd7, 0x3c, 0xb4, 0xce, 0xf9, 0x13, 0xc0, 0x83, 0xf5, 0x67, 0x41,
-	0x4f, 0x76, 0xe0, 0x6f, 0x1f, 0xc4, 0xe9, 0xde, 0x54, 0x5e, 0x96, 0x78, 0x66, 0x4a, 0x5c, 0xa0,
-	0x6e, 0xbd, 0x12, 0xa5, 0x85, 0xbe, 0x03, 0x9e, 0xe8, 0xeb, 0x4f, 0xf1, 0x64, 0x40, 0xf3, 0x69,
-	0xef, 0xed, 0x6c, 0xe9, 0x82, 0xf9, 0xd2, 0x05, 0xbf, 0x97, 0x2e, 0xf8, 0xb2, 0x72, 0xad, 0xf9,
-	0xca, 0xb5, 0x7e, 0xad, 0x5c, 0xeb, 0xf5, 0x65, 0xc4, 0xd4, 0xfb, 0xec, 0xca, 0x0f, 0xc5, 0x70,
-	0x9d, 0x71, 0xf2, 0x49, 0x70, 0xba, 0x01, 0xfd, 0x58, 0x8d, 0xd5, 0x14, 0x79, 0xd5, 0x32, 0xe8,
-	0xf3, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x07, 0x81, 0x87, 0x6f, 0xdf, 0x03, 0x00, 0x00,
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x6a, 0x13, 0x41,
+	0x18, 0xcf, 0x36, 0x31, 0xd5, 0x49, 0x41, 0x19, 0x7b, 0xd8, 0xc6, 0xb2, 0x09, 0xeb, 0x25, 0x10,
+	0xba, 0x43, 0x93, 0x83, 0xa5, 0x60, 0x8c, 0xa1, 0x88, 0x3d, 0x08, 0x36, 0x82, 0x42, 0x11, 0xeb,
+	0x74, 0x77, 0xd8, 0x8c, 0x49, 0x76, 0x36, 0x3b, 0x93, 0xc4, 0x35, 0xe4, 0xe2, 0x13, 0x08, 0xbe,
+	0x88, 0x8f, 0xd1, 0x8b, 0x50, 0x10, 0xc1, 0x53, 0x28, 0x89, 0x4f, 0x20, 0x78, 0xf1, 0x24, 0x3b,
+	0xbb, 0x0b, 0x89, 0xdd, 0xc3, 0xb6, 0xde, 0x26, 0x5f, 0xf6, 0xf7, 0xef, 0x9b, 0xdf, 0x00, 0x34,
+	0x18, 0x52, 0xb3, 0xcb, 0x69, 0x6f, 0x44, 0x3c, 0xe4, 0x62, 0x4f, 0x50, 0x93, 0xba, 0x58, 0x50,
+	0xe6, 0x78, 0x64, 0x8c, 0x3d, 0x8b, 0xa3, 0xd1, 0x2e, 0x1a, 0x0c, 0x89, 0xe7, 0x1b, 0xae, 0xc7,
+	0x04, 0x83, 0xf7, 0x97, 0x00, 0x46, 0x12, 0xc0, 0x18, 0xed, 0x16, 0x37, 0x6d, 0x66, 0x33, 0xf9,
+	0x3d, 0x0a, 0x4e, 0x21, 0xb4, 0xb8, 0x6d, 0x33, 0x66, 0xf7, 0x08, 0xc2, 0x2e, 0x45, 0xd8, 0x71,
+	0x98, 0x90, 0x30, 0x1e, 0xfd, 0xdb, 0x48, 0xe3, 0x24, 0x51, 0x50, 0xe2, 0xf5, 0x4d, 0x00, 0x8f,
+	0x02, 0x9f, 0xcf, 0xb1, 0x87, 0xfb, 0xbc, 0x4d, 0x06, 0x43, 0xc2, 0x85, 0xfe, 0x16, 0xdc, 0x5d,
+	0x99, 0x72, 0x97, 0x39, 0x9c, 0xc0, 0x43, 0x90, 0x77, 0xe5, 0x44, 0x55, 0xca, 0x4a, 0xa5, 0x50,
+	0xab, 0x1a, 0x29, 0x62, 0x19, 0x21, 0x49, 0x2b, 0x77, 0x36, 0x2b, 0x65, 0xda, 0x11, 0x81, 0xde,
+	0x04, 0x6a, 0xa8, 0x10, 0xb8, 0x30, 0x59, 0xef, 0x00, 0x0b, 0x1c, 0xa9, 0x43, 0x08, 0x72, 0xc2,
+	0x77, 0x89, 0x14, 0xb9, 0xd5, 0x96, 0x67, 0x78, 0x07, 0x64, 0xbb, 0xc4, 0x57, 0xd7, 0xe4, 0x28,
+	0x38, 0xea, 0xaf, 0xc1, 0x56, 0x02, 0x43, 0xe4, 0xf4, 0x11, 0xc8, 0x59, 0x58, 0x60, 0x55, 0x29,
+	0x67, 0x2b, 0x1b, 0xad, 0xea, 0xaf, 0x59, 0xa9, 0xe0, 0xe3, 0x7e, 0x6f, 0x5f, 0x0f, 0xa6, 0xfa,
+	0x9f, 0x59, 0x49, 0x25, 0x8e, 0xc9, 0x2c, 0xea, 0xd8, 0xe8, 0x1d, 0x67, 0x8e, 0xd1, 0xc6, 0xe3,
+	0x67, 0x84, 0x73, 0x6c, 0x93, 0xb6, 0x04, 0xea, 0x7b, 0xe0, 0x9e, 0x64, 0x7f, 0x89, 0x7b, 0xd4,
+	0xc2, 0x82, 0x79, 0x2f, 0x4c, 0xe6, 0x91, 0x78, 0x41, 0x70, 0x0b, 0xdc, 0x34, 0x3b, 0x98, 0x3a,
+	0x27, 0xd4, 0x8a, 0x6c, 0xae, 0xcb, 0xdf, 0x87, 0x96, 0xfe, 0x55, 0x01, 0xdb, 0xc9, 0xd0, 0xc8,
+	0xdb, 0x31, 0x58, 0x1f, 0x13, 0x6a, 0x77, 0x44, 0xbc, 0xc6, 0xfd, 0x54, 0x6b, 0x5c, 0xa5, 0x7b,
+	0x15, 0x32, 0x44, 0x5b, 0x8d, 0x09, 0xe1, 0x11, 0xc8, 0x73, 0xa9, 0xa6, 0xae, 0x95, 0xb3, 0x95,
+	0x42, 0xad, 0x7e, 0x0d, 0xea, 0xf8, 0xa6, 0x42, 0xa2, 0xda, 0xef, 0x1c, 0xb8, 0x21, 0xf3, 0xc0,
+	0x2f, 0x0a, 0xc8, 0x87, 0x97, 0x09, 0x1f, 0xa4, 0xe2, 0xbd, 0xdc, 0xac, 0xe2, 0xde, 0xd5, 0x81,
+	0xe1, 0xda, 0xf4, 0xfa, 0xc7, 0x6f, 0x3f, 0x3f, 0xaf, 0xed, 0xc0, 0x2a, 0x4a, 0x59, 0xf9, 0xc0,
+	0xe7, 0x77, 0x05, 0x6c, 0x2c, 0x17, 0x04, 0x3e, 0xbc, 0x82, 0xfe, 0xe5, 0x6a, 0x16, 0x1b, 0xd7,
+	0x85, 0x47, 0x21, 0x9e, 0xc8, 0x10, 0x4d, 0xd8, 0x48, 0x17, 0x22, 0xa2, 0x08, 0x1a, 0x89, 0x26,
+	0xc1, 0x3b, 0x98, 0xa2, 0x49, 0x97, 0xf8, 0x53, 0x78, 0xa1, 0x80, 0xdb, 0xff, 0xf4, 0x0b, 0x36,
+	0xd3, 0x7b, 0x4b, 0x6e, 0x75, 0xf1, 0xf1, 0x7f, 0x30, 0x44, 0x01, 0x9f, 0xca, 0x80, 0x2d, 0xd8,
+	0x4c, 0x15, 0x70, 0x14, 0xb3, 0x9c, 0x84, 0x65, 0x43, 0x93, 0xf8, 0x55, 0x4d, 0x5b, 0x6f, 0xce,
+	0xe6, 0x9a, 0x72, 0x3e, 0xd7, 0x94, 0x8b, 0xb9, 0xa6, 0x7c, 0x5a, 0x68, 0x99, 0xf3, 0x85, 0x96,
+	0xf9, 0xb1, 0xd0, 0x32, 0xc7, 0x07, 0x36, 0x15, 0x9d, 0xe1, 0xa9, 0x61, 0xb2, 0xfe, 0xb2, 0xca,
+	0xce, 0x07, 0xe6, 0x90, 0x15, 0xd9, 0xf7, 0xc9, 0xc2, 0xc1, 0x22, 0xf9, 0x69, 0x5e, 0x6e, 0xb7,
+	0xfe, 0x77, 0x00, 0x7c, 0xa7, 0x5b, 0x67, 0xcc, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -271,6 +381,9 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// ProtocolData returns the requested protocol data.
 	ProtocolData(ctx context.Context, in *QueryProtocolDataRequest, opts ...grpc.CallOption) (*QueryProtocolDataResponse, error)
+	// ValidatorScores returns the validator score weights, and the score
+	// components of each validator of the given zone.
+	ValidatorScores(ctx context.Context, in *QueryValidatorScoresRequest, opts ...grpc.CallOption) (*QueryValidatorScoresResponse, error)
 }
 
 type queryClient struct {
@@ -299,12 +412,24 @@ func (c *queryClient) ProtocolData(ctx context.Context, in *QueryProtocolDataReq
 	return out, nil
 }
 
+func (c *queryClient) ValidatorScores(ctx context.Context, in *QueryValidatorScoresRequest, opts ...grpc.CallOption) (*QueryValidatorScoresResponse, error) {
+	out := new(QueryValidatorScoresResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.participationrewards.v1.Query/ValidatorScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of participation rewards parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// ProtocolData returns the requested protocol data.
 	ProtocolData(context.Context, *QueryProtocolDataRequest) (*QueryProtocolDataResponse, error)
+	// ValidatorScores returns the validator score weights, and the score
+	// components of each validator of the given zone.
+	ValidatorScores(context.Context, *QueryValidatorScoresRequest) (*QueryValidatorScoresResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -317,6 +442,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) ProtocolData(ctx context.Context, req *QueryProtocolDataRequest) (*QueryProtocolDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProtocolData not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorScores(ctx context.Context, req *QueryValidatorScoresRequest) (*QueryValidatorScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorScores not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -358,6 +486,24 @@ func _Query_ProtocolData_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.participationrewards.v1.Query/ValidatorScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorScores(ctx, req.(*QueryValidatorScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "quicksilver.participationrewards.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -370,6 +516,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProtocolData",
 			Handler:    _Query_ProtocolData_Handler,
 		},
+		{
+			MethodName: "ValidatorScores",
+			Handler:    _Query_ValidatorScores_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "quicksilver/participationrewards/v1/query.proto",
@@ -500,6 +650,83 @@ func (m *QueryProtocolDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryValidatorScoresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorScoresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorScoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorScoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorScoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorScoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Weights.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -563,6 +790,36 @@ func (m *QueryProtocolDataResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorScoresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorScoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Weights.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -898,6 +1155,205 @@ func (m *QueryProtocolDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidatorScoresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorScoresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorScoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorScoresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorScoresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorScoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scores = append(m.Scores, ValidatorScore{})
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ingenuity-build/multierror"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HostProposalLookback is the number of concluded host chain proposals that
+// are considered when determining validator governance participation.
+const HostProposalLookback = 10
+
+// DefaultValidatorScoreWeights returns zero weights, such that validators are
+// scored on distribution and performance only.
+func DefaultValidatorScoreWeights() ValidatorScoreWeights {
+	return ValidatorScoreWeights{
+		Uptime:     sdk.ZeroDec(),
+		Commission: sdk.ZeroDec(),
+		Governance: sdk.ZeroDec(),
+	}
+}
+
+// ValidateBasic ensures each weight is in the range [0, 1] and that the weights
+// do not sum to more than 1.
+func (w *ValidatorScoreWeights) ValidateBasic() error {
+	errs := make(map[string]error)
+
+	for name, weight := range map[string]sdk.Dec{
+		"Uptime":     w.Uptime,
+		"Commission": w.Commission,
+		"Governance": w.Governance,
+	} {
+		switch {
+		case weight.IsNil():
+			errs[name] = ErrUndefinedAttribute
+		case weight.IsNegative():
+			errs[name] = ErrNegativeAttribute
+		case weight.GT(sdk.OneDec()):
+			errs[name] = fmt.Errorf("weight must not exceed 1.0, got %v", weight)
+		}
+	}
+
+	// no errors yet: check total weight
+	if len(errs) == 0 {
+		if total := w.Total(); total.GT(sdk.OneDec()) {
+			errs["TotalWeight"] = fmt.Errorf("total weight must not exceed 1.0, got %v", total)
+		}
+	}
+
+	if len(errs) > 0 {
+		return multierror.New(errs)
+	}
+
+	return nil
+}
+
+func (w *ValidatorScoreWeights) Total() sdk.Dec {
+	return w.Uptime.Add(w.Commission).Add(w.Governance)
+}
+
+// Modifier returns the factor applied to the distribution and performance
+// score of a validator: one, less the weighted shortfall of each of the
+// supplementary input scores.
+func (w *ValidatorScoreWeights) Modifier(uptime, commission, governance sdk.Dec) sdk.Dec {
+	shortfall := w.Uptime.Mul(sdk.OneDec().Sub(uptime)).
+		Add(w.Commission.Mul(sdk.OneDec().Sub(commission))).
+		Add(w.Governance.Mul(sdk.OneDec().Sub(governance)))
+	return clampScore(sdk.OneDec().Sub(shortfall))
+}
+
+// UptimeScore returns the proportion of the signed blocks window in which the
+// validator signed. Absent a window, the validator is given the full score.
+func UptimeScore(missedBlocks, signedBlocksWindow int64) sdk.Dec {
+	if signedBlocksWindow <= 0 {
+		return sdk.OneDec()
+	}
+	return clampScore(sdk.OneDec().Sub(sdk.NewDec(missedBlocks).QuoInt64(signedBlocksWindow)))
+}
+
+// CommissionScore penalises a commission increase between epochs by the
+// proportion of the remaining headroom it consumed; e.g. an increase from 5%
+// to 100% scores zero. Unchanged or decreased commission scores one.
+func CommissionScore(previous, current sdk.Dec) sdk.Dec {
+	if previous.IsNil() || current.IsNil() || current.LTE(previous) {
+		return sdk.OneDec()
+	}
+	headroom := sdk.OneDec().Sub(previous)
+	if !headroom.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return clampScore(sdk.OneDec().Sub(current.Sub(previous).Quo(headroom)))
+}
+
+// GovernanceScore returns the proportion of concluded host proposals the
+// validator voted on. Absent any proposals, the validator is given the full
+// score.
+func GovernanceScore(voted, total int) sdk.Dec {
+	if total == 0 {
+		return sdk.OneDec()
+	}
+	return clampScore(sdk.NewDec(int64(voted)).QuoInt64(int64(total)))
+}
+
+func clampScore(score sdk.Dec) sdk.Dec {
+	if score.IsNegative() {
+		return sdk.ZeroDec()
+	}
+	if score.GT(sdk.OneDec()) {
+		return sdk.OneDec()
+	}
+	return score
+}
+
+// HasVoted returns true if the given validator voted on the proposal.
+func (p *HostProposal) HasVoted(valoperAddress string) bool {
+	for _, voter := range p.Voters {
+		if voter == valoperAddress {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+func TestValidatorScoreWeights_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights types.ValidatorScoreWeights
+		wantErr bool
+	}{
+		{
+			"blank",
+			types.ValidatorScoreWeights{},
+			true,
+		},
+		{
+			"negative",
+			types.ValidatorScoreWeights{Uptime: sdk.MustNewDecFromStr("-0.1"), Commission: sdk.ZeroDec(), Governance: sdk.ZeroDec()},
+			true,
+		},
+		{
+			"total_gt_one",
+			types.ValidatorScoreWeights{Uptime: sdk.MustNewDecFromStr("0.5"), Commission: sdk.MustNewDecFromStr("0.5"), Governance: sdk.MustNewDecFromStr("0.1")},
+			true,
+		},
+		{
+			"default",
+			types.DefaultValidatorScoreWeights(),
+			false,
+		},
+		{
+			"valid",
+			types.ValidatorScoreWeights{Uptime: sdk.MustNewDecFromStr("0.5"), Commission: sdk.MustNewDecFromStr("0.25"), Governance: sdk.MustNewDecFromStr("0.25")},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.weights.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidatorScoreComponents(t *testing.T) {
+	requireDecEqual(t, sdk.OneDec(), types.UptimeScore(10, 0))
+	requireDecEqual(t, sdk.MustNewDecFromStr("0.9"), types.UptimeScore(10, 100))
+	requireDecEqual(t, sdk.ZeroDec(), types.UptimeScore(200, 100))
+
+	requireDecEqual(t, sdk.OneDec(), types.CommissionScore(sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.05")))
+	requireDecEqual(t, sdk.MustNewDecFromStr("0.5"), types.CommissionScore(sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.55")))
+	requireDecEqual(t, sdk.ZeroDec(), types.CommissionScore(sdk.MustNewDecFromStr("0.05"), sdk.OneDec()))
+
+	requireDecEqual(t, sdk.OneDec(), types.GovernanceScore(0, 0))
+	requireDecEqual(t, sdk.MustNewDecFromStr("0.25"), types.GovernanceScore(1, 4))
+
+	weights := types.ValidatorScoreWeights{Uptime: sdk.MustNewDecFromStr("0.5"), Commission: sdk.MustNewDecFromStr("0.25"), Governance: sdk.MustNewDecFromStr("0.25")}
+	requireDecEqual(t, sdk.OneDec(), weights.Modifier(sdk.OneDec(), sdk.OneDec(), sdk.OneDec()))
+	requireDecEqual(t, sdk.MustNewDecFromStr("0.75"), weights.Modifier(sdk.MustNewDecFromStr("0.5"), sdk.OneDec(), sdk.OneDec()))
+	requireDecEqual(t, sdk.ZeroDec(), weights.Modifier(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()))
+
+	defaults := types.DefaultValidatorScoreWeights()
+	requireDecEqual(t, sdk.OneDec(), defaults.Modifier(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()))
+}
+
+func requireDecEqual(t *testing.T, want, got sdk.Dec) {
+	t.Helper()
+	require.True(t, want.Equal(got), "expected %v, got %v", want, got)
+}
+
+func TestMsgGovSetValidatorScoreWeights_ValidateBasic(t *testing.T) {
+	authority := addressutils.GenerateAccAddressForTest().String()
+	valid := types.ValidatorScoreWeights{Uptime: sdk.MustNewDecFromStr("0.5"), Commission: sdk.ZeroDec(), Governance: sdk.ZeroDec()}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgGovSetValidatorScoreWeights
+		wantErr bool
+	}{
+		{"blank", types.MsgGovSetValidatorScoreWeights{}, true},
+		{"invalid_weights", types.MsgGovSetValidatorScoreWeights{Title: "title", Description: "desc", Weights: types.ValidatorScoreWeights{}, Authority: authority}, true},
+		{"invalid_authority", types.MsgGovSetValidatorScoreWeights{Title: "title", Description: "desc", Weights: valid, Authority: "quick123"}, true},
+		{"valid", types.MsgGovSetValidatorScoreWeights{Title: "title", Description: "desc", Weights: valid, Authority: authority}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}